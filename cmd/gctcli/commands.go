@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -723,6 +725,142 @@ func getOrderbooks(_ *cli.Context) error {
 	return nil
 }
 
+var getOrderbookAnalyticsCommand = cli.Command{
+	Name:      "getorderbookanalytics",
+	Usage:     "gets mid price, spread, liquidity, imbalance and impact cost analytics for an orderbook",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getOrderbookAnalytics,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the orderbook analytics for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the orderbook analytics for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		cli.Float64Flag{
+			Name:  "liquidity_percentage",
+			Usage: "sums liquidity priced within this percentage of the mid price",
+			Value: 1,
+		},
+		cli.Int64Flag{
+			Name:  "imbalance_levels",
+			Usage: "the number of levels used to calculate imbalance, 0 uses the full book",
+			Value: 10,
+		},
+		cli.StringFlag{
+			Name:  "impact_amounts",
+			Usage: "comma separated base amounts used to build the buy and sell impact cost curves e.g. 0.1,1,10",
+		},
+		cli.StringFlag{
+			Name:  "depth_amounts",
+			Usage: "comma separated cumulative base amounts to find the bid and ask price levels for",
+		},
+	},
+}
+
+func getOrderbookAnalytics(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getorderbookanalytics")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	impactAmounts, err := parseFloats(c.String("impact_amounts"))
+	if err != nil {
+		return fmt.Errorf("invalid impact_amounts: %w", err)
+	}
+	depthAmounts, err := parseFloats(c.String("depth_amounts"))
+	if err != nil {
+		return fmt.Errorf("invalid depth_amounts: %w", err)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderbookAnalytics(context.Background(),
+		&gctrpc.GetOrderbookAnalyticsRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:           assetType,
+			LiquidityPercentage: c.Float64("liquidity_percentage"),
+			ImbalanceLevels:     c.Int64("imbalance_levels"),
+			ImpactAmounts:       impactAmounts,
+			DepthAmounts:        depthAmounts,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+// parseFloats parses a comma separated list of floats
+func parseFloats(list string) ([]float64, error) {
+	if list == "" {
+		return nil, nil
+	}
+	split := strings.Split(list, ",")
+	resp := make([]float64, len(split))
+	for i := range split {
+		f, err := strconv.ParseFloat(strings.TrimSpace(split[i]), 64)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = f
+	}
+	return resp, nil
+}
+
 var getAccountInfoCommand = cli.Command{
 	Name:      "getaccountinfo",
 	Usage:     "gets the exchange account balance info",
@@ -2830,6 +2968,23 @@ var withdrawalRequestCommand = cli.Command{
 			},
 			Action: withdrawlRequestByDate,
 		},
+		{
+			Name:      "bystatus",
+			Usage:     "status limit",
+			ArgsUsage: "<status> <limit>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "status",
+					Usage: "approval status: pending, approved or rejected",
+					Value: "pending",
+				},
+				cli.Int64Flag{
+					Name:  "limit",
+					Usage: "max number of withdrawals to return",
+				},
+			},
+			Action: withdrawlRequestByStatus,
+		},
 	},
 }
 
@@ -3013,33 +3168,24 @@ func withdrawlRequestByDate(c *cli.Context) error {
 	return nil
 }
 
-var getLoggerDetailsCommand = cli.Command{
-	Name:      "getloggerdetails",
-	Usage:     "gets an individual loggers details",
-	ArgsUsage: "<logger>",
-	Action:    getLoggerDetails,
-	Flags: []cli.Flag{
-		cli.StringFlag{
-			Name:  "logger",
-			Usage: "logger to get level details of",
-		},
-	},
-}
-
-func getLoggerDetails(c *cli.Context) error {
-	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "getloggerdetails")
-	}
-
-	var logger string
-	if c.IsSet("logger") {
-		logger = c.String("logger")
-	} else {
-		logger = c.Args().First()
+func withdrawlRequestByStatus(c *cli.Context) error {
+	status := c.String("status")
+	if !c.IsSet("status") && c.Args().First() != "" {
+		status = c.Args().First()
 	}
 
-	if logger == "" {
-		return errors.New("a logger must be specified")
+	var limit int64
+	if c.IsSet("limit") {
+		limit = c.Int64("limit")
+	} else if c.Args().Get(1) != "" {
+		var err error
+		limit, err = strconv.ParseInt(c.Args().Get(1), 10, 64)
+		if err != nil {
+			return err
+		}
+		if limit > math.MaxInt32 {
+			return fmt.Errorf("limit greater than max size: %v", math.MaxInt32)
+		}
 	}
 
 	conn, err := setupClient()
@@ -3049,10 +3195,10 @@ func getLoggerDetails(c *cli.Context) error {
 	defer conn.Close()
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-
-	result, err := client.GetLoggerDetails(context.Background(),
-		&gctrpc.GetLoggerDetailsRequest{
-			Logger: logger,
+	result, err := client.WithdrawalEventsByApprovalStatus(context.Background(),
+		&gctrpc.WithdrawalEventsByApprovalStatusRequest{
+			Status: status,
+			Limit:  int32(limit),
 		},
 	)
 	if err != nil {
@@ -3062,49 +3208,58 @@ func getLoggerDetails(c *cli.Context) error {
 	return nil
 }
 
-var setLoggerDetailsCommand = cli.Command{
-	Name:      "setloggerdetails",
-	Usage:     "sets an individual loggers details",
-	ArgsUsage: "<logger> <flags>",
-	Action:    setLoggerDetails,
+var depositHistoryCommand = cli.Command{
+	Name:      "deposithistory",
+	Usage:     "retrieve deposits stored by the deposit tracker or an exchange's deposit history",
+	ArgsUsage: "<exchange> <currency> <limit>",
+	Action:    getDepositHistory,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  "logger",
-			Usage: "logger to get level details of",
+			Name:  "exchange",
+			Usage: "exchange name",
 		},
 		cli.StringFlag{
-			Name:  "flags",
-			Usage: "pipe separated value of loggers e.g INFO|WARN",
+			Name:  "currency",
+			Usage: "the deposit currency, required when database support is disabled",
+		},
+		cli.Int64Flag{
+			Name:  "limit",
+			Usage: "max number of deposits to return",
 		},
 	},
 }
 
-func setLoggerDetails(c *cli.Context) error {
+func getDepositHistory(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "setloggerdetails")
+		return cli.ShowCommandHelp(c, "deposithistory")
 	}
 
-	var logger string
-	var level string
-
-	if c.IsSet("logger") {
-		logger = c.String("logger")
+	var exchange string
+	if c.IsSet("exchange") {
+		exchange = c.String("exchange")
 	} else {
-		logger = c.Args().First()
-	}
-
-	if logger == "" {
-		return errors.New("a logger must be specified")
+		exchange = c.Args().First()
 	}
 
-	if c.IsSet("level") {
-		level = c.String("level")
+	var code string
+	if c.IsSet("currency") {
+		code = c.String("currency")
 	} else {
-		level = c.Args().Get(1)
+		code = c.Args().Get(1)
 	}
 
-	if level == "" {
-		return errors.New("level must be specified")
+	var limit int64
+	if c.IsSet("limit") {
+		limit = c.Int64("limit")
+	} else if c.Args().Get(2) != "" {
+		var err error
+		limit, err = strconv.ParseInt(c.Args().Get(2), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+	if limit > math.MaxInt32 {
+		return fmt.Errorf("limit greater than max size: %v", math.MaxInt32)
 	}
 
 	conn, err := setupClient()
@@ -3114,11 +3269,11 @@ func setLoggerDetails(c *cli.Context) error {
 	defer conn.Close()
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-
-	result, err := client.SetLoggerDetails(context.Background(),
-		&gctrpc.SetLoggerDetailsRequest{
-			Logger: logger,
-			Level:  level,
+	result, err := client.DepositEventsByExchange(context.Background(),
+		&gctrpc.DepositEventsByExchangeRequest{
+			Exchange: exchange,
+			Currency: code,
+			Limit:    int32(limit),
 		},
 	)
 	if err != nil {
@@ -3128,71 +3283,81 @@ func setLoggerDetails(c *cli.Context) error {
 	return nil
 }
 
-var getOrderbookStreamCommand = cli.Command{
-	Name:      "getorderbookstream",
-	Usage:     "gets the orderbook stream for a specific currency pair and exchange",
-	ArgsUsage: "<exchange> <pair> <asset>",
-	Action:    getOrderbookStream,
+var getRebalancePlanCommand = cli.Command{
+	Name:      "getrebalanceplan",
+	Usage:     "returns the transfers which would restore a currency's target allocation across exchanges",
+	ArgsUsage: "<currency>",
+	Action:    getRebalancePlan,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  "exchange",
-			Usage: "the exchange to get the orderbook from",
+			Name:  "currency",
+			Usage: "the currency to rebalance",
 		},
+	},
+}
+
+var executeRebalancePlanCommand = cli.Command{
+	Name:      "executerebalanceplan",
+	Usage:     "submits the transfers of a reviewed rebalance plan as withdrawals",
+	ArgsUsage: "<currency> <planid>",
+	Action:    executeRebalancePlan,
+	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  "pair",
-			Usage: "currency pair",
+			Name:  "currency",
+			Usage: "the currency to rebalance",
 		},
 		cli.StringFlag{
-			Name:  "asset",
-			Usage: "the asset type of the currency pair",
+			Name:  "planid",
+			Usage: "the id of the plan returned by getrebalanceplan, refused if the plan has since changed",
 		},
 	},
 }
 
-func getOrderbookStream(c *cli.Context) error {
+func getRebalancePlan(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "getorderbookstream")
+		return cli.ShowCommandHelp(c, "getrebalanceplan")
 	}
 
-	var exchangeName string
-	var pair string
-	var assetType string
-
-	if c.IsSet("exchange") {
-		exchangeName = c.String("exchange")
+	var code string
+	if c.IsSet("currency") {
+		code = c.String("currency")
 	} else {
-		exchangeName = c.Args().First()
+		code = c.Args().First()
 	}
 
-	if !validExchange(exchangeName) {
-		return errInvalidExchange
+	conn, err := setupClient()
+	if err != nil {
+		return err
 	}
+	defer conn.Close()
 
-	if c.IsSet("pair") {
-		pair = c.String("pair")
-	} else {
-		pair = c.Args().Get(1)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetRebalancePlan(context.Background(),
+		&gctrpc.RebalancePlanRequest{Currency: code})
+	if err != nil {
+		return err
 	}
+	jsonOutput(result)
+	return nil
+}
 
-	if !validPair(pair) {
-		return errInvalidPair
+func executeRebalancePlan(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "executerebalanceplan")
 	}
 
-	if c.IsSet("asset") {
-		assetType = c.String("asset")
+	var code string
+	if c.IsSet("currency") {
+		code = c.String("currency")
 	} else {
-		assetType = c.Args().Get(2)
-	}
-
-	assetType = strings.ToLower(assetType)
-
-	if !validAsset(assetType) {
-		return errInvalidAsset
+		code = c.Args().First()
 	}
 
-	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
-	if err != nil {
-		return err
+	var planID string
+	if c.IsSet("planid") {
+		planID = c.String("planid")
+	} else {
+		planID = c.Args().Get(1)
 	}
 
 	conn, err := setupClient()
@@ -3202,7 +3367,636 @@ func getOrderbookStream(c *cli.Context) error {
 	defer conn.Close()
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.GetOrderbookStream(context.Background(),
+	result, err := client.ExecuteRebalancePlan(context.Background(),
+		&gctrpc.ExecuteRebalancePlanRequest{
+			Currency: code,
+			PlanId:   planID,
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var getPortfolioHistoryCommand = cli.Command{
+	Name:      "getportfoliohistory",
+	Usage:     "gets the stored portfolio valuations and profit and loss between the start and end times",
+	ArgsUsage: "<fiatcurrency> <start> <end>",
+	Action:    getPortfolioHistory,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "fiatcurrency, f",
+			Usage: "the fiat currency the portfolio was valued in, defaults to the portfolio history currency",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().AddDate(0, 0, -7).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+	},
+}
+
+func getPortfolioHistory(c *cli.Context) error {
+	var fiat string
+	if c.IsSet("fiatcurrency") {
+		fiat = c.String("fiatcurrency")
+	} else {
+		fiat = c.Args().First()
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(1) != "" {
+			startTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(2) != "" {
+			endTime = c.Args().Get(2)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetPortfolioHistory(context.Background(),
+		&gctrpc.GetPortfolioHistoryRequest{
+			FiatCurrency: fiat,
+			Start:        negateLocalOffset(s),
+			End:          negateLocalOffset(e),
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var getTaxReportCommand = cli.Command{
+	Name:      "taxreport",
+	Usage:     "calculates the realised gains for a tax year and exports the disposals to a CSV file",
+	ArgsUsage: "<year> <method> <fiatcurrency>",
+	Action:    getTaxReport,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  "year, y",
+			Usage: "the calendar year the tax year starts in",
+			Value: int64(time.Now().Year()),
+		},
+		cli.StringFlag{
+			Name:  "method, m",
+			Usage: "the lot matching method: fifo, lifo, hifo or average",
+			Value: "fifo",
+		},
+		cli.StringFlag{
+			Name:  "fiatcurrency, f",
+			Usage: "the fiat currency to report in, defaults to the portfolio history currency",
+		},
+		cli.StringFlag{
+			Name:  "yearstart",
+			Usage: "the month and day the tax year starts on in MM-DD format",
+			Value: "01-01",
+		},
+		cli.BoolFlag{
+			Name:  "exchangehistory",
+			Usage: "includes order history fetched from each exchange",
+		},
+		cli.StringFlag{
+			Name:  "output, o",
+			Usage: "the CSV file to write, defaults to taxreport_<year>.csv",
+		},
+	},
+}
+
+func getTaxReport(c *cli.Context) error {
+	year := c.Int64("year")
+	if !c.IsSet("year") && c.Args().First() != "" {
+		var err error
+		year, err = strconv.ParseInt(c.Args().First(), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	method := c.String("method")
+	if !c.IsSet("method") && c.Args().Get(1) != "" {
+		method = c.Args().Get(1)
+	}
+
+	fiat := c.String("fiatcurrency")
+	if !c.IsSet("fiatcurrency") {
+		fiat = c.Args().Get(2)
+	}
+
+	output := c.String("output")
+	if output == "" {
+		output = fmt.Sprintf("taxreport_%d.csv", year)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTaxReport(context.Background(),
+		&gctrpc.GetTaxReportRequest{
+			FiatCurrency:           fiat,
+			Method:                 method,
+			Year:                   year,
+			YearStart:              c.String("yearstart"),
+			IncludeExchangeHistory: c.Bool("exchangehistory"),
+		})
+	if err != nil {
+		return err
+	}
+
+	err = writeTaxReportCSV(output, result)
+	if err != nil {
+		return err
+	}
+	jsonOutput(struct {
+		File          string  `json:"file"`
+		FiatCurrency  string  `json:"fiat_currency"`
+		Method        string  `json:"method"`
+		Start         string  `json:"start"`
+		End           string  `json:"end"`
+		Disposals     int     `json:"disposals"`
+		TotalProceeds float64 `json:"total_proceeds"`
+		TotalCost     float64 `json:"total_cost"`
+		TotalGain     float64 `json:"total_gain"`
+	}{
+		File:          output,
+		FiatCurrency:  result.FiatCurrency,
+		Method:        result.Method,
+		Start:         result.Start,
+		End:           result.End,
+		Disposals:     len(result.Disposals),
+		TotalProceeds: result.TotalProceeds,
+		TotalCost:     result.TotalCost,
+		TotalGain:     result.TotalGain,
+	})
+	return nil
+}
+
+// writeTaxReportCSV writes a row per disposal followed by the totals
+func writeTaxReportCSV(path string, r *gctrpc.GetTaxReportResponse) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	formatFloat := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	w := csv.NewWriter(f)
+	records := [][]string{{
+		"currency",
+		"exchange",
+		"amount",
+		"acquired",
+		"disposed",
+		"proceeds_" + strings.ToLower(r.FiatCurrency),
+		"cost_" + strings.ToLower(r.FiatCurrency),
+		"gain_" + strings.ToLower(r.FiatCurrency),
+	}}
+	for i := range r.Disposals {
+		records = append(records, []string{
+			r.Disposals[i].Currency,
+			r.Disposals[i].Exchange,
+			formatFloat(r.Disposals[i].Amount),
+			r.Disposals[i].Acquired,
+			r.Disposals[i].Disposed,
+			formatFloat(r.Disposals[i].Proceeds),
+			formatFloat(r.Disposals[i].Cost),
+			formatFloat(r.Disposals[i].Gain),
+		})
+	}
+	records = append(records, []string{
+		"total", "", "", "", "",
+		formatFloat(r.TotalProceeds),
+		formatFloat(r.TotalCost),
+		formatFloat(r.TotalGain),
+	})
+	err = w.WriteAll(records)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+var createAPITokenCommand = cli.Command{
+	Name:      "createapitoken",
+	Usage:     "creates a scoped API token which is only shown once",
+	ArgsUsage: "<name> <role> <expires>",
+	Action:    createAPIToken,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the unique name of the token",
+		},
+		cli.StringFlag{
+			Name:  "role",
+			Usage: "the role granted to the token: readonly, trader, withdrawer or admin",
+			Value: "readonly",
+		},
+		cli.StringFlag{
+			Name:  "expires",
+			Usage: "the time the token expires",
+			Value: time.Now().AddDate(0, 0, 30).Format(common.SimpleTimeFormat),
+		},
+	},
+}
+
+func createAPIToken(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "createapitoken")
+	}
+
+	var name string
+	if c.IsSet("name") {
+		name = c.String("name")
+	} else {
+		name = c.Args().First()
+	}
+
+	role := c.String("role")
+	if !c.IsSet("role") && c.Args().Get(1) != "" {
+		role = c.Args().Get(1)
+	}
+
+	expires := c.String("expires")
+	if !c.IsSet("expires") && c.Args().Get(2) != "" {
+		expires = c.Args().Get(2)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, expires)
+	if err != nil {
+		return fmt.Errorf("invalid time format for expires: %v", err)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CreateAPIToken(context.Background(),
+		&gctrpc.CreateAPITokenRequest{
+			Name:    name,
+			Role:    role,
+			Expires: negateLocalOffset(e),
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var revokeAPITokenCommand = cli.Command{
+	Name:      "revokeapitoken",
+	Usage:     "revokes an API token",
+	ArgsUsage: "<name>",
+	Action:    revokeAPIToken,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the name of the token",
+		},
+	},
+}
+
+func revokeAPIToken(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "revokeapitoken")
+	}
+
+	var name string
+	if c.IsSet("name") {
+		name = c.String("name")
+	} else {
+		name = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RevokeAPIToken(context.Background(),
+		&gctrpc.RevokeAPITokenRequest{Name: name})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var getAPITokensCommand = cli.Command{
+	Name:   "getapitokens",
+	Usage:  "gets the name, role and expiry of each API token",
+	Action: getAPITokens,
+}
+
+func getAPITokens(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetAPITokens(context.Background(), &gctrpc.GetAPITokensRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var approveWithdrawalCommand = cli.Command{
+	Name:      "approvewithdrawal",
+	Usage:     "approves a withdrawal request pending approval as the current rpc user",
+	ArgsUsage: "<id>",
+	Action:    actionWithdrawal,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "withdrawal id",
+		},
+	},
+}
+
+var rejectWithdrawalCommand = cli.Command{
+	Name:      "rejectwithdrawal",
+	Usage:     "rejects a withdrawal request pending approval as the current rpc user",
+	ArgsUsage: "<id>",
+	Action:    actionWithdrawal,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "withdrawal id",
+		},
+	},
+}
+
+func actionWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	var ID string
+	if c.IsSet("id") {
+		ID = c.String("id")
+	} else {
+		ID = c.Args().First()
+	}
+	if ID == "" {
+		return errors.New("an ID must be specified")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	req := &gctrpc.WithdrawalApprovalRequest{Id: ID}
+	var result *gctrpc.WithdrawResponse
+	if c.Command.Name == "rejectwithdrawal" {
+		result, err = client.RejectWithdrawal(context.Background(), req)
+	} else {
+		result, err = client.ApproveWithdrawal(context.Background(), req)
+	}
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var getLoggerDetailsCommand = cli.Command{
+	Name:      "getloggerdetails",
+	Usage:     "gets an individual loggers details",
+	ArgsUsage: "<logger>",
+	Action:    getLoggerDetails,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "logger",
+			Usage: "logger to get level details of",
+		},
+	},
+}
+
+func getLoggerDetails(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getloggerdetails")
+	}
+
+	var logger string
+	if c.IsSet("logger") {
+		logger = c.String("logger")
+	} else {
+		logger = c.Args().First()
+	}
+
+	if logger == "" {
+		return errors.New("a logger must be specified")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GetLoggerDetails(context.Background(),
+		&gctrpc.GetLoggerDetailsRequest{
+			Logger: logger,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var setLoggerDetailsCommand = cli.Command{
+	Name:      "setloggerdetails",
+	Usage:     "sets an individual loggers details",
+	ArgsUsage: "<logger> <flags>",
+	Action:    setLoggerDetails,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "logger",
+			Usage: "logger to get level details of",
+		},
+		cli.StringFlag{
+			Name:  "flags",
+			Usage: "pipe separated value of loggers e.g INFO|WARN",
+		},
+	},
+}
+
+func setLoggerDetails(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "setloggerdetails")
+	}
+
+	var logger string
+	var level string
+
+	if c.IsSet("logger") {
+		logger = c.String("logger")
+	} else {
+		logger = c.Args().First()
+	}
+
+	if logger == "" {
+		return errors.New("a logger must be specified")
+	}
+
+	if c.IsSet("level") {
+		level = c.String("level")
+	} else {
+		level = c.Args().Get(1)
+	}
+
+	if level == "" {
+		return errors.New("level must be specified")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.SetLoggerDetails(context.Background(),
+		&gctrpc.SetLoggerDetailsRequest{
+			Logger: logger,
+			Level:  level,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var getOrderbookStreamCommand = cli.Command{
+	Name:      "getorderbookstream",
+	Usage:     "gets the orderbook stream for a specific currency pair and exchange",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getOrderbookStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the orderbook from",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "currency pair",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+	},
+}
+
+func getOrderbookStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getorderbookstream")
+	}
+
+	var exchangeName string
+	var pair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderbookStream(context.Background(),
 		&gctrpc.GetOrderbookStreamRequest{
 			Exchange: exchangeName,
 			Pair: &gctrpc.CurrencyPair{
@@ -3288,20 +4082,115 @@ var getExchangeOrderbookStreamCommand = cli.Command{
 	},
 }
 
-func getExchangeOrderbookStream(c *cli.Context) error {
+func getExchangeOrderbookStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getexchangeorderbookstream")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExchangeOrderbookStream(context.Background(),
+		&gctrpc.GetExchangeOrderbookStreamRequest{
+			Exchange: exchangeName,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Orderbook streamed for %s %s",
+			exchangeName,
+			resp.Pair.String())
+	}
+}
+
+var getConsolidatedOrderbookStreamCommand = cli.Command{
+	Name:      "getconsolidatedorderbookstream",
+	Usage:     "gets a stream of a single orderbook merged from every exchange trading a currency pair",
+	ArgsUsage: "<pair> <asset>",
+	Action:    getConsolidatedOrderbookStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair, venue prices are converted to its quote currency",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "exchanges",
+			Usage: "comma separated exchanges to restrict the book to, defaults to all exchanges",
+		},
+		cli.Int64Flag{
+			Name:  "depth",
+			Usage: "the number of levels to display per side",
+			Value: 10,
+		},
+	},
+}
+
+func getConsolidatedOrderbookStream(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "getexchangeorderbookstream")
+		return cli.ShowCommandHelp(c, "getconsolidatedorderbookstream")
 	}
 
-	var exchangeName string
-	if c.IsSet("exchange") {
-		exchangeName = c.String("exchange")
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
 	} else {
-		exchangeName = c.Args().First()
+		pair = c.Args().First()
+	}
+	if !validPair(pair) {
+		return errInvalidPair
 	}
 
-	if !validExchange(exchangeName) {
-		return errInvalidExchange
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var exchanges []string
+	if c.String("exchanges") != "" {
+		exchanges = strings.Split(c.String("exchanges"), ",")
 	}
 
 	conn, err := setupClient()
@@ -3311,11 +4200,17 @@ func getExchangeOrderbookStream(c *cli.Context) error {
 	defer conn.Close()
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.GetExchangeOrderbookStream(context.Background(),
-		&gctrpc.GetExchangeOrderbookStreamRequest{
-			Exchange: exchangeName,
+	result, err := client.GetConsolidatedOrderbookStream(context.Background(),
+		&gctrpc.GetConsolidatedOrderbookStreamRequest{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Exchanges: exchanges,
+			Depth:     c.Int64("depth"),
 		})
-
 	if err != nil {
 		return err
 	}
@@ -3331,9 +4226,49 @@ func getExchangeOrderbookStream(c *cli.Context) error {
 			return err
 		}
 
-		fmt.Printf("Orderbook streamed for %s %s",
-			exchangeName,
-			resp.Pair.String())
+		fmt.Printf("Consolidated orderbook stream for %s %s:\n\n",
+			p,
+			strings.ToUpper(resp.AssetType))
+		fmt.Println("\t\tBids\t\t\t\t\tAsks")
+		fmt.Println()
+		bidLen := len(resp.Bids) - 1
+		askLen := len(resp.Asks) - 1
+		var maxLen int
+		if bidLen >= askLen {
+			maxLen = bidLen
+		} else {
+			maxLen = askLen
+		}
+		for i := 0; i <= maxLen; i++ {
+			var bidAmount, bidPrice, askAmount, askPrice float64
+			var bidExchange, askExchange string
+			if i <= bidLen {
+				bidAmount = resp.Bids[i].Amount
+				bidPrice = resp.Bids[i].Price
+				bidExchange = resp.Bids[i].Exchange
+			}
+			if i <= askLen {
+				askAmount = resp.Asks[i].Amount
+				askPrice = resp.Asks[i].Price
+				askExchange = resp.Asks[i].Exchange
+			}
+			fmt.Printf("%-12s %.8f @ %.8f\t\t%-12s %.8f @ %.8f\n",
+				bidExchange,
+				bidAmount,
+				bidPrice,
+				askExchange,
+				askAmount,
+				askPrice)
+		}
+		fmt.Println()
+		for i := range resp.Venues {
+			fmt.Printf("%s %s rate: %v fee: %v subscribed: %v\n",
+				resp.Venues[i].Exchange,
+				resp.Venues[i].Pair,
+				resp.Venues[i].Rate,
+				resp.Venues[i].Fee,
+				resp.Venues[i].Subscribed)
+		}
 	}
 }
 
@@ -3713,9 +4648,116 @@ var gctScriptCommand = cli.Command{
 					Name:  "archived",
 					Usage: "<true/false>",
 				},
+				cli.StringFlag{
+					Name:  "signature",
+					Usage: "<path> to the hex encoded ed25519 signature of the script or zip",
+				},
 			},
 			Action: gctScriptUpload,
 		},
+		{
+			Name:      "versions",
+			Usage:     "list the stored versions of a script",
+			ArgsUsage: "<name>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "name",
+					Usage: "the script name relative to the script path",
+				},
+			},
+			Action: gctScriptVersions,
+		},
+		{
+			Name:      "diff",
+			Usage:     "show the differences between two versions of a script",
+			ArgsUsage: "<name> <from> <to>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "name",
+					Usage: "the script name relative to the script path",
+				},
+				cli.Int64Flag{
+					Name:  "from",
+					Usage: "the version to compare from",
+				},
+				cli.Int64Flag{
+					Name:  "to",
+					Usage: "the version to compare to, defaults to the latest version",
+				},
+			},
+			Action: gctScriptDiff,
+		},
+		{
+			Name:      "rollback",
+			Usage:     "restore a previous version of a script",
+			ArgsUsage: "<name> <version>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "name",
+					Usage: "the script name relative to the script path",
+				},
+				cli.Int64Flag{
+					Name:  "version",
+					Usage: "the version to restore",
+				},
+			},
+			Action: gctScriptRollback,
+		},
+		{
+			Name:  "schedule",
+			Usage: "manage scheduled script runs",
+			Subcommands: []cli.Command{
+				{
+					Name:      "add",
+					Usage:     "add or replace a schedule running a script on a cron expression or once at a time",
+					ArgsUsage: "<name> <script>",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "name",
+							Usage: "the schedule name",
+						},
+						cli.StringFlag{
+							Name:  "script",
+							Usage: "the script name relative to the script path",
+						},
+						cli.StringFlag{
+							Name:  "cron",
+							Usage: "the cron expression in UTC, e.g. '0 */4 * * *'",
+						},
+						cli.StringFlag{
+							Name:  "at",
+							Usage: "the UTC time to run once, e.g. '2006-01-02 15:04:05'",
+						},
+						cli.StringFlag{
+							Name:  "exchanges",
+							Usage: "comma separated exchanges whose maintenance windows skip runs",
+						},
+						cli.StringSliceFlag{
+							Name:  "skip",
+							Usage: "a window in which runs are skipped as '<cron>;<duration>' or '<start>;<end>', may be repeated",
+						},
+					},
+					Action: gctScriptAddSchedule,
+				},
+				{
+					Name:      "remove",
+					Usage:     "remove a schedule",
+					ArgsUsage: "<name>",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "name",
+							Usage: "the schedule name",
+						},
+					},
+					Action: gctScriptRemoveSchedule,
+				},
+				{
+					Name:   "list",
+					Usage:  "list schedules with their last and next runs",
+					Action: gctScriptListSchedules,
+				},
+			},
+		},
 		{
 			Name:  "autoload",
 			Usage: "add or remove script from autoload list",
@@ -4038,12 +5080,25 @@ func gctScriptUpload(c *cli.Context) error {
 		return err
 	}
 
+	var signature []byte
+	if c.IsSet("signature") {
+		sig, err := ioutil.ReadFile(c.String("signature"))
+		if err != nil {
+			return err
+		}
+		signature, err = hex.DecodeString(strings.TrimSpace(string(sig)))
+		if err != nil {
+			return err
+		}
+	}
+
 	uploadCommand, err := client.GCTScriptUpload(context.Background(),
 		&gctrpc.GCTScriptUploadRequest{
 			ScriptName: filepath.Base(file.Name()),
 			Data:       data,
 			Archived:   archived,
 			Overwrite:  overwrite,
+			Signature:  signature,
 		})
 
 	if err != nil {
@@ -4054,6 +5109,224 @@ func gctScriptUpload(c *cli.Context) error {
 	return nil
 }
 
+func gctScriptVersions(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	name := c.String("name")
+	if !c.IsSet("name") {
+		name = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptListVersions(context.Background(),
+		&gctrpc.GCTScriptListVersionsRequest{
+			ScriptName: name,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func gctScriptDiff(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	name := c.String("name")
+	if !c.IsSet("name") {
+		name = c.Args().First()
+	}
+
+	var err error
+	from := c.Int64("from")
+	if !c.IsSet("from") {
+		from, err = strconv.ParseInt(c.Args().Get(1), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	to := c.Int64("to")
+	if !c.IsSet("to") && c.Args().Get(2) != "" {
+		to, err = strconv.ParseInt(c.Args().Get(2), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptDiffVersions(context.Background(),
+		&gctrpc.GCTScriptDiffVersionsRequest{
+			ScriptName:  name,
+			FromVersion: from,
+			ToVersion:   to,
+		})
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(result.Diff)
+	return nil
+}
+
+func gctScriptRollback(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	name := c.String("name")
+	if !c.IsSet("name") {
+		name = c.Args().First()
+	}
+
+	var err error
+	version := c.Int64("version")
+	if !c.IsSet("version") {
+		version, err = strconv.ParseInt(c.Args().Get(1), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptRollback(context.Background(),
+		&gctrpc.GCTScriptRollbackRequest{
+			ScriptName: name,
+			Version:    version,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func gctScriptAddSchedule(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	name := c.String("name")
+	if !c.IsSet("name") {
+		name = c.Args().First()
+	}
+
+	script := c.String("script")
+	if !c.IsSet("script") {
+		script = c.Args().Get(1)
+	}
+
+	var exchanges []string
+	if c.String("exchanges") != "" {
+		exchanges = strings.Split(c.String("exchanges"), ",")
+	}
+
+	var windows []*gctrpc.GCTScriptScheduleWindow
+	for _, skip := range c.StringSlice("skip") {
+		parts := strings.Split(skip, ";")
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid skip window %q, expected '<cron>;<duration>' or '<start>;<end>'", skip)
+		}
+		if _, err := time.ParseDuration(parts[1]); err == nil {
+			windows = append(windows, &gctrpc.GCTScriptScheduleWindow{Cron: parts[0], Duration: parts[1]})
+			continue
+		}
+		windows = append(windows, &gctrpc.GCTScriptScheduleWindow{Start: parts[0], End: parts[1]})
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptAddSchedule(context.Background(),
+		&gctrpc.GCTScriptSchedule{
+			Name:        name,
+			ScriptName:  script,
+			Cron:        c.String("cron"),
+			RunAt:       c.String("at"),
+			Exchanges:   exchanges,
+			SkipWindows: windows,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func gctScriptRemoveSchedule(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	name := c.String("name")
+	if !c.IsSet("name") {
+		name = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptRemoveSchedule(context.Background(),
+		&gctrpc.GCTScriptRemoveScheduleRequest{Name: name})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func gctScriptListSchedules(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptListSchedules(context.Background(),
+		&gctrpc.GCTScriptListSchedulesRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 const klineMessage = "%v in seconds supported values are: 15, 60(1min), 180(3min), 300(5min), 600(10min), 900(15min), " +
 	"1800(30min), 3600(1h), 7200(2h), 14400(4h), 21600(6h), 28800(8h), 43200(12h), 86400(1d), 259200(3d) " +
 	"60480(1w), 1209600(2w), 1296000(15d), 2592000(1M), 31536000(1Y)"
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli"
+)
+
+var dataHistoryCommand = cli.Command{
+	Name:      "datahistory",
+	Usage:     "manage data history jobs which retrieve and validate candle and trade data",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:   "upsertjob",
+			Usage:  "adds or updates a job to retrieve missing candle or trade data",
+			Action: upsertDataHistoryJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "nickname, n",
+					Usage: "a unique name for the job",
+				},
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to retrieve data from",
+				},
+				cli.StringFlag{
+					Name:  "pair, p",
+					Usage: "the currency pair",
+				},
+				cli.StringFlag{
+					Name:  "asset, a",
+					Usage: "the asset type of the currency pair",
+				},
+				cli.Int64Flag{
+					Name:        "interval, i",
+					Usage:       fmt.Sprintf(klineMessage, "interval"),
+					Value:       86400,
+					Destination: &candleGranularity,
+				},
+				cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start> rounded down to the nearest interval",
+					Value:       time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end> rounded down to the nearest interval",
+					Value:       time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+				cli.Int64Flag{
+					Name:  "data_type",
+					Usage: "0 for candles, 1 for trades",
+				},
+				cli.Int64Flag{
+					Name:  "request_size_limit",
+					Usage: "the number of candles to request per API call",
+					Value: 500,
+				},
+				cli.Int64Flag{
+					Name:  "batch_size",
+					Usage: "the number of API calls to make each time the job is processed",
+					Value: 10,
+				},
+				cli.Int64Flag{
+					Name:  "max_retry_attempts",
+					Usage: "the number of failed attempts allowed per interval range",
+					Value: 3,
+				},
+				cli.BoolFlag{
+					Name:  "insert_only",
+					Usage: "fails if a job with the nickname already exists",
+				},
+			},
+		},
+		{
+			Name:      "getjob",
+			Usage:     "returns a job's details",
+			ArgsUsage: "<nickname>",
+			Action:    getDataHistoryJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "nickname, n",
+					Usage: "the job nickname",
+				},
+				cli.BoolFlag{
+					Name:  "full_details",
+					Usage: "include the result of every interval range processed",
+				},
+			},
+		},
+		{
+			Name:   "getactivejobs",
+			Usage:  "returns all jobs which are still being processed",
+			Action: getActiveDataHistoryJobs,
+		},
+		{
+			Name:   "getjobsbetween",
+			Usage:  "returns all jobs created between two dates",
+			Action: getDataHistoryJobsBetween,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start>",
+					Value:       time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Add(time.Hour).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+			},
+		},
+		{
+			Name:      "deletejob",
+			Usage:     "stops a job from being processed",
+			ArgsUsage: "<nickname>",
+			Action:    deleteDataHistoryJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "nickname, n",
+					Usage: "the job nickname",
+				},
+			},
+		},
+	},
+}
+
+func upsertDataHistoryJob(c *cli.Context) error {
+	if c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	nickname := c.String("nickname")
+	if nickname == "" {
+		return errInvalidNickname
+	}
+	exchangeName := c.String("exchange")
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+	currencyPair := c.String("pair")
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+	assetType := c.String("asset")
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+	if c.IsSet("interval") {
+		candleGranularity = c.Int64("interval")
+	}
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return errInvalidTimes
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.UpsertDataHistoryJob(context.Background(),
+		&gctrpc.UpsertDataHistoryJobRequest{
+			Nickname: nickname,
+			Exchange: exchangeName,
+			Asset:    assetType,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			StartDate:        negateLocalOffset(s),
+			EndDate:          negateLocalOffset(e),
+			Interval:         int64(time.Duration(candleGranularity) * time.Second),
+			RequestSizeLimit: c.Int64("request_size_limit"),
+			DataType:         c.Int64("data_type"),
+			MaxRetryAttempts: c.Int64("max_retry_attempts"),
+			BatchSize:        c.Int64("batch_size"),
+			InsertOnly:       c.Bool("insert_only"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getDataHistoryJob(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var nickname string
+	if c.IsSet("nickname") {
+		nickname = c.String("nickname")
+	} else {
+		nickname = c.Args().First()
+	}
+	if nickname == "" {
+		return errInvalidNickname
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataHistoryJobDetails(context.Background(),
+		&gctrpc.GetDataHistoryJobDetailsRequest{
+			Nickname:    nickname,
+			FullDetails: c.Bool("full_details"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getActiveDataHistoryJobs(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetActiveDataHistoryJobs(context.Background(),
+		&gctrpc.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getDataHistoryJobsBetween(c *cli.Context) error {
+	if c.IsSet("start") {
+		startTime = c.String("start")
+	}
+	if c.IsSet("end") {
+		endTime = c.String("end")
+	}
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return errInvalidTimes
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataHistoryJobsBetween(context.Background(),
+		&gctrpc.GetDataHistoryJobsBetweenRequest{
+			StartDate: negateLocalOffset(s),
+			EndDate:   negateLocalOffset(e),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func deleteDataHistoryJob(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var nickname string
+	if c.IsSet("nickname") {
+		nickname = c.String("nickname")
+	} else {
+		nickname = c.Args().First()
+	}
+	if nickname == "" {
+		return errInvalidNickname
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.DeleteDataHistoryJob(context.Background(),
+		&gctrpc.DeleteDataHistoryJobRequest{
+			Nickname: nickname,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
@@ -17,11 +20,15 @@ import (
 )
 
 var (
-	host          string
-	username      string
-	password      string
-	pairDelimiter string
-	certPath      string
+	host           string
+	username       string
+	password       string
+	apiToken       string
+	pairDelimiter  string
+	certPath       string
+	clientCertPath string
+	clientKeyPath  string
+	basicAuthSet   bool
 )
 
 func jsonOutput(in interface{}) {
@@ -37,12 +44,23 @@ func setupClient() (*grpc.ClientConn, error) {
 	if err != nil {
 		return nil, err
 	}
+	if clientCertPath != "" {
+		creds, err = clientCertCredentials()
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	switch {
+	case apiToken != "":
+		opts = append(opts, grpc.WithPerRPCCredentials(auth.BearerToken{Token: apiToken}))
+	case clientCertPath == "" || basicAuthSet:
+		// A client certificate identifies the user unless credentials are set
+		opts = append(opts, grpc.WithPerRPCCredentials(auth.BasicAuth{
 			Username: username,
 			Password: password,
-		}),
+		}))
 	}
 	conn, err := grpc.Dial(host, opts...)
 	if err != nil {
@@ -52,6 +70,28 @@ func setupClient() (*grpc.ClientConn, error) {
 	return conn, err
 }
 
+// clientCertCredentials returns TLS credentials trusting the gRPC server
+// certificate and presenting the client certificate
+func clientCertCredentials() (credentials.TransportCredentials, error) {
+	serverCert, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(serverCert) {
+		return nil, fmt.Errorf("no certificates found in %s", certPath)
+	}
+	cert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
 func main() {
 	app := cli.NewApp()
 	app.Name = "gctcli"
@@ -77,6 +117,11 @@ func main() {
 			Usage:       "the gRPC password",
 			Destination: &password,
 		},
+		cli.StringFlag{
+			Name:        "rpctoken",
+			Usage:       "the gRPC API token, used instead of the username and password when set",
+			Destination: &apiToken,
+		},
 		cli.StringFlag{
 			Name:        "delimiter",
 			Value:       "-",
@@ -89,6 +134,20 @@ func main() {
 			Usage:       "the path to TLS cert of the gRPC server",
 			Destination: &certPath,
 		},
+		cli.StringFlag{
+			Name:        "clientcert",
+			Usage:       "the path to a client certificate signed by the gRPC server's client CA, identifying the user unless rpcuser or rpcpassword are set",
+			Destination: &clientCertPath,
+		},
+		cli.StringFlag{
+			Name:        "clientkey",
+			Usage:       "the path to the client certificate's private key",
+			Destination: &clientKeyPath,
+		},
+	}
+	app.Before = func(c *cli.Context) error {
+		basicAuthSet = c.GlobalIsSet("rpcuser") || c.GlobalIsSet("rpcpassword")
+		return nil
 	}
 	app.Commands = []cli.Command{
 		getInfoCommand,
@@ -107,6 +166,7 @@ func main() {
 		getTickersCommand,
 		getOrderbookCommand,
 		getOrderbooksCommand,
+		getOrderbookAnalyticsCommand,
 		getAccountInfoCommand,
 		getAccountInfoStreamCommand,
 		updateAccountInfoCommand,
@@ -133,11 +193,22 @@ func main() {
 		withdrawCryptocurrencyFundsCommand,
 		withdrawFiatFundsCommand,
 		withdrawalRequestCommand,
+		depositHistoryCommand,
+		getRebalancePlanCommand,
+		executeRebalancePlanCommand,
+		getPortfolioHistoryCommand,
+		getTaxReportCommand,
+		createAPITokenCommand,
+		revokeAPITokenCommand,
+		getAPITokensCommand,
+		approveWithdrawalCommand,
+		rejectWithdrawalCommand,
 		getLoggerDetailsCommand,
 		setLoggerDetailsCommand,
 		exchangePairManagerCommand,
 		getOrderbookStreamCommand,
 		getExchangeOrderbookStreamCommand,
+		getConsolidatedOrderbookStreamCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
 		getAuditEventCommand,
@@ -147,6 +218,7 @@ func main() {
 		gctScriptCommand,
 		websocketManagerCommand,
 		tradeCommand,
+		dataHistoryCommand,
 	}
 
 	err := app.Run(os.Args)
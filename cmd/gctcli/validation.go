@@ -12,6 +12,8 @@ var (
 	errInvalidPair     = errors.New("invalid currency pair supplied")
 	errInvalidExchange = errors.New("invalid exchange supplied")
 	errInvalidAsset    = errors.New("invalid asset supplied")
+	errInvalidNickname = errors.New("invalid nickname supplied")
+	errInvalidTimes    = errors.New("start cannot be after end")
 )
 
 func validPair(pair string) bool {
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/core"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/urfave/cli"
+)
+
+var errTestsFailed = errors.New("tests failed")
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "gctscript"
+	app.Version = core.Version(true)
+	app.Usage = "command line tools for developing gocryptotrader scripts"
+	app.Commands = []cli.Command{
+		testCommand,
+		keygenCommand,
+		signCommand,
+	}
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+var testCommand = cli.Command{
+	Name:      "test",
+	Usage:     "runs scripts against the validator wrapper and reports assertion failures and coverage",
+	ArgsUsage: "<script or directory>...",
+	Action:    runTests,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "fixtures",
+			Usage: "JSON file of tickers, orderbooks and candles returned to scripts",
+		},
+		cli.BoolFlag{
+			Name:  "verbose",
+			Usage: "logs script output and lists uncovered lines of passing scripts",
+		},
+	},
+}
+
+func runTests(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return cli.ShowCommandHelp(c, "test")
+	}
+
+	logConfig := log.GenDefaultSettings()
+	logConfig.Enabled = convert.BoolPtr(c.Bool("verbose"))
+	log.RWM.Lock()
+	log.GlobalLogConfig = &logConfig
+	log.RWM.Unlock()
+	log.SetupGlobalLogger()
+
+	var fixtures *validator.Fixtures
+	if path := c.String("fixtures"); path != "" {
+		var err error
+		fixtures, err = validator.LoadFixtures(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	scripts, err := findScripts(c.Args())
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for x := range scripts {
+		result := vm.TestScript(scripts[x], fixtures)
+		if !printResult(result, c.Bool("verbose")) {
+			failed++
+		}
+	}
+	fmt.Printf("%d scripts, %d failed\n", len(scripts), failed)
+	if failed > 0 {
+		return errTestsFailed
+	}
+	return nil
+}
+
+// findScripts expands directories to the scripts they contain
+func findScripts(args []string) ([]string, error) {
+	var scripts []string
+	for x := range args {
+		info, err := os.Stat(args[x])
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			scripts = append(scripts, args[x])
+			continue
+		}
+		err = filepath.Walk(args[x], func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && filepath.Ext(path) == common.GctExt {
+				scripts = append(scripts, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return scripts, nil
+}
+
+// printResult prints the outcome of a script and returns whether it passed
+func printResult(r *vm.TestResult, verbose bool) bool {
+	passed := r.Err == nil && len(r.Failures) == 0
+	status := "PASS"
+	if !passed {
+		status = "FAIL"
+	}
+	coverage := 0.0
+	if r.Statements > 0 {
+		coverage = float64(r.Covered) / float64(r.Statements) * 100
+	}
+	fmt.Printf("%s\t%s\t%.1f%% of %d statements\t%s\n",
+		status, r.Script, coverage, r.Statements, r.Duration)
+	for x := range r.Failures {
+		fmt.Printf("\t%s:%d: %s\n", r.Script, r.Failures[x].Line, r.Failures[x].Message)
+	}
+	if r.Err != nil {
+		fmt.Printf("\t%v\n", r.Err)
+	}
+	if len(r.Uncovered) > 0 && (!passed || verbose) {
+		lines := make([]string, len(r.Uncovered))
+		for x := range r.Uncovered {
+			lines[x] = fmt.Sprint(r.Uncovered[x])
+		}
+		fmt.Printf("\tuncovered lines: %s\n", strings.Join(lines, ", "))
+	}
+	return passed
+}
+
+var keygenCommand = cli.Command{
+	Name:      "keygen",
+	Usage:     "generates an ed25519 key pair for signing script uploads",
+	ArgsUsage: "<private key file>",
+	Action:    generateKey,
+}
+
+func generateKey(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cli.ShowCommandHelp(c, "keygen")
+	}
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(c.Args().First(), []byte(hex.EncodeToString(private)), 0600)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("private key written to %s, add the public key to gctscript public_keys:\n%s\n",
+		c.Args().First(), hex.EncodeToString(public))
+	return nil
+}
+
+var signCommand = cli.Command{
+	Name:      "sign",
+	Usage:     "signs a script or zip for upload, writing the signature to <file>.sig",
+	ArgsUsage: "<script or zip>",
+	Action:    signScript,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "key",
+			Usage: "the private key file generated by keygen",
+		},
+	},
+}
+
+func signScript(c *cli.Context) error {
+	if c.NArg() != 1 || !c.IsSet("key") {
+		return cli.ShowCommandHelp(c, "sign")
+	}
+	key, err := ioutil.ReadFile(c.String("key"))
+	if err != nil {
+		return err
+	}
+	private, err := hex.DecodeString(strings.TrimSpace(string(key)))
+	if err != nil || len(private) != ed25519.PrivateKeySize {
+		return errors.New("invalid private key")
+	}
+	data, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return err
+	}
+	signature := hex.EncodeToString(ed25519.Sign(private, data))
+	err = ioutil.WriteFile(c.Args().First()+".sig", []byte(signature), 0600)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("signature written to %s.sig\n", c.Args().First())
+	return nil
+}
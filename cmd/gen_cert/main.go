@@ -8,30 +8,58 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"math/big"
 	"net"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 )
 
-func main() {
-	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-	if err != nil {
-		log.Fatalf("failed to generate private key: %s", err)
-	}
+// Certificate generation modes
+const (
+	modeServer = "server"
+	modeCA     = "ca"
+	modeClient = "client"
+)
 
-	notBefore := time.Now()
-	notAfter := notBefore.Add(time.Hour * 24 * 365)
+var errInvalidCAFile = errors.New("CA certificate PEM data is invalid")
 
-	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
-	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
-	if err != nil {
-		log.Fatalf("failed to generate serial number: %s", err)
+func main() {
+	var mode, dir, name, caFile, caKeyFile string
+	var days int
+	flag.StringVar(&mode, "mode", modeServer, "the certificate to generate: server for a self-signed gRPC server certificate, ca for a client certificate authority or client for a client certificate signed by the CA")
+	flag.StringVar(&dir, "dir", ".", "the directory to write the certificate and key files to")
+	flag.StringVar(&name, "name", "", "the client certificate common name, which must match a remote control username")
+	flag.StringVar(&caFile, "ca", "ca.pem", "the CA certificate used to sign client certificates")
+	flag.StringVar(&caKeyFile, "cakey", "ca_key.pem", "the CA private key used to sign client certificates")
+	flag.IntVar(&days, "days", 365, "the number of days the certificate is valid for")
+	flag.Parse()
+
+	validFor := time.Hour * 24 * time.Duration(days)
+	switch mode {
+	case modeServer:
+		genServerCert(dir, validFor)
+	case modeCA:
+		genCACert(dir, validFor)
+	case modeClient:
+		if name == "" {
+			log.Fatal("a client certificate name must be set with -name")
+		}
+		genClientCert(dir, name, caFile, caKeyFile, validFor)
+	default:
+		log.Fatalf("unsupported mode %q", mode)
 	}
+	log.Printf("ok!")
+}
 
+func genServerCert(dir string, validFor time.Duration) {
 	host, err := os.Hostname()
 	if err != nil {
 		log.Fatalf("failed to get hostname: %s", err)
@@ -42,28 +70,87 @@ func main() {
 		dnsNames = append(dnsNames, "localhost")
 	}
 
-	template := x509.Certificate{
+	template := newTemplate(host, validFor)
+	template.IsCA = true
+	template.KeyUsage = x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	template.IPAddresses = []net.IP{
+		net.ParseIP("127.0.0.1"),
+		net.ParseIP("::1"),
+	}
+	template.DNSNames = dnsNames
+
+	writeCert(dir, "cert.pem", "key.pem", template, nil, nil)
+}
+
+func genCACert(dir string, validFor time.Duration) {
+	template := newTemplate("gocryptotrader client CA", validFor)
+	template.IsCA = true
+	template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+
+	writeCert(dir, "ca.pem", "ca_key.pem", template, nil, nil)
+}
+
+func genClientCert(dir, name, caFile, caKeyFile string, validFor time.Duration) {
+	ca, err := tls.LoadX509KeyPair(caFile, caKeyFile)
+	if err != nil {
+		log.Fatalf("failed to load CA key pair: %s", err)
+	}
+	caPEM, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		log.Fatalf("failed to read CA certificate: %s", err)
+	}
+	block, _ := pem.Decode(caPEM)
+	if block == nil {
+		log.Fatal(errInvalidCAFile)
+	}
+	caCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		log.Fatalf("failed to parse CA certificate: %s", err)
+	}
+
+	template := newTemplate(name, validFor)
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	if template.NotAfter.After(caCert.NotAfter) {
+		template.NotAfter = caCert.NotAfter
+	}
+
+	writeCert(dir, name+".pem", name+"_key.pem", template, caCert, ca.PrivateKey)
+}
+
+func newTemplate(commonName string, validFor time.Duration) *x509.Certificate {
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		log.Fatalf("failed to generate serial number: %s", err)
+	}
+
+	notBefore := time.Now()
+	return &x509.Certificate{
 		SerialNumber: serialNumber,
 		Subject: pkix.Name{
 			Organization: []string{"gocryptotrader"},
-			CommonName:   host,
+			CommonName:   commonName,
 		},
 		NotBefore:             notBefore,
-		NotAfter:              notAfter,
-		IsCA:                  true,
+		NotAfter:              notBefore.Add(validFor),
 		BasicConstraintsValid: true,
+	}
+}
 
-		KeyUsage:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
-		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
-
-		IPAddresses: []net.IP{
-			net.ParseIP("127.0.0.1"),
-			net.ParseIP("::1"),
-		},
-		DNSNames: dnsNames,
+// writeCert generates a key, signs the certificate with the parent or itself
+// when the parent is nil, then writes both files to the directory
+func writeCert(dir, certName, keyName string, template, parent *x509.Certificate, parentKey interface{}) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		log.Fatalf("failed to generate private key: %s", err)
+	}
+	if parent == nil {
+		parent, parentKey = template, privKey
 	}
 
-	derBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &privKey.PublicKey, privKey)
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, parent, &privKey.PublicKey, parentKey)
 	if err != nil {
 		log.Fatalf("Failed to create certificate: %s", err)
 	}
@@ -75,7 +162,7 @@ func main() {
 
 	b, err := x509.MarshalECPrivateKey(privKey)
 	if err != nil {
-		log.Printf("failed to marshal ECDSA private key: %s", err)
+		log.Fatalf("failed to marshal ECDSA private key: %s", err)
 	}
 
 	keyData := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b})
@@ -83,23 +170,23 @@ func main() {
 		log.Fatalf("key pem data is nil")
 	}
 
-	err = file.Write("key.pem", keyData)
+	certPath := filepath.Join(dir, certName)
+	keyPath := filepath.Join(dir, keyName)
+	err = file.Write(keyPath, keyData)
 	if err != nil {
-		log.Fatalf("failed to write key.pem file %s", err)
+		log.Fatalf("failed to write %s file %s", keyName, err)
 	}
-	log.Printf("wrote key.pem file")
+	log.Printf("wrote %s file", keyPath)
 
-	err = file.Write("cert.pem", certData)
+	err = file.Write(certPath, certData)
 	if err != nil {
-		log.Fatalf("failed to write cert.pem file %s", err)
+		log.Fatalf("failed to write %s file %s", certName, err)
 	}
-	log.Printf("wrote cert.pem file")
+	log.Printf("wrote %s file", certPath)
 
 	log.Printf("testing tls.LoadX509Keypair..")
-	_, err = tls.LoadX509KeyPair("cert.pem", "key.pem")
+	_, err = tls.LoadX509KeyPair(certPath, keyPath)
 	if err != nil {
-		log.Fatal(err)
+		log.Fatal(fmt.Errorf("%s: %w", certName, err))
 	}
-
-	log.Printf("ok!")
 }
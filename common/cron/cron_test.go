@@ -0,0 +1,74 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	for _, expr := range []string{
+		"* * * * *",
+		"0 */4 * * *",
+		"15,45 9-17 * * mon-fri",
+		"0 0 1 jan,jul *",
+		"5/10 * * * 7",
+		"@daily",
+	} {
+		if _, err := Parse(expr); err != nil {
+			t.Errorf("%s: %v", expr, err)
+		}
+	}
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		_, err := Parse(expr)
+		if !errors.Is(err, errInvalidExpression) && !errors.Is(err, errInvalidField) {
+			t.Errorf("%q: received %v expected invalid expression", expr, err)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2021, 1, 29, 13, 7, 30, 0, time.UTC) // Friday
+	testCases := []struct {
+		expr     string
+		from     time.Time
+		expected time.Time
+	}{
+		{"* * * * *", from, time.Date(2021, 1, 29, 13, 8, 0, 0, time.UTC)},
+		{"0 */4 * * *", from, time.Date(2021, 1, 29, 16, 0, 0, 0, time.UTC)},
+		{"30 9 * * mon-fri", from, time.Date(2021, 2, 1, 9, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", from, time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", from, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 12 13 * 5", from, time.Date(2021, 2, 5, 12, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", from, time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", from, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", from, time.Time{}},
+		{"7 13 * * *", time.Date(2021, 1, 29, 13, 7, 0, 0, time.UTC), time.Date(2021, 1, 30, 13, 7, 0, 0, time.UTC)},
+	}
+	for x := range testCases {
+		s, err := Parse(testCases[x].expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		next := s.Next(testCases[x].from)
+		if !next.Equal(testCases[x].expected) {
+			t.Errorf("%s: received %v expected %v", testCases[x].expr, next, testCases[x].expected)
+		}
+		if !next.IsZero() && !s.Matches(next) {
+			t.Errorf("%s: expected %v to match", testCases[x].expr, next)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package cron
+
+import "errors"
+
+var (
+	errInvalidExpression = errors.New("invalid cron expression")
+	errInvalidField      = errors.New("invalid cron field")
+)
+
+// Schedule is a parsed cron expression of minute, hour, day of month, month
+// and day of week fields
+type Schedule struct {
+	expression string
+	minute     uint64
+	hour       uint64
+	dom        uint64
+	month      uint64
+	dow        uint64
+	// domAny and dowAny record unrestricted day fields, when both are
+	// restricted a day matching either field matches
+	domAny bool
+	dowAny bool
+}
+
+// field describes the range and names of a cron field
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
@@ -1,6 +1,7 @@
 package base
 
 import (
+	"sync"
 	"time"
 )
 
@@ -16,6 +17,10 @@ type Base struct {
 	Enabled   bool
 	Verbose   bool
 	Connected bool
+
+	commandMtx      sync.RWMutex
+	commandHandler  CommandHandler
+	authorisedUsers map[string]string
 }
 
 // Event is a generalise event type
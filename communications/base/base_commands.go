@@ -0,0 +1,62 @@
+package base
+
+import (
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+// CommandHandler executes an inbound chat command on behalf of the remote
+// control user and returns the reply to send back to the chat
+type CommandHandler func(username, command string) string
+
+// CommandReceiver is implemented by communication packages which accept
+// inbound chat commands
+type CommandReceiver interface {
+	SetCommandHandler(CommandHandler)
+}
+
+// SetAuthorisedUsers sets the chat users allowed to send commands, mapped to
+// their remote control usernames
+func (b *Base) SetAuthorisedUsers(users []config.ChatUser) {
+	b.commandMtx.Lock()
+	defer b.commandMtx.Unlock()
+	b.authorisedUsers = make(map[string]string, len(users))
+	for i := range users {
+		b.authorisedUsers[users[i].ID] = users[i].Username
+	}
+}
+
+// SetCommandHandler sets the handler executing inbound chat commands
+func (b *Base) SetCommandHandler(h CommandHandler) {
+	b.commandMtx.Lock()
+	b.commandHandler = h
+	b.commandMtx.Unlock()
+}
+
+// HandleCommand passes a command sent by the chat user to the command
+// handler, returning false when no handler is set so the package can fall
+// back to its built in commands
+func (b *Base) HandleCommand(chatUser, command string) (string, bool) {
+	b.commandMtx.RLock()
+	h := b.commandHandler
+	username, ok := b.authorisedUsers[chatUser]
+	b.commandMtx.RUnlock()
+	if h == nil {
+		return "", false
+	}
+	if !ok {
+		return fmt.Sprintf("%s: chat user %s is not authorised to send commands", b.Name, chatUser), true
+	}
+	return h(username, command), true
+}
+
+// SetCommandHandler sets the command handler on each communication package
+// which accepts inbound chat commands
+func (c IComm) SetCommandHandler(h CommandHandler) {
+	for i := range c {
+		if r, ok := c[i].(CommandReceiver); ok {
+			r.SetCommandHandler(h)
+		}
+	}
+}
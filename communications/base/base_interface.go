@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -50,6 +51,27 @@ func (c IComm) PushEvent(event Event) {
 	}
 }
 
+// PushEventTo pushes an event to the named communication links, all enabled
+// links are used when no names are given
+func (c IComm) PushEventTo(event Event, names []string) {
+	if len(names) == 0 {
+		c.PushEvent(event)
+		return
+	}
+	for i := range c {
+		if !common.StringDataCompareInsensitive(names, c[i].GetName()) ||
+			!c[i].IsEnabled() ||
+			!c[i].IsConnected() {
+			continue
+		}
+		err := c[i].PushEvent(event)
+		if err != nil {
+			log.Errorf(log.CommunicationMgr, "Communications error - PushEvent() in package %s with %v. Err %s",
+				c[i].GetName(), event, err)
+		}
+	}
+}
+
 // GetStatus returns the status of the comms relayers
 func (c IComm) GetStatus() map[string]CommsStatus {
 	result := make(map[string]CommsStatus)
@@ -1,7 +1,10 @@
 package base
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
 )
 
 var (
@@ -127,3 +130,52 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestPushEventTo(t *testing.T) {
+	provider := &CommunicationProvider{isEnabled: true, isConnected: true}
+	ic := IComm{provider}
+	ic.PushEventTo(Event{}, []string{"slack"})
+	if provider.PushEventCalled {
+		t.Fatal("provider should not be pushed events addressed to other providers")
+	}
+	ic.PushEventTo(Event{}, []string{"slack", "SOMETESTPROVIDER"})
+	if !provider.PushEventCalled {
+		t.Fatal("provider should be pushed events addressed to it")
+	}
+	provider.PushEventCalled = false
+	ic.PushEventTo(Event{}, nil)
+	if !provider.PushEventCalled {
+		t.Fatal("provider should be pushed events addressed to all providers")
+	}
+}
+
+type commandProvider struct {
+	Base
+}
+
+func (p *commandProvider) Setup(*config.CommunicationsConfig) {}
+
+func (p *commandProvider) Connect() error { return nil }
+
+func (p *commandProvider) PushEvent(Event) error { return nil }
+
+func TestHandleCommand(t *testing.T) {
+	p := &commandProvider{Base: Base{Name: "test"}}
+	p.SetAuthorisedUsers([]config.ChatUser{{ID: "1337", Username: "trader"}})
+	if _, ok := p.HandleCommand("1337", "/status"); ok {
+		t.Fatal("command should not be handled without a command handler")
+	}
+
+	ic := IComm{&CommunicationProvider{}, p}
+	ic.SetCommandHandler(func(username, command string) string {
+		return username + " " + command
+	})
+	reply, ok := p.HandleCommand("1337", "/status")
+	if !ok || reply != "trader /status" {
+		t.Errorf("received %q %v expected command to be handled for trader", reply, ok)
+	}
+	reply, ok = p.HandleCommand("1338", "/status")
+	if !ok || !strings.Contains(reply, "not authorised") {
+		t.Errorf("received %q %v expected unauthorised chat user to be refused", reply, ok)
+	}
+}
@@ -63,6 +63,7 @@ func (s *Slack) Setup(cfg *config.CommunicationsConfig) {
 	s.Verbose = cfg.SlackConfig.Verbose
 	s.TargetChannel = cfg.SlackConfig.TargetChannel
 	s.VerificationToken = cfg.SlackConfig.VerificationToken
+	s.SetAuthorisedUsers(cfg.SlackConfig.AuthorisedUsers)
 }
 
 // Connect connects to the service
@@ -344,14 +345,20 @@ func (s *Slack) WebsocketKeepAlive() {
 	}
 }
 
-// WebsocketSend sends a message via the websocket connection
+// WebsocketSend sends a message to the target channel via the websocket
+// connection
 func (s *Slack) WebsocketSend(eventType, text string) error {
+	return s.websocketSendTo(s.TargetChannelID, eventType, text)
+}
+
+// websocketSendTo sends a message to the channel via the websocket connection
+func (s *Slack) websocketSendTo(channel, eventType, text string) error {
 	s.Lock()
 	defer s.Unlock()
 	newMessage := SendMessage{
 		ID:      time.Now().Unix(),
 		Type:    eventType,
-		Channel: s.TargetChannelID,
+		Channel: channel,
 		Text:    text,
 	}
 	data, err := json.Marshal(newMessage)
@@ -369,21 +376,31 @@ func (s *Slack) WebsocketSend(eventType, text string) error {
 	return s.WebsocketConn.WriteMessage(websocket.TextMessage, data)
 }
 
-// HandleMessage handles incoming messages and/or commands from slack
+// HandleMessage handles incoming messages and/or commands from slack. Replies
+// are sent to the channel the message was received on
 func (s *Slack) HandleMessage(msg *Message) error {
 	if msg == nil {
 		return errors.New("slack msg is nil")
 	}
 
+	channel := msg.Channel
+	if channel == "" {
+		channel = s.TargetChannelID
+	}
+
+	if reply, ok := s.HandleCommand(msg.User, msg.Text); ok {
+		return s.websocketSendTo(channel, "message", reply)
+	}
+
 	msg.Text = strings.ToLower(msg.Text)
 	switch {
 	case strings.Contains(msg.Text, cmdStatus):
-		return s.WebsocketSend("message", s.GetStatus())
+		return s.websocketSendTo(channel, "message", s.GetStatus())
 
 	case strings.Contains(msg.Text, cmdHelp):
-		return s.WebsocketSend("message", getHelp)
+		return s.websocketSendTo(channel, "message", getHelp)
 
 	default:
-		return s.WebsocketSend("message", "GoCryptoTrader SlackBot - Command Unknown!")
+		return s.websocketSendTo(channel, "message", "GoCryptoTrader SlackBot - Command Unknown!")
 	}
 }
@@ -2,8 +2,13 @@ package slack
 
 import (
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 )
@@ -303,3 +308,52 @@ func TestHandleMessage(t *testing.T) {
 		t.Error("slack HandleMessage(), Sent message through nil websocket")
 	}
 }
+
+func TestHandleMessageRepliesToChannel(t *testing.T) {
+	received := make(chan SendMessage, 2)
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		for {
+			var msg SendMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			received <- msg
+		}
+	}))
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	sl := Slack{TargetChannelID: "C-target", WebsocketConn: conn}
+
+	for _, tc := range []struct {
+		channel  string
+		expected string
+	}{
+		{"D-direct", "D-direct"},
+		{"", "C-target"},
+	} {
+		err = sl.HandleMessage(&Message{Channel: tc.channel, Text: cmdHelp})
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case msg := <-received:
+			if msg.Channel != tc.expected {
+				t.Errorf("received reply to %v expected %v", msg.Channel, tc.expected)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("reply not received")
+		}
+	}
+}
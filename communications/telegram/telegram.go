@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +39,9 @@ const (
 	/settings 	- Displays current bot settings`
 
 	talkRoot = "GoCryptoTrader bot"
+
+	// maxMessageLength is the longest message text the bot API accepts
+	maxMessageLength = 4096
 )
 
 var (
@@ -64,6 +68,16 @@ func (t *Telegram) Setup(cfg *config.CommunicationsConfig) {
 	t.Enabled = cfg.TelegramConfig.Enabled
 	t.Token = cfg.TelegramConfig.VerificationToken
 	t.Verbose = cfg.TelegramConfig.Verbose
+	t.SetAuthorisedUsers(cfg.TelegramConfig.AuthorisedUsers)
+	for i := range cfg.TelegramConfig.AuthorisedUsers {
+		id, err := strconv.ParseInt(cfg.TelegramConfig.AuthorisedUsers[i].ID, 10, 64)
+		if err != nil {
+			log.Warnf(log.CommunicationMgr, "Telegram: Invalid authorised user ID %s\n",
+				cfg.TelegramConfig.AuthorisedUsers[i].ID)
+			continue
+		}
+		t.AuthorisedClients = append(t.AuthorisedClients, id)
+	}
 }
 
 // Connect starts an initial connection
@@ -170,6 +184,12 @@ func (t *Telegram) HandleMessages(text string, chatID int64) error {
 		log.Debugf(log.CommunicationMgr, "Telegram: Received message: %s\n", text)
 	}
 
+	if !strings.Contains(text, cmdStart) {
+		if reply, ok := t.HandleCommand(strconv.FormatInt(chatID, 10), text); ok {
+			return t.SendMessage(reply, chatID)
+		}
+	}
+
 	switch {
 	case strings.Contains(text, cmdHelp):
 		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply), chatID)
@@ -210,6 +230,9 @@ func (t *Telegram) TestConnection() error {
 
 // SendMessage sends a message to a user by their chatID
 func (t *Telegram) SendMessage(text string, chatID int64) error {
+	if len(text) > maxMessageLength {
+		text = text[:maxMessageLength]
+	}
 	path := fmt.Sprintf(apiURL, t.Token, methodSendMessage)
 
 	messageToSend := struct {
@@ -23,6 +23,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -333,6 +335,24 @@ func (c *Config) CheckCommunicationsConfig() {
 			log.Warnln(log.ConfigMgr, "Telegram enabled in config but variable data not set, disabling.")
 		}
 	}
+	c.Communications.SlackConfig.AuthorisedUsers = checkChatUsers(
+		c.Communications.SlackConfig.Name, c.Communications.SlackConfig.AuthorisedUsers)
+	c.Communications.TelegramConfig.AuthorisedUsers = checkChatUsers(
+		c.Communications.TelegramConfig.Name, c.Communications.TelegramConfig.AuthorisedUsers)
+}
+
+// checkChatUsers removes authorised chat users without an ID or remote
+// control username
+func checkChatUsers(medium string, users []ChatUser) []ChatUser {
+	var checked []ChatUser
+	for i := range users {
+		if users[i].ID == "" || users[i].Username == "" {
+			log.Warnf(log.ConfigMgr, "%s authorised chat user missing ID or username, removing.\n", medium)
+			continue
+		}
+		checked = append(checked, users[i])
+	}
+	return checked
 }
 
 // GetExchangeAssetTypes returns the exchanges supported asset types
@@ -1299,6 +1319,30 @@ func (c *Config) checkGCTScriptConfig() error {
 		c.GCTScript.MaxVirtualMachines = gctscript.DefaultMaxVirtualMachines
 	}
 
+	for name, p := range c.GCTScript.Permissions {
+		if err := p.Validate(); err != nil {
+			// scripts must not run without the permissions they were given
+			c.GCTScript.Enabled = false
+			return fmt.Errorf("invalid permissions for script %s: %w", name, err)
+		}
+	}
+
+	if err := c.GCTScript.ValidateSigning(); err != nil {
+		// scripts must not run unverified when signatures are required
+		c.GCTScript.Enabled = false
+		return fmt.Errorf("invalid script signing: %w", err)
+	}
+
+	if err := c.GCTScript.ValidateWindows(); err != nil {
+		log.Warnf(log.ConfigMgr, "Invalid GCTScript maintenance windows ignored: %v\n", err)
+		c.GCTScript.MaintenanceWindows = nil
+	}
+
+	if err := c.GCTScript.ValidateComms(); err != nil {
+		log.Warnf(log.ConfigMgr, "Invalid GCTScript comms config, using defaults: %v\n", err)
+		c.GCTScript.Comms = gctscript.CommsConfig{}
+	}
+
 	scriptPath := c.GetDataPath("scripts")
 	err := common.CreateDir(scriptPath)
 	if err != nil {
@@ -1426,6 +1470,55 @@ func (c *Config) CheckConnectionMonitorConfig() {
 	}
 }
 
+// CheckCandleManagerConfig checks and if zero value assigns default values
+func (c *Config) CheckCandleManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.CandleManager.CloseDelay <= 0 {
+		c.CandleManager.CloseDelay = defaultCandleManagerCloseDelay
+	}
+
+	if len(c.CandleManager.Intervals) == 0 {
+		c.CandleManager.Intervals = []kline.Interval{kline.OneMin}
+		return
+	}
+
+	var intervals []kline.Interval
+	for i := range c.CandleManager.Intervals {
+		if c.CandleManager.Intervals[i].Duration() < time.Second {
+			log.Warnf(log.ConfigMgr,
+				"Candle manager interval %v invalid, removing.\n",
+				c.CandleManager.Intervals[i])
+			continue
+		}
+		intervals = append(intervals, c.CandleManager.Intervals[i])
+	}
+	if len(intervals) == 0 {
+		intervals = []kline.Interval{kline.OneMin}
+	}
+	c.CandleManager.Intervals = intervals
+}
+
+// CheckDataHistoryConfig checks and if zero value assigns default values
+func (c *Config) CheckDataHistoryConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.DataHistory.CheckInterval <= 0 {
+		c.DataHistory.CheckInterval = defaultDataHistoryCheckInterval
+	}
+	if c.DataHistory.MaxJobsPerCycle <= 0 {
+		c.DataHistory.MaxJobsPerCycle = defaultDataHistoryMaxJobsPerCycle
+	}
+	if c.DataHistory.MaxZeroVolumeRun <= 0 {
+		c.DataHistory.MaxZeroVolumeRun = defaultDataHistoryMaxZeroVolumeRun
+	}
+	if c.DataHistory.MaxPriceDeviation <= 0 {
+		c.DataHistory.MaxPriceDeviation = defaultDataHistoryMaxPriceDeviation
+	}
+}
+
 // DefaultFilePath returns the default config file path
 // MacOS/Linux: $HOME/.gocryptotrader/config.json or config.dat
 // Windows: %APPDATA%\GoCryptoTrader\config.json or config.dat
@@ -1723,6 +1816,152 @@ func (c *Config) CheckRemoteControlConfig() {
 		// Then flush the old webserver settings
 		c.Webserver = nil
 	}
+
+	for i := range c.RemoteControl.Users {
+		role, err := auth.ParseRole(string(c.RemoteControl.Users[i].Role))
+		if err != nil {
+			if c.RemoteControl.Users[i].Role != "" {
+				log.Warnf(log.ConfigMgr, "Remote control user %s %v, defaulting to %s\n",
+					c.RemoteControl.Users[i].Username, err, auth.RoleReadOnly)
+			}
+			role = auth.RoleReadOnly
+		}
+		c.RemoteControl.Users[i].Role = role
+	}
+
+	tokens := c.RemoteControl.Tokens[:0]
+	for i := range c.RemoteControl.Tokens {
+		if c.RemoteControl.Tokens[i].Name == "" || c.RemoteControl.Tokens[i].Hash == "" {
+			log.Warnln(log.ConfigMgr, "Remote control token name or hash unset, removing")
+			continue
+		}
+		role, err := auth.ParseRole(string(c.RemoteControl.Tokens[i].Role))
+		if err != nil {
+			log.Warnf(log.ConfigMgr, "Remote control token %s %v, defaulting to %s\n",
+				c.RemoteControl.Tokens[i].Name, err, auth.RoleReadOnly)
+			role = auth.RoleReadOnly
+		}
+		c.RemoteControl.Tokens[i].Role = role
+		tokens = append(tokens, c.RemoteControl.Tokens[i])
+	}
+	c.RemoteControl.Tokens = tokens
+}
+
+// CheckWithdrawalConfig normalises withdrawal policy currency codes, removes
+// invalid limits and thresholds and assigns the default required approvals
+func (c *Config) CheckWithdrawalConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	c.Withdrawal.DailyLimits = checkWithdrawalAmounts("daily limit", c.Withdrawal.DailyLimits)
+	c.Withdrawal.ApprovalThresholds = checkWithdrawalAmounts("approval threshold", c.Withdrawal.ApprovalThresholds)
+	if len(c.Withdrawal.ApprovalThresholds) > 0 && c.Withdrawal.RequiredApprovals <= 0 {
+		log.Warnf(log.ConfigMgr,
+			"Withdrawal required approvals value not set, defaulting to %v.\n",
+			defaultWithdrawalRequiredApprovals)
+		c.Withdrawal.RequiredApprovals = defaultWithdrawalRequiredApprovals
+	}
+}
+
+// CheckWithdrawalTrackerConfig checks and if zero value assigns default values
+func (c *Config) CheckWithdrawalTrackerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.WithdrawalTracker.PollInterval <= 0 {
+		c.WithdrawalTracker.PollInterval = defaultWithdrawalTrackerPollInterval
+	}
+	if c.WithdrawalTracker.MaxAge <= 0 {
+		c.WithdrawalTracker.MaxAge = defaultWithdrawalTrackerMaxAge
+	}
+}
+
+// CheckDepositTrackerConfig checks and if zero value assigns default values
+func (c *Config) CheckDepositTrackerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.DepositTracker.PollInterval <= 0 {
+		c.DepositTracker.PollInterval = defaultDepositTrackerPollInterval
+	}
+}
+
+// CheckRebalancerConfig checks and if zero value assigns default values,
+// removing rebalance targets without a currency or a valid allocation
+func (c *Config) CheckRebalancerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.Rebalancer.CheckInterval <= 0 {
+		c.Rebalancer.CheckInterval = defaultRebalancerCheckInterval
+	}
+
+	var targets []RebalanceTarget
+	for i := range c.Rebalancer.Targets {
+		target := c.Rebalancer.Targets[i]
+		if target.Currency == "" {
+			log.Warnf(log.ConfigMgr, "Rebalance target %d currency unset, removing.\n", i)
+			continue
+		}
+		target.Currency = strings.ToUpper(target.Currency)
+		allocations := make(map[string]float64, len(target.Allocations))
+		for exch, share := range target.Allocations {
+			if exch == "" || share < 0 {
+				log.Warnf(log.ConfigMgr,
+					"Rebalance target %s allocation %q %v invalid, removing.\n",
+					target.Currency, exch, share)
+				continue
+			}
+			allocations[exch] = share
+		}
+		if len(allocations) < 2 {
+			log.Warnf(log.ConfigMgr,
+				"Rebalance target %s requires allocations for at least two exchanges, removing.\n",
+				target.Currency)
+			continue
+		}
+		target.Allocations = allocations
+		if target.MinimumTransfer < 0 {
+			target.MinimumTransfer = 0
+		}
+		targets = append(targets, target)
+	}
+	c.Rebalancer.Targets = targets
+}
+
+// CheckPortfolioHistoryConfig checks and if zero value assigns default values
+func (c *Config) CheckPortfolioHistoryConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.PortfolioHistory.SnapshotInterval <= 0 {
+		c.PortfolioHistory.SnapshotInterval = defaultPortfolioSnapshotInterval
+	}
+	c.PortfolioHistory.FiatCurrency = strings.ToUpper(c.PortfolioHistory.FiatCurrency)
+	if c.PortfolioHistory.FiatCurrency != "" &&
+		!currency.NewCode(c.PortfolioHistory.FiatCurrency).IsFiatCurrency() {
+		log.Warnf(log.ConfigMgr,
+			"Portfolio history fiat currency %s is not a fiat currency, using fiat display currency.\n",
+			c.PortfolioHistory.FiatCurrency)
+		c.PortfolioHistory.FiatCurrency = ""
+	}
+}
+
+func checkWithdrawalAmounts(name string, amounts map[string]float64) map[string]float64 {
+	if len(amounts) == 0 {
+		return nil
+	}
+	checked := make(map[string]float64, len(amounts))
+	for code, amount := range amounts {
+		if code == "" || amount <= 0 {
+			log.Warnf(log.ConfigMgr,
+				"Withdrawal %s %v for currency %q invalid, removing.\n",
+				name, amount, code)
+			continue
+		}
+		checked[strings.ToUpper(code)] = amount
+	}
+	return checked
 }
 
 // CheckConfig checks all config settings
@@ -1754,6 +1993,13 @@ func (c *Config) CheckConfig() error {
 	}
 
 	c.CheckConnectionMonitorConfig()
+	c.CheckCandleManagerConfig()
+	c.CheckDataHistoryConfig()
+	c.CheckWithdrawalConfig()
+	c.CheckWithdrawalTrackerConfig()
+	c.CheckDepositTrackerConfig()
+	c.CheckRebalancerConfig()
+	c.CheckPortfolioHistoryConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
@@ -1800,6 +2046,7 @@ func (c *Config) UpdateConfig(configPath string, newCfg *Config, dryrun bool) er
 	c.Currency = newCfg.Currency
 	c.GlobalHTTPTimeout = newCfg.GlobalHTTPTimeout
 	c.Portfolio = newCfg.Portfolio
+	c.Withdrawal = newCfg.Withdrawal
 	c.Communications = newCfg.Communications
 	c.Webserver = newCfg.Webserver
 	c.Exchanges = newCfg.Exchanges
@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
@@ -15,6 +16,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/ntpclient"
@@ -422,6 +426,31 @@ func TestCheckCommunicationsConfig(t *testing.T) {
 	}
 }
 
+func TestCheckCommunicationsConfigChatUsers(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	c.Communications.TelegramConfig.Name = "Telegram"
+	c.Communications.SlackConfig.Name = "Slack"
+	c.Communications.TelegramConfig.AuthorisedUsers = []ChatUser{
+		{ID: "1337", Username: "trader"},
+		{ID: "", Username: "trader"},
+		{ID: "1338"},
+	}
+	c.Communications.SlackConfig.AuthorisedUsers = []ChatUser{
+		{ID: "U1337", Username: "viewer"},
+	}
+	c.CheckCommunicationsConfig()
+	if len(c.Communications.TelegramConfig.AuthorisedUsers) != 1 ||
+		c.Communications.TelegramConfig.AuthorisedUsers[0].ID != "1337" {
+		t.Errorf("expected incomplete chat users to be removed, got %v",
+			c.Communications.TelegramConfig.AuthorisedUsers)
+	}
+	if len(c.Communications.SlackConfig.AuthorisedUsers) != 1 {
+		t.Errorf("expected slack chat user to be kept, got %v",
+			c.Communications.SlackConfig.AuthorisedUsers)
+	}
+}
+
 func TestGetExchangeAssetTypes(t *testing.T) {
 	t.Parallel()
 	var c Config
@@ -1715,6 +1744,157 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	}
 }
 
+func TestCheckCandleManagerConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckCandleManagerConfig()
+	if c.CandleManager.CloseDelay != defaultCandleManagerCloseDelay {
+		t.Errorf("received %v expected %v", c.CandleManager.CloseDelay, defaultCandleManagerCloseDelay)
+	}
+	if len(c.CandleManager.Intervals) != 1 || c.CandleManager.Intervals[0] != kline.OneMin {
+		t.Errorf("received %v expected default interval", c.CandleManager.Intervals)
+	}
+
+	c.CandleManager.Intervals = []kline.Interval{kline.Interval(time.Millisecond), kline.OneHour}
+	c.CheckCandleManagerConfig()
+	if len(c.CandleManager.Intervals) != 1 || c.CandleManager.Intervals[0] != kline.OneHour {
+		t.Errorf("received %v expected invalid interval to be removed", c.CandleManager.Intervals)
+	}
+}
+
+func TestCheckDataHistoryConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckDataHistoryConfig()
+	if c.DataHistory.CheckInterval != defaultDataHistoryCheckInterval {
+		t.Errorf("received %v expected %v", c.DataHistory.CheckInterval, defaultDataHistoryCheckInterval)
+	}
+	if c.DataHistory.MaxJobsPerCycle != defaultDataHistoryMaxJobsPerCycle {
+		t.Errorf("received %v expected %v", c.DataHistory.MaxJobsPerCycle, defaultDataHistoryMaxJobsPerCycle)
+	}
+	if c.DataHistory.MaxZeroVolumeRun != defaultDataHistoryMaxZeroVolumeRun {
+		t.Errorf("received %v expected %v", c.DataHistory.MaxZeroVolumeRun, defaultDataHistoryMaxZeroVolumeRun)
+	}
+	if c.DataHistory.MaxPriceDeviation != defaultDataHistoryMaxPriceDeviation {
+		t.Errorf("received %v expected %v", c.DataHistory.MaxPriceDeviation, defaultDataHistoryMaxPriceDeviation)
+	}
+
+	c.DataHistory.MaxJobsPerCycle = 1
+	c.CheckDataHistoryConfig()
+	if c.DataHistory.MaxJobsPerCycle != 1 {
+		t.Errorf("received %v expected %v", c.DataHistory.MaxJobsPerCycle, 1)
+	}
+}
+
+func TestCheckWithdrawalTrackerConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckWithdrawalTrackerConfig()
+	if c.WithdrawalTracker.PollInterval != defaultWithdrawalTrackerPollInterval {
+		t.Errorf("received %v expected %v", c.WithdrawalTracker.PollInterval, defaultWithdrawalTrackerPollInterval)
+	}
+	if c.WithdrawalTracker.MaxAge != defaultWithdrawalTrackerMaxAge {
+		t.Errorf("received %v expected %v", c.WithdrawalTracker.MaxAge, defaultWithdrawalTrackerMaxAge)
+	}
+
+	c.WithdrawalTracker.PollInterval = time.Second
+	c.CheckWithdrawalTrackerConfig()
+	if c.WithdrawalTracker.PollInterval != time.Second {
+		t.Errorf("received %v expected %v", c.WithdrawalTracker.PollInterval, time.Second)
+	}
+}
+
+func TestCheckDepositTrackerConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckDepositTrackerConfig()
+	if c.DepositTracker.PollInterval != defaultDepositTrackerPollInterval {
+		t.Errorf("received %v expected %v", c.DepositTracker.PollInterval, defaultDepositTrackerPollInterval)
+	}
+
+	c.DepositTracker.PollInterval = time.Second
+	c.CheckDepositTrackerConfig()
+	if c.DepositTracker.PollInterval != time.Second {
+		t.Errorf("received %v expected %v", c.DepositTracker.PollInterval, time.Second)
+	}
+}
+
+func TestCheckRebalancerConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.Rebalancer.Targets = []RebalanceTarget{
+		{Allocations: map[string]float64{"a": 1, "b": 1}},
+		{Currency: "btc", Allocations: map[string]float64{"a": 1, "b": -1}},
+		{Currency: "eth", Allocations: map[string]float64{"a": 1, "b": 0, "": 1}, MinimumTransfer: -1},
+	}
+	c.CheckRebalancerConfig()
+	if c.Rebalancer.CheckInterval != defaultRebalancerCheckInterval {
+		t.Errorf("received %v expected %v", c.Rebalancer.CheckInterval, defaultRebalancerCheckInterval)
+	}
+	if len(c.Rebalancer.Targets) != 1 {
+		t.Fatalf("received %v expected %v", len(c.Rebalancer.Targets), 1)
+	}
+	target := c.Rebalancer.Targets[0]
+	if target.Currency != "ETH" {
+		t.Errorf("received %v expected %v", target.Currency, "ETH")
+	}
+	if len(target.Allocations) != 2 {
+		t.Errorf("received %v expected %v", len(target.Allocations), 2)
+	}
+	if target.MinimumTransfer != 0 {
+		t.Errorf("received %v expected %v", target.MinimumTransfer, 0)
+	}
+}
+
+func TestCheckPortfolioHistoryConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.PortfolioHistory.FiatCurrency = "aud"
+	c.CheckPortfolioHistoryConfig()
+	if c.PortfolioHistory.SnapshotInterval != defaultPortfolioSnapshotInterval {
+		t.Errorf("received %v expected %v", c.PortfolioHistory.SnapshotInterval, defaultPortfolioSnapshotInterval)
+	}
+	if c.PortfolioHistory.FiatCurrency != "AUD" {
+		t.Errorf("received %v expected %v", c.PortfolioHistory.FiatCurrency, "AUD")
+	}
+
+	c.PortfolioHistory.FiatCurrency = "btc"
+	c.CheckPortfolioHistoryConfig()
+	if c.PortfolioHistory.FiatCurrency != "" {
+		t.Errorf("received %v expected empty fiat currency", c.PortfolioHistory.FiatCurrency)
+	}
+}
+
+func TestCheckWithdrawalConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.Withdrawal.DailyLimits = map[string]float64{"btc": 1, "ETH": -1, "": 5}
+	c.Withdrawal.ApprovalThresholds = map[string]float64{"aud": 1000}
+	c.CheckWithdrawalConfig()
+	if len(c.Withdrawal.DailyLimits) != 1 || c.Withdrawal.DailyLimits["BTC"] != 1 {
+		t.Errorf("unexpected daily limits %v", c.Withdrawal.DailyLimits)
+	}
+	if c.Withdrawal.ApprovalThresholds["AUD"] != 1000 {
+		t.Errorf("unexpected approval thresholds %v", c.Withdrawal.ApprovalThresholds)
+	}
+	if c.Withdrawal.RequiredApprovals != defaultWithdrawalRequiredApprovals {
+		t.Errorf("received %v expected %v", c.Withdrawal.RequiredApprovals, defaultWithdrawalRequiredApprovals)
+	}
+
+	c.Withdrawal.RequiredApprovals = 3
+	c.CheckWithdrawalConfig()
+	if c.Withdrawal.RequiredApprovals != 3 {
+		t.Errorf("received %v expected %v", c.Withdrawal.RequiredApprovals, 3)
+	}
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
@@ -1791,6 +1971,36 @@ func TestCheckRemoteControlConfig(t *testing.T) {
 	}
 }
 
+func TestCheckRemoteControlConfigRoles(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.RemoteControl.Users = []RPCUser{
+		{Username: "a", Role: "Trader"},
+		{Username: "b"},
+		{Username: "c", Role: "superuser"},
+	}
+	c.RemoteControl.Tokens = []RPCToken{
+		{Name: "a", Hash: "abc", Role: auth.RoleWithdrawer},
+		{Name: "b", Hash: "abc", Role: "superuser"},
+		{Name: "c"},
+	}
+	c.CheckRemoteControlConfig()
+
+	expectedRoles := []auth.Role{auth.RoleTrader, auth.RoleReadOnly, auth.RoleReadOnly}
+	for i := range expectedRoles {
+		if c.RemoteControl.Users[i].Role != expectedRoles[i] {
+			t.Errorf("user %d received %v expected %v", i, c.RemoteControl.Users[i].Role, expectedRoles[i])
+		}
+	}
+	if len(c.RemoteControl.Tokens) != 2 {
+		t.Fatalf("received %v tokens expected %v", len(c.RemoteControl.Tokens), 2)
+	}
+	if c.RemoteControl.Tokens[0].Role != auth.RoleWithdrawer ||
+		c.RemoteControl.Tokens[1].Role != auth.RoleReadOnly {
+		t.Errorf("unexpected token roles %+v", c.RemoteControl.Tokens)
+	}
+}
+
 func TestCheckConfig(t *testing.T) {
 	var c Config
 	err := c.LoadConfig(TestFile, true)
@@ -1922,6 +2132,32 @@ func TestCheckGCTScriptConfig(t *testing.T) {
 	if c.GCTScript.MaxVirtualMachines != gctscript.DefaultMaxVirtualMachines {
 		t.Fatal("unexpected value return")
 	}
+
+	c.GCTScript.Enabled = true
+	c.GCTScript.Permissions = map[string]modules.Permissions{
+		gctscript.AllScripts: {Access: modules.AccessRead},
+	}
+	if err := c.checkGCTScriptConfig(); err != nil {
+		t.Error(err)
+	}
+
+	c.GCTScript.Permissions["trade.gct"] = modules.Permissions{Access: "admin"}
+	if err := c.checkGCTScriptConfig(); err == nil {
+		t.Error("expected invalid permissions error")
+	}
+	if c.GCTScript.Enabled {
+		t.Error("expected gctscript to be disabled")
+	}
+
+	c.GCTScript.Enabled = true
+	delete(c.GCTScript.Permissions, "trade.gct")
+	c.GCTScript.RequireSignedScripts = true
+	if err := c.checkGCTScriptConfig(); err == nil {
+		t.Error("expected missing public keys error")
+	}
+	if c.GCTScript.Enabled {
+		t.Error("expected gctscript to be disabled")
+	}
 }
 
 func TestCheckDatabaseConfig(t *testing.T) {
@@ -6,7 +6,9 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
@@ -31,6 +33,17 @@ const (
 	maxAuthFailures                      = 3
 	defaultNTPAllowedDifference          = 50000000
 	defaultNTPAllowedNegativeDifference  = 50000000
+	defaultCandleManagerCloseDelay       = time.Second * 5
+	defaultDataHistoryCheckInterval      = time.Minute
+	defaultDataHistoryMaxJobsPerCycle    = 5
+	defaultDataHistoryMaxZeroVolumeRun   = 10
+	defaultDataHistoryMaxPriceDeviation  = 50
+	defaultWithdrawalRequiredApprovals   = 1
+	defaultWithdrawalTrackerPollInterval = time.Minute * 5
+	defaultWithdrawalTrackerMaxAge       = time.Hour * 24 * 7
+	defaultDepositTrackerPollInterval    = time.Minute * 5
+	defaultRebalancerCheckInterval       = time.Hour
+	defaultPortfolioSnapshotInterval     = time.Hour
 	DefaultAPIKey                        = "Key"
 	DefaultAPISecret                     = "Secret"
 	DefaultAPIClientID                   = "ClientID"
@@ -75,22 +88,30 @@ var (
 // prestart management of Portfolio, Communications, Webserver and Enabled
 // Exchanges
 type Config struct {
-	Name              string                  `json:"name"`
-	DataDirectory     string                  `json:"dataDirectory"`
-	EncryptConfig     int                     `json:"encryptConfig"`
-	GlobalHTTPTimeout time.Duration           `json:"globalHTTPTimeout"`
-	Database          database.Config         `json:"database"`
-	Logging           log.Config              `json:"logging"`
-	ConnectionMonitor ConnectionMonitorConfig `json:"connectionMonitor"`
-	Profiler          Profiler                `json:"profiler"`
-	NTPClient         NTPClientConfig         `json:"ntpclient"`
-	GCTScript         gctscript.Config        `json:"gctscript"`
-	Currency          CurrencyConfig          `json:"currencyConfig"`
-	Communications    CommunicationsConfig    `json:"communications"`
-	RemoteControl     RemoteControlConfig     `json:"remoteControl"`
-	Portfolio         portfolio.Base          `json:"portfolioAddresses"`
-	Exchanges         []ExchangeConfig        `json:"exchanges"`
-	BankAccounts      []banking.Account       `json:"bankAccounts"`
+	Name               string                     `json:"name"`
+	DataDirectory      string                     `json:"dataDirectory"`
+	EncryptConfig      int                        `json:"encryptConfig"`
+	GlobalHTTPTimeout  time.Duration              `json:"globalHTTPTimeout"`
+	Database           database.Config            `json:"database"`
+	Logging            log.Config                 `json:"logging"`
+	ConnectionMonitor  ConnectionMonitorConfig    `json:"connectionMonitor"`
+	Profiler           Profiler                   `json:"profiler"`
+	NTPClient          NTPClientConfig            `json:"ntpclient"`
+	CandleManager      CandleManagerConfig        `json:"candleManager"`
+	DataHistory        DataHistoryConfig          `json:"dataHistoryManager"`
+	GCTScript          gctscript.Config           `json:"gctscript"`
+	Currency           CurrencyConfig             `json:"currencyConfig"`
+	Communications     CommunicationsConfig       `json:"communications"`
+	RemoteControl      RemoteControlConfig        `json:"remoteControl"`
+	Portfolio          portfolio.Base             `json:"portfolioAddresses"`
+	PortfolioProviders []portfolio.ProviderConfig `json:"portfolioProviders"`
+	Exchanges          []ExchangeConfig           `json:"exchanges"`
+	BankAccounts       []banking.Account          `json:"bankAccounts"`
+	Withdrawal         WithdrawalConfig           `json:"withdrawal"`
+	WithdrawalTracker  WithdrawalTrackerConfig    `json:"withdrawalTracker"`
+	DepositTracker     DepositTrackerConfig       `json:"depositTracker"`
+	Rebalancer         RebalancerConfig           `json:"rebalancer"`
+	PortfolioHistory   PortfolioHistoryConfig     `json:"portfolioHistory"`
 
 	// Deprecated config settings, will be removed at a future date
 	Webserver           *WebserverConfig          `json:"webserver,omitempty"`
@@ -167,12 +188,130 @@ type NTPClientConfig struct {
 	AllowedNegativeDifference *time.Duration `json:"allowedNegativeDifference"`
 }
 
+// CandleManagerConfig defines the live candle aggregation settings. Candles
+// are built for every interval listed and emitted once closed
+type CandleManagerConfig struct {
+	Enabled        bool             `json:"enabled"`
+	Verbose        bool             `json:"verbose"`
+	Intervals      []kline.Interval `json:"intervals"`
+	CloseDelay     time.Duration    `json:"closeDelay"`
+	SaveToDatabase bool             `json:"saveToDatabase"`
+}
+
+// WithdrawalConfig defines the treasury policy applied to withdrawal requests
+// before they are submitted to an exchange
+type WithdrawalConfig struct {
+	Enabled bool `json:"enabled"`
+	// DailyLimits is the maximum amount per currency code that can be
+	// requested for withdrawal in a rolling 24 hour period
+	DailyLimits map[string]float64 `json:"dailyLimits,omitempty"`
+	// ApprovalThresholds is the amount per currency code above which a
+	// request is held until it receives RequiredApprovals approvals
+	ApprovalThresholds map[string]float64 `json:"approvalThresholds,omitempty"`
+	RequiredApprovals  int                `json:"requiredApprovals"`
+}
+
+// WithdrawalTrackerConfig defines the withdrawal tracker settings which poll
+// exchange withdrawal history until submitted withdrawals complete
+type WithdrawalTrackerConfig struct {
+	Enabled      bool          `json:"enabled"`
+	PollInterval time.Duration `json:"pollInterval"`
+	// MaxAge is how long a withdrawal is tracked before it is marked as
+	// expired
+	MaxAge time.Duration `json:"maxAge"`
+}
+
+// DepositTrackerConfig defines the deposit tracker settings which poll
+// exchange deposit history for deposits to known deposit addresses
+type DepositTrackerConfig struct {
+	Enabled      bool          `json:"enabled"`
+	PollInterval time.Duration `json:"pollInterval"`
+}
+
+// RebalancerConfig defines the target allocations the rebalancer plans
+// transfers between exchanges to reach
+type RebalancerConfig struct {
+	Enabled       bool              `json:"enabled"`
+	CheckInterval time.Duration     `json:"checkInterval"`
+	Targets       []RebalanceTarget `json:"targets"`
+}
+
+// RebalanceTarget defines how a currency's combined balance should be split
+// across exchanges
+type RebalanceTarget struct {
+	Currency string `json:"currency"`
+	// Allocations is the share of the combined balance to hold on each
+	// exchange. Shares are relative to each other and need not sum to one
+	Allocations map[string]float64 `json:"allocations"`
+	// MinimumTransfer is the smallest transfer worth planning
+	MinimumTransfer float64 `json:"minimumTransfer"`
+}
+
+// PortfolioHistoryConfig defines how often the portfolio is valued and
+// stored and the fiat currency it is valued in
+type PortfolioHistoryConfig struct {
+	Enabled          bool          `json:"enabled"`
+	SnapshotInterval time.Duration `json:"snapshotInterval"`
+	// FiatCurrency defaults to the fiat display currency when unset
+	FiatCurrency string `json:"fiatCurrency"`
+}
+
+// DataHistoryConfig defines the data history manager settings which fill gaps
+// in stored candle and trade data
+type DataHistoryConfig struct {
+	Enabled         bool          `json:"enabled"`
+	Verbose         bool          `json:"verbose"`
+	CheckInterval   time.Duration `json:"checkInterval"`
+	MaxJobsPerCycle int64         `json:"maxJobsPerCycle"`
+	// MaxZeroVolumeRun is the number of consecutive zero volume candles
+	// allowed before the run is flagged during validation
+	MaxZeroVolumeRun int64 `json:"maxZeroVolumeRun"`
+	// MaxPriceDeviation is the percentage move between consecutive candle
+	// closes before a candle is flagged as an outlier during validation
+	MaxPriceDeviation float64 `json:"maxPriceDeviation"`
+}
+
 // GRPCConfig stores the gRPC settings
 type GRPCConfig struct {
-	Enabled                bool   `json:"enabled"`
-	ListenAddress          string `json:"listenAddress"`
-	GRPCProxyEnabled       bool   `json:"grpcProxyEnabled"`
-	GRPCProxyListenAddress string `json:"grpcProxyListenAddress"`
+	Enabled                bool                 `json:"enabled"`
+	ListenAddress          string               `json:"listenAddress"`
+	GRPCProxyEnabled       bool                 `json:"grpcProxyEnabled"`
+	GRPCProxyListenAddress string               `json:"grpcProxyListenAddress"`
+	ClientCertificates     GRPCClientCertConfig `json:"clientCertificates"`
+}
+
+// GRPCClientCertConfig stores the mutual TLS settings of the gRPC server.
+// Clients presenting a certificate signed by the CA are identified by the
+// certificate subject's common name, which must match a remote control user
+type GRPCClientCertConfig struct {
+	Enabled bool `json:"enabled"`
+	// Required rejects connections without a client certificate signed by the
+	// CA, otherwise clients may still use basic or bearer authorisation
+	Required bool `json:"required"`
+	// CAFile is the PEM encoded CA certificate, defaulting to ca.pem in the
+	// TLS directory
+	CAFile string `json:"caFile,omitempty"`
+}
+
+// RPCUser stores credentials for an additional gRPC user
+type RPCUser struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// Role limits the remote procedures the user may call and defaults to
+	// read only
+	Role auth.Role `json:"role,omitempty"`
+}
+
+// RPCToken stores a scoped API token. Only the SHA256 hash of the token is
+// kept and the token is rejected after it expires
+type RPCToken struct {
+	Name string `json:"name"`
+	Hash string `json:"hash"`
+	// Owner is the remote control user who created the token. Withdrawals
+	// requested or approved with the token are attributed to the owner
+	Owner   string    `json:"owner"`
+	Role    auth.Role `json:"role"`
+	Expires time.Time `json:"expires"`
 }
 
 // DepcrecatedRPCConfig stores the deprecatedRPCConfig settings
@@ -194,6 +333,11 @@ type WebsocketRPCConfig struct {
 type RemoteControlConfig struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	// Users holds additional gRPC credentials, allowing withdrawal approvals
+	// to be signed off by distinct users
+	Users []RPCUser `json:"users,omitempty"`
+	// Tokens holds API tokens accepted as bearer authorisation
+	Tokens []RPCToken `json:"tokens,omitempty"`
 
 	GRPC          GRPCConfig           `json:"gRPC"`
 	DeprecatedRPC DepcrecatedRPCConfig `json:"deprecatedRPC"`
@@ -274,11 +418,19 @@ func (c *CommunicationsConfig) IsAnyEnabled() bool {
 
 // SlackConfig holds all variables to start and run the Slack package
 type SlackConfig struct {
-	Name              string `json:"name"`
-	Enabled           bool   `json:"enabled"`
-	Verbose           bool   `json:"verbose"`
-	TargetChannel     string `json:"targetChannel"`
-	VerificationToken string `json:"verificationToken"`
+	Name              string     `json:"name"`
+	Enabled           bool       `json:"enabled"`
+	Verbose           bool       `json:"verbose"`
+	TargetChannel     string     `json:"targetChannel"`
+	VerificationToken string     `json:"verificationToken"`
+	AuthorisedUsers   []ChatUser `json:"authorisedUsers,omitempty"`
+}
+
+// ChatUser maps a chat user ID to the remote control user whose role governs
+// the commands they may send
+type ChatUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
 }
 
 // SMSContact stores the SMS contact info
@@ -315,10 +467,11 @@ type SMTPConfig struct {
 
 // TelegramConfig holds all variables to start and run the Telegram package
 type TelegramConfig struct {
-	Name              string `json:"name"`
-	Enabled           bool   `json:"enabled"`
-	Verbose           bool   `json:"verbose"`
-	VerificationToken string `json:"verificationToken"`
+	Name              string     `json:"name"`
+	Enabled           bool       `json:"enabled"`
+	Verbose           bool       `json:"verbose"`
+	VerificationToken string     `json:"verificationToken"`
+	AuthorisedUsers   []ChatUser `json:"authorisedUsers,omitempty"`
 }
 
 // FeaturesSupportedConfig stores the exchanges supported features
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS datahistoryjob
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    nickname varchar(255) NOT NULL,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    interval bigint NOT NULL,
+    data_type bigint NOT NULL,
+    request_size bigint NOT NULL,
+    batch_size bigint NOT NULL,
+    max_retries bigint NOT NULL,
+    status bigint NOT NULL,
+    created TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquenickname
+        unique(nickname)
+);
+
+CREATE TABLE IF NOT EXISTS datahistoryjobresult
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_id uuid REFERENCES datahistoryjob(id) ON DELETE CASCADE NOT NULL,
+    result TEXT,
+    status bigint NOT NULL,
+    interval_start_time TIMESTAMPTZ NOT NULL,
+    interval_end_time TIMESTAMPTZ NOT NULL,
+    run_time TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose Down
+DROP TABLE datahistoryjobresult;
+DROP TABLE datahistoryjob;
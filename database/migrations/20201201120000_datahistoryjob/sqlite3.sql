@@ -0,0 +1,36 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS datahistoryjob
+(
+    id text not null primary key,
+    nickname text NOT NULL,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    interval integer NOT NULL,
+    data_type integer NOT NULL,
+    request_size integer NOT NULL,
+    batch_size integer NOT NULL,
+    max_retries integer NOT NULL,
+    status integer NOT NULL,
+    created TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquenickname
+        unique(nickname)
+);
+
+CREATE TABLE IF NOT EXISTS datahistoryjobresult
+(
+    id text not null primary key,
+    job_id text NOT NULL,
+    result text,
+    status integer NOT NULL,
+    interval_start_time TIMESTAMP NOT NULL,
+    interval_end_time TIMESTAMP NOT NULL,
+    run_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(job_id) REFERENCES datahistoryjob(id) ON DELETE CASCADE
+);
+-- +goose Down
+DROP TABLE datahistoryjobresult;
+DROP TABLE datahistoryjob;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS script_version
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    script_name varchar NOT NULL,
+    version bigint NOT NULL,
+    hash varchar NOT NULL,
+    author varchar NOT NULL,
+    data bytea NOT NULL,
+    signature varchar NOT NULL DEFAULT '',
+    signer varchar NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquescriptversion
+        unique(script_name, version)
+);
+-- +goose Down
+DROP TABLE script_version;
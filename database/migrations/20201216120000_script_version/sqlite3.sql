@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS script_version
+(
+    id text not null primary key,
+    script_name text NOT NULL,
+    version integer NOT NULL,
+    hash text NOT NULL,
+    author text NOT NULL,
+    data blob NOT NULL,
+    signature text NOT NULL DEFAULT '',
+    signer text NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquescriptversion
+        unique(script_name, version)
+);
+-- +goose Down
+DROP TABLE script_version;
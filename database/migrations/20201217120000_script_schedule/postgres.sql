@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS script_schedule
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    name varchar NOT NULL UNIQUE,
+    script_name varchar NOT NULL,
+    cron_expression varchar NOT NULL DEFAULT '',
+    run_at TIMESTAMPTZ NULL,
+    exchanges varchar NOT NULL DEFAULT '',
+    skip_windows TEXT NOT NULL DEFAULT '',
+    enabled boolean NOT NULL DEFAULT true,
+    last_run_at TIMESTAMPTZ NULL,
+    last_status varchar NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose Down
+DROP TABLE script_schedule;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS script_schedule
+(
+    id text not null primary key,
+    name text NOT NULL UNIQUE,
+    script_name text NOT NULL,
+    cron_expression text NOT NULL DEFAULT '',
+    run_at TIMESTAMP NULL,
+    exchanges text NOT NULL DEFAULT '',
+    skip_windows text NOT NULL DEFAULT '',
+    enabled integer NOT NULL DEFAULT 1,
+    last_run_at TIMESTAMP NULL,
+    last_status text NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose Down
+DROP TABLE script_schedule;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE withdrawal_history ADD COLUMN approval_status varchar NOT NULL DEFAULT '';
+ALTER TABLE withdrawal_history ADD COLUMN requested_by varchar NOT NULL DEFAULT '';
+CREATE TABLE IF NOT EXISTS withdrawal_approval
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    withdrawal_history_id uuid NOT NULL REFERENCES withdrawal_history(id) ON DELETE CASCADE,
+    username varchar NOT NULL,
+    approved boolean NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquewithdrawalapproval
+        unique(withdrawal_history_id, username)
+);
+-- +goose Down
+DROP TABLE withdrawal_approval;
+ALTER TABLE withdrawal_history DROP COLUMN requested_by;
+ALTER TABLE withdrawal_history DROP COLUMN approval_status;
@@ -0,0 +1,39 @@
+-- +goose Up
+ALTER TABLE withdrawal_history ADD COLUMN approval_status text NOT NULL DEFAULT '';
+ALTER TABLE withdrawal_history ADD COLUMN requested_by text NOT NULL DEFAULT '';
+CREATE TABLE IF NOT EXISTS withdrawal_approval
+(
+    id text not null primary key,
+    withdrawal_history_id text NOT NULL,
+    username text NOT NULL,
+    approved integer NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(withdrawal_history_id) REFERENCES withdrawal_history(id) ON DELETE CASCADE,
+    CONSTRAINT uniquewithdrawalapproval
+        unique(withdrawal_history_id, username)
+);
+-- +goose Down
+DROP TABLE withdrawal_approval;
+CREATE TABLE IF NOT EXISTS withdrawal_history_new
+(
+    id                            text                  PRIMARY KEY NOT NULL,
+    exchange_name_id              text                  NOT NULL,
+    exchange_id                   text                  NOT NULL,
+    status                        text                  NOT NULL,
+    currency                      text                  NOT NULL,
+    amount                        real                  NOT NULL,
+    description                   text,
+    withdraw_type                 integer               NOT NULL,
+    created_at                    timestamp             NOT NULL default CURRENT_TIMESTAMP,
+    updated_at                    timestamp             NOT NULL default CURRENT_TIMESTAMP,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT
+);
+INSERT INTO
+    withdrawal_history_new (id, exchange_name_id, exchange_id, status, currency, amount, description, withdraw_type, created_at, updated_at)
+SELECT
+    id, exchange_name_id, exchange_id, status, currency, amount, description, withdraw_type, created_at, updated_at
+FROM
+    withdrawal_history;
+
+DROP TABLE withdrawal_history;
+ALTER TABLE withdrawal_history_new RENAME TO withdrawal_history;
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE withdrawal_history ADD COLUMN tracking_status varchar NOT NULL DEFAULT '';
+ALTER TABLE withdrawal_crypto ADD COLUMN tx_id text NULL;
+-- +goose Down
+ALTER TABLE withdrawal_crypto DROP COLUMN tx_id;
+ALTER TABLE withdrawal_history DROP COLUMN tracking_status;
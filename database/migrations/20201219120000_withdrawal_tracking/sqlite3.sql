@@ -0,0 +1,48 @@
+-- +goose Up
+ALTER TABLE withdrawal_history ADD COLUMN tracking_status text NOT NULL DEFAULT '';
+ALTER TABLE withdrawal_crypto ADD COLUMN tx_id text NULL;
+-- +goose Down
+CREATE TABLE IF NOT EXISTS withdrawal_crypto_new
+(
+    id	        integer not null primary key,
+    address                   text NOT NULL,
+    address_tag               text NULL,
+    fee                       real NOT NULL,
+    withdrawal_history_id  text NOT NULL,
+    FOREIGN KEY(withdrawal_history_id) REFERENCES withdrawal_history(id) ON DELETE RESTRICT
+);
+INSERT INTO
+    withdrawal_crypto_new (id, address, address_tag, fee, withdrawal_history_id)
+SELECT
+    id, address, address_tag, fee, withdrawal_history_id
+FROM
+    withdrawal_crypto;
+
+DROP TABLE withdrawal_crypto;
+ALTER TABLE withdrawal_crypto_new RENAME TO withdrawal_crypto;
+
+CREATE TABLE IF NOT EXISTS withdrawal_history_new
+(
+    id                            text                  PRIMARY KEY NOT NULL,
+    exchange_name_id              text                  NOT NULL,
+    exchange_id                   text                  NOT NULL,
+    status                        text                  NOT NULL,
+    currency                      text                  NOT NULL,
+    amount                        real                  NOT NULL,
+    description                   text,
+    withdraw_type                 integer               NOT NULL,
+    created_at                    timestamp             NOT NULL default CURRENT_TIMESTAMP,
+    updated_at                    timestamp             NOT NULL default CURRENT_TIMESTAMP,
+    approval_status               text                  NOT NULL DEFAULT '',
+    requested_by                  text                  NOT NULL DEFAULT '',
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT
+);
+INSERT INTO
+    withdrawal_history_new (id, exchange_name_id, exchange_id, status, currency, amount, description, withdraw_type, created_at, updated_at, approval_status, requested_by)
+SELECT
+    id, exchange_name_id, exchange_id, status, currency, amount, description, withdraw_type, created_at, updated_at, approval_status, requested_by
+FROM
+    withdrawal_history;
+
+DROP TABLE withdrawal_history;
+ALTER TABLE withdrawal_history_new RENAME TO withdrawal_history;
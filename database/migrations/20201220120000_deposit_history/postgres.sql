@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS deposit_history
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid NOT NULL REFERENCES exchange(id) ON DELETE RESTRICT,
+    transfer_id varchar NOT NULL,
+    currency varchar NOT NULL,
+    amount double precision NOT NULL,
+    fee double precision NOT NULL DEFAULT 0,
+    status varchar NOT NULL,
+    address varchar NOT NULL DEFAULT '',
+    tx_id varchar NOT NULL DEFAULT '',
+    confirmations bigint NOT NULL DEFAULT 0,
+    credited boolean NOT NULL DEFAULT false,
+    deposited_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uniquedeposithistory
+        unique(exchange_name_id, currency, transfer_id)
+);
+-- +goose Down
+DROP TABLE deposit_history;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS deposit_history
+(
+    id text not null primary key,
+    exchange_name_id text NOT NULL,
+    transfer_id text NOT NULL,
+    currency text NOT NULL,
+    amount real NOT NULL,
+    fee real NOT NULL DEFAULT 0,
+    status text NOT NULL,
+    address text NOT NULL DEFAULT '',
+    tx_id text NOT NULL DEFAULT '',
+    confirmations integer NOT NULL DEFAULT 0,
+    credited integer NOT NULL DEFAULT 0,
+    deposited_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    CONSTRAINT uniquedeposithistory
+        unique(exchange_name_id, currency, transfer_id)
+);
+-- +goose Down
+DROP TABLE deposit_history;
@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS portfolio_snapshot
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    fiat_currency varchar NOT NULL,
+    total_value double precision NOT NULL,
+    realised_pnl double precision NOT NULL DEFAULT 0,
+    unrealised_pnl double precision NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX portfolio_snapshot_created_at_idx ON portfolio_snapshot (created_at);
+
+CREATE TABLE IF NOT EXISTS portfolio_snapshot_holding
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    portfolio_snapshot_id uuid NOT NULL REFERENCES portfolio_snapshot(id) ON DELETE CASCADE,
+    currency varchar NOT NULL,
+    amount double precision NOT NULL,
+    price double precision NOT NULL,
+    value double precision NOT NULL,
+    realised_pnl double precision NOT NULL DEFAULT 0,
+    unrealised_pnl double precision NOT NULL DEFAULT 0,
+    CONSTRAINT uniqueportfoliosnapshotholding
+        unique(portfolio_snapshot_id, currency)
+);
+-- +goose Down
+DROP TABLE portfolio_snapshot_holding;
+DROP TABLE portfolio_snapshot;
@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS portfolio_snapshot
+(
+    id text not null primary key,
+    fiat_currency text NOT NULL,
+    total_value real NOT NULL,
+    realised_pnl real NOT NULL DEFAULT 0,
+    unrealised_pnl real NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX portfolio_snapshot_created_at_idx ON portfolio_snapshot (created_at);
+
+CREATE TABLE IF NOT EXISTS portfolio_snapshot_holding
+(
+    id text not null primary key,
+    portfolio_snapshot_id text NOT NULL,
+    currency text NOT NULL,
+    amount real NOT NULL,
+    price real NOT NULL,
+    value real NOT NULL,
+    realised_pnl real NOT NULL DEFAULT 0,
+    unrealised_pnl real NOT NULL DEFAULT 0,
+    FOREIGN KEY(portfolio_snapshot_id) REFERENCES portfolio_snapshot(id) ON DELETE CASCADE,
+    CONSTRAINT uniqueportfoliosnapshotholding
+        unique(portfolio_snapshot_id, currency)
+);
+-- +goose Down
+DROP TABLE portfolio_snapshot_holding;
+DROP TABLE portfolio_snapshot;
@@ -4,23 +4,41 @@
 package postgres
 
 var TableNames = struct {
-	AuditEvent        string
-	Candle            string
-	Exchange          string
-	Script            string
-	ScriptExecution   string
-	Trade             string
-	WithdrawalCrypto  string
-	WithdrawalFiat    string
-	WithdrawalHistory string
+	AuditEvent               string
+	Candle                   string
+	Datahistoryjob           string
+	Datahistoryjobresult     string
+	DepositHistory           string
+	Exchange                 string
+	PortfolioSnapshot        string
+	PortfolioSnapshotHolding string
+	Script                   string
+	ScriptExecution          string
+	ScriptSchedule           string
+	ScriptState              string
+	ScriptVersion            string
+	Trade                    string
+	WithdrawalApproval       string
+	WithdrawalCrypto         string
+	WithdrawalFiat           string
+	WithdrawalHistory        string
 }{
-	AuditEvent:        "audit_event",
-	Candle:            "candle",
-	Exchange:          "exchange",
-	Script:            "script",
-	ScriptExecution:   "script_execution",
-	Trade:             "trade",
-	WithdrawalCrypto:  "withdrawal_crypto",
-	WithdrawalFiat:    "withdrawal_fiat",
-	WithdrawalHistory: "withdrawal_history",
+	AuditEvent:               "audit_event",
+	Candle:                   "candle",
+	Datahistoryjob:           "datahistoryjob",
+	Datahistoryjobresult:     "datahistoryjobresult",
+	DepositHistory:           "deposit_history",
+	Exchange:                 "exchange",
+	PortfolioSnapshot:        "portfolio_snapshot",
+	PortfolioSnapshotHolding: "portfolio_snapshot_holding",
+	Script:                   "script",
+	ScriptExecution:          "script_execution",
+	ScriptSchedule:           "script_schedule",
+	ScriptState:              "script_state",
+	ScriptVersion:            "script_version",
+	Trade:                    "trade",
+	WithdrawalApproval:       "withdrawal_approval",
+	WithdrawalCrypto:         "withdrawal_crypto",
+	WithdrawalFiat:           "withdrawal_fiat",
+	WithdrawalHistory:        "withdrawal_history",
 }
@@ -7,6 +7,21 @@ import "testing"
 
 func TestUpsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpsert)
+	t.Run("Candles", testCandlesUpsert)
+	t.Run("Datahistoryjobs", testDatahistoryjobsUpsert)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpsert)
+	t.Run("DepositHistories", testDepositHistoriesUpsert)
 	t.Run("Exchanges", testExchangesUpsert)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsUpsert)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsUpsert)
 	t.Run("Scripts", testScriptsUpsert)
+	t.Run("ScriptExecutions", testScriptExecutionsUpsert)
+	t.Run("ScriptSchedules", testScriptSchedulesUpsert)
+	t.Run("ScriptStates", testScriptStatesUpsert)
+	t.Run("ScriptVersions", testScriptVersionsUpsert)
+	t.Run("Trades", testTradesUpsert)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsUpsert)
+	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpsert)
+	t.Run("WithdrawalFiats", testWithdrawalFiatsUpsert)
+	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpsert)
 }
@@ -14,10 +14,19 @@ import "testing"
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
 	t.Run("Candles", testCandles)
+	t.Run("Datahistoryjobs", testDatahistoryjobs)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
+	t.Run("DepositHistories", testDepositHistories)
 	t.Run("Exchanges", testExchanges)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshots)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldings)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("ScriptSchedules", testScriptSchedules)
+	t.Run("ScriptStates", testScriptStates)
+	t.Run("ScriptVersions", testScriptVersions)
 	t.Run("Trades", testTrades)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovals)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptos)
 	t.Run("WithdrawalFiats", testWithdrawalFiats)
 	t.Run("WithdrawalHistories", testWithdrawalHistories)
@@ -26,10 +35,19 @@ func TestParent(t *testing.T) {
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
 	t.Run("Candles", testCandlesDelete)
+	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
+	t.Run("DepositHistories", testDepositHistoriesDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsDelete)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("ScriptSchedules", testScriptSchedulesDelete)
+	t.Run("ScriptStates", testScriptStatesDelete)
+	t.Run("ScriptVersions", testScriptVersionsDelete)
 	t.Run("Trades", testTradesDelete)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsDelete)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosDelete)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsDelete)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesDelete)
@@ -38,10 +56,19 @@ func TestDelete(t *testing.T) {
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
 	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
+	t.Run("DepositHistories", testDepositHistoriesQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsQueryDeleteAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("ScriptSchedules", testScriptSchedulesQueryDeleteAll)
+	t.Run("ScriptStates", testScriptStatesQueryDeleteAll)
+	t.Run("ScriptVersions", testScriptVersionsQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsQueryDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosQueryDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsQueryDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesQueryDeleteAll)
@@ -50,10 +77,19 @@ func TestQueryDeleteAll(t *testing.T) {
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
 	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
+	t.Run("DepositHistories", testDepositHistoriesSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsSliceDeleteAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("ScriptSchedules", testScriptSchedulesSliceDeleteAll)
+	t.Run("ScriptStates", testScriptStatesSliceDeleteAll)
+	t.Run("ScriptVersions", testScriptVersionsSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsSliceDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceDeleteAll)
@@ -62,10 +98,19 @@ func TestSliceDeleteAll(t *testing.T) {
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
 	t.Run("Candles", testCandlesExists)
+	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
+	t.Run("DepositHistories", testDepositHistoriesExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsExists)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("ScriptSchedules", testScriptSchedulesExists)
+	t.Run("ScriptStates", testScriptStatesExists)
+	t.Run("ScriptVersions", testScriptVersionsExists)
 	t.Run("Trades", testTradesExists)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsExists)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosExists)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsExists)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesExists)
@@ -74,10 +119,19 @@ func TestExists(t *testing.T) {
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
 	t.Run("Candles", testCandlesFind)
+	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
+	t.Run("DepositHistories", testDepositHistoriesFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsFind)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("ScriptSchedules", testScriptSchedulesFind)
+	t.Run("ScriptStates", testScriptStatesFind)
+	t.Run("ScriptVersions", testScriptVersionsFind)
 	t.Run("Trades", testTradesFind)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsFind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosFind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsFind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesFind)
@@ -86,10 +140,19 @@ func TestFind(t *testing.T) {
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
 	t.Run("Candles", testCandlesBind)
+	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
+	t.Run("DepositHistories", testDepositHistoriesBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsBind)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("ScriptSchedules", testScriptSchedulesBind)
+	t.Run("ScriptStates", testScriptStatesBind)
+	t.Run("ScriptVersions", testScriptVersionsBind)
 	t.Run("Trades", testTradesBind)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsBind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosBind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsBind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesBind)
@@ -98,10 +161,19 @@ func TestBind(t *testing.T) {
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
 	t.Run("Candles", testCandlesOne)
+	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
+	t.Run("DepositHistories", testDepositHistoriesOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsOne)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("ScriptSchedules", testScriptSchedulesOne)
+	t.Run("ScriptStates", testScriptStatesOne)
+	t.Run("ScriptVersions", testScriptVersionsOne)
 	t.Run("Trades", testTradesOne)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsOne)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosOne)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsOne)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesOne)
@@ -110,10 +182,19 @@ func TestOne(t *testing.T) {
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
 	t.Run("Candles", testCandlesAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
+	t.Run("DepositHistories", testDepositHistoriesAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("ScriptSchedules", testScriptSchedulesAll)
+	t.Run("ScriptStates", testScriptStatesAll)
+	t.Run("ScriptVersions", testScriptVersionsAll)
 	t.Run("Trades", testTradesAll)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesAll)
@@ -122,10 +203,19 @@ func TestAll(t *testing.T) {
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
 	t.Run("Candles", testCandlesCount)
+	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
+	t.Run("DepositHistories", testDepositHistoriesCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsCount)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("ScriptSchedules", testScriptSchedulesCount)
+	t.Run("ScriptStates", testScriptStatesCount)
+	t.Run("ScriptVersions", testScriptVersionsCount)
 	t.Run("Trades", testTradesCount)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsCount)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosCount)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsCount)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesCount)
@@ -134,10 +224,19 @@ func TestCount(t *testing.T) {
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
 	t.Run("Candles", testCandlesHooks)
+	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
+	t.Run("DepositHistories", testDepositHistoriesHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsHooks)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("ScriptSchedules", testScriptSchedulesHooks)
+	t.Run("ScriptStates", testScriptStatesHooks)
+	t.Run("ScriptVersions", testScriptVersionsHooks)
 	t.Run("Trades", testTradesHooks)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsHooks)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosHooks)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsHooks)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesHooks)
@@ -148,14 +247,32 @@ func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
 	t.Run("Candles", testCandlesInsert)
 	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
+	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
+	t.Run("DepositHistories", testDepositHistoriesInsert)
+	t.Run("DepositHistories", testDepositHistoriesInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsInsert)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsInsertWhitelist)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsInsert)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("ScriptSchedules", testScriptSchedulesInsert)
+	t.Run("ScriptSchedules", testScriptSchedulesInsertWhitelist)
+	t.Run("ScriptStates", testScriptStatesInsert)
+	t.Run("ScriptStates", testScriptStatesInsertWhitelist)
+	t.Run("ScriptVersions", testScriptVersionsInsert)
+	t.Run("ScriptVersions", testScriptVersionsInsertWhitelist)
 	t.Run("Trades", testTradesInsert)
 	t.Run("Trades", testTradesInsertWhitelist)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsInsert)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsInsertWhitelist)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsert)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsertWhitelist)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsInsert)
@@ -168,8 +285,13 @@ func TestInsert(t *testing.T) {
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
 	t.Run("CandleToExchangeUsingExchangeName", testCandleToOneExchangeUsingExchangeName)
+	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
+	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("DepositHistoryToExchangeUsingExchangeName", testDepositHistoryToOneExchangeUsingExchangeName)
+	t.Run("PortfolioSnapshotHoldingToPortfolioSnapshotUsingPortfolioSnapshot", testPortfolioSnapshotHoldingToOnePortfolioSnapshotUsingPortfolioSnapshot)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
+	t.Run("WithdrawalApprovalToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalApprovalToOneWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalFiatToOneWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalHistoryToExchangeUsingExchangeName", testWithdrawalHistoryToOneExchangeUsingExchangeName)
@@ -185,8 +307,13 @@ func TestOneToOne(t *testing.T) {
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyJobDatahistoryjobresults)
+	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameDepositHistories", testExchangeToManyExchangeNameDepositHistories)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
+	t.Run("PortfolioSnapshotToPortfolioSnapshotHoldings", testPortfolioSnapshotToManyPortfolioSnapshotHoldings)
 	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
+	t.Run("WithdrawalHistoryToWithdrawalApprovals", testWithdrawalHistoryToManyWithdrawalApprovals)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyWithdrawalFiats)
 }
@@ -195,8 +322,13 @@ func TestToMany(t *testing.T) {
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
 	t.Run("CandleToExchangeUsingExchangeNameCandle", testCandleToOneSetOpExchangeUsingExchangeName)
+	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
+	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("DepositHistoryToExchangeUsingExchangeNameDepositHistories", testDepositHistoryToOneSetOpExchangeUsingExchangeName)
+	t.Run("PortfolioSnapshotHoldingToPortfolioSnapshotUsingPortfolioSnapshotHoldings", testPortfolioSnapshotHoldingToOneSetOpPortfolioSnapshotUsingPortfolioSnapshot)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
+	t.Run("WithdrawalApprovalToWithdrawalHistoryUsingWithdrawalApprovals", testWithdrawalApprovalToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalFiats", testWithdrawalFiatToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalHistoryToExchangeUsingExchangeNameWithdrawalHistories", testWithdrawalHistoryToOneSetOpExchangeUsingExchangeName)
@@ -220,8 +352,13 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyAddOpJobDatahistoryjobresults)
+	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameDepositHistories", testExchangeToManyAddOpExchangeNameDepositHistories)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
+	t.Run("PortfolioSnapshotToPortfolioSnapshotHoldings", testPortfolioSnapshotToManyAddOpPortfolioSnapshotHoldings)
 	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
+	t.Run("WithdrawalHistoryToWithdrawalApprovals", testWithdrawalHistoryToManyAddOpWithdrawalApprovals)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyAddOpWithdrawalFiats)
 }
@@ -237,10 +374,19 @@ func TestToManyRemove(t *testing.T) {}
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
 	t.Run("Candles", testCandlesReload)
+	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
+	t.Run("DepositHistories", testDepositHistoriesReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsReload)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("ScriptSchedules", testScriptSchedulesReload)
+	t.Run("ScriptStates", testScriptStatesReload)
+	t.Run("ScriptVersions", testScriptVersionsReload)
 	t.Run("Trades", testTradesReload)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsReload)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReload)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReload)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReload)
@@ -249,10 +395,19 @@ func TestReload(t *testing.T) {
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
 	t.Run("Candles", testCandlesReloadAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
+	t.Run("DepositHistories", testDepositHistoriesReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsReloadAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("ScriptSchedules", testScriptSchedulesReloadAll)
+	t.Run("ScriptStates", testScriptStatesReloadAll)
+	t.Run("ScriptVersions", testScriptVersionsReloadAll)
 	t.Run("Trades", testTradesReloadAll)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsReloadAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReloadAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReloadAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReloadAll)
@@ -261,10 +416,19 @@ func TestReloadAll(t *testing.T) {
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
 	t.Run("Candles", testCandlesSelect)
+	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
+	t.Run("DepositHistories", testDepositHistoriesSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsSelect)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("ScriptSchedules", testScriptSchedulesSelect)
+	t.Run("ScriptStates", testScriptStatesSelect)
+	t.Run("ScriptVersions", testScriptVersionsSelect)
 	t.Run("Trades", testTradesSelect)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsSelect)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSelect)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSelect)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSelect)
@@ -273,10 +437,19 @@ func TestSelect(t *testing.T) {
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
 	t.Run("Candles", testCandlesUpdate)
+	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
+	t.Run("DepositHistories", testDepositHistoriesUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsUpdate)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("ScriptSchedules", testScriptSchedulesUpdate)
+	t.Run("ScriptStates", testScriptStatesUpdate)
+	t.Run("ScriptVersions", testScriptVersionsUpdate)
 	t.Run("Trades", testTradesUpdate)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsUpdate)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpdate)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsUpdate)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpdate)
@@ -285,10 +458,19 @@ func TestUpdate(t *testing.T) {
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
 	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
+	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
+	t.Run("DepositHistories", testDepositHistoriesSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsSliceUpdateAll)
+	t.Run("PortfolioSnapshotHoldings", testPortfolioSnapshotHoldingsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("ScriptSchedules", testScriptSchedulesSliceUpdateAll)
+	t.Run("ScriptStates", testScriptStatesSliceUpdateAll)
+	t.Run("ScriptVersions", testScriptVersionsSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
+	t.Run("WithdrawalApprovals", testWithdrawalApprovalsSliceUpdateAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceUpdateAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceUpdateAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceUpdateAll)
@@ -28,6 +28,7 @@ type WithdrawalCrypto struct {
 	AddressTag          null.String `boil:"address_tag" json:"address_tag,omitempty" toml:"address_tag" yaml:"address_tag,omitempty"`
 	Fee                 float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
 	WithdrawalHistoryID string      `boil:"withdrawal_history_id" json:"withdrawal_history_id" toml:"withdrawal_history_id" yaml:"withdrawal_history_id"`
+	TXID                null.String `boil:"tx_id" json:"tx_id,omitempty" toml:"tx_id" yaml:"tx_id,omitempty"`
 
 	R *withdrawalCryptoR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalCryptoL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -39,12 +40,14 @@ var WithdrawalCryptoColumns = struct {
 	AddressTag          string
 	Fee                 string
 	WithdrawalHistoryID string
+	TXID                string
 }{
 	ID:                  "id",
 	Address:             "address",
 	AddressTag:          "address_tag",
 	Fee:                 "fee",
 	WithdrawalHistoryID: "withdrawal_history_id",
+	TXID:                "tx_id",
 }
 
 // Generated where
@@ -55,12 +58,14 @@ var WithdrawalCryptoWhere = struct {
 	AddressTag          whereHelpernull_String
 	Fee                 whereHelperfloat64
 	WithdrawalHistoryID whereHelperstring
+	TXID                whereHelpernull_String
 }{
 	ID:                  whereHelperint64{field: "\"withdrawal_crypto\".\"id\""},
 	Address:             whereHelperstring{field: "\"withdrawal_crypto\".\"address\""},
 	AddressTag:          whereHelpernull_String{field: "\"withdrawal_crypto\".\"address_tag\""},
 	Fee:                 whereHelperfloat64{field: "\"withdrawal_crypto\".\"fee\""},
 	WithdrawalHistoryID: whereHelperstring{field: "\"withdrawal_crypto\".\"withdrawal_history_id\""},
+	TXID:                whereHelpernull_String{field: "\"withdrawal_crypto\".\"tx_id\""},
 }
 
 // WithdrawalCryptoRels is where relationship names are stored.
@@ -84,8 +89,8 @@ func (*withdrawalCryptoR) NewStruct() *withdrawalCryptoR {
 type withdrawalCryptoL struct{}
 
 var (
-	withdrawalCryptoAllColumns            = []string{"id", "address", "address_tag", "fee", "withdrawal_history_id"}
-	withdrawalCryptoColumnsWithoutDefault = []string{"address", "address_tag", "fee", "withdrawal_history_id"}
+	withdrawalCryptoAllColumns            = []string{"id", "address", "address_tag", "fee", "withdrawal_history_id", "tx_id"}
+	withdrawalCryptoColumnsWithoutDefault = []string{"address", "address_tag", "fee", "withdrawal_history_id", "tx_id"}
 	withdrawalCryptoColumnsWithDefault    = []string{"id"}
 	withdrawalCryptoPrimaryKeyColumns     = []string{"id"}
 )
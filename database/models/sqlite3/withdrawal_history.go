@@ -33,6 +33,9 @@ type WithdrawalHistory struct {
 	WithdrawType   int64       `boil:"withdraw_type" json:"withdraw_type" toml:"withdraw_type" yaml:"withdraw_type"`
 	CreatedAt      string      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt      string      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ApprovalStatus string      `boil:"approval_status" json:"approval_status" toml:"approval_status" yaml:"approval_status"`
+	RequestedBy    string      `boil:"requested_by" json:"requested_by" toml:"requested_by" yaml:"requested_by"`
+	TrackingStatus string      `boil:"tracking_status" json:"tracking_status" toml:"tracking_status" yaml:"tracking_status"`
 
 	R *withdrawalHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -49,6 +52,9 @@ var WithdrawalHistoryColumns = struct {
 	WithdrawType   string
 	CreatedAt      string
 	UpdatedAt      string
+	ApprovalStatus string
+	RequestedBy    string
+	TrackingStatus string
 }{
 	ID:             "id",
 	ExchangeNameID: "exchange_name_id",
@@ -60,6 +66,9 @@ var WithdrawalHistoryColumns = struct {
 	WithdrawType:   "withdraw_type",
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
+	ApprovalStatus: "approval_status",
+	RequestedBy:    "requested_by",
+	TrackingStatus: "tracking_status",
 }
 
 // Generated where
@@ -75,6 +84,9 @@ var WithdrawalHistoryWhere = struct {
 	WithdrawType   whereHelperint64
 	CreatedAt      whereHelperstring
 	UpdatedAt      whereHelperstring
+	ApprovalStatus whereHelperstring
+	RequestedBy    whereHelperstring
+	TrackingStatus whereHelperstring
 }{
 	ID:             whereHelperstring{field: "\"withdrawal_history\".\"id\""},
 	ExchangeNameID: whereHelperstring{field: "\"withdrawal_history\".\"exchange_name_id\""},
@@ -86,24 +98,30 @@ var WithdrawalHistoryWhere = struct {
 	WithdrawType:   whereHelperint64{field: "\"withdrawal_history\".\"withdraw_type\""},
 	CreatedAt:      whereHelperstring{field: "\"withdrawal_history\".\"created_at\""},
 	UpdatedAt:      whereHelperstring{field: "\"withdrawal_history\".\"updated_at\""},
+	ApprovalStatus: whereHelperstring{field: "\"withdrawal_history\".\"approval_status\""},
+	RequestedBy:    whereHelperstring{field: "\"withdrawal_history\".\"requested_by\""},
+	TrackingStatus: whereHelperstring{field: "\"withdrawal_history\".\"tracking_status\""},
 }
 
 // WithdrawalHistoryRels is where relationship names are stored.
 var WithdrawalHistoryRels = struct {
-	ExchangeName      string
-	WithdrawalCryptos string
-	WithdrawalFiats   string
+	ExchangeName        string
+	WithdrawalApprovals string
+	WithdrawalCryptos   string
+	WithdrawalFiats     string
 }{
-	ExchangeName:      "ExchangeName",
-	WithdrawalCryptos: "WithdrawalCryptos",
-	WithdrawalFiats:   "WithdrawalFiats",
+	ExchangeName:        "ExchangeName",
+	WithdrawalApprovals: "WithdrawalApprovals",
+	WithdrawalCryptos:   "WithdrawalCryptos",
+	WithdrawalFiats:     "WithdrawalFiats",
 }
 
 // withdrawalHistoryR is where relationships are stored.
 type withdrawalHistoryR struct {
-	ExchangeName      *Exchange
-	WithdrawalCryptos WithdrawalCryptoSlice
-	WithdrawalFiats   WithdrawalFiatSlice
+	ExchangeName        *Exchange
+	WithdrawalApprovals WithdrawalApprovalSlice
+	WithdrawalCryptos   WithdrawalCryptoSlice
+	WithdrawalFiats     WithdrawalFiatSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -115,9 +133,9 @@ func (*withdrawalHistoryR) NewStruct() *withdrawalHistoryR {
 type withdrawalHistoryL struct{}
 
 var (
-	withdrawalHistoryAllColumns            = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at"}
+	withdrawalHistoryAllColumns            = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "approval_status", "requested_by", "tracking_status"}
 	withdrawalHistoryColumnsWithoutDefault = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type"}
-	withdrawalHistoryColumnsWithDefault    = []string{"created_at", "updated_at"}
+	withdrawalHistoryColumnsWithDefault    = []string{"created_at", "updated_at", "approval_status", "requested_by", "tracking_status"}
 	withdrawalHistoryPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -410,6 +428,27 @@ func (o *WithdrawalHistory) ExchangeName(mods ...qm.QueryMod) exchangeQuery {
 	return query
 }
 
+// WithdrawalApprovals retrieves all the withdrawal_approval's WithdrawalApprovals with an executor.
+func (o *WithdrawalHistory) WithdrawalApprovals(mods ...qm.QueryMod) withdrawalApprovalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"withdrawal_approval\".\"withdrawal_history_id\"=?", o.ID),
+	)
+
+	query := WithdrawalApprovals(queryMods...)
+	queries.SetFrom(query.Query, "\"withdrawal_approval\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"withdrawal_approval\".*"})
+	}
+
+	return query
+}
+
 // WithdrawalCryptos retrieves all the withdrawal_crypto's WithdrawalCryptos with an executor.
 func (o *WithdrawalHistory) WithdrawalCryptos(mods ...qm.QueryMod) withdrawalCryptoQuery {
 	var queryMods []qm.QueryMod
@@ -553,6 +592,101 @@ func (withdrawalHistoryL) LoadExchangeName(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
+// LoadWithdrawalApprovals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (withdrawalHistoryL) LoadWithdrawalApprovals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalHistory interface{}, mods queries.Applicator) error {
+	var slice []*WithdrawalHistory
+	var object *WithdrawalHistory
+
+	if singular {
+		object = maybeWithdrawalHistory.(*WithdrawalHistory)
+	} else {
+		slice = *maybeWithdrawalHistory.(*[]*WithdrawalHistory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &withdrawalHistoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &withdrawalHistoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`withdrawal_approval`), qm.WhereIn(`withdrawal_approval.withdrawal_history_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_approval")
+	}
+
+	var resultSlice []*WithdrawalApproval
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_approval")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_approval")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_approval")
+	}
+
+	if len(withdrawalApprovalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WithdrawalApprovals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalApprovalR{}
+			}
+			foreign.R.WithdrawalHistory = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WithdrawalHistoryID {
+				local.R.WithdrawalApprovals = append(local.R.WithdrawalApprovals, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalApprovalR{}
+				}
+				foreign.R.WithdrawalHistory = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadWithdrawalCryptos allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (withdrawalHistoryL) LoadWithdrawalCryptos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWithdrawalHistory interface{}, mods queries.Applicator) error {
@@ -790,6 +924,59 @@ func (o *WithdrawalHistory) SetExchangeName(ctx context.Context, exec boil.Conte
 	return nil
 }
 
+// AddWithdrawalApprovals adds the given related objects to the existing relationships
+// of the withdrawal_history, optionally inserting them as new records.
+// Appends related to o.R.WithdrawalApprovals.
+// Sets related.R.WithdrawalHistory appropriately.
+func (o *WithdrawalHistory) AddWithdrawalApprovals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WithdrawalApproval) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WithdrawalHistoryID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"withdrawal_approval\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"withdrawal_history_id"}),
+				strmangle.WhereClause("\"", "\"", 0, withdrawalApprovalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WithdrawalHistoryID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &withdrawalHistoryR{
+			WithdrawalApprovals: related,
+		}
+	} else {
+		o.R.WithdrawalApprovals = append(o.R.WithdrawalApprovals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &withdrawalApprovalR{
+				WithdrawalHistory: o,
+			}
+		} else {
+			rel.R.WithdrawalHistory = o
+		}
+	}
+	return nil
+}
+
 // AddWithdrawalCryptos adds the given related objects to the existing relationships
 // of the withdrawal_history, optionally inserting them as new records.
 // Appends related to o.R.WithdrawalCryptos.
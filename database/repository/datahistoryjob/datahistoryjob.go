@@ -0,0 +1,461 @@
+package datahistoryjob
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Upsert inserts or updates jobs in the database. Jobs are matched on their
+// nickname, so an existing job will retain its ID
+func Upsert(jobs ...*DataHistoryJob) error {
+	for i := range jobs {
+		if jobs[i].Nickname == "" {
+			return errNicknameUnset
+		}
+		if jobs[i].ExchangeID != "" {
+			continue
+		}
+		if jobs[i].ExchangeName == "" {
+			return fmt.Errorf("%v %w", jobs[i].Nickname, errExchangeNameUnset)
+		}
+		exchangeUUID, err := exchange.UUIDByName(jobs[i].ExchangeName)
+		if err != nil {
+			return err
+		}
+		jobs[i].ExchangeID = exchangeUUID.String()
+	}
+
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = upsertSQLite(ctx, tx, jobs...)
+	} else {
+		err = upsertPostgres(ctx, tx, jobs...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) error {
+	for i := range jobs {
+		existing, err := modelSQLite.Datahistoryjobs(
+			modelSQLite.DatahistoryjobWhere.Nickname.EQ(strings.ToLower(jobs[i].Nickname))).One(ctx, tx)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if existing != nil {
+			jobs[i].ID = existing.ID
+		} else if jobs[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			jobs[i].ID = freshUUID.String()
+		}
+		tempJob := modelSQLite.Datahistoryjob{
+			ID:             jobs[i].ID,
+			Nickname:       strings.ToLower(jobs[i].Nickname),
+			ExchangeNameID: jobs[i].ExchangeID,
+			Asset:          strings.ToLower(jobs[i].Asset),
+			Base:           strings.ToUpper(jobs[i].Base),
+			Quote:          strings.ToUpper(jobs[i].Quote),
+			StartTime:      jobs[i].StartDate.UTC().Format(time.RFC3339),
+			EndTime:        jobs[i].EndDate.UTC().Format(time.RFC3339),
+			Interval:       jobs[i].Interval,
+			DataType:       jobs[i].DataType,
+			RequestSize:    jobs[i].RequestSizeLimit,
+			BatchSize:      jobs[i].BatchSize,
+			MaxRetries:     jobs[i].MaxRetryAttempts,
+			Status:         jobs[i].Status,
+			Created:        time.Now().UTC().Format(time.RFC3339),
+		}
+		if existing != nil {
+			tempJob.Created = existing.Created
+			_, err = tempJob.Update(ctx, tx, boil.Infer())
+		} else {
+			err = tempJob.Insert(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) error {
+	for i := range jobs {
+		existing, err := modelPSQL.Datahistoryjobs(
+			modelPSQL.DatahistoryjobWhere.Nickname.EQ(strings.ToLower(jobs[i].Nickname))).One(ctx, tx)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if existing != nil {
+			jobs[i].ID = existing.ID
+		} else if jobs[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			jobs[i].ID = freshUUID.String()
+		}
+		tempJob := modelPSQL.Datahistoryjob{
+			ID:             jobs[i].ID,
+			Nickname:       strings.ToLower(jobs[i].Nickname),
+			ExchangeNameID: jobs[i].ExchangeID,
+			Asset:          strings.ToLower(jobs[i].Asset),
+			Base:           strings.ToUpper(jobs[i].Base),
+			Quote:          strings.ToUpper(jobs[i].Quote),
+			StartTime:      jobs[i].StartDate.UTC(),
+			EndTime:        jobs[i].EndDate.UTC(),
+			Interval:       jobs[i].Interval,
+			DataType:       jobs[i].DataType,
+			RequestSize:    jobs[i].RequestSizeLimit,
+			BatchSize:      jobs[i].BatchSize,
+			MaxRetries:     jobs[i].MaxRetryAttempts,
+			Status:         jobs[i].Status,
+			Created:        time.Now().UTC(),
+		}
+		err = tempJob.Upsert(ctx,
+			tx,
+			true,
+			[]string{"nickname"},
+			boil.Blacklist("id", "created"),
+			boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetByNickName returns a job by its unique nickname
+func GetByNickName(nickname string) (*DataHistoryJob, error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		return getSQLite(modelSQLite.DatahistoryjobWhere.Nickname.EQ(strings.ToLower(nickname)))
+	}
+	return getPostgres(modelPSQL.DatahistoryjobWhere.Nickname.EQ(strings.ToLower(nickname)))
+}
+
+// GetByID returns a job by its ID
+func GetByID(id string) (*DataHistoryJob, error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		return getSQLite(modelSQLite.DatahistoryjobWhere.ID.EQ(id))
+	}
+	return getPostgres(modelPSQL.DatahistoryjobWhere.ID.EQ(id))
+}
+
+// GetJobsBetween returns all jobs created between the supplied dates
+func GetJobsBetween(startDate, endDate time.Time) ([]DataHistoryJob, error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		return getAllSQLite(qm.Where("created BETWEEN ? AND ?",
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)))
+	}
+	return getAllPostgres(qm.Where("created BETWEEN ? AND ?", startDate.UTC(), endDate.UTC()))
+}
+
+// GetJobsByStatus returns all jobs matching any of the supplied statuses
+func GetJobsByStatus(statuses ...int64) ([]DataHistoryJob, error) {
+	var statusIDs []interface{}
+	for i := range statuses {
+		statusIDs = append(statusIDs, statuses[i])
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		return getAllSQLite(qm.WhereIn(`status in ?`, statusIDs...))
+	}
+	return getAllPostgres(qm.WhereIn(`status in ?`, statusIDs...))
+}
+
+func getSQLite(mods ...qm.QueryMod) (*DataHistoryJob, error) {
+	mods = append(mods, qm.Load(modelSQLite.DatahistoryjobRels.ExchangeName))
+	result, err := modelSQLite.Datahistoryjobs(mods...).One(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	return sqliteToJob(result)
+}
+
+func getAllSQLite(mods ...qm.QueryMod) ([]DataHistoryJob, error) {
+	mods = append(mods, qm.Load(modelSQLite.DatahistoryjobRels.ExchangeName))
+	results, err := modelSQLite.Datahistoryjobs(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]DataHistoryJob, 0, len(results))
+	for i := range results {
+		job, err := sqliteToJob(results[i])
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *job)
+	}
+	return jobs, nil
+}
+
+func sqliteToJob(result *modelSQLite.Datahistoryjob) (*DataHistoryJob, error) {
+	start, err := time.Parse(time.RFC3339, result.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(time.RFC3339, result.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	created, err := time.Parse(time.RFC3339, result.Created)
+	if err != nil {
+		// CURRENT_TIMESTAMP defaults are not stored in RFC3339
+		created, err = time.Parse("2006-01-02 15:04:05", result.Created)
+		if err != nil {
+			return nil, err
+		}
+	}
+	job := &DataHistoryJob{
+		ID:               result.ID,
+		Nickname:         result.Nickname,
+		ExchangeID:       result.ExchangeNameID,
+		Asset:            result.Asset,
+		Base:             result.Base,
+		Quote:            result.Quote,
+		StartDate:        start,
+		EndDate:          end,
+		Interval:         result.Interval,
+		DataType:         result.DataType,
+		RequestSizeLimit: result.RequestSize,
+		BatchSize:        result.BatchSize,
+		MaxRetryAttempts: result.MaxRetries,
+		Status:           result.Status,
+		CreatedDate:      created,
+	}
+	if result.R != nil && result.R.ExchangeName != nil {
+		job.ExchangeName = result.R.ExchangeName.Name
+	}
+	return job, nil
+}
+
+func getPostgres(mods ...qm.QueryMod) (*DataHistoryJob, error) {
+	mods = append(mods, qm.Load(modelPSQL.DatahistoryjobRels.ExchangeName))
+	result, err := modelPSQL.Datahistoryjobs(mods...).One(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	return postgresToJob(result), nil
+}
+
+func getAllPostgres(mods ...qm.QueryMod) ([]DataHistoryJob, error) {
+	mods = append(mods, qm.Load(modelPSQL.DatahistoryjobRels.ExchangeName))
+	results, err := modelPSQL.Datahistoryjobs(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]DataHistoryJob, 0, len(results))
+	for i := range results {
+		jobs = append(jobs, *postgresToJob(results[i]))
+	}
+	return jobs, nil
+}
+
+func postgresToJob(result *modelPSQL.Datahistoryjob) *DataHistoryJob {
+	job := &DataHistoryJob{
+		ID:               result.ID,
+		Nickname:         result.Nickname,
+		ExchangeID:       result.ExchangeNameID,
+		Asset:            result.Asset,
+		Base:             result.Base,
+		Quote:            result.Quote,
+		StartDate:        result.StartTime,
+		EndDate:          result.EndTime,
+		Interval:         result.Interval,
+		DataType:         result.DataType,
+		RequestSizeLimit: result.RequestSize,
+		BatchSize:        result.BatchSize,
+		MaxRetryAttempts: result.MaxRetries,
+		Status:           result.Status,
+		CreatedDate:      result.Created,
+	}
+	if result.R != nil && result.R.ExchangeName != nil {
+		job.ExchangeName = result.R.ExchangeName.Name
+	}
+	return job
+}
+
+// InsertResults saves the outcome of processing job intervals to the
+// database
+func InsertResults(results ...*DataHistoryJobResult) error {
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "InsertResults tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertResultsSQLite(ctx, tx, results...)
+	} else {
+		err = insertResultsPostgres(ctx, tx, results...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertResultsSQLite(ctx context.Context, tx *sql.Tx, results ...*DataHistoryJobResult) error {
+	for i := range results {
+		if results[i].JobID == "" {
+			return errJobIDUnset
+		}
+		if results[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			results[i].ID = freshUUID.String()
+		}
+		if results[i].Date.IsZero() {
+			results[i].Date = time.Now()
+		}
+		tempResult := modelSQLite.Datahistoryjobresult{
+			ID:                results[i].ID,
+			JobID:             results[i].JobID,
+			Status:            results[i].Status,
+			IntervalStartTime: results[i].IntervalStartDate.UTC().Format(time.RFC3339),
+			IntervalEndTime:   results[i].IntervalEndDate.UTC().Format(time.RFC3339),
+			RunTime:           results[i].Date.UTC().Format(time.RFC3339),
+		}
+		if results[i].Result != "" {
+			tempResult.Result.SetValid(results[i].Result)
+		}
+		err := tempResult.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertResultsPostgres(ctx context.Context, tx *sql.Tx, results ...*DataHistoryJobResult) error {
+	for i := range results {
+		if results[i].JobID == "" {
+			return errJobIDUnset
+		}
+		if results[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			results[i].ID = freshUUID.String()
+		}
+		if results[i].Date.IsZero() {
+			results[i].Date = time.Now()
+		}
+		tempResult := modelPSQL.Datahistoryjobresult{
+			ID:                results[i].ID,
+			JobID:             results[i].JobID,
+			Status:            results[i].Status,
+			IntervalStartTime: results[i].IntervalStartDate.UTC(),
+			IntervalEndTime:   results[i].IntervalEndDate.UTC(),
+			RunTime:           results[i].Date.UTC(),
+		}
+		if results[i].Result != "" {
+			tempResult.Result.SetValid(results[i].Result)
+		}
+		err := tempResult.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetResultsByJobID returns all results recorded for a job ordered by the
+// interval they cover
+func GetResultsByJobID(jobID string) ([]DataHistoryJobResult, error) {
+	var resp []DataHistoryJobResult
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		results, err := modelSQLite.Datahistoryjobresults(
+			modelSQLite.DatahistoryjobresultWhere.JobID.EQ(jobID),
+			qm.OrderBy("interval_start_time")).All(context.Background(), database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			start, err := time.Parse(time.RFC3339, results[i].IntervalStartTime)
+			if err != nil {
+				return nil, err
+			}
+			end, err := time.Parse(time.RFC3339, results[i].IntervalEndTime)
+			if err != nil {
+				return nil, err
+			}
+			runTime, err := time.Parse(time.RFC3339, results[i].RunTime)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, DataHistoryJobResult{
+				ID:                results[i].ID,
+				JobID:             results[i].JobID,
+				Result:            results[i].Result.String,
+				Status:            results[i].Status,
+				IntervalStartDate: start,
+				IntervalEndDate:   end,
+				Date:              runTime,
+			})
+		}
+		return resp, nil
+	}
+
+	results, err := modelPSQL.Datahistoryjobresults(
+		modelPSQL.DatahistoryjobresultWhere.JobID.EQ(jobID),
+		qm.OrderBy("interval_start_time")).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		resp = append(resp, DataHistoryJobResult{
+			ID:                results[i].ID,
+			JobID:             results[i].JobID,
+			Result:            results[i].Result.String,
+			Status:            results[i].Status,
+			IntervalStartDate: results[i].IntervalStartTime,
+			IntervalEndDate:   results[i].IntervalEndTime,
+			Date:              results[i].RunTime,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,199 @@
+package datahistoryjob
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestDataHistoryJob(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			dataHistoryJobSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func dataHistoryJobSQLTester(t *testing.T) {
+	err := Upsert(&DataHistoryJob{ExchangeName: testExchanges[0].Name})
+	if !errors.Is(err, errNicknameUnset) {
+		t.Errorf("received %v expected %v", err, errNicknameUnset)
+	}
+
+	start := time.Now().Add(-time.Hour * 24).Truncate(time.Hour)
+	var jobs []*DataHistoryJob
+	for i := 0; i < 20; i++ {
+		jobs = append(jobs, &DataHistoryJob{
+			Nickname:         fmt.Sprintf("testJob%v", i),
+			ExchangeName:     testExchanges[0].Name,
+			Asset:            asset.Spot.String(),
+			Base:             currency.BTC.String(),
+			Quote:            currency.USD.String(),
+			StartDate:        start,
+			EndDate:          start.Add(time.Hour * 12),
+			Interval:         int64(time.Hour),
+			RequestSizeLimit: 500,
+			BatchSize:        10,
+			MaxRetryAttempts: 3,
+			Status:           int64(i % 2),
+		})
+	}
+	err = Upsert(jobs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// upserting an existing nickname updates the existing job
+	id := jobs[0].ID
+	jobs[0].ID = ""
+	jobs[0].Status = 2
+	err = Upsert(jobs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jobs[0].ID != id {
+		t.Errorf("received %v expected %v", jobs[0].ID, id)
+	}
+
+	job, err := GetByNickName("TESTJOB0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != 2 || job.ExchangeName != testExchanges[0].Name || !job.StartDate.Equal(start) {
+		t.Errorf("unexpected job %+v", job)
+	}
+	job, err = GetByID(jobs[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Nickname != "testjob1" || job.BatchSize != 10 {
+		t.Errorf("received %v expected %v", job.Nickname, "testjob1")
+	}
+
+	resp, err := GetJobsBetween(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 20 {
+		t.Errorf("received %v jobs expected 20", len(resp))
+	}
+
+	resp, err = GetJobsByStatus(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 10 {
+		t.Errorf("received %v jobs expected 10", len(resp))
+	}
+
+	err = InsertResults(&DataHistoryJobResult{})
+	if !errors.Is(err, errJobIDUnset) {
+		t.Errorf("received %v expected %v", err, errJobIDUnset)
+	}
+	var results []*DataHistoryJobResult
+	for i := 0; i < 12; i++ {
+		results = append(results, &DataHistoryJobResult{
+			JobID:             jobs[1].ID,
+			Status:            1,
+			IntervalStartDate: start.Add(time.Hour * time.Duration(i)),
+			IntervalEndDate:   start.Add(time.Hour * time.Duration(i+1)),
+		})
+	}
+	results[0].Result = "test failure"
+	err = InsertResults(results...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := GetResultsByJobID(jobs[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 12 {
+		t.Fatalf("received %v results expected 12", len(r))
+	}
+	if r[0].Result != "test failure" || !r[0].IntervalStartDate.Equal(start) {
+		t.Errorf("unexpected result %+v", r[0])
+	}
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
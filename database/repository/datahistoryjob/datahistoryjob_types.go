@@ -0,0 +1,45 @@
+package datahistoryjob
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errNicknameUnset     = errors.New("job nickname must be set")
+	errExchangeNameUnset = errors.New("job exchange name must be set")
+	errJobIDUnset        = errors.New("result job id must be set")
+)
+
+// DataHistoryJob is a DTO for database data
+type DataHistoryJob struct {
+	ID               string
+	Nickname         string
+	ExchangeID       string
+	ExchangeName     string
+	Asset            string
+	Base             string
+	Quote            string
+	StartDate        time.Time
+	EndDate          time.Time
+	Interval         int64
+	DataType         int64
+	RequestSizeLimit int64
+	BatchSize        int64
+	MaxRetryAttempts int64
+	Status           int64
+	CreatedDate      time.Time
+	Results          []DataHistoryJobResult
+}
+
+// DataHistoryJobResult is a DTO for a single attempt at processing a
+// data history job interval
+type DataHistoryJobResult struct {
+	ID                string
+	JobID             string
+	Result            string
+	Status            int64
+	IntervalStartDate time.Time
+	IntervalEndDate   time.Time
+	Date              time.Time
+}
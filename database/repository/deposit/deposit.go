@@ -0,0 +1,248 @@
+package deposit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Upsert stores the deposit, updating the status, fee, confirmations and
+// credited state of an existing deposit with the same exchange, currency and
+// transfer ID
+func Upsert(d *Deposit) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if d.TransferID == "" {
+		return errTransferIDUnset
+	}
+	if d.Currency == "" {
+		return errCurrencyUnset
+	}
+	exchangeUUID, err := exchangeDB.UUIDByName(d.Exchange)
+	if err != nil {
+		return err
+	}
+	d.Currency = strings.ToUpper(d.Currency)
+
+	ctx := boil.SkipTimestamps(context.Background())
+	if isSQLite() {
+		return upsertSQLite(ctx, exchangeUUID.String(), d)
+	}
+	return upsertPSQL(ctx, exchangeUUID.String(), d)
+}
+
+func upsertSQLite(ctx context.Context, exchangeUUID string, d *Deposit) error {
+	existing, err := modelSQLite.DepositHistories(
+		modelSQLite.DepositHistoryWhere.ExchangeNameID.EQ(exchangeUUID),
+		modelSQLite.DepositHistoryWhere.Currency.EQ(d.Currency),
+		modelSQLite.DepositHistoryWhere.TransferID.EQ(d.TransferID)).One(ctx, database.DB.SQL)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	insert := existing == nil
+	if insert {
+		var id uuid.UUID
+		id, err = uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		existing = &modelSQLite.DepositHistory{
+			ID:             id.String(),
+			ExchangeNameID: exchangeUUID,
+			TransferID:     d.TransferID,
+			Currency:       d.Currency,
+			CreatedAt:      now,
+		}
+	}
+	existing.Amount = d.Amount
+	existing.Fee = d.Fee
+	existing.Status = d.Status
+	existing.Address = d.Address
+	existing.TXID = d.TxID
+	existing.Confirmations = d.Confirmations
+	existing.Credited = 0
+	if d.Credited {
+		existing.Credited = 1
+	}
+	existing.DepositedAt = d.DepositedAt.UTC().Format(time.RFC3339)
+	existing.UpdatedAt = now
+	d.ID = existing.ID
+	if insert {
+		return existing.Insert(ctx, database.DB.SQL, boil.Infer())
+	}
+	_, err = existing.Update(ctx, database.DB.SQL, boil.Infer())
+	return err
+}
+
+func upsertPSQL(ctx context.Context, exchangeUUID string, d *Deposit) error {
+	now := time.Now().UTC()
+	tempDeposit := modelPSQL.DepositHistory{
+		ExchangeNameID: exchangeUUID,
+		TransferID:     d.TransferID,
+		Currency:       d.Currency,
+		Amount:         d.Amount,
+		Fee:            d.Fee,
+		Status:         d.Status,
+		Address:        d.Address,
+		TXID:           d.TxID,
+		Confirmations:  d.Confirmations,
+		Credited:       d.Credited,
+		DepositedAt:    d.DepositedAt.UTC(),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	err := tempDeposit.Upsert(ctx,
+		database.DB.SQL,
+		true,
+		[]string{
+			modelPSQL.DepositHistoryColumns.ExchangeNameID,
+			modelPSQL.DepositHistoryColumns.Currency,
+			modelPSQL.DepositHistoryColumns.TransferID,
+		},
+		boil.Blacklist(modelPSQL.DepositHistoryColumns.ID, modelPSQL.DepositHistoryColumns.CreatedAt),
+		boil.Infer())
+	if err != nil {
+		return err
+	}
+	d.ID = tempDeposit.ID
+	return nil
+}
+
+// GetByTransferID returns the stored deposit for the exchange, currency and
+// transfer ID
+func GetByTransferID(exchange, code, transferID string) (*Deposit, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	exchangeUUID, err := exchangeDB.UUIDByName(exchange)
+	if err != nil {
+		return nil, err
+	}
+	where := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+		qm.Where("currency = ?", strings.ToUpper(code)),
+		qm.Where("transfer_id = ?", transferID),
+	}
+	deposits, err := getByQuery(exchange, where)
+	if err != nil {
+		return nil, err
+	}
+	if len(deposits) == 0 {
+		return nil, ErrDepositNotFound
+	}
+	return &deposits[0], nil
+}
+
+// GetByExchange returns the most recent stored deposits for the exchange,
+// optionally limited to a currency
+func GetByExchange(exchange, code string, limit int) ([]Deposit, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	exchangeUUID, err := exchangeDB.UUIDByName(exchange)
+	if err != nil {
+		return nil, err
+	}
+	where := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+		qm.OrderBy("deposited_at desc"),
+	}
+	if code != "" {
+		where = append(where, qm.Where("currency = ?", strings.ToUpper(code)))
+	}
+	if limit > 0 {
+		where = append(where, qm.Limit(limit))
+	}
+	return getByQuery(exchange, where)
+}
+
+func getByQuery(exchange string, where []qm.QueryMod) ([]Deposit, error) {
+	ctx := context.Background()
+	var deposits []Deposit
+	if isSQLite() {
+		results, err := modelSQLite.DepositHistories(where...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			d, err := fromSQLite(exchange, results[i])
+			if err != nil {
+				return nil, err
+			}
+			deposits = append(deposits, *d)
+		}
+		return deposits, nil
+	}
+	results, err := modelPSQL.DepositHistories(where...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		deposits = append(deposits, Deposit{
+			ID:            results[i].ID,
+			Exchange:      exchange,
+			TransferID:    results[i].TransferID,
+			Currency:      results[i].Currency,
+			Amount:        results[i].Amount,
+			Fee:           results[i].Fee,
+			Status:        results[i].Status,
+			Address:       results[i].Address,
+			TxID:          results[i].TXID,
+			Confirmations: results[i].Confirmations,
+			Credited:      results[i].Credited,
+			DepositedAt:   results[i].DepositedAt.UTC(),
+			CreatedAt:     results[i].CreatedAt.UTC(),
+			UpdatedAt:     results[i].UpdatedAt.UTC(),
+		})
+	}
+	return deposits, nil
+}
+
+func fromSQLite(exchange string, d *modelSQLite.DepositHistory) (*Deposit, error) {
+	depositedAt, err := time.Parse(time.RFC3339, d.DepositedAt)
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := time.Parse(time.RFC3339, d.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := time.Parse(time.RFC3339, d.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &Deposit{
+		ID:            d.ID,
+		Exchange:      exchange,
+		TransferID:    d.TransferID,
+		Currency:      d.Currency,
+		Amount:        d.Amount,
+		Fee:           d.Fee,
+		Status:        d.Status,
+		Address:       d.Address,
+		TxID:          d.TXID,
+		Confirmations: d.Confirmations,
+		Credited:      d.Credited == 1,
+		DepositedAt:   depositedAt,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}, nil
+}
+
+func isSQLite() bool {
+	return repository.GetSQLDialect() == database.DBSQLite3 ||
+		repository.GetSQLDialect() == database.DBSQLite
+}
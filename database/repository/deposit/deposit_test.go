@@ -0,0 +1,181 @@
+package deposit
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestDeposit(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(dbConn *database.Instance) error
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb-deposit"},
+			},
+			depositHelper,
+			testhelpers.CloseDatabase,
+		},
+		{
+			"Postgres-Write",
+			testhelpers.PostgresTestDatabase,
+			depositHelper,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			exchange.ResetExchangeCache()
+			err = exchange.InsertMany(testExchanges)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func depositHelper(t *testing.T) {
+	depositedAt := time.Now().UTC().Truncate(time.Second)
+	for x := 0; x < 5; x++ {
+		err := Upsert(&Deposit{
+			Exchange:    testExchanges[0].Name,
+			TransferID:  fmt.Sprintf("test-%v", x),
+			Currency:    "btc",
+			Amount:      float64(x + 1),
+			Status:      "pending",
+			Address:     "test-address",
+			DepositedAt: depositedAt.Add(time.Duration(x) * time.Minute),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d, err := GetByTransferID(testExchanges[0].Name, "BTC", "test-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Amount != 3 || d.Credited || d.Currency != "BTC" {
+		t.Errorf("unexpected deposit %+v", d)
+	}
+
+	d.Status = "credited"
+	d.Credited = true
+	d.Confirmations = 6
+	d.TxID = "test-tx"
+	id := d.ID
+	err = Upsert(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = GetByTransferID(testExchanges[0].Name, "BTC", "test-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.ID != id {
+		t.Errorf("expected upsert to update deposit %v, received %v", id, d.ID)
+	}
+	if !d.Credited || d.Status != "credited" || d.Confirmations != 6 || d.TxID != "test-tx" {
+		t.Errorf("deposit not updated %+v", d)
+	}
+	if !d.DepositedAt.Equal(depositedAt.Add(2 * time.Minute)) {
+		t.Errorf("expected deposited at %v, received %v", depositedAt.Add(2*time.Minute), d.DepositedAt)
+	}
+
+	deposits, err := GetByExchange(testExchanges[0].Name, "BTC", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != 3 {
+		t.Fatalf("expected 3 deposits, received %v", len(deposits))
+	}
+	if deposits[0].TransferID != "test-4" {
+		t.Errorf("expected most recent deposit first, received %v", deposits[0].TransferID)
+	}
+
+	deposits, err = GetByExchange(testExchanges[0].Name, "ETH", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != 0 {
+		t.Errorf("expected no ETH deposits, received %v", len(deposits))
+	}
+
+	_, err = GetByTransferID(testExchanges[0].Name, "BTC", "missing")
+	if !errors.Is(err, ErrDepositNotFound) {
+		t.Errorf("expected %v, received %v", ErrDepositNotFound, err)
+	}
+
+	err = Upsert(&Deposit{Exchange: testExchanges[0].Name, Currency: "BTC"})
+	if !errors.Is(err, errTransferIDUnset) {
+		t.Errorf("expected %v, received %v", errTransferIDUnset, err)
+	}
+}
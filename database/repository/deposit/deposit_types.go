@@ -0,0 +1,34 @@
+package deposit
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrDepositNotFound is returned when no stored deposit matches the
+	// exchange, currency and transfer ID
+	ErrDepositNotFound = errors.New("deposit not found")
+
+	errTransferIDUnset = errors.New("deposit transfer ID must be set")
+	errCurrencyUnset   = errors.New("deposit currency must be set")
+)
+
+// Deposit is an exchange deposit. Deposits are unique by exchange, currency
+// and the exchange's transfer ID
+type Deposit struct {
+	ID            string
+	Exchange      string
+	TransferID    string
+	Currency      string
+	Amount        float64
+	Fee           float64
+	Status        string
+	Address       string
+	TxID          string
+	Confirmations int64
+	Credited      bool
+	DepositedAt   time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,215 @@
+package portfoliosnapshot
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert stores the snapshot and its holdings
+func Insert(s *Snapshot) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if s.FiatCurrency == "" {
+		return errFiatCurrencyUnset
+	}
+	s.FiatCurrency = strings.ToUpper(s.FiatCurrency)
+	if s.Timestamp.IsZero() {
+		s.Timestamp = time.Now()
+	}
+
+	ctx := boil.SkipTimestamps(context.Background())
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if isSQLite() {
+		err = insertSQLite(ctx, tx, s)
+	} else {
+		err = insertPSQL(ctx, tx, s)
+	}
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorln(log.DatabaseMgr, errRB)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, s *Snapshot) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	snapshot := modelSQLite.PortfolioSnapshot{
+		ID:            id.String(),
+		FiatCurrency:  s.FiatCurrency,
+		TotalValue:    s.TotalValue,
+		RealisedPNL:   s.RealisedPNL,
+		UnrealisedPNL: s.UnrealisedPNL,
+		CreatedAt:     s.Timestamp.UTC().Format(time.RFC3339),
+	}
+	err = snapshot.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return err
+	}
+	for i := range s.Holdings {
+		var holdingID uuid.UUID
+		holdingID, err = uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		holding := modelSQLite.PortfolioSnapshotHolding{
+			ID:            holdingID.String(),
+			Currency:      strings.ToUpper(s.Holdings[i].Currency),
+			Amount:        s.Holdings[i].Amount,
+			Price:         s.Holdings[i].Price,
+			Value:         s.Holdings[i].Value,
+			RealisedPNL:   s.Holdings[i].RealisedPNL,
+			UnrealisedPNL: s.Holdings[i].UnrealisedPNL,
+		}
+		err = snapshot.AddPortfolioSnapshotHoldings(ctx, tx, true, &holding)
+		if err != nil {
+			return err
+		}
+	}
+	s.ID = snapshot.ID
+	return nil
+}
+
+func insertPSQL(ctx context.Context, tx *sql.Tx, s *Snapshot) error {
+	snapshot := modelPSQL.PortfolioSnapshot{
+		FiatCurrency:  s.FiatCurrency,
+		TotalValue:    s.TotalValue,
+		RealisedPNL:   s.RealisedPNL,
+		UnrealisedPNL: s.UnrealisedPNL,
+		CreatedAt:     s.Timestamp.UTC(),
+	}
+	err := snapshot.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return err
+	}
+	for i := range s.Holdings {
+		holding := modelPSQL.PortfolioSnapshotHolding{
+			Currency:      strings.ToUpper(s.Holdings[i].Currency),
+			Amount:        s.Holdings[i].Amount,
+			Price:         s.Holdings[i].Price,
+			Value:         s.Holdings[i].Value,
+			RealisedPNL:   s.Holdings[i].RealisedPNL,
+			UnrealisedPNL: s.Holdings[i].UnrealisedPNL,
+		}
+		err = snapshot.AddPortfolioSnapshotHoldings(ctx, tx, true, &holding)
+		if err != nil {
+			return err
+		}
+	}
+	s.ID = snapshot.ID
+	return nil
+}
+
+// GetInRange returns the snapshots valued in the fiat currency taken between
+// the start and end times, oldest first
+func GetInRange(fiatCurrency string, start, end time.Time) ([]Snapshot, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	ctx := context.Background()
+	fiatCurrency = strings.ToUpper(fiatCurrency)
+	var snapshots []Snapshot
+	if isSQLite() {
+		results, err := modelSQLite.PortfolioSnapshots(
+			qm.Where("fiat_currency = ?", fiatCurrency),
+			qm.Where("created_at BETWEEN ? AND ?",
+				start.UTC().Format(time.RFC3339),
+				end.UTC().Format(time.RFC3339)),
+			qm.OrderBy(modelSQLite.PortfolioSnapshotColumns.CreatedAt),
+			qm.Load(modelSQLite.PortfolioSnapshotRels.PortfolioSnapshotHoldings,
+				qm.OrderBy(modelSQLite.PortfolioSnapshotHoldingColumns.Currency)),
+		).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			var ts time.Time
+			ts, err = time.Parse(time.RFC3339, results[i].CreatedAt)
+			if err != nil {
+				return nil, err
+			}
+			s := Snapshot{
+				ID:            results[i].ID,
+				FiatCurrency:  results[i].FiatCurrency,
+				TotalValue:    results[i].TotalValue,
+				RealisedPNL:   results[i].RealisedPNL,
+				UnrealisedPNL: results[i].UnrealisedPNL,
+				Timestamp:     ts,
+			}
+			if results[i].R != nil {
+				for _, h := range results[i].R.PortfolioSnapshotHoldings {
+					s.Holdings = append(s.Holdings, Holding{
+						Currency:      h.Currency,
+						Amount:        h.Amount,
+						Price:         h.Price,
+						Value:         h.Value,
+						RealisedPNL:   h.RealisedPNL,
+						UnrealisedPNL: h.UnrealisedPNL,
+					})
+				}
+			}
+			snapshots = append(snapshots, s)
+		}
+		return snapshots, nil
+	}
+
+	results, err := modelPSQL.PortfolioSnapshots(
+		qm.Where("fiat_currency = ?", fiatCurrency),
+		qm.Where("created_at BETWEEN ? AND ?", start.UTC(), end.UTC()),
+		qm.OrderBy(modelPSQL.PortfolioSnapshotColumns.CreatedAt),
+		qm.Load(modelPSQL.PortfolioSnapshotRels.PortfolioSnapshotHoldings,
+			qm.OrderBy(modelPSQL.PortfolioSnapshotHoldingColumns.Currency)),
+	).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		s := Snapshot{
+			ID:            results[i].ID,
+			FiatCurrency:  results[i].FiatCurrency,
+			TotalValue:    results[i].TotalValue,
+			RealisedPNL:   results[i].RealisedPNL,
+			UnrealisedPNL: results[i].UnrealisedPNL,
+			Timestamp:     results[i].CreatedAt.UTC(),
+		}
+		if results[i].R != nil {
+			for _, h := range results[i].R.PortfolioSnapshotHoldings {
+				s.Holdings = append(s.Holdings, Holding{
+					Currency:      h.Currency,
+					Amount:        h.Amount,
+					Price:         h.Price,
+					Value:         h.Value,
+					RealisedPNL:   h.RealisedPNL,
+					UnrealisedPNL: h.UnrealisedPNL,
+				})
+			}
+		}
+		snapshots = append(snapshots, s)
+	}
+	return snapshots, nil
+}
+
+func isSQLite() bool {
+	return repository.GetSQLDialect() == database.DBSQLite3 ||
+		repository.GetSQLDialect() == database.DBSQLite
+}
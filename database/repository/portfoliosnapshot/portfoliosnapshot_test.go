@@ -0,0 +1,146 @@
+package portfoliosnapshot
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestPortfolioSnapshot(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(dbConn *database.Instance) error
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb-portfoliosnapshot"},
+			},
+			snapshotHelper,
+			testhelpers.CloseDatabase,
+		},
+		{
+			"Postgres-Write",
+			testhelpers.PostgresTestDatabase,
+			snapshotHelper,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func snapshotHelper(t *testing.T) {
+	start := time.Now().UTC().Truncate(time.Second)
+	for x := 0; x < 3; x++ {
+		err := Insert(&Snapshot{
+			FiatCurrency:  "usd",
+			TotalValue:    float64(100 * (x + 1)),
+			RealisedPNL:   1,
+			UnrealisedPNL: float64(x),
+			Timestamp:     start.Add(time.Duration(x) * time.Hour),
+			Holdings: []Holding{
+				{Currency: "eth", Amount: 1, Price: 50, Value: 50},
+				{Currency: "btc", Amount: float64(x + 1), Price: 50, Value: float64(50 * (x + 1))},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := Insert(&Snapshot{FiatCurrency: "AUD", TotalValue: 1, Timestamp: start})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots, err := GetInRange("USD", start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("received %v snapshots expected 2", len(snapshots))
+	}
+	if snapshots[0].TotalValue != 100 || snapshots[1].TotalValue != 200 {
+		t.Errorf("expected snapshots oldest first, received %v %v", snapshots[0].TotalValue, snapshots[1].TotalValue)
+	}
+	if !snapshots[1].Timestamp.Equal(start.Add(time.Hour)) {
+		t.Errorf("received %v expected %v", snapshots[1].Timestamp, start.Add(time.Hour))
+	}
+	if len(snapshots[1].Holdings) != 2 {
+		t.Fatalf("received %v holdings expected 2", len(snapshots[1].Holdings))
+	}
+	if snapshots[1].Holdings[0].Currency != "BTC" || snapshots[1].Holdings[0].Amount != 2 {
+		t.Errorf("unexpected holding %+v", snapshots[1].Holdings[0])
+	}
+
+	snapshots, err = GetInRange("AUD", start, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 || len(snapshots[0].Holdings) != 0 {
+		t.Errorf("unexpected AUD snapshots %+v", snapshots)
+	}
+
+	err = Insert(&Snapshot{})
+	if !errors.Is(err, errFiatCurrencyUnset) {
+		t.Errorf("received %v expected %v", err, errFiatCurrencyUnset)
+	}
+}
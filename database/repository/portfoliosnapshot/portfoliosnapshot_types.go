@@ -0,0 +1,31 @@
+package portfoliosnapshot
+
+import (
+	"errors"
+	"time"
+)
+
+var errFiatCurrencyUnset = errors.New("snapshot fiat currency must be set")
+
+// Snapshot is the value of the portfolio in a fiat currency at a point in
+// time along with the value and profit and loss of each currency held
+type Snapshot struct {
+	ID            string
+	FiatCurrency  string
+	TotalValue    float64
+	RealisedPNL   float64
+	UnrealisedPNL float64
+	Timestamp     time.Time
+	Holdings      []Holding
+}
+
+// Holding is the amount held of a currency and its value in the snapshot's
+// fiat currency
+type Holding struct {
+	Currency      string
+	Amount        float64
+	Price         float64
+	Value         float64
+	RealisedPNL   float64
+	UnrealisedPNL float64
+}
@@ -0,0 +1,221 @@
+package scriptschedule
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Upsert stores the schedule, replacing any schedule with the same name
+func Upsert(s *Schedule) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if s.Name == "" {
+		return errNameUnset
+	}
+	if s.ScriptName == "" {
+		return errScriptNameUnset
+	}
+	ctx := boil.SkipTimestamps(context.Background())
+	if isSQLite() {
+		return upsertSQLite(ctx, s)
+	}
+	tempSchedule := modelPSQL.ScriptSchedule{
+		Name:           s.Name,
+		ScriptName:     s.ScriptName,
+		CronExpression: s.CronExpression,
+		RunAt:          nullTime(s.RunAt),
+		Exchanges:      strings.Join(s.Exchanges, ","),
+		SkipWindows:    s.SkipWindows,
+		Enabled:        s.Enabled,
+		LastRunAt:      nullTime(s.LastRunAt),
+		LastStatus:     s.LastStatus,
+		CreatedAt:      time.Now().UTC(),
+	}
+	return tempSchedule.Upsert(ctx,
+		database.DB.SQL,
+		true,
+		[]string{modelPSQL.ScriptScheduleColumns.Name},
+		boil.Blacklist(modelPSQL.ScriptScheduleColumns.ID, modelPSQL.ScriptScheduleColumns.CreatedAt),
+		boil.Infer())
+}
+
+func upsertSQLite(ctx context.Context, s *Schedule) error {
+	existing, err := modelSQLite.ScriptSchedules(
+		modelSQLite.ScriptScheduleWhere.Name.EQ(s.Name)).One(ctx, database.DB.SQL)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	insert := existing == nil
+	if insert {
+		var id uuid.UUID
+		id, err = uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		existing = &modelSQLite.ScriptSchedule{
+			ID:        id.String(),
+			Name:      s.Name,
+			CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		}
+	}
+	existing.ScriptName = s.ScriptName
+	existing.CronExpression = s.CronExpression
+	existing.RunAt = nullString(s.RunAt)
+	existing.Exchanges = strings.Join(s.Exchanges, ",")
+	existing.SkipWindows = s.SkipWindows
+	existing.Enabled = 0
+	if s.Enabled {
+		existing.Enabled = 1
+	}
+	existing.LastRunAt = nullString(s.LastRunAt)
+	existing.LastStatus = s.LastStatus
+	if insert {
+		return existing.Insert(ctx, database.DB.SQL, boil.Infer())
+	}
+	_, err = existing.Update(ctx, database.DB.SQL, boil.Infer())
+	return err
+}
+
+// Delete removes the named schedule
+func Delete(name string) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if name == "" {
+		return errNameUnset
+	}
+	ctx := context.Background()
+	var deleted int64
+	var err error
+	if isSQLite() {
+		deleted, err = modelSQLite.ScriptSchedules(
+			modelSQLite.ScriptScheduleWhere.Name.EQ(name)).DeleteAll(ctx, database.DB.SQL)
+	} else {
+		deleted, err = modelPSQL.ScriptSchedules(
+			modelPSQL.ScriptScheduleWhere.Name.EQ(name)).DeleteAll(ctx, database.DB.SQL)
+	}
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrScheduleNotFound
+	}
+	return nil
+}
+
+// All returns every stored schedule ordered by name
+func All() ([]Schedule, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	ctx := context.Background()
+	var schedules []Schedule
+	if isSQLite() {
+		results, err := modelSQLite.ScriptSchedules(
+			qm.OrderBy(modelSQLite.ScriptScheduleColumns.Name)).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			s, err := fromSQLite(results[i])
+			if err != nil {
+				return nil, err
+			}
+			schedules = append(schedules, *s)
+		}
+		return schedules, nil
+	}
+	results, err := modelPSQL.ScriptSchedules(
+		qm.OrderBy(modelPSQL.ScriptScheduleColumns.Name)).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		schedules = append(schedules, Schedule{
+			Name:           results[i].Name,
+			ScriptName:     results[i].ScriptName,
+			CronExpression: results[i].CronExpression,
+			RunAt:          results[i].RunAt.Time.UTC(),
+			Exchanges:      splitExchanges(results[i].Exchanges),
+			SkipWindows:    results[i].SkipWindows,
+			Enabled:        results[i].Enabled,
+			LastRunAt:      results[i].LastRunAt.Time.UTC(),
+			LastStatus:     results[i].LastStatus,
+			CreatedAt:      results[i].CreatedAt.UTC(),
+		})
+	}
+	return schedules, nil
+}
+
+func fromSQLite(s *modelSQLite.ScriptSchedule) (*Schedule, error) {
+	created, err := time.Parse(time.RFC3339, s.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	runAt, err := parseNullString(s.RunAt)
+	if err != nil {
+		return nil, err
+	}
+	lastRun, err := parseNullString(s.LastRunAt)
+	if err != nil {
+		return nil, err
+	}
+	return &Schedule{
+		Name:           s.Name,
+		ScriptName:     s.ScriptName,
+		CronExpression: s.CronExpression,
+		RunAt:          runAt,
+		Exchanges:      splitExchanges(s.Exchanges),
+		SkipWindows:    s.SkipWindows,
+		Enabled:        s.Enabled == 1,
+		LastRunAt:      lastRun,
+		LastStatus:     s.LastStatus,
+		CreatedAt:      created,
+	}, nil
+}
+
+func splitExchanges(in string) []string {
+	if in == "" {
+		return nil
+	}
+	return strings.Split(in, ",")
+}
+
+func nullTime(t time.Time) null.Time {
+	if t.IsZero() {
+		return null.Time{}
+	}
+	return null.TimeFrom(t.UTC())
+}
+
+func nullString(t time.Time) null.String {
+	if t.IsZero() {
+		return null.String{}
+	}
+	return null.StringFrom(t.UTC().Format(time.RFC3339))
+}
+
+func parseNullString(s null.String) (time.Time, error) {
+	if !s.Valid {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s.String)
+}
+
+func isSQLite() bool {
+	return repository.GetSQLDialect() == database.DBSQLite3 ||
+		repository.GetSQLDialect() == database.DBSQLite
+}
@@ -0,0 +1,143 @@
+package scriptschedule
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestScriptSchedule(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			scriptScheduleSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func scriptScheduleSQLTester(t *testing.T) {
+	if err := Upsert(&Schedule{ScriptName: "test.gct"}); !errors.Is(err, errNameUnset) {
+		t.Errorf("received %v expected %v", err, errNameUnset)
+	}
+	if err := Upsert(&Schedule{Name: "test"}); !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v expected %v", err, errScriptNameUnset)
+	}
+	if err := Delete("missing"); !errors.Is(err, ErrScheduleNotFound) {
+		t.Errorf("received %v expected %v", err, ErrScheduleNotFound)
+	}
+
+	runAt := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	err := Upsert(&Schedule{
+		Name:           "rebalance",
+		ScriptName:     "rebalance.gct",
+		CronExpression: "0 */4 * * *",
+		Exchanges:      []string{"binance", "bitstamp"},
+		SkipWindows:    `[{"cron":"0 2 * * 2","duration":7200000000000}]`,
+		Enabled:        true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Upsert(&Schedule{
+		Name:       "once",
+		ScriptName: "once.gct",
+		RunAt:      runAt,
+		Enabled:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Upsert(&Schedule{
+		Name:       "once",
+		ScriptName: "once.gct",
+		RunAt:      runAt,
+		LastRunAt:  runAt,
+		LastStatus: "success",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schedules, err := All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules) != 2 {
+		t.Fatalf("received %v expected %v schedules", len(schedules), 2)
+	}
+	once := schedules[0]
+	if once.Name != "once" || once.Enabled || !once.RunAt.Equal(runAt) ||
+		!once.LastRunAt.Equal(runAt) || once.LastStatus != "success" || once.CreatedAt.IsZero() {
+		t.Errorf("unexpected schedule %+v", once)
+	}
+	rebalance := schedules[1]
+	if !rebalance.Enabled || rebalance.CronExpression != "0 */4 * * *" || !rebalance.RunAt.IsZero() ||
+		len(rebalance.Exchanges) != 2 || rebalance.SkipWindows == "" || !rebalance.LastRunAt.IsZero() {
+		t.Errorf("unexpected schedule %+v", rebalance)
+	}
+
+	err = Delete("once")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedules, err = All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules) != 1 {
+		t.Errorf("received %v expected %v schedules", len(schedules), 1)
+	}
+}
@@ -0,0 +1,29 @@
+package scriptschedule
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrScheduleNotFound is returned when no schedule has the name
+	ErrScheduleNotFound = errors.New("script schedule not found")
+
+	errNameUnset       = errors.New("schedule name must be set")
+	errScriptNameUnset = errors.New("script name must be set")
+)
+
+// Schedule is a stored script schedule, skip windows are stored as encoded by
+// the script manager
+type Schedule struct {
+	Name           string
+	ScriptName     string
+	CronExpression string
+	RunAt          time.Time
+	Exchanges      []string
+	SkipWindows    string
+	Enabled        bool
+	LastRunAt      time.Time
+	LastStatus     string
+	CreatedAt      time.Time
+}
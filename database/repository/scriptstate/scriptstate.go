@@ -0,0 +1,170 @@
+package scriptstate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Set stores the value against the script key, replacing any existing value
+func Set(scriptName, key, value string) error {
+	if err := validate(scriptName, key); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	if isSQLite() {
+		return setSQLite(ctx, scriptName, key, value)
+	}
+	tempState := modelPSQL.ScriptState{
+		ScriptName: scriptName,
+		StateKey:   key,
+		StateValue: value,
+		UpdatedAt:  time.Now().UTC(),
+	}
+	return tempState.Upsert(ctx,
+		database.DB.SQL,
+		true,
+		[]string{modelPSQL.ScriptStateColumns.ScriptName, modelPSQL.ScriptStateColumns.StateKey},
+		boil.Whitelist(modelPSQL.ScriptStateColumns.StateValue, modelPSQL.ScriptStateColumns.UpdatedAt),
+		boil.Infer())
+}
+
+func setSQLite(ctx context.Context, scriptName, key, value string) error {
+	existing, err := modelSQLite.ScriptStates(
+		modelSQLite.ScriptStateWhere.ScriptName.EQ(scriptName),
+		modelSQLite.ScriptStateWhere.StateKey.EQ(key)).One(ctx, database.DB.SQL)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	updated := time.Now().UTC().Format(time.RFC3339)
+	if existing != nil {
+		existing.StateValue = value
+		existing.UpdatedAt = updated
+		_, err = existing.Update(ctx, database.DB.SQL, boil.Infer())
+		return err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	tempState := modelSQLite.ScriptState{
+		ID:         id.String(),
+		ScriptName: scriptName,
+		StateKey:   key,
+		StateValue: value,
+		UpdatedAt:  updated,
+	}
+	return tempState.Insert(ctx, database.DB.SQL, boil.Infer())
+}
+
+// Get returns the value stored against the script key
+func Get(scriptName, key string) (string, error) {
+	if err := validate(scriptName, key); err != nil {
+		return "", err
+	}
+	ctx := context.Background()
+	var value string
+	var err error
+	if isSQLite() {
+		var state *modelSQLite.ScriptState
+		state, err = modelSQLite.ScriptStates(
+			modelSQLite.ScriptStateWhere.ScriptName.EQ(scriptName),
+			modelSQLite.ScriptStateWhere.StateKey.EQ(key)).One(ctx, database.DB.SQL)
+		if state != nil {
+			value = state.StateValue
+		}
+	} else {
+		var state *modelPSQL.ScriptState
+		state, err = modelPSQL.ScriptStates(
+			modelPSQL.ScriptStateWhere.ScriptName.EQ(scriptName),
+			modelPSQL.ScriptStateWhere.StateKey.EQ(key)).One(ctx, database.DB.SQL)
+		if state != nil {
+			value = state.StateValue
+		}
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrStateNotFound
+	}
+	return value, err
+}
+
+// Delete removes the script key, deleting a key which does not exist is not
+// an error
+func Delete(scriptName, key string) error {
+	if err := validate(scriptName, key); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	var err error
+	if isSQLite() {
+		_, err = modelSQLite.ScriptStates(
+			modelSQLite.ScriptStateWhere.ScriptName.EQ(scriptName),
+			modelSQLite.ScriptStateWhere.StateKey.EQ(key)).DeleteAll(ctx, database.DB.SQL)
+	} else {
+		_, err = modelPSQL.ScriptStates(
+			modelPSQL.ScriptStateWhere.ScriptName.EQ(scriptName),
+			modelPSQL.ScriptStateWhere.StateKey.EQ(key)).DeleteAll(ctx, database.DB.SQL)
+	}
+	return err
+}
+
+// Keys returns all keys stored by the script in ascending order
+func Keys(scriptName string) ([]string, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if scriptName == "" {
+		return nil, errScriptNameUnset
+	}
+	ctx := context.Background()
+	var keys []string
+	if isSQLite() {
+		states, err := modelSQLite.ScriptStates(
+			modelSQLite.ScriptStateWhere.ScriptName.EQ(scriptName),
+			qm.OrderBy(modelSQLite.ScriptStateColumns.StateKey)).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range states {
+			keys = append(keys, states[i].StateKey)
+		}
+		return keys, nil
+	}
+	states, err := modelPSQL.ScriptStates(
+		modelPSQL.ScriptStateWhere.ScriptName.EQ(scriptName),
+		qm.OrderBy(modelPSQL.ScriptStateColumns.StateKey)).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range states {
+		keys = append(keys, states[i].StateKey)
+	}
+	return keys, nil
+}
+
+func validate(scriptName, key string) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if scriptName == "" {
+		return errScriptNameUnset
+	}
+	if key == "" {
+		return errKeyUnset
+	}
+	return nil
+}
+
+func isSQLite() bool {
+	return repository.GetSQLDialect() == database.DBSQLite3 ||
+		repository.GetSQLDialect() == database.DBSQLite
+}
@@ -0,0 +1,127 @@
+package scriptstate
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestScriptState(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			scriptStateSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func scriptStateSQLTester(t *testing.T) {
+	if err := Set("", "key", "value"); !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v expected %v", err, errScriptNameUnset)
+	}
+	if err := Set("test.gct", "", "value"); !errors.Is(err, errKeyUnset) {
+		t.Errorf("received %v expected %v", err, errKeyUnset)
+	}
+	if _, err := Get("test.gct", "missing"); !errors.Is(err, ErrStateNotFound) {
+		t.Errorf("received %v expected %v", err, ErrStateNotFound)
+	}
+
+	err := Set("test.gct", "position", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Set("test.gct", "position", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Set("test.gct", "last_signal", "buy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Set("other.gct", "position", "3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := Get("test.gct", "position")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "2" {
+		t.Errorf("received %v expected %v", value, "2")
+	}
+	keys, err := Keys("test.gct")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0] != "last_signal" || keys[1] != "position" {
+		t.Errorf("unexpected keys %v", keys)
+	}
+
+	err = Delete("test.gct", "position")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Get("test.gct", "position"); !errors.Is(err, ErrStateNotFound) {
+		t.Errorf("received %v expected %v", err, ErrStateNotFound)
+	}
+	value, err = Get("other.gct", "position")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "3" {
+		t.Errorf("received %v expected %v", value, "3")
+	}
+}
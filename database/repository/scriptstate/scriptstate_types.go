@@ -0,0 +1,12 @@
+package scriptstate
+
+import "errors"
+
+var (
+	// ErrStateNotFound is returned when a script has no value stored against
+	// the key
+	ErrStateNotFound = errors.New("script state not found")
+
+	errScriptNameUnset = errors.New("script name must be set")
+	errKeyUnset        = errors.New("state key must be set")
+)
@@ -0,0 +1,235 @@
+package scriptversion
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert stores a new version of the script, numbering it after the latest
+// stored version. The version number and creation time are set on v
+func Insert(v *Version) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if v.ScriptName == "" {
+		return errScriptNameUnset
+	}
+	if v.Hash == "" {
+		return errHashUnset
+	}
+	if v.Author == "" {
+		return errAuthorUnset
+	}
+
+	ctx := boil.SkipTimestamps(context.Background())
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	latest, err := latest(ctx, tx, v.ScriptName)
+	if err != nil && !errors.Is(err, ErrVersionNotFound) {
+		if errRB := tx.Rollback(); errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Insert Transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+	var next int64 = 1
+	if latest != nil {
+		next = latest.Version + 1
+	}
+	created := time.Now().UTC()
+	data := v.Data
+	if data == nil {
+		// empty scripts are stored as empty rather than null data
+		data = []byte{}
+	}
+
+	if isSQLite() {
+		var id uuid.UUID
+		id, err = uuid.NewV4()
+		if err != nil {
+			if errRB := tx.Rollback(); errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert Transaction rollback failed: %v", errRB)
+			}
+			return err
+		}
+		tempVersion := modelSQLite.ScriptVersion{
+			ID:         id.String(),
+			ScriptName: v.ScriptName,
+			Version:    next,
+			Hash:       v.Hash,
+			Author:     v.Author,
+			Data:       data,
+			Signature:  v.Signature,
+			Signer:     v.Signer,
+			CreatedAt:  created.Format(time.RFC3339),
+		}
+		err = tempVersion.Insert(ctx, tx, boil.Infer())
+	} else {
+		tempVersion := modelPSQL.ScriptVersion{
+			ScriptName: v.ScriptName,
+			Version:    next,
+			Hash:       v.Hash,
+			Author:     v.Author,
+			Data:       data,
+			Signature:  v.Signature,
+			Signer:     v.Signer,
+			CreatedAt:  created,
+		}
+		err = tempVersion.Insert(ctx, tx, boil.Infer())
+	}
+	if err != nil {
+		if errRB := tx.Rollback(); errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Insert Transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	v.Version = next
+	v.CreatedAt = created
+	return nil
+}
+
+// Get returns the version of the script, the latest version is returned when
+// version is zero
+func Get(scriptName string, version int64) (*Version, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if scriptName == "" {
+		return nil, errScriptNameUnset
+	}
+	ctx := context.Background()
+	if version == 0 {
+		return latest(ctx, database.DB.SQL, scriptName)
+	}
+	if isSQLite() {
+		v, err := modelSQLite.ScriptVersions(
+			modelSQLite.ScriptVersionWhere.ScriptName.EQ(scriptName),
+			modelSQLite.ScriptVersionWhere.Version.EQ(version)).One(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, notFound(err)
+		}
+		return fromSQLite(v)
+	}
+	v, err := modelPSQL.ScriptVersions(
+		modelPSQL.ScriptVersionWhere.ScriptName.EQ(scriptName),
+		modelPSQL.ScriptVersionWhere.Version.EQ(version)).One(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return fromPostgres(v), nil
+}
+
+// List returns all versions of the script, newest first
+func List(scriptName string) ([]Version, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if scriptName == "" {
+		return nil, errScriptNameUnset
+	}
+	ctx := context.Background()
+	var versions []Version
+	if isSQLite() {
+		results, err := modelSQLite.ScriptVersions(
+			modelSQLite.ScriptVersionWhere.ScriptName.EQ(scriptName),
+			qm.OrderBy(modelSQLite.ScriptVersionColumns.Version+" desc")).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			v, err := fromSQLite(results[i])
+			if err != nil {
+				return nil, err
+			}
+			versions = append(versions, *v)
+		}
+		return versions, nil
+	}
+	results, err := modelPSQL.ScriptVersions(
+		modelPSQL.ScriptVersionWhere.ScriptName.EQ(scriptName),
+		qm.OrderBy(modelPSQL.ScriptVersionColumns.Version+" desc")).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		versions = append(versions, *fromPostgres(results[i]))
+	}
+	return versions, nil
+}
+
+func latest(ctx context.Context, exec boil.ContextExecutor, scriptName string) (*Version, error) {
+	if isSQLite() {
+		v, err := modelSQLite.ScriptVersions(
+			modelSQLite.ScriptVersionWhere.ScriptName.EQ(scriptName),
+			qm.OrderBy(modelSQLite.ScriptVersionColumns.Version+" desc")).One(ctx, exec)
+		if err != nil {
+			return nil, notFound(err)
+		}
+		return fromSQLite(v)
+	}
+	v, err := modelPSQL.ScriptVersions(
+		modelPSQL.ScriptVersionWhere.ScriptName.EQ(scriptName),
+		qm.OrderBy(modelPSQL.ScriptVersionColumns.Version+" desc")).One(ctx, exec)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return fromPostgres(v), nil
+}
+
+func fromSQLite(v *modelSQLite.ScriptVersion) (*Version, error) {
+	created, err := time.Parse(time.RFC3339, v.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &Version{
+		ScriptName: v.ScriptName,
+		Version:    v.Version,
+		Hash:       v.Hash,
+		Author:     v.Author,
+		Data:       v.Data,
+		Signature:  v.Signature,
+		Signer:     v.Signer,
+		CreatedAt:  created,
+	}, nil
+}
+
+func fromPostgres(v *modelPSQL.ScriptVersion) *Version {
+	return &Version{
+		ScriptName: v.ScriptName,
+		Version:    v.Version,
+		Hash:       v.Hash,
+		Author:     v.Author,
+		Data:       v.Data,
+		Signature:  v.Signature,
+		Signer:     v.Signer,
+		CreatedAt:  v.CreatedAt.UTC(),
+	}
+}
+
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrVersionNotFound
+	}
+	return err
+}
+
+func isSQLite() bool {
+	return repository.GetSQLDialect() == database.DBSQLite3 ||
+		repository.GetSQLDialect() == database.DBSQLite
+}
@@ -0,0 +1,136 @@
+package scriptversion
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestScriptVersion(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			scriptVersionSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func scriptVersionSQLTester(t *testing.T) {
+	if err := Insert(&Version{Hash: "hash", Author: "author"}); !errors.Is(err, errScriptNameUnset) {
+		t.Errorf("received %v expected %v", err, errScriptNameUnset)
+	}
+	if err := Insert(&Version{ScriptName: "test.gct", Author: "author"}); !errors.Is(err, errHashUnset) {
+		t.Errorf("received %v expected %v", err, errHashUnset)
+	}
+	if err := Insert(&Version{ScriptName: "test.gct", Hash: "hash"}); !errors.Is(err, errAuthorUnset) {
+		t.Errorf("received %v expected %v", err, errAuthorUnset)
+	}
+	if _, err := Get("test.gct", 0); !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("received %v expected %v", err, ErrVersionNotFound)
+	}
+
+	for i, data := range []string{"a := 1", "a := 2"} {
+		v := &Version{
+			ScriptName: "test.gct",
+			Hash:       data,
+			Author:     "author",
+			Data:       []byte(data),
+		}
+		err := Insert(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Version != int64(i+1) {
+			t.Errorf("received %v expected %v", v.Version, i+1)
+		}
+	}
+	err := Insert(&Version{ScriptName: "other.gct", Hash: "hash", Author: "author", Signer: "key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := Get("test.gct", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Version != 2 || string(v.Data) != "a := 2" {
+		t.Errorf("unexpected latest version %+v", v)
+	}
+	v, err = Get("test.gct", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(v.Data) != "a := 1" || v.CreatedAt.IsZero() {
+		t.Errorf("unexpected version %+v", v)
+	}
+	if _, err = Get("test.gct", 3); !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("received %v expected %v", err, ErrVersionNotFound)
+	}
+
+	versions, err := List("test.gct")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 2 || versions[0].Version != 2 || versions[1].Version != 1 {
+		t.Errorf("unexpected versions %+v", versions)
+	}
+	v, err = Get("other.gct", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Version != 1 || v.Signer != "key" {
+		t.Errorf("unexpected version %+v", v)
+	}
+}
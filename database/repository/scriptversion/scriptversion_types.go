@@ -0,0 +1,27 @@
+package scriptversion
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrVersionNotFound is returned when a script has no matching version
+	ErrVersionNotFound = errors.New("script version not found")
+
+	errScriptNameUnset = errors.New("script name must be set")
+	errHashUnset       = errors.New("script hash must be set")
+	errAuthorUnset     = errors.New("script author must be set")
+)
+
+// Version is a stored revision of a script
+type Version struct {
+	ScriptName string
+	Version    int64
+	Hash       string
+	Author     string
+	Data       []byte
+	Signature  string
+	Signer     string
+	CreatedAt  time.Time
+}
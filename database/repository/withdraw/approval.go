@@ -0,0 +1,193 @@
+package withdraw
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+const sqliteTimeFormat = "2006-01-02 15:04:05"
+
+var (
+	// ErrApprovalExists is returned when a user has already approved or
+	// rejected a withdrawal request
+	ErrApprovalExists = errors.New("user has already actioned withdrawal request")
+	errUsernameUnset  = errors.New("username unset")
+)
+
+// UpdateEvent updates the exchange, approval and tracking status of a stored
+// withdrawal request
+func UpdateEvent(res *withdraw.Response) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	cols := map[string]interface{}{
+		"exchange_id":     res.Exchange.ID,
+		"status":          res.Exchange.Status,
+		"approval_status": res.ApprovalStatus,
+		"tracking_status": res.TrackingStatus,
+	}
+	where := qm.Where("id = ?", res.ID.String())
+
+	var rows int64
+	var err error
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		cols["updated_at"] = time.Now().UTC().Format(sqliteTimeFormat)
+		rows, err = modelSQLite.WithdrawalHistories(where).UpdateAll(ctx, database.DB.SQL, cols)
+	} else {
+		cols["updated_at"] = time.Now().UTC()
+		rows, err = modelPSQL.WithdrawalHistories(where).UpdateAll(ctx, database.DB.SQL, cols)
+	}
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNoResults
+	}
+	return nil
+}
+
+// AddApproval records a user's approval or rejection of a withdrawal request
+func AddApproval(id, username string, approved bool) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if username == "" {
+		return errUsernameUnset
+	}
+
+	ctx := context.Background()
+	where := []qm.QueryMod{
+		qm.Where("withdrawal_history_id = ?", id),
+		qm.Where("username = ?", username),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		exists, err := modelSQLite.WithdrawalApprovals(where...).Exists(ctx, database.DB.SQL)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrApprovalExists
+		}
+		newUUID, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		approval := modelSQLite.WithdrawalApproval{
+			ID:                  newUUID.String(),
+			WithdrawalHistoryID: id,
+			Username:            username,
+		}
+		if approved {
+			approval.Approved = 1
+		}
+		return approval.Insert(ctx, database.DB.SQL, boil.Infer())
+	}
+
+	exists, err := modelPSQL.WithdrawalApprovals(where...).Exists(ctx, database.DB.SQL)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrApprovalExists
+	}
+	approval := modelPSQL.WithdrawalApproval{
+		WithdrawalHistoryID: id,
+		Username:            username,
+		Approved:            approved,
+	}
+	return approval.Insert(ctx, database.DB.SQL, boil.Infer())
+}
+
+// GetEventsByApprovalStatus returns withdrawal requests with the supplied
+// approval status
+func GetEventsByApprovalStatus(status string, limit int) ([]*withdraw.Response, error) {
+	return getByColumns(append(generateWhereQuery([]string{"approval_status"}, []string{status}, limit), qm.OrderBy("created_at")))
+}
+
+// GetTotalByCurrency returns the total amount of a currency requested for
+// withdrawal since the supplied time. Rejected requests and requests the
+// exchange failed to accept are excluded
+func GetTotalByCurrency(code string, since time.Time) (float64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+
+	q := []qm.QueryMod{
+		qm.Where("upper(currency) = ?", strings.ToUpper(code)),
+		qm.Where("approval_status != ?", withdraw.ApprovalRejected),
+		qm.Where("exchange_id != ?", "error"),
+	}
+
+	var total float64
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		q = append(q, qm.Where("created_at >= ?", since.UTC().Format(sqliteTimeFormat)))
+		v, err := modelSQLite.WithdrawalHistories(q...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return 0, err
+		}
+		for x := range v {
+			total += v[x].Amount
+		}
+		return total, nil
+	}
+
+	q = append(q, qm.Where("created_at >= ?", since.UTC()))
+	v, err := modelPSQL.WithdrawalHistories(q...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return 0, err
+	}
+	for x := range v {
+		total += v[x].Amount
+	}
+	return total, nil
+}
+
+func getSQLiteApprovals(ctx context.Context, v *modelSQLite.WithdrawalHistory) ([]withdraw.Approval, error) {
+	a, err := v.WithdrawalApprovals(qm.OrderBy("created_at")).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	var resp []withdraw.Approval
+	for x := range a {
+		createdAt, err := time.Parse(time.RFC3339, a[x].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, withdraw.Approval{
+			Username:  a[x].Username,
+			Approved:  a[x].Approved == 1,
+			CreatedAt: createdAt,
+		})
+	}
+	return resp, nil
+}
+
+func getPSQLApprovals(ctx context.Context, v *modelPSQL.WithdrawalHistory) ([]withdraw.Approval, error) {
+	a, err := v.WithdrawalApprovals(qm.OrderBy("created_at")).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	var resp []withdraw.Approval
+	for x := range a {
+		resp = append(resp, withdraw.Approval{
+			Username:  a[x].Username,
+			Approved:  a[x].Approved,
+			CreatedAt: a[x].CreatedAt,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,46 @@
+package withdraw
+
+import (
+	"context"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// GetEventsByTrackingStatus returns withdrawal requests with the supplied
+// tracking status, oldest first
+func GetEventsByTrackingStatus(status string, limit int) ([]*withdraw.Response, error) {
+	return getByColumns(append(generateWhereQuery([]string{"tracking_status"}, []string{status}, limit), qm.OrderBy("created_at")))
+}
+
+// UpdateTracking updates the exchange and tracking status of a stored
+// withdrawal request along with the fee and transaction ID reported by the
+// exchange for crypto withdrawals
+func UpdateTracking(res *withdraw.Response) error {
+	err := UpdateEvent(res)
+	if err != nil {
+		return err
+	}
+	if res.RequestDetails.Type != withdraw.Crypto {
+		return nil
+	}
+
+	cols := map[string]interface{}{
+		"fee": res.RequestDetails.Crypto.FeeAmount,
+	}
+	if res.Exchange.TxID != "" {
+		cols["tx_id"] = res.Exchange.TxID
+	}
+
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		_, err = modelSQLite.WithdrawalCryptos(qm.Where("withdrawal_history_id = ?", res.ID.String())).UpdateAll(ctx, database.DB.SQL, cols)
+	} else {
+		_, err = modelPSQL.WithdrawalCryptos(qm.Where("withdrawal_crypto_id = ?", res.ID.String())).UpdateAll(ctx, database.DB.SQL, cols)
+	}
+	return err
+}
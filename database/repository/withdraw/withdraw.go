@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -26,8 +27,17 @@ var (
 
 // Event stores Withdrawal Response details in database
 func Event(res *withdraw.Response) {
+	err := AddEvent(res)
+	if err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
+	}
+}
+
+// AddEvent stores Withdrawal Response details in database and sets the
+// response ID to the stored record ID
+func AddEvent(res *withdraw.Response) error {
 	if database.DB.SQL == nil {
-		return
+		return database.ErrDatabaseSupportDisabled
 	}
 
 	ctx := context.Background()
@@ -35,46 +45,41 @@ func Event(res *withdraw.Response) {
 
 	exchangeUUID, err := exchangeDB.UUIDByName(res.Exchange.Name)
 	if err != nil {
-		log.Error(log.DatabaseMgr, err)
-		return
+		return err
 	}
 
-	res.Exchange.Name = exchangeUUID.String()
 	tx, err := database.DB.SQL.BeginTx(ctx, nil)
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event transaction being failed: %v", err)
-		return
+		return fmt.Errorf("event transaction begin failed: %w", err)
 	}
 
 	if repository.GetSQLDialect() == database.DBSQLite3 {
-		err = addSQLiteEvent(ctx, tx, res)
+		err = addSQLiteEvent(ctx, tx, exchangeUUID.String(), res)
 	} else {
-		err = addPSQLEvent(ctx, tx, res)
+		err = addPSQLEvent(ctx, tx, exchangeUUID.String(), res)
 	}
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-		err = tx.Rollback()
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-		}
-		return
+		return err
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event Transaction commit failed: %v", err)
-		return
+		return fmt.Errorf("event transaction commit failed: %w", err)
 	}
+	return nil
 }
 
-func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err error) {
+func addPSQLEvent(ctx context.Context, tx *sql.Tx, exchangeUUID string, res *withdraw.Response) (err error) {
 	var tempEvent = modelPSQL.WithdrawalHistory{
-		ExchangeNameID: res.Exchange.Name,
+		ExchangeNameID: exchangeUUID,
 		ExchangeID:     res.Exchange.ID,
 		Status:         res.Exchange.Status,
 		Currency:       res.RequestDetails.Currency.String(),
 		Amount:         res.RequestDetails.Amount,
 		WithdrawType:   int(res.RequestDetails.Type),
+		ApprovalStatus: res.ApprovalStatus,
+		RequestedBy:    res.RequestDetails.RequestedBy,
+		TrackingStatus: res.TrackingStatus,
 	}
 
 	if res.RequestDetails.Description != "" {
@@ -120,6 +125,9 @@ func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err
 		if res.RequestDetails.Crypto.AddressTag != "" {
 			cryptoEvent.AddressTag.SetValid(res.RequestDetails.Crypto.AddressTag)
 		}
+		if res.Exchange.TxID != "" {
+			cryptoEvent.TXID.SetValid(res.Exchange.TxID)
+		}
 		err = tempEvent.AddWithdrawalCryptoWithdrawalCryptos(ctx, tx, true, cryptoEvent)
 		if err != nil {
 			log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
@@ -137,7 +145,7 @@ func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err
 	return nil
 }
 
-func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err error) {
+func addSQLiteEvent(ctx context.Context, tx *sql.Tx, exchangeUUID string, res *withdraw.Response) (err error) {
 	newUUID, errUUID := uuid.NewV4()
 	if errUUID != nil {
 		log.Errorf(log.DatabaseMgr, "Failed to generate UUID: %v", errUUID)
@@ -150,12 +158,15 @@ func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (er
 
 	var tempEvent = modelSQLite.WithdrawalHistory{
 		ID:             newUUID.String(),
-		ExchangeNameID: res.Exchange.Name,
+		ExchangeNameID: exchangeUUID,
 		ExchangeID:     res.Exchange.ID,
 		Status:         res.Exchange.Status,
 		Currency:       res.RequestDetails.Currency.String(),
 		Amount:         res.RequestDetails.Amount,
 		WithdrawType:   int64(res.RequestDetails.Type),
+		ApprovalStatus: res.ApprovalStatus,
+		RequestedBy:    res.RequestDetails.RequestedBy,
+		TrackingStatus: res.TrackingStatus,
 	}
 
 	if res.RequestDetails.Description != "" {
@@ -203,6 +214,9 @@ func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (er
 		if res.RequestDetails.Crypto.AddressTag != "" {
 			cryptoEvent.AddressTag.SetValid(res.RequestDetails.Crypto.AddressTag)
 		}
+		if res.Exchange.TxID != "" {
+			cryptoEvent.TXID.SetValid(res.Exchange.TxID)
+		}
 
 		err = tempEvent.AddWithdrawalCryptos(ctx, tx, true, cryptoEvent)
 		if err != nil {
@@ -311,7 +325,10 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				Description: v[x].Description.String,
 				Amount:      v[x].Amount,
 				Type:        withdraw.RequestType(v[x].WithdrawType),
+				RequestedBy: v[x].RequestedBy,
 			}
+			tempResp.ApprovalStatus = v[x].ApprovalStatus
+			tempResp.TrackingStatus = v[x].TrackingStatus
 
 			exchangeName, err := v[x].ExchangeName().One(ctx, database.DB.SQL)
 			if err != nil {
@@ -350,6 +367,7 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				tempResp.RequestDetails.Crypto.Address = x.Address
 				tempResp.RequestDetails.Crypto.AddressTag = x.AddressTag.String
 				tempResp.RequestDetails.Crypto.FeeAmount = x.Fee
+				tempResp.Exchange.TxID = x.TXID.String
 			} else {
 				x, err := v[x].WithdrawalFiats().One(ctx, database.DB.SQL)
 				if err != nil {
@@ -360,6 +378,14 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				tempResp.RequestDetails.Fiat.Bank.IBAN = x.Iban
 				tempResp.RequestDetails.Fiat.Bank.SWIFTCode = x.SwiftCode
 				tempResp.RequestDetails.Fiat.Bank.BSBNumber = x.BSB
+				tempResp.RequestDetails.Fiat.Bank.BankName = x.BankName
+				tempResp.RequestDetails.Fiat.Bank.BankAddress = x.BankAddress
+			}
+			if tempResp.ApprovalStatus != "" {
+				tempResp.Approvals, err = getSQLiteApprovals(ctx, v[x])
+				if err != nil {
+					return nil, err
+				}
 			}
 			resp = append(resp, tempResp)
 		}
@@ -380,7 +406,10 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				Description: v[x].Description.String,
 				Amount:      v[x].Amount,
 				Type:        withdraw.RequestType(v[x].WithdrawType),
+				RequestedBy: v[x].RequestedBy,
 			}
+			tempResp.ApprovalStatus = v[x].ApprovalStatus
+			tempResp.TrackingStatus = v[x].TrackingStatus
 			tempResp.CreatedAt = v[x].CreatedAt
 			tempResp.UpdatedAt = v[x].UpdatedAt
 
@@ -405,6 +434,7 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				tempResp.RequestDetails.Crypto.Address = x.Address
 				tempResp.RequestDetails.Crypto.AddressTag = x.AddressTag.String
 				tempResp.RequestDetails.Crypto.FeeAmount = x.Fee
+				tempResp.Exchange.TxID = x.TXID.String
 			} else if withdraw.RequestType(v[x].WithdrawType) == withdraw.Fiat {
 				x, err := v[x].WithdrawalFiatWithdrawalFiats().One(ctx, database.DB.SQL)
 				if err != nil {
@@ -415,6 +445,14 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				tempResp.RequestDetails.Fiat.Bank.IBAN = x.Iban
 				tempResp.RequestDetails.Fiat.Bank.SWIFTCode = x.SwiftCode
 				tempResp.RequestDetails.Fiat.Bank.BSBNumber = x.BSB
+				tempResp.RequestDetails.Fiat.Bank.BankName = x.BankName
+				tempResp.RequestDetails.Fiat.Bank.BankAddress = x.BankAddress
+			}
+			if tempResp.ApprovalStatus != "" {
+				tempResp.Approvals, err = getPSQLApprovals(ctx, v[x])
+				if err != nil {
+					return nil, err
+				}
 			}
 			resp = append(resp, tempResp)
 		}
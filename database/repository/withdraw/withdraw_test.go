@@ -75,6 +75,40 @@ func TestWithdraw(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"SQLite-Approval",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb-approval"},
+			},
+			approvalHelper,
+			testhelpers.CloseDatabase,
+			nil,
+		},
+		{
+			"Postgres-Approval",
+			testhelpers.PostgresTestDatabase,
+			approvalHelper,
+			nil,
+			nil,
+		},
+		{
+			"SQLite-Tracking",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb-tracking"},
+			},
+			trackingHelper,
+			testhelpers.CloseDatabase,
+			nil,
+		},
+		{
+			"Postgres-Tracking",
+			testhelpers.PostgresTestDatabase,
+			trackingHelper,
+			nil,
+			nil,
+		},
 	}
 
 	for _, tests := range testCases {
@@ -188,3 +222,149 @@ func withdrawHelper(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func approvalHelper(t *testing.T) {
+	exchange.ResetExchangeCache()
+	resp := &withdraw.Response{
+		Exchange: withdraw.ExchangeResponse{
+			Name:   testExchanges[0].Name,
+			Status: withdraw.ApprovalPending,
+		},
+		RequestDetails: withdraw.Request{
+			Exchange:    testExchanges[0].Name,
+			Currency:    currency.BTC,
+			Amount:      2,
+			Type:        withdraw.Crypto,
+			RequestedBy: "alice",
+			Crypto: withdraw.CryptoRequest{
+				Address: "approval-address",
+			},
+		},
+		ApprovalStatus: withdraw.ApprovalPending,
+	}
+	err := AddEvent(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Exchange.Name != testExchanges[0].Name {
+		t.Errorf("expected exchange name %v to be unchanged, received %v", testExchanges[0].Name, resp.Exchange.Name)
+	}
+
+	err = AddApproval(resp.ID.String(), "bob", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = AddApproval(resp.ID.String(), "bob", false)
+	if !errors.Is(err, ErrApprovalExists) {
+		t.Errorf("received %v, expected %v", err, ErrApprovalExists)
+	}
+	err = AddApproval(resp.ID.String(), "", true)
+	if !errors.Is(err, errUsernameUnset) {
+		t.Errorf("received %v, expected %v", err, errUsernameUnset)
+	}
+
+	pending, err := GetEventsByApprovalStatus(withdraw.ApprovalPending, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 {
+		t.Fatalf("expected 1 pending withdrawal, received %v", len(pending))
+	}
+	if pending[0].RequestDetails.RequestedBy != "alice" {
+		t.Errorf("expected requester alice, received %v", pending[0].RequestDetails.RequestedBy)
+	}
+	if len(pending[0].Approvals) != 1 || pending[0].Approvals[0].Username != "bob" || !pending[0].Approvals[0].Approved {
+		t.Errorf("unexpected approvals %+v", pending[0].Approvals)
+	}
+
+	total, err := GetTotalByCurrency("btc", time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 {
+		t.Errorf("expected total of 2, received %v", total)
+	}
+
+	resp.ApprovalStatus = withdraw.ApprovalRejected
+	resp.Exchange.Status = withdraw.ApprovalRejected
+	err = UpdateEvent(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := GetEventByUUID(resp.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.ApprovalStatus != withdraw.ApprovalRejected {
+		t.Errorf("expected status %v, received %v", withdraw.ApprovalRejected, v.ApprovalStatus)
+	}
+
+	total, err = GetTotalByCurrency("BTC", time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 0 {
+		t.Errorf("expected rejected withdrawals to be excluded from total, received %v", total)
+	}
+}
+
+func trackingHelper(t *testing.T) {
+	exchange.ResetExchangeCache()
+	resp := &withdraw.Response{
+		Exchange: withdraw.ExchangeResponse{
+			Name:   testExchanges[0].Name,
+			ID:     "tracking-1",
+			Status: "submitted",
+		},
+		RequestDetails: withdraw.Request{
+			Exchange: testExchanges[0].Name,
+			Currency: currency.BTC,
+			Amount:   1,
+			Type:     withdraw.Crypto,
+			Crypto: withdraw.CryptoRequest{
+				Address: "tracking-address",
+			},
+		},
+		TrackingStatus: withdraw.TrackingPending,
+	}
+	err := AddEvent(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := GetEventsByTrackingStatus(withdraw.TrackingPending, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].ID != resp.ID {
+		t.Fatalf("expected withdrawal %v to be pending, received %+v", resp.ID, pending)
+	}
+
+	resp.Exchange.Status = "Completed"
+	resp.Exchange.TxID = "0xdeadbeef"
+	resp.RequestDetails.Crypto.FeeAmount = 0.0005
+	resp.TrackingStatus = withdraw.TrackingCompleted
+	err = UpdateTracking(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := GetEventByUUID(resp.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.TrackingStatus != withdraw.TrackingCompleted {
+		t.Errorf("expected tracking status %v, received %v", withdraw.TrackingCompleted, v.TrackingStatus)
+	}
+	if v.Exchange.TxID != "0xdeadbeef" {
+		t.Errorf("expected tx id 0xdeadbeef, received %v", v.Exchange.TxID)
+	}
+	if v.RequestDetails.Crypto.FeeAmount != 0.0005 {
+		t.Errorf("expected fee 0.0005, received %v", v.RequestDetails.Crypto.FeeAmount)
+	}
+
+	_, err = GetEventsByTrackingStatus(withdraw.TrackingPending, 0)
+	if !errors.Is(err, ErrNoResults) {
+		t.Errorf("received %v, expected %v", err, ErrNoResults)
+	}
+}
@@ -4,19 +4,34 @@ import (
 	"errors"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 )
 
-// DepositAddressStore stores a list of exchange deposit addresses
+// DepositAddressStore stores a list of exchange deposit addresses and the
+// address tags which must accompany deposits to them
 type DepositAddressStore struct {
 	m     sync.Mutex
 	Store map[string]map[string]string
+	Tags  map[string]map[string]string
 }
 
-// DepositAddressManager manages the exchange deposit address store
+// DepositAddressManager manages the exchange deposit address store and
+// optionally tracks deposits made to the stored addresses
 type DepositAddressManager struct {
 	Store DepositAddressStore
+
+	bot           *Engine
+	started       int32
+	shutdown      chan struct{}
+	wg            sync.WaitGroup
+	cfg           config.DepositTrackerConfig
+	trackingStart time.Time
+	// deposits holds whether each seen deposit has been credited, keyed by
+	// exchange, currency and transfer ID
+	deposits map[string]bool
 }
 
 // vars related to the deposit address helpers
@@ -42,6 +57,32 @@ func (d *DepositAddressStore) Seed(coinData map[string]map[string]string) {
 	}
 }
 
+// SeedTags seeds the deposit address tags, keyed by exchange and currency.
+// Currencies which deposit without a tag are omitted
+func (d *DepositAddressStore) SeedTags(tagData map[string]map[string]string) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.Tags == nil {
+		d.Tags = make(map[string]map[string]string)
+	}
+
+	for k, v := range tagData {
+		r := make(map[string]string)
+		for w, x := range v {
+			r[strings.ToUpper(w)] = x
+		}
+		d.Tags[strings.ToUpper(k)] = r
+	}
+}
+
+// GetDepositAddressTag returns the address tag for the specified item's
+// deposit address, empty when deposits do not require one
+func (d *DepositAddressStore) GetDepositAddressTag(exchName string, item currency.Code) string {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.Tags[strings.ToUpper(exchName)][strings.ToUpper(item.String())]
+}
+
 // GetDepositAddress returns a deposit address based on the specified item
 func (d *DepositAddressStore) GetDepositAddress(exchName string, item currency.Code) (string, error) {
 	d.m.Lock()
@@ -81,12 +122,33 @@ func (d *DepositAddressStore) GetDepositAddresses(exchName string) (map[string]s
 	return r, nil
 }
 
+// getAll returns a copy of the stored deposit addresses
+func (d *DepositAddressStore) getAll() map[string]map[string]string {
+	d.m.Lock()
+	defer d.m.Unlock()
+	all := make(map[string]map[string]string, len(d.Store))
+	for exch, addresses := range d.Store {
+		r := make(map[string]string, len(addresses))
+		for code, addr := range addresses {
+			r[code] = addr
+		}
+		all[exch] = r
+	}
+	return all
+}
+
 // GetDepositAddressByExchange returns a deposit address for the specified exchange and cryptocurrency
 // if it exists
 func (d *DepositAddressManager) GetDepositAddressByExchange(exchName string, currencyItem currency.Code) (string, error) {
 	return d.Store.GetDepositAddress(exchName, currencyItem)
 }
 
+// GetDepositAddressTagByExchange returns the address tag for the specified
+// exchange and cryptocurrency's deposit address, empty when none is required
+func (d *DepositAddressManager) GetDepositAddressTagByExchange(exchName string, currencyItem currency.Code) string {
+	return d.Store.GetDepositAddressTag(exchName, currencyItem)
+}
+
 // GetDepositAddressesByExchange returns a list of cryptocurrency addresses for the specified
 // exchange if they exist
 func (d *DepositAddressManager) GetDepositAddressesByExchange(exchName string) (map[string]string, error) {
@@ -97,4 +159,5 @@ func (d *DepositAddressManager) GetDepositAddressesByExchange(exchName string) (
 func (d *DepositAddressManager) Sync() {
 	result := Bot.GetExchangeCryptocurrencyDepositAddresses()
 	d.Store.Seed(result)
+	d.Store.SeedTags(Bot.GetExchangeCryptocurrencyDepositAddressTags(result))
 }
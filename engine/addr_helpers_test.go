@@ -29,6 +29,25 @@ func TestSeed(t *testing.T) {
 	}
 }
 
+func TestSeedTags(t *testing.T) {
+	var d DepositAddressStore
+	if tag := d.GetDepositAddressTag("BITSTAMP", currency.XRP); tag != "" {
+		t.Errorf("received tag %v expected none", tag)
+	}
+
+	d.SeedTags(map[string]map[string]string{
+		"bitstamp": {
+			"xrp": "1337",
+		},
+	})
+	if tag := d.GetDepositAddressTag("BiTStAmP", currency.XRP); tag != "1337" {
+		t.Errorf("received tag %v expected %v", tag, "1337")
+	}
+	if tag := d.GetDepositAddressTag("BITSTAMP", currency.BTC); tag != "" {
+		t.Errorf("received tag %v expected none", tag)
+	}
+}
+
 func TestGetDepositAddress(t *testing.T) {
 	var d DepositAddressStore
 	_, err := d.GetDepositAddress("", currency.BTC)
@@ -0,0 +1,365 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (c *candleManager) Started() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+func (c *candleManager) Start(cfg *config.CandleManagerConfig) error {
+	if cfg == nil {
+		return errCandleManagerNilConfig
+	}
+	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
+		return fmt.Errorf("candle manager %w", subsystem.ErrSubSystemAlreadyStarted)
+	}
+	if !dispatch.IsRunning() {
+		atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		return fmt.Errorf("candle manager %w", errDispatcherNotRunning)
+	}
+
+	log.Debugln(log.CandleMgr, "Candle manager starting...")
+	c.cfg = *cfg
+	c.series = make(map[candleSeriesKey]*liveCandle)
+	c.lastClosed = make(map[candleSeriesKey]time.Time)
+	c.ids = make(map[candleSeriesKey]uuid.UUID)
+	c.mux = dispatch.GetNewMux()
+	var err error
+	c.allID, err = c.mux.GetID()
+	if err != nil {
+		atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		return err
+	}
+
+	pipe, err := trade.SubscribeToFeed()
+	if err != nil {
+		atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		return err
+	}
+
+	c.shutdown = make(chan struct{})
+	c.wg.Add(1)
+	go c.run(pipe)
+	log.Debugf(log.CandleMgr, "Candle manager started. Building candles for intervals %v\n", c.cfg.Intervals)
+	return nil
+}
+
+func (c *candleManager) Stop() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return fmt.Errorf("candle manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	defer func() {
+		atomic.CompareAndSwapInt32(&c.started, 1, 0)
+	}()
+	log.Debugln(log.CandleMgr, "Candle manager shutting down...")
+	close(c.shutdown)
+	c.wg.Wait()
+	log.Debugln(log.CandleMgr, "Candle manager shutdown.")
+	return nil
+}
+
+func (c *candleManager) run(pipe dispatch.Pipe) {
+	tick := time.NewTicker(CandleManagerCheckInterval)
+	defer func() {
+		tick.Stop()
+		err := pipe.Release()
+		if err != nil {
+			log.Errorln(log.CandleMgr, err)
+		}
+		c.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case data, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			trades, ok := (*data.(*interface{})).([]trade.Data)
+			if !ok {
+				log.Errorf(log.CandleMgr, "unexpected trade feed type %T\n", data)
+				continue
+			}
+			c.emit(c.processTrades(trades...)...)
+		case t := <-tick.C:
+			c.emit(c.closeExpired(t)...)
+		}
+	}
+}
+
+// processTrades updates the live candles for every configured interval using
+// incoming trades and returns any candles closed by a trade in a new interval
+func (c *candleManager) processTrades(trades ...trade.Data) []liveCandle {
+	var closed []liveCandle
+	c.m.Lock()
+	for i := range trades {
+		for j := range c.cfg.Intervals {
+			key := newCandleSeriesKey(trades[i].Exchange, trades[i].CurrencyPair, trades[i].AssetType, c.cfg.Intervals[j])
+			start := trades[i].Timestamp.Truncate(key.Interval.Duration()).UTC()
+			lc, ok := c.series[key]
+			if !ok {
+				if !c.isClosed(key, start) {
+					c.series[key] = newLiveCandleFromTrade(&trades[i], key.Interval, start)
+				}
+				continue
+			}
+			if lc.FromKlines || start.Before(lc.Candle.Time) {
+				// Trades for a candle which has already been closed are
+				// dropped
+				continue
+			}
+			if start.After(lc.Candle.Time) {
+				closed = append(closed, *lc)
+				c.lastClosed[key] = lc.Candle.Time
+				c.series[key] = newLiveCandleFromTrade(&trades[i], key.Interval, start)
+				continue
+			}
+			if trades[i].Price > lc.Candle.High {
+				lc.Candle.High = trades[i].Price
+			}
+			if trades[i].Price < lc.Candle.Low {
+				lc.Candle.Low = trades[i].Price
+			}
+			lc.Candle.Close = trades[i].Price
+			lc.Candle.Volume += trades[i].Amount
+		}
+	}
+	c.m.Unlock()
+	return closed
+}
+
+// processKline updates the live candle matching the kline interval with
+// exchange supplied values. Klines for intervals which are not configured are
+// ignored
+func (c *candleManager) processKline(k *stream.KlineData) error {
+	if k == nil {
+		return fmt.Errorf("candle manager %w", errNilKlineData)
+	}
+	interval := kline.Interval(k.CloseTime.Sub(k.StartTime).Round(time.Second))
+	if !c.isIntervalConfigured(interval) {
+		return nil
+	}
+	key := newCandleSeriesKey(k.Exchange, k.Pair, k.AssetType, interval)
+	start := k.StartTime.Truncate(interval.Duration()).UTC()
+	candle := kline.Candle{
+		Time:   start,
+		Open:   k.OpenPrice,
+		High:   k.HighPrice,
+		Low:    k.LowPrice,
+		Close:  k.ClosePrice,
+		Volume: k.Volume,
+	}
+
+	var closed *liveCandle
+	c.m.Lock()
+	lc, ok := c.series[key]
+	switch {
+	case !ok && c.isClosed(key, start):
+		// late update for a candle which has already been expired
+	case !ok:
+		c.series[key] = &liveCandle{
+			Exchange:   k.Exchange,
+			Pair:       k.Pair,
+			Asset:      k.AssetType,
+			Interval:   interval,
+			Candle:     candle,
+			FromKlines: true,
+		}
+	case start.Before(lc.Candle.Time):
+		// stale update for a candle which has already been closed
+	case start.After(lc.Candle.Time):
+		prev := *lc
+		closed = &prev
+		c.lastClosed[key] = lc.Candle.Time
+		lc.Candle = candle
+		lc.FromKlines = true
+	default:
+		lc.Candle = candle
+		lc.FromKlines = true
+	}
+	c.m.Unlock()
+	if closed != nil {
+		c.emit(*closed)
+	}
+	return nil
+}
+
+// closeExpired removes and returns all live candles whose interval has ended
+// plus the configured close delay. The start of each closed candle is kept
+// so later data for it is dropped
+func (c *candleManager) closeExpired(now time.Time) []liveCandle {
+	var closed []liveCandle
+	c.m.Lock()
+	for key, lc := range c.series {
+		end := lc.Candle.Time.Add(lc.Interval.Duration()).Add(c.cfg.CloseDelay)
+		if now.Before(end) {
+			continue
+		}
+		closed = append(closed, *lc)
+		c.lastClosed[key] = lc.Candle.Time
+		delete(c.series, key)
+	}
+	c.m.Unlock()
+	return closed
+}
+
+// isClosed returns whether a candle starting at or before the time has
+// already been closed for the series. Must be called with the lock held
+func (c *candleManager) isClosed(key candleSeriesKey, start time.Time) bool {
+	last, ok := c.lastClosed[key]
+	return ok && !start.After(last)
+}
+
+// emit publishes closed candles to their series subscribers and to
+// subscribers of all candles, then stores them if enabled
+func (c *candleManager) emit(closed ...liveCandle) {
+	for i := range closed {
+		item := kline.Item{
+			Exchange: closed[i].Exchange,
+			Pair:     closed[i].Pair,
+			Asset:    closed[i].Asset,
+			Interval: closed[i].Interval,
+			Candles:  []kline.Candle{closed[i].Candle},
+		}
+		if c.cfg.Verbose {
+			log.Debugf(log.CandleMgr, "%s %s %s %s candle closed %+v\n",
+				item.Exchange,
+				item.Pair,
+				item.Asset,
+				item.Interval.Short(),
+				closed[i].Candle)
+		}
+		ids := []uuid.UUID{c.allID}
+		c.m.Lock()
+		id, ok := c.ids[newCandleSeriesKey(item.Exchange, item.Pair, item.Asset, item.Interval)]
+		c.m.Unlock()
+		if ok {
+			ids = append(ids, id)
+		}
+		err := c.mux.Publish(ids, &item)
+		if err != nil {
+			log.Errorf(log.CandleMgr, "%s %s %s unable to publish candle: %v\n",
+				item.Exchange,
+				item.Pair,
+				item.Asset,
+				err)
+		}
+		if c.cfg.SaveToDatabase && database.DB.Config != nil && database.DB.Config.Enabled {
+			_, err = kline.StoreInDatabase(&item, false)
+			if err != nil {
+				log.Errorf(log.CandleMgr, "%s %s %s unable to store candle: %v\n",
+					item.Exchange,
+					item.Pair,
+					item.Asset,
+					err)
+			}
+		}
+	}
+}
+
+// Subscribe returns a pipe which receives each closed kline.Item for the
+// supplied series
+func (c *candleManager) Subscribe(exchName string, p currency.Pair, a asset.Item, interval kline.Interval) (dispatch.Pipe, error) {
+	if !c.Started() {
+		return dispatch.Pipe{}, fmt.Errorf("candle manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	if !c.isIntervalConfigured(interval) {
+		return dispatch.Pipe{}, fmt.Errorf("%v %w", interval, errIntervalNotConfigured)
+	}
+	key := newCandleSeriesKey(exchName, p, a, interval)
+	c.m.Lock()
+	id, ok := c.ids[key]
+	if !ok {
+		var err error
+		id, err = c.mux.GetID()
+		if err != nil {
+			c.m.Unlock()
+			return dispatch.Pipe{}, err
+		}
+		c.ids[key] = id
+	}
+	c.m.Unlock()
+	return c.mux.Subscribe(id)
+}
+
+// SubscribeAll returns a pipe which receives every closed kline.Item
+func (c *candleManager) SubscribeAll() (dispatch.Pipe, error) {
+	if !c.Started() {
+		return dispatch.Pipe{}, fmt.Errorf("candle manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	return c.mux.Subscribe(c.allID)
+}
+
+// GetLiveCandle returns a copy of the in-progress candle for a series
+func (c *candleManager) GetLiveCandle(exchName string, p currency.Pair, a asset.Item, interval kline.Interval) (kline.Candle, error) {
+	if !c.Started() {
+		return kline.Candle{}, fmt.Errorf("candle manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	lc, ok := c.series[newCandleSeriesKey(exchName, p, a, interval)]
+	if !ok {
+		return kline.Candle{}, fmt.Errorf("%s %s %s %s %w",
+			exchName,
+			p,
+			a,
+			interval.Short(),
+			errNoLiveCandleFound)
+	}
+	return lc.Candle, nil
+}
+
+func (c *candleManager) isIntervalConfigured(interval kline.Interval) bool {
+	for i := range c.cfg.Intervals {
+		if c.cfg.Intervals[i] == interval {
+			return true
+		}
+	}
+	return false
+}
+
+func newCandleSeriesKey(exchName string, p currency.Pair, a asset.Item, interval kline.Interval) candleSeriesKey {
+	return candleSeriesKey{
+		Exchange: strings.ToLower(exchName),
+		Base:     p.Base.Item,
+		Quote:    p.Quote.Item,
+		Asset:    a,
+		Interval: interval,
+	}
+}
+
+func newLiveCandleFromTrade(t *trade.Data, interval kline.Interval, start time.Time) *liveCandle {
+	return &liveCandle{
+		Exchange: t.Exchange,
+		Pair:     t.CurrencyPair,
+		Asset:    t.AssetType,
+		Interval: interval,
+		Candle: kline.Candle{
+			Time:   start,
+			Open:   t.Price,
+			High:   t.Price,
+			Low:    t.Price,
+			Close:  t.Price,
+			Volume: t.Amount,
+		},
+	}
+}
@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func candleManagerTestSetup(t *testing.T) *candleManager {
+	t.Helper()
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// prevent the background routine closing historic test candles
+	CandleManagerCheckInterval = time.Hour
+	var c candleManager
+	err := c.Start(&config.CandleManagerConfig{
+		Intervals:  []kline.Interval{kline.OneMin, kline.FiveMin},
+		CloseDelay: time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &c
+}
+
+func TestCandleManagerStartStop(t *testing.T) {
+	var c candleManager
+	if err := c.Start(nil); !errors.Is(err, errCandleManagerNilConfig) {
+		t.Errorf("received %v expected %v", err, errCandleManagerNilConfig)
+	}
+	if err := c.Stop(); !errors.Is(err, subsystem.ErrSubSystemNotStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemNotStarted)
+	}
+
+	m := candleManagerTestSetup(t)
+	if !m.Started() {
+		t.Error("expected candle manager to be started")
+	}
+	if err := m.Start(&config.CandleManagerConfig{}); !errors.Is(err, subsystem.ErrSubSystemAlreadyStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemAlreadyStarted)
+	}
+	if err := m.Stop(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCandleManagerProcessTrades(t *testing.T) {
+	m := candleManagerTestSetup(t)
+	defer func() {
+		if err := m.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	_, err := m.Subscribe(testExchange, cp, asset.Spot, kline.OneHour)
+	if !errors.Is(err, errIntervalNotConfigured) {
+		t.Errorf("received %v expected %v", err, errIntervalNotConfigured)
+	}
+	pipe, err := m.Subscribe(testExchange, cp, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = pipe.Release(); err != nil {
+		t.Error(err)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.processTrades(
+		trade.Data{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 10, Amount: 1, Timestamp: start.Add(time.Second)},
+		trade.Data{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 15, Amount: 2, Timestamp: start.Add(time.Second * 10)},
+		trade.Data{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 5, Amount: 3, Timestamp: start.Add(time.Second * 20)},
+		trade.Data{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 12, Amount: 4, Timestamp: start.Add(time.Second * 30)},
+	)
+
+	live, err := m.GetLiveCandle(testExchange, cp, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if live.Open != 10 || live.High != 15 || live.Low != 5 || live.Close != 12 || live.Volume != 10 {
+		t.Errorf("unexpected live candle %+v", live)
+	}
+
+	// trade in the next interval closes the first candle
+	closed := m.processTrades(trade.Data{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 13, Amount: 1, Timestamp: start.Add(time.Minute)})
+	if len(closed) != 1 {
+		t.Fatalf("received %v closed candles expected 1", len(closed))
+	}
+	if !closed[0].Candle.Time.Equal(start) || closed[0].Candle.Close != 12 || closed[0].Interval != kline.OneMin {
+		t.Errorf("unexpected closed candle %+v", closed[0])
+	}
+
+	live, err = m.GetLiveCandle(testExchange, cp, asset.Spot, kline.FiveMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if live.Open != 10 || live.Close != 13 || live.Volume != 11 {
+		t.Errorf("unexpected five minute candle %+v", live)
+	}
+
+	closed = m.closeExpired(start.Add(time.Hour))
+	if len(closed) != 2 {
+		t.Errorf("received %v closed candles expected 2", len(closed))
+	}
+	_, err = m.GetLiveCandle(testExchange, cp, asset.Spot, kline.OneMin)
+	if !errors.Is(err, errNoLiveCandleFound) {
+		t.Errorf("received %v expected %v", err, errNoLiveCandleFound)
+	}
+
+	// late data for expired candles must not open duplicate candles
+	m.processTrades(trade.Data{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 14, Amount: 1, Timestamp: start.Add(time.Minute + time.Second)})
+	_, err = m.GetLiveCandle(testExchange, cp, asset.Spot, kline.OneMin)
+	if !errors.Is(err, errNoLiveCandleFound) {
+		t.Errorf("received %v expected %v", err, errNoLiveCandleFound)
+	}
+	err = m.processKline(&stream.KlineData{
+		Exchange:   testExchange,
+		Pair:       cp,
+		AssetType:  asset.Spot,
+		StartTime:  start,
+		CloseTime:  start.Add(time.Minute*5 - time.Millisecond),
+		ClosePrice: 14,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.GetLiveCandle(testExchange, cp, asset.Spot, kline.FiveMin)
+	if !errors.Is(err, errNoLiveCandleFound) {
+		t.Errorf("received %v expected %v", err, errNoLiveCandleFound)
+	}
+	if closed = m.closeExpired(start.Add(time.Hour * 2)); len(closed) != 0 {
+		t.Errorf("received %v closed candles expected 0", len(closed))
+	}
+
+	m.processTrades(trade.Data{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 16, Amount: 1, Timestamp: start.Add(time.Minute * 2)})
+	live, err = m.GetLiveCandle(testExchange, cp, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if live.Open != 16 || !live.Time.Equal(start.Add(time.Minute*2)) {
+		t.Errorf("unexpected live candle %+v", live)
+	}
+}
+
+func TestCandleManagerProcessKline(t *testing.T) {
+	m := candleManagerTestSetup(t)
+	defer func() {
+		if err := m.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	if err := m.processKline(nil); !errors.Is(err, errNilKlineData) {
+		t.Errorf("received %v expected %v", err, errNilKlineData)
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := m.processKline(&stream.KlineData{
+		Exchange:   testExchange,
+		Pair:       cp,
+		AssetType:  asset.Spot,
+		StartTime:  start,
+		CloseTime:  start.Add(time.Minute - time.Millisecond),
+		OpenPrice:  1,
+		HighPrice:  3,
+		LowPrice:   0.5,
+		ClosePrice: 2,
+		Volume:     100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// trades are ignored once klines are received for a series
+	m.processTrades(trade.Data{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 50, Amount: 1, Timestamp: start.Add(time.Second)})
+	live, err := m.GetLiveCandle(testExchange, cp, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if live.High != 3 || live.Volume != 100 {
+		t.Errorf("unexpected live candle %+v", live)
+	}
+}
@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// vars for the candle manager
+var (
+	// CandleManagerCheckInterval is how often in-progress candles are checked
+	// to see if they have closed without any new trade activity
+	CandleManagerCheckInterval = time.Second
+
+	errDispatcherNotRunning   = errors.New("dispatcher must be running")
+	errIntervalNotConfigured  = errors.New("interval not configured for candle manager")
+	errNoLiveCandleFound      = errors.New("no live candle found")
+	errCandleManagerNilConfig = errors.New("candle manager config is nil")
+	errNilKlineData           = errors.New("kline data is nil")
+)
+
+// candleManager builds candles in real time from websocket trades and klines
+// and publishes every closed candle through the dispatch system
+type candleManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	cfg      config.CandleManagerConfig
+
+	m      sync.Mutex
+	series map[candleSeriesKey]*liveCandle
+	// lastClosed is the start time of the last candle closed for each series
+	// so late trades and klines cannot open a duplicate candle once the
+	// series has been removed
+	lastClosed map[candleSeriesKey]time.Time
+	mux        *dispatch.Mux
+	ids        map[candleSeriesKey]uuid.UUID
+	allID      uuid.UUID
+}
+
+// candleSeriesKey uniquely identifies a live candle stream
+type candleSeriesKey struct {
+	Exchange string
+	Base     *currency.Item
+	Quote    *currency.Item
+	Asset    asset.Item
+	Interval kline.Interval
+}
+
+// liveCandle is an in-progress candle for a single series
+type liveCandle struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval kline.Interval
+	Candle   kline.Candle
+	// FromKlines is set once the series has received exchange kline data,
+	// the exchange values are then treated as authoritative and trades are
+	// ignored for the series to prevent volume from being counted twice
+	FromKlines bool
+}
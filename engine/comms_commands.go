@@ -0,0 +1,277 @@
+package engine
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+)
+
+// newChatCommander returns a chat commander with the supported commands
+func newChatCommander(bot *Engine) *chatCommander {
+	return &chatCommander{
+		bot:     bot,
+		pending: make(map[string]*chatConfirmation),
+		commands: map[string]*chatCommand{
+			"status": {
+				description: "Displays the status of the engine subsystems",
+				method:      "GetSubsystems",
+				execute: func(ctx context.Context, s *RPCServer, _ []string) (interface{}, error) {
+					return s.GetSubsystems(ctx, &gctrpc.GetSubsystemsRequest{})
+				},
+			},
+			"balance": {
+				usage:       "<exchange> [asset]",
+				description: "Displays the account balances of an exchange",
+				method:      "GetAccountInfo",
+				minArgs:     1,
+				execute: func(ctx context.Context, s *RPCServer, args []string) (interface{}, error) {
+					return s.GetAccountInfo(ctx, &gctrpc.GetAccountInfoRequest{
+						Exchange:  args[0],
+						AssetType: chatAsset(args, 1),
+					})
+				},
+			},
+			"ticker": {
+				usage:       "<exchange> <pair> [asset]",
+				description: "Displays the ticker of a currency pair",
+				method:      "GetTicker",
+				minArgs:     2,
+				execute: func(ctx context.Context, s *RPCServer, args []string) (interface{}, error) {
+					p, err := chatPair(args[1])
+					if err != nil {
+						return nil, err
+					}
+					return s.GetTicker(ctx, &gctrpc.GetTickerRequest{
+						Exchange:  args[0],
+						Pair:      p,
+						AssetType: chatAsset(args, 2),
+					})
+				},
+			},
+			"orders": {
+				usage:       "<exchange> <pair> [asset]",
+				description: "Displays the open orders of a currency pair",
+				method:      "GetOrders",
+				minArgs:     2,
+				execute: func(ctx context.Context, s *RPCServer, args []string) (interface{}, error) {
+					p, err := chatPair(args[1])
+					if err != nil {
+						return nil, err
+					}
+					return s.GetOrders(ctx, &gctrpc.GetOrdersRequest{
+						Exchange:  args[0],
+						Pair:      p,
+						AssetType: chatAsset(args, 2),
+					})
+				},
+			},
+			"cancel": {
+				usage:       "<exchange> <order id> <pair> [asset]",
+				description: "Cancels an open order",
+				method:      "CancelOrder",
+				minArgs:     3,
+				confirm:     true,
+				execute: func(ctx context.Context, s *RPCServer, args []string) (interface{}, error) {
+					p, err := chatPair(args[2])
+					if err != nil {
+						return nil, err
+					}
+					return s.CancelOrder(ctx, &gctrpc.CancelOrderRequest{
+						Exchange:  args[0],
+						OrderId:   args[1],
+						Pair:      p,
+						AssetType: chatAsset(args, 3),
+					})
+				},
+			},
+			"enable": {
+				usage:       "<subsystem>",
+				description: "Enables an engine subsystem",
+				method:      "EnableSubsystem",
+				minArgs:     1,
+				confirm:     true,
+				execute: func(ctx context.Context, s *RPCServer, args []string) (interface{}, error) {
+					return s.EnableSubsystem(ctx, &gctrpc.GenericSubsystemRequest{Subsystem: args[0]})
+				},
+			},
+			"disable": {
+				usage:       "<subsystem>",
+				description: "Disables an engine subsystem",
+				method:      "DisableSubsystem",
+				minArgs:     1,
+				confirm:     true,
+				execute: func(ctx context.Context, s *RPCServer, args []string) (interface{}, error) {
+					return s.DisableSubsystem(ctx, &gctrpc.GenericSubsystemRequest{Subsystem: args[0]})
+				},
+			},
+		},
+	}
+}
+
+// handle executes a chat command sent by the remote control user and returns
+// the reply. Commands are authorised against the user's role the same way as
+// the remote procedures they call, and privileged commands must be confirmed
+func (c *chatCommander) handle(username, text string) string {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return errChatUnknownCommand.Error()
+	}
+	name, args := chatCommandName(fields[0]), fields[1:]
+
+	role, ok := c.bot.remoteControlRole(username)
+	if !ok {
+		return fmt.Sprintf("%s %v", username, errChatUnknownUser)
+	}
+	ctx := context.WithValue(context.Background(), rpcUserKey{}, username)
+	ctx = context.WithValue(ctx, rpcRoleKey{}, role)
+
+	switch name {
+	case chatCommandHelp:
+		return c.help(role)
+	case chatCommandConfirm:
+		var code string
+		if len(args) > 0 {
+			code = args[0]
+		}
+		p, err := c.confirm(username, code)
+		if err != nil {
+			return err.Error()
+		}
+		return c.run(ctx, p.name, p.args)
+	}
+
+	cmd, ok := c.commands[name]
+	if !ok {
+		return errChatUnknownCommand.Error()
+	}
+	if len(args) < cmd.minArgs {
+		return fmt.Sprintf("usage: %s %s", name, cmd.usage)
+	}
+	if cmd.confirm && role.Allows(rpcPermission(cmd.method)) {
+		code, err := c.stage(username, name, args)
+		if err != nil {
+			return err.Error()
+		}
+		return fmt.Sprintf("send %s %s within %s to run: %s %s",
+			chatCommandConfirm, code, chatConfirmTimeout, name, strings.Join(args, " "))
+	}
+	return c.run(ctx, name, args)
+}
+
+// run authorises and executes the command, returning its response as JSON
+func (c *chatCommander) run(ctx context.Context, name string, args []string) string {
+	cmd := c.commands[name]
+	err := authoriseRPC(ctx, "/"+gctrpc.GoCryptoTrader_ServiceDesc.ServiceName+"/"+cmd.method)
+	if err != nil {
+		return err.Error()
+	}
+	resp, err := cmd.execute(ctx, &RPCServer{Engine: c.bot}, args)
+	if err != nil {
+		return err.Error()
+	}
+	j, err := json.MarshalIndent(resp, "", " ")
+	if err != nil {
+		return err.Error()
+	}
+	return string(j)
+}
+
+// help lists the commands the role is permitted to run
+func (c *chatCommander) help(role auth.Role) string {
+	names := make([]string, 0, len(c.commands))
+	for name, cmd := range c.commands {
+		if role.Allows(rpcPermission(cmd.method)) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("GoCryptoTrader commands available to role " + string(role) + ":\n")
+	for i := range names {
+		cmd := c.commands[names[i]]
+		fmt.Fprintf(&b, "%s %s - %s\n", names[i], cmd.usage, cmd.description)
+	}
+	fmt.Fprintf(&b, "%s <code> - Confirms a cancel, enable or disable command\n", chatCommandConfirm)
+	b.WriteString(chatCommandHelp + " - Displays this command list")
+	return b.String()
+}
+
+// stage stores the command until the user confirms it, replacing any command
+// already awaiting confirmation, and returns the confirmation code
+func (c *chatCommander) stage(username, name string, args []string) (string, error) {
+	b := make([]byte, chatConfirmCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := crypto.HexEncodeToString(b)
+	c.mtx.Lock()
+	c.pending[username] = &chatConfirmation{
+		code:    code,
+		name:    name,
+		args:    args,
+		expires: time.Now().Add(chatConfirmTimeout),
+	}
+	c.mtx.Unlock()
+	return code, nil
+}
+
+// confirm removes and returns the user's command awaiting confirmation if the
+// code matches and it has not expired
+func (c *chatCommander) confirm(username, code string) (*chatConfirmation, error) {
+	c.mtx.Lock()
+	p, ok := c.pending[username]
+	delete(c.pending, username)
+	c.mtx.Unlock()
+	if !ok {
+		return nil, errChatNoConfirmation
+	}
+	if !time.Now().Before(p.expires) {
+		return nil, errChatConfirmExpired
+	}
+	if !strings.EqualFold(code, p.code) {
+		return nil, errChatConfirmCode
+	}
+	return p, nil
+}
+
+// chatCommandName returns the lower case command name without the relayer's
+// command prefix or a Telegram bot name suffix
+func chatCommandName(s string) string {
+	s = strings.TrimLeft(s, "/!")
+	if i := strings.Index(s, "@"); i != -1 {
+		s = s[:i]
+	}
+	return strings.ToLower(s)
+}
+
+// chatAsset returns the asset type argument at the index, or the default
+// asset type when it is omitted
+func chatAsset(args []string, i int) string {
+	if len(args) > i {
+		return args[i]
+	}
+	return chatDefaultAsset
+}
+
+// chatPair parses a currency pair argument
+func chatPair(s string) (*gctrpc.CurrencyPair, error) {
+	p, err := currency.NewPairFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
+}
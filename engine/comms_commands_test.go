@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+)
+
+func chatCommanderTestSetup() *chatCommander {
+	bot := &Engine{Config: &config.Config{}, GctScriptManager: &vm.GctScriptManager{}}
+	bot.Config.RemoteControl.Username = "admin"
+	bot.Config.RemoteControl.Users = []config.RPCUser{
+		{Username: "viewer"},
+		{Username: "trader", Role: auth.RoleTrader},
+	}
+	return newChatCommander(bot)
+}
+
+// chatConfirmCode returns the confirmation code from a staged command reply
+func chatConfirmCode(t *testing.T, reply string) string {
+	t.Helper()
+	fields := strings.Fields(reply)
+	if len(fields) < 3 || fields[0] != "send" || fields[1] != chatCommandConfirm {
+		t.Fatalf("expected confirmation request, received %q", reply)
+	}
+	return fields[2]
+}
+
+func TestChatCommanderHandle(t *testing.T) {
+	t.Parallel()
+	c := chatCommanderTestSetup()
+
+	if r := c.handle("nobody", "/status"); !strings.Contains(r, errChatUnknownUser.Error()) {
+		t.Errorf("received %q expected %v", r, errChatUnknownUser)
+	}
+	if r := c.handle("viewer", "/notacommand"); r != errChatUnknownCommand.Error() {
+		t.Errorf("received %q expected %v", r, errChatUnknownCommand)
+	}
+	if r := c.handle("viewer", "!balance"); r != "usage: balance <exchange> [asset]" {
+		t.Errorf("received %q expected usage", r)
+	}
+	if r := c.handle("viewer", "/status@GCTBot"); !strings.Contains(r, "ntp_timekeeper") {
+		t.Errorf("expected subsystem status, received %q", r)
+	}
+
+	help := c.handle("viewer", "help")
+	if strings.Contains(help, "cancel <exchange>") || !strings.Contains(help, "ticker <exchange>") {
+		t.Errorf("unexpected read only help %q", help)
+	}
+	help = c.handle("trader", "help")
+	if !strings.Contains(help, "cancel <exchange>") || strings.Contains(help, "disable <subsystem>") {
+		t.Errorf("unexpected trader help %q", help)
+	}
+
+	if r := c.handle("viewer", "disable ntp_timekeeper"); !strings.Contains(r, errRPCPermissionDenied.Error()) {
+		t.Errorf("received %q expected %v", r, errRPCPermissionDenied)
+	}
+	if r := c.handle("trader", "disable ntp_timekeeper"); !strings.Contains(r, errRPCPermissionDenied.Error()) {
+		t.Errorf("received %q expected %v", r, errRPCPermissionDenied)
+	}
+}
+
+func TestChatCommanderConfirm(t *testing.T) {
+	t.Parallel()
+	c := chatCommanderTestSetup()
+
+	if r := c.handle("admin", "confirm abc"); r != errChatNoConfirmation.Error() {
+		t.Errorf("received %q expected %v", r, errChatNoConfirmation)
+	}
+
+	chatConfirmCode(t, c.handle("admin", "disable ntp_timekeeper"))
+	if r := c.handle("admin", "confirm wrong"); r != errChatConfirmCode.Error() {
+		t.Errorf("received %q expected %v", r, errChatConfirmCode)
+	}
+	if r := c.handle("admin", "confirm wrong"); r != errChatNoConfirmation.Error() {
+		t.Errorf("received %q expected %v", r, errChatNoConfirmation)
+	}
+
+	code := chatConfirmCode(t, c.handle("admin", "disable ntp_timekeeper"))
+	c.pending["admin"].expires = time.Now().Add(-time.Second)
+	if r := c.handle("admin", "confirm "+code); r != errChatConfirmExpired.Error() {
+		t.Errorf("received %q expected %v", r, errChatConfirmExpired)
+	}
+
+	code = chatConfirmCode(t, c.handle("admin", "disable ntp_timekeeper"))
+	if r := c.handle("trader", "confirm "+code); r != errChatNoConfirmation.Error() {
+		t.Errorf("received %q expected confirmations to be per user", r)
+	}
+	if r := c.handle("admin", "/confirm "+strings.ToUpper(code)); !strings.Contains(r, subsystem.ErrSubSystemNotStarted.Error()) {
+		t.Errorf("expected disable to run, received %q", r)
+	}
+}
+
+func TestChatCommandName(t *testing.T) {
+	t.Parallel()
+	for in, expected := range map[string]string{
+		"/Balance@GCTBot": "balance",
+		"!status":         "status",
+		"ticker":          "ticker",
+	} {
+		if r := chatCommandName(in); r != expected {
+			t.Errorf("received %s expected %s", r, expected)
+		}
+	}
+}
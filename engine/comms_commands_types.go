@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// chatConfirmTimeout is how long a privileged chat command waits for the
+	// user to confirm it
+	chatConfirmTimeout = 2 * time.Minute
+	// chatConfirmCodeLength is the number of random bytes in a confirmation
+	// code
+	chatConfirmCodeLength = 3
+	// chatDefaultAsset is the asset type used when a chat command omits it
+	chatDefaultAsset = "spot"
+
+	chatCommandHelp    = "help"
+	chatCommandConfirm = "confirm"
+)
+
+var (
+	errChatUnknownUser    = errors.New("unknown remote control user")
+	errChatUnknownCommand = errors.New("unknown command, send help for a list of commands")
+	errChatNoConfirmation = errors.New("no command awaiting confirmation")
+	errChatConfirmExpired = errors.New("confirmation expired, send the command again")
+	errChatConfirmCode    = errors.New("confirmation code does not match")
+)
+
+// chatCommand is an inbound chat command executed through a remote procedure
+// so it behaves the same as the matching gctcli command
+type chatCommand struct {
+	usage       string
+	description string
+	// method is the remote procedure called, which sets the permission
+	// needed to run the command
+	method  string
+	minArgs int
+	// confirm requires the user to confirm the command before it is run
+	confirm bool
+	execute func(ctx context.Context, s *RPCServer, args []string) (interface{}, error)
+}
+
+// chatConfirmation is a privileged chat command awaiting confirmation
+type chatConfirmation struct {
+	code    string
+	name    string
+	args    []string
+	expires time.Time
+}
+
+// chatCommander executes inbound chat commands sent via the communication
+// relayers on behalf of remote control users
+type chatCommander struct {
+	bot      *Engine
+	commands map[string]*chatCommand
+	mtx      sync.Mutex
+	pending  map[string]*chatConfirmation
+}
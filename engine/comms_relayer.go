@@ -3,7 +3,9 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
@@ -11,14 +13,30 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
+// notifyTimeout is how long Notify waits for the relayer to accept an event
+const notifyTimeout = 5 * time.Second
+
+var (
+	errCommsNotStarted = errors.New("communications manager not started")
+	errMediumNotFound  = errors.New("communication medium not enabled or connected")
+	errRelayerBusy     = errors.New("communications relayer busy")
+)
+
 // commsManager starts the NTP manager
 type commsManager struct {
 	started  int32
 	shutdown chan struct{}
-	relayMsg chan base.Event
+	relayMsg chan relayEvent
 	comms    *communications.Communications
 }
 
+// relayEvent is an event to push to the named mediums, or all mediums when
+// none are named
+type relayEvent struct {
+	event   base.Event
+	mediums []string
+}
+
 func (c *commsManager) Started() bool {
 	return atomic.LoadInt32(&c.started) == 1
 }
@@ -40,9 +58,10 @@ func (c *commsManager) Start() (err error) {
 	if err != nil {
 		return err
 	}
+	c.comms.SetCommandHandler(newChatCommander(Bot).handle)
 
 	c.shutdown = make(chan struct{})
-	c.relayMsg = make(chan base.Event)
+	c.relayMsg = make(chan relayEvent)
 	go c.run()
 	log.Debugln(log.CommunicationMgr, "Communications manager started.")
 	return nil
@@ -50,7 +69,7 @@ func (c *commsManager) Start() (err error) {
 
 func (c *commsManager) GetStatus() (map[string]base.CommsStatus, error) {
 	if !c.Started() {
-		return nil, errors.New("communications manager not started")
+		return nil, errCommsNotStarted
 	}
 	return c.comms.GetStatus(), nil
 }
@@ -72,12 +91,44 @@ func (c *commsManager) PushEvent(evt base.Event) {
 		return
 	}
 	select {
-	case c.relayMsg <- evt:
+	case c.relayMsg <- relayEvent{event: evt}:
 	default:
 		log.Errorf(log.CommunicationMgr, "Failed to send, no receiver when pushing event [%v]", evt)
 	}
 }
 
+// Notify pushes an event to the named mediums, or all enabled mediums when
+// none are named, returning an error if a medium is not enabled and connected
+// or the relayer does not accept the event within the notify timeout
+func (c *commsManager) Notify(evt base.Event, mediums ...string) error {
+	if !c.Started() {
+		return errCommsNotStarted
+	}
+	status := c.comms.GetStatus()
+	for x := range mediums {
+		found := false
+		for name, s := range status {
+			if strings.EqualFold(name, mediums[x]) {
+				found = s.Enabled && s.Connected
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: %s", errMediumNotFound, mediums[x])
+		}
+	}
+	timer := time.NewTimer(notifyTimeout)
+	defer timer.Stop()
+	select {
+	case c.relayMsg <- relayEvent{event: evt, mediums: mediums}:
+		return nil
+	case <-c.shutdown:
+		return errCommsNotStarted
+	case <-timer.C:
+		return errRelayerBusy
+	}
+}
+
 func (c *commsManager) run() {
 	defer func() {
 		// TO-DO shutdown comms connections for connected services (Slack etc)
@@ -87,7 +138,7 @@ func (c *commsManager) run() {
 	for {
 		select {
 		case msg := <-c.relayMsg:
-			c.comms.PushEvent(msg)
+			c.comms.PushEventTo(msg.event, msg.mediums)
 		case <-c.shutdown:
 			return
 		}
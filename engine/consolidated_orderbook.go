@@ -0,0 +1,392 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Subscribe returns a subscription to the consolidated book for the pair and
+// asset. Every enabled exchange with a pair matching the base currency is
+// included unless exchanges are supplied
+func (c *consolidatedOrderbookManager) Subscribe(bot *Engine, p currency.Pair, a asset.Item, exchanges []string) (*ConsolidatedOrderbookSubscription, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	if p.IsEmpty() {
+		return nil, errCurrencyPairUnset
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%v %w", a, asset.ErrNotSupported)
+	}
+	if !dispatch.IsRunning() {
+		return nil, fmt.Errorf("consolidated orderbook %w", errDispatcherNotRunning)
+	}
+
+	filter := make([]string, len(exchanges))
+	for i := range exchanges {
+		filter[i] = strings.ToLower(exchanges[i])
+	}
+	sort.Strings(filter)
+	key := consolidatedOrderbookKey{
+		Base:      p.Base.Item,
+		Quote:     p.Quote.Item,
+		Asset:     a,
+		Exchanges: strings.Join(filter, ","),
+	}
+
+	c.m.Lock()
+	if c.mux == nil {
+		c.mux = dispatch.GetNewMux()
+		c.books = make(map[consolidatedOrderbookKey]*consolidatedBook)
+	}
+	book, ok := c.books[key]
+	if !ok {
+		venues := findConsolidatedVenues(bot, p, a, filter)
+		if len(venues) == 0 {
+			c.m.Unlock()
+			return nil, fmt.Errorf("%v %v %w", p, a, errNoVenuesFound)
+		}
+		id, err := c.mux.GetID()
+		if err != nil {
+			c.m.Unlock()
+			return nil, err
+		}
+		book = &consolidatedBook{
+			key:    key,
+			id:     id,
+			mux:    c.mux,
+			pair:   p,
+			asset:  a,
+			venues: venues,
+		}
+		book.start()
+		c.books[key] = book
+	}
+	pipe, err := c.mux.Subscribe(book.id)
+	if err != nil {
+		if book.subscribers == 0 {
+			delete(c.books, key)
+			book.stop()
+		}
+		c.m.Unlock()
+		return nil, err
+	}
+	book.subscribers++
+	c.m.Unlock()
+
+	// publish the current state so new subscribers do not wait for the next
+	// venue update
+	book.publish()
+	return &ConsolidatedOrderbookSubscription{
+		Pipe:    pipe,
+		manager: c,
+		key:     key,
+	}, nil
+}
+
+// Release unsubscribes from the consolidated book, the book is shut down once
+// it has no remaining subscribers
+func (s *ConsolidatedOrderbookSubscription) Release() error {
+	if s == nil {
+		return errNilSubscription
+	}
+	c := s.manager
+	c.m.Lock()
+	defer c.m.Unlock()
+	if s.released {
+		return errAlreadyReleased
+	}
+	s.released = true
+	err := s.Pipe.Release()
+	book, ok := c.books[s.key]
+	if !ok {
+		return err
+	}
+	book.subscribers--
+	if book.subscribers <= 0 {
+		delete(c.books, s.key)
+		book.stop()
+	}
+	return err
+}
+
+// Stop shuts down all consolidated books
+func (c *consolidatedOrderbookManager) Stop() {
+	c.m.Lock()
+	defer c.m.Unlock()
+	for key, book := range c.books {
+		book.stop()
+		delete(c.books, key)
+	}
+}
+
+// findConsolidatedVenues returns every enabled exchange pair which shares the
+// base currency and whose quote currency can be converted to the requested
+// quote
+func findConsolidatedVenues(bot *Engine, p currency.Pair, a asset.Item, filter []string) []*consolidatedVenue {
+	var venues []*consolidatedVenue
+	exchanges := bot.GetExchanges()
+	for i := range exchanges {
+		name := exchanges[i].GetName()
+		if len(filter) > 0 && !common.StringDataCompareInsensitive(filter, name) {
+			continue
+		}
+		if !exchanges[i].IsEnabled() {
+			continue
+		}
+		pairs, err := exchanges[i].GetEnabledPairs(a)
+		if err != nil {
+			continue
+		}
+		for j := range pairs {
+			if !pairs[j].Base.Match(p.Base) {
+				continue
+			}
+			if _, err = consolidatedRate(pairs[j].Quote, p.Quote); err != nil {
+				continue
+			}
+			venues = append(venues, &consolidatedVenue{
+				Exchange: name,
+				Pair:     pairs[j],
+				Fee:      consolidatedVenueFee(exchanges[i], pairs[j]),
+			})
+		}
+	}
+	return venues
+}
+
+// consolidatedVenueFee returns the taker fee for the pair as a fraction of the
+// traded value
+func consolidatedVenueFee(exch exchange.IBotExchange, p currency.Pair) float64 {
+	fee, err := exch.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          p,
+		PurchasePrice: 1,
+		Amount:        1,
+	})
+	if err != nil {
+		log.Warnf(log.OrderBook, "Consolidated orderbook unable to get %s %s fee, fees will not be applied: %v\n",
+			exch.GetName(),
+			p,
+			err)
+		return 0
+	}
+	return fee
+}
+
+// consolidatedRate returns the rate to convert a venue quote currency to the
+// consolidated quote currency
+func consolidatedRate(from, to currency.Code) (float64, error) {
+	if from.Match(to) {
+		return 1, nil
+	}
+	return currency.ConvertCurrency(1, from, to)
+}
+
+// start seeds venues from loaded orderbooks and begins listening for updates
+func (b *consolidatedBook) start() {
+	b.pipes = make(map[string]dispatch.Pipe)
+	b.updates = make(chan orderbook.Base)
+	b.shutdown = make(chan struct{})
+	for i := range b.venues {
+		depth, err := orderbook.GetDepth(b.venues[i].Exchange, b.venues[i].Pair, b.asset)
+		if err == nil {
+			b.venues[i].book = depth.Retrieve()
+		}
+	}
+	b.subscribeVenues()
+	b.rebuild()
+	b.wg.Add(1)
+	go b.run()
+}
+
+// stop shuts down all venue listeners
+func (b *consolidatedBook) stop() {
+	close(b.shutdown)
+	b.wg.Wait()
+}
+
+// subscribeVenues subscribes to the orderbook feed of every venue exchange
+// which is not yet subscribed, exchanges without any loaded orderbooks are
+// retried on the next interval
+func (b *consolidatedBook) subscribeVenues() {
+	for i := range b.venues {
+		name := strings.ToLower(b.venues[i].Exchange)
+		if _, ok := b.pipes[name]; ok {
+			continue
+		}
+		pipe, err := orderbook.SubscribeToExchangeOrderbooks(name)
+		if err != nil {
+			continue
+		}
+		b.pipes[name] = pipe
+		b.wg.Add(1)
+		go b.listen(pipe)
+	}
+}
+
+// listen forwards orderbook updates from an exchange feed which are relevant
+// to the consolidated book
+func (b *consolidatedBook) listen(pipe dispatch.Pipe) {
+	defer func() {
+		err := pipe.Release()
+		if err != nil {
+			log.Errorln(log.OrderBook, err)
+		}
+		b.wg.Done()
+	}()
+	for {
+		select {
+		case <-b.shutdown:
+			return
+		case data, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			ob, ok := (*data.(*interface{})).(orderbook.Base)
+			if !ok || ob.Asset != b.asset || !ob.Pair.Base.Match(b.pair.Base) {
+				continue
+			}
+			select {
+			case b.updates <- ob:
+			case <-b.shutdown:
+				return
+			}
+		}
+	}
+}
+
+func (b *consolidatedBook) run() {
+	tick := time.NewTicker(ConsolidatedOrderbookRetryInterval)
+	defer func() {
+		tick.Stop()
+		b.wg.Done()
+	}()
+	for {
+		select {
+		case <-b.shutdown:
+			return
+		case ob := <-b.updates:
+			if b.update(&ob) {
+				b.publish()
+			}
+		case <-tick.C:
+			b.m.Lock()
+			b.subscribeVenues()
+			b.m.Unlock()
+		}
+	}
+}
+
+// update stores the orderbook against its venue and rebuilds the
+// consolidated book, returns false if the orderbook is not for a venue
+func (b *consolidatedBook) update(ob *orderbook.Base) bool {
+	b.m.Lock()
+	defer b.m.Unlock()
+	for i := range b.venues {
+		if strings.EqualFold(b.venues[i].Exchange, ob.Exchange) &&
+			b.venues[i].Pair.Equal(ob.Pair) {
+			b.venues[i].book = ob
+			b.rebuild()
+			return true
+		}
+	}
+	return false
+}
+
+// rebuild merges the venue orderbooks converting prices to the consolidated
+// quote currency and sorting levels by their fee adjusted price
+func (b *consolidatedBook) rebuild() {
+	book := ConsolidatedOrderbook{
+		Pair:  b.pair,
+		Asset: b.asset,
+	}
+	for i := range b.venues {
+		v := b.venues[i]
+		venue := ConsolidatedVenue{
+			Exchange: v.Exchange,
+			Pair:     v.Pair,
+			Fee:      v.Fee,
+		}
+		_, venue.Subscribed = b.pipes[strings.ToLower(v.Exchange)]
+		if v.book == nil {
+			book.Venues = append(book.Venues, venue)
+			continue
+		}
+		rate, err := consolidatedRate(v.Pair.Quote, b.pair.Quote)
+		if err != nil {
+			log.Errorf(log.OrderBook, "Consolidated orderbook unable to convert %s %s to %s: %v\n",
+				v.Exchange,
+				v.Pair,
+				b.pair.Quote,
+				err)
+			book.Venues = append(book.Venues, venue)
+			continue
+		}
+		venue.Rate = rate
+		venue.LastUpdated = v.book.LastUpdated
+		if venue.LastUpdated.After(book.LastUpdated) {
+			book.LastUpdated = venue.LastUpdated
+		}
+		book.Venues = append(book.Venues, venue)
+		for j := range v.book.Bids {
+			price := v.book.Bids[j].Price * rate
+			book.Bids = append(book.Bids, ConsolidatedItem{
+				Exchange:         v.Exchange,
+				Pair:             v.Pair,
+				Price:            price,
+				Amount:           v.book.Bids[j].Amount,
+				OriginalPrice:    v.book.Bids[j].Price,
+				FeeAdjustedPrice: price * (1 - v.Fee),
+			})
+		}
+		for j := range v.book.Asks {
+			price := v.book.Asks[j].Price * rate
+			book.Asks = append(book.Asks, ConsolidatedItem{
+				Exchange:         v.Exchange,
+				Pair:             v.Pair,
+				Price:            price,
+				Amount:           v.book.Asks[j].Amount,
+				OriginalPrice:    v.book.Asks[j].Price,
+				FeeAdjustedPrice: price * (1 + v.Fee),
+			})
+		}
+	}
+	sort.SliceStable(book.Bids, func(i, j int) bool {
+		return book.Bids[i].FeeAdjustedPrice > book.Bids[j].FeeAdjustedPrice
+	})
+	sort.SliceStable(book.Asks, func(i, j int) bool {
+		return book.Asks[i].FeeAdjustedPrice < book.Asks[j].FeeAdjustedPrice
+	})
+	b.book = book
+}
+
+// snapshot returns the latest consolidated book
+func (b *consolidatedBook) snapshot() ConsolidatedOrderbook {
+	b.m.Lock()
+	defer b.m.Unlock()
+	return b.book
+}
+
+// publish sends the latest consolidated book to subscribers
+func (b *consolidatedBook) publish() {
+	book := b.snapshot()
+	err := b.mux.Publish([]uuid.UUID{b.id}, &book)
+	if err != nil {
+		log.Errorf(log.OrderBook, "Consolidated orderbook %v %v unable to publish: %v\n",
+			b.pair,
+			b.asset,
+			err)
+	}
+}
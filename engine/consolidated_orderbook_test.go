@@ -0,0 +1,143 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestConsolidatedOrderbookSubscribe(t *testing.T) {
+	bot := RPCTestSetup(t)
+	defer CleanRPCTest(t, bot)
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	m := &bot.ConsolidatedOrderbooks
+	defer m.Stop()
+	cp := currency.NewPair(currency.BTC, currency.USD)
+
+	if _, err := m.Subscribe(nil, cp, asset.Spot, nil); !errors.Is(err, errNilBot) {
+		t.Errorf("received %v expected %v", err, errNilBot)
+	}
+	if _, err := m.Subscribe(bot, currency.Pair{}, asset.Spot, nil); !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received %v expected %v", err, errCurrencyPairUnset)
+	}
+	_, err := m.Subscribe(bot, currency.NewPair(currency.LTC, currency.USD), asset.Spot, nil)
+	if !errors.Is(err, errNoVenuesFound) {
+		t.Errorf("received %v expected %v", err, errNoVenuesFound)
+	}
+
+	err = (&orderbook.Base{
+		Exchange: testExchange,
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Bids:     []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks:     []orderbook.Item{{Price: 101, Amount: 1}},
+	}).Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sub, err := m.Subscribe(bot, cp, asset.Spot, []string{testExchange})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub2, err := m.Subscribe(bot, cp, asset.Spot, []string{"bitstamp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.m.Lock()
+	if len(m.books) != 1 {
+		t.Errorf("received %v books expected 1", len(m.books))
+	}
+	book := m.books[sub.key]
+	m.m.Unlock()
+	ob := book.snapshot()
+	if len(ob.Venues) != 1 || !ob.Venues[0].Subscribed || ob.Venues[0].Rate != 1 {
+		t.Errorf("unexpected venues %+v", ob.Venues)
+	}
+	if len(ob.Bids) != 2 || len(ob.Asks) != 1 {
+		t.Fatalf("unexpected book %+v", ob)
+	}
+	if ob.Bids[0].Exchange != testExchange || ob.Bids[0].Price != 99 {
+		t.Errorf("unexpected bid %+v", ob.Bids[0])
+	}
+
+	if err = sub.Release(); err != nil {
+		t.Error(err)
+	}
+	if err = sub.Release(); !errors.Is(err, errAlreadyReleased) {
+		t.Errorf("received %v expected %v", err, errAlreadyReleased)
+	}
+	if err = sub2.Release(); err != nil {
+		t.Error(err)
+	}
+	m.m.Lock()
+	if len(m.books) != 0 {
+		t.Errorf("received %v books expected 0", len(m.books))
+	}
+	m.m.Unlock()
+	var nilSub *ConsolidatedOrderbookSubscription
+	if err = nilSub.Release(); !errors.Is(err, errNilSubscription) {
+		t.Errorf("received %v expected %v", err, errNilSubscription)
+	}
+}
+
+func TestConsolidatedBookRebuild(t *testing.T) {
+	t.Parallel()
+	usd := currency.NewPair(currency.BTC, currency.USD)
+	b := &consolidatedBook{
+		pair:  usd,
+		asset: asset.Spot,
+		pipes: map[string]dispatch.Pipe{},
+		venues: []*consolidatedVenue{
+			{Exchange: "cheap", Pair: usd},
+			{Exchange: "expensive", Pair: usd, Fee: 0.01},
+			{Exchange: "empty", Pair: usd},
+		},
+	}
+	if b.update(&orderbook.Base{Exchange: "unknown", Pair: usd}) {
+		t.Error("expected update for unknown venue to be ignored")
+	}
+	if !b.update(&orderbook.Base{
+		Exchange: "expensive",
+		Pair:     usd,
+		Bids:     []orderbook.Item{{Price: 100, Amount: 1}},
+		Asks:     []orderbook.Item{{Price: 100.5, Amount: 1}},
+	}) {
+		t.Fatal("expected venue update")
+	}
+	if !b.update(&orderbook.Base{
+		Exchange: "CHEAP",
+		Pair:     usd,
+		Bids:     []orderbook.Item{{Price: 99.5, Amount: 2}},
+		Asks:     []orderbook.Item{{Price: 101, Amount: 2}},
+	}) {
+		t.Fatal("expected venue update")
+	}
+
+	ob := b.snapshot()
+	if len(ob.Venues) != 3 || ob.Venues[2].Rate != 0 {
+		t.Errorf("unexpected venues %+v", ob.Venues)
+	}
+	if len(ob.Bids) != 2 || len(ob.Asks) != 2 {
+		t.Fatalf("unexpected book %+v", ob)
+	}
+	// fees on the expensive venue outweigh its better prices
+	if ob.Bids[0].Exchange != "cheap" || ob.Asks[0].Exchange != "cheap" {
+		t.Errorf("unexpected ordering bids %+v asks %+v", ob.Bids, ob.Asks)
+	}
+	if ob.Bids[1].Price != 100 || ob.Bids[1].FeeAdjustedPrice != 99 {
+		t.Errorf("unexpected bid %+v", ob.Bids[1])
+	}
+	if ob.Asks[1].FeeAdjustedPrice != 100.5*1.01 {
+		t.Errorf("unexpected ask %+v", ob.Asks[1])
+	}
+}
@@ -0,0 +1,121 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// vars for the consolidated orderbook manager
+var (
+	// ConsolidatedOrderbookRetryInterval is how often a consolidated book
+	// attempts to subscribe to venues which had no orderbook loaded
+	ConsolidatedOrderbookRetryInterval = time.Second * 10
+
+	errNilBot              = errors.New("nil engine received")
+	errNoVenuesFound       = errors.New("no exchanges found with an enabled pair matching the base currency")
+	errNilSubscription     = errors.New("nil consolidated orderbook subscription")
+	errAlreadyReleased     = errors.New("consolidated orderbook subscription already released")
+	errConsolidatedNilBook = errors.New("consolidated orderbook is nil")
+)
+
+// consolidatedOrderbookManager builds a single orderbook for a currency pair
+// from every exchange which trades the same base currency. Books are created
+// on the first subscription and shut down once all subscribers are released
+type consolidatedOrderbookManager struct {
+	m     sync.Mutex
+	mux   *dispatch.Mux
+	books map[consolidatedOrderbookKey]*consolidatedBook
+}
+
+// consolidatedOrderbookKey identifies a consolidated book by pair, asset and
+// the exchanges it is restricted to
+type consolidatedOrderbookKey struct {
+	Base      *currency.Item
+	Quote     *currency.Item
+	Asset     asset.Item
+	Exchanges string
+}
+
+// consolidatedBook merges the orderbooks of its venues and publishes the
+// result each time a venue updates
+type consolidatedBook struct {
+	key         consolidatedOrderbookKey
+	id          uuid.UUID
+	mux         *dispatch.Mux
+	pair        currency.Pair
+	asset       asset.Item
+	subscribers int
+
+	m        sync.Mutex
+	venues   []*consolidatedVenue
+	pipes    map[string]dispatch.Pipe
+	updates  chan orderbook.Base
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	book     ConsolidatedOrderbook
+}
+
+// consolidatedVenue is a single exchange pair contributing to a consolidated
+// book
+type consolidatedVenue struct {
+	Exchange string
+	Pair     currency.Pair
+	// Fee is the taker fee as a fraction of the traded value
+	Fee  float64
+	book *orderbook.Base
+}
+
+// ConsolidatedOrderbookSubscription holds a pipe to a consolidated book, the
+// subscription must be released when no longer required
+type ConsolidatedOrderbookSubscription struct {
+	Pipe     dispatch.Pipe
+	manager  *consolidatedOrderbookManager
+	key      consolidatedOrderbookKey
+	released bool
+}
+
+// ConsolidatedOrderbook is the merged orderbook published to subscribers,
+// prices are converted to the quote currency of the requested pair
+type ConsolidatedOrderbook struct {
+	Pair        currency.Pair
+	Asset       asset.Item
+	Bids        []ConsolidatedItem
+	Asks        []ConsolidatedItem
+	Venues      []ConsolidatedVenue
+	LastUpdated time.Time
+}
+
+// ConsolidatedItem is a price level attributed to the exchange it is resting
+// on
+type ConsolidatedItem struct {
+	Exchange string
+	Pair     currency.Pair
+	// Price is the level price converted to the consolidated quote currency
+	Price  float64
+	Amount float64
+	// OriginalPrice is the level price in the venue quote currency
+	OriginalPrice float64
+	// FeeAdjustedPrice is the price after taker fees are applied, bids are
+	// reduced and asks are increased. Levels are sorted by this price
+	FeeAdjustedPrice float64
+}
+
+// ConsolidatedVenue describes an exchange pair contributing to a
+// consolidated book
+type ConsolidatedVenue struct {
+	Exchange string
+	Pair     currency.Pair
+	// Rate converts the venue quote currency to the consolidated quote
+	Rate        float64
+	Fee         float64
+	LastUpdated time.Time
+	// Subscribed is false while the venue has no orderbook loaded
+	Subscribed bool
+}
@@ -0,0 +1,748 @@
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (m *dataHistoryManager) Started() bool {
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+func (m *dataHistoryManager) Start(bot *Engine) error {
+	if bot == nil {
+		return errDataHistoryNilEngine
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("data history manager %w", subsystem.ErrSubSystemAlreadyStarted)
+	}
+	if !bot.DatabaseManager.Started() {
+		atomic.CompareAndSwapInt32(&m.started, 1, 0)
+		return fmt.Errorf("data history manager %w", errDatabaseNotConnected)
+	}
+	log.Debugln(log.DataHistory, "Data history manager starting...")
+	m.bot = bot
+	m.cfg = bot.Config.DataHistory
+	m.jobs = nil
+	err := m.loadJobs()
+	if err != nil {
+		atomic.CompareAndSwapInt32(&m.started, 1, 0)
+		return err
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.DataHistory, "Data history manager started. %v active jobs loaded\n", len(m.jobs))
+	return nil
+}
+
+func (m *dataHistoryManager) Stop() error {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("data history manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	defer func() {
+		atomic.CompareAndSwapInt32(&m.started, 1, 0)
+	}()
+	log.Debugln(log.DataHistory, "Data history manager shutting down...")
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugln(log.DataHistory, "Data history manager shutdown.")
+	return nil
+}
+
+func (m *dataHistoryManager) run() {
+	tick := time.NewTicker(m.cfg.CheckInterval)
+	defer func() {
+		tick.Stop()
+		m.wg.Done()
+	}()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-tick.C:
+			m.runJobs()
+		}
+	}
+}
+
+// loadJobs loads all active jobs and their results from the database so
+// processing resumes where it left off
+func (m *dataHistoryManager) loadJobs() error {
+	dbJobs, err := datahistoryjob.GetJobsByStatus(int64(dataHistoryStatusActive))
+	if err != nil {
+		return err
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range dbJobs {
+		job, err := m.convertDBModelToJob(&dbJobs[i])
+		if err != nil {
+			log.Errorf(log.DataHistory, "unable to load job %v: %v\n", dbJobs[i].Nickname, err)
+			continue
+		}
+		err = m.loadResults(job)
+		if err != nil {
+			return err
+		}
+		m.jobs = append(m.jobs, job)
+	}
+	return nil
+}
+
+func (m *dataHistoryManager) loadResults(job *DataHistoryJob) error {
+	dbResults, err := datahistoryjob.GetResultsByJobID(job.ID.String())
+	if err != nil {
+		return err
+	}
+	job.Results = make(map[time.Time][]DataHistoryJobResult)
+	for i := range dbResults {
+		result, err := convertDBResultToJobResult(&dbResults[i])
+		if err != nil {
+			return err
+		}
+		job.Results[result.IntervalStartDate] = append(job.Results[result.IntervalStartDate], *result)
+	}
+	return nil
+}
+
+// UpsertJob validates and saves a job. An existing job with the same nickname
+// is replaced unless insertOnly is set. Results of the existing job are
+// retained, so failed attempts continue to count towards the retry limit
+func (m *dataHistoryManager) UpsertJob(job *DataHistoryJob, insertOnly bool) error {
+	if !m.Started() {
+		return fmt.Errorf("data history manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	if job == nil {
+		return errNilJob
+	}
+	if job.Nickname == "" {
+		return errNicknameUnset
+	}
+	existing, err := m.GetByNickname(job.Nickname, false)
+	if err != nil && !errors.Is(err, errJobNotFound) {
+		return err
+	}
+	if existing != nil && insertOnly {
+		return fmt.Errorf("%s %w", job.Nickname, errNicknameInUse)
+	}
+	err = m.validateJob(job)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		job.ID = existing.ID
+		job.CreatedDate = existing.CreatedDate
+		err = m.loadResults(job)
+		if err != nil {
+			return err
+		}
+	} else {
+		job.ID, err = uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		job.CreatedDate = time.Now()
+		job.Results = make(map[time.Time][]DataHistoryJobResult)
+	}
+	job.rangeHolder = nil
+
+	dbJob := m.convertJobToDBModel(job)
+	err = datahistoryjob.Upsert(dbJob)
+	if err != nil {
+		return err
+	}
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range m.jobs {
+		if m.jobs[i].ID != job.ID {
+			continue
+		}
+		if job.Status == dataHistoryStatusActive {
+			m.jobs[i] = job
+		} else {
+			m.jobs = append(m.jobs[:i], m.jobs[i+1:]...)
+		}
+		return nil
+	}
+	if job.Status == dataHistoryStatusActive {
+		m.jobs = append(m.jobs, job)
+	}
+	return nil
+}
+
+// validateJob checks a job can be processed and sets default values
+func (m *dataHistoryManager) validateJob(job *DataHistoryJob) error {
+	if job.DataType != dataHistoryCandleDataType && job.DataType != dataHistoryTradeDataType {
+		return fmt.Errorf("%s %w %v", job.Nickname, errInvalidDataType, job.DataType)
+	}
+	if job.Status != dataHistoryStatusActive && job.Status != dataHistoryStatusFailed {
+		return fmt.Errorf("%s %w", job.Nickname, errJobMustBeActiveOrFailed)
+	}
+	exch := m.bot.GetExchangeByName(job.Exchange)
+	if exch == nil {
+		return fmt.Errorf("%s %w", job.Exchange, errExchangeNotLoaded)
+	}
+	job.Exchange = exch.GetName()
+	if !exch.GetAssetTypes().Contains(job.Asset) {
+		return fmt.Errorf("%s %w", job.Asset, asset.ErrNotSupported)
+	}
+	if job.Pair.IsEmpty() {
+		return fmt.Errorf("%s %w", job.Nickname, errCurrencyPairUnset)
+	}
+	if job.Interval <= 0 {
+		return fmt.Errorf("%s %w", job.Nickname, kline.ErrUnsetInterval)
+	}
+	if job.StartDate.IsZero() || job.EndDate.IsZero() || !job.StartDate.Before(job.EndDate) || job.EndDate.After(time.Now()) {
+		return fmt.Errorf("%s %w, start %v end %v", job.Nickname, errInvalidTimes, job.StartDate, job.EndDate)
+	}
+	job.StartDate = job.StartDate.Truncate(job.Interval.Duration()).UTC()
+	job.EndDate = job.EndDate.Truncate(job.Interval.Duration()).UTC()
+	if job.RequestSizeLimit <= 0 {
+		job.RequestSizeLimit = defaultDataHistoryRequestSizeLimit
+	}
+	if job.RunBatchLimit <= 0 {
+		job.RunBatchLimit = defaultDataHistoryRunBatchLimit
+	}
+	if job.MaxRetryAttempts <= 0 {
+		job.MaxRetryAttempts = defaultDataHistoryMaxRetryAttempts
+	}
+	return nil
+}
+
+// GetByNickname returns a copy of a job. Full details includes all results
+// stored for the job
+func (m *dataHistoryManager) GetByNickname(nickname string, fullDetails bool) (*DataHistoryJob, error) {
+	if !m.Started() {
+		return nil, fmt.Errorf("data history manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	for i := range m.jobs {
+		if strings.EqualFold(m.jobs[i].Nickname, nickname) {
+			cpy := *m.jobs[i]
+			m.m.Unlock()
+			if fullDetails {
+				return &cpy, m.loadResults(&cpy)
+			}
+			return &cpy, nil
+		}
+	}
+	m.m.Unlock()
+
+	dbJob, err := datahistoryjob.GetByNickName(nickname)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s %w", nickname, errJobNotFound)
+		}
+		return nil, err
+	}
+	job, err := m.convertDBModelToJob(dbJob)
+	if err != nil {
+		return nil, err
+	}
+	if fullDetails {
+		return job, m.loadResults(job)
+	}
+	return job, nil
+}
+
+// GetActiveJobs returns a copy of all jobs which are still being processed
+func (m *dataHistoryManager) GetActiveJobs() ([]DataHistoryJob, error) {
+	if !m.Started() {
+		return nil, fmt.Errorf("data history manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	resp := make([]DataHistoryJob, len(m.jobs))
+	for i := range m.jobs {
+		resp[i] = *m.jobs[i]
+	}
+	return resp, nil
+}
+
+// GetJobsBetween returns all jobs created between the supplied dates
+func (m *dataHistoryManager) GetJobsBetween(start, end time.Time) ([]DataHistoryJob, error) {
+	if !m.Started() {
+		return nil, fmt.Errorf("data history manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	dbJobs, err := datahistoryjob.GetJobsBetween(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]DataHistoryJob, 0, len(dbJobs))
+	for i := range dbJobs {
+		job, err := m.convertDBModelToJob(&dbJobs[i])
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, *job)
+	}
+	return resp, nil
+}
+
+// DeleteJob marks a job as removed so it is no longer processed
+func (m *dataHistoryManager) DeleteJob(nickname string) error {
+	if !m.Started() {
+		return fmt.Errorf("data history manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	job, err := m.GetByNickname(nickname, false)
+	if err != nil {
+		return err
+	}
+	job.Status = dataHistoryStatusRemoved
+	err = datahistoryjob.Upsert(m.convertJobToDBModel(job))
+	if err != nil {
+		return err
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range m.jobs {
+		if m.jobs[i].ID == job.ID {
+			m.jobs = append(m.jobs[:i], m.jobs[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// runJobs processes active jobs, up to the configured limit each cycle. Jobs
+// are processed on copies so the lock is not held while data is fetched, and
+// the copies only replace jobs which were not changed or deleted meanwhile
+func (m *dataHistoryManager) runJobs() {
+	m.m.Lock()
+	n := len(m.jobs)
+	if m.cfg.MaxJobsPerCycle < int64(n) {
+		n = int(m.cfg.MaxJobsPerCycle)
+	}
+	if n < 0 {
+		n = 0
+	}
+	original := make([]*DataHistoryJob, n)
+	copy(original, m.jobs)
+	jobs := make([]DataHistoryJob, n)
+	for i := range original {
+		jobs[i] = *original[i]
+		jobs[i].Results = make(map[time.Time][]DataHistoryJobResult, len(original[i].Results))
+		for k, v := range original[i].Results {
+			jobs[i].Results[k] = append([]DataHistoryJobResult(nil), v...)
+		}
+	}
+	m.m.Unlock()
+
+	for i := range jobs {
+		err := m.runJob(&jobs[i])
+		if err != nil {
+			log.Errorf(log.DataHistory, "job %v: %v\n", jobs[i].Nickname, err)
+		}
+	}
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range original {
+		for j := range m.jobs {
+			if m.jobs[j] != original[i] {
+				continue
+			}
+			if jobs[i].Status == dataHistoryStatusActive {
+				m.jobs[j] = &jobs[i]
+			} else {
+				m.jobs = append(m.jobs[:j], m.jobs[j+1:]...)
+			}
+			break
+		}
+	}
+}
+
+// runJob fetches up to the job's batch limit of interval ranges which are
+// missing data, records the result of each and updates the job status once
+// all data is retrieved or retries are exhausted
+func (m *dataHistoryManager) runJob(job *DataHistoryJob) error {
+	if job.Status != dataHistoryStatusActive {
+		return nil
+	}
+	exch := m.bot.GetExchangeByName(job.Exchange)
+	if exch == nil {
+		return fmt.Errorf("%s %w", job.Exchange, errExchangeNotLoaded)
+	}
+	if job.rangeHolder == nil {
+		rh := kline.CalculateCandleDateRanges(job.StartDate, job.EndDate, job.Interval, uint32(job.RequestSizeLimit))
+		job.rangeHolder = &rh
+		err := m.checkExistingData(job)
+		if err != nil {
+			return err
+		}
+	}
+
+	var requests int64
+	for i := range job.rangeHolder.Ranges {
+		if requests >= job.RunBatchLimit {
+			break
+		}
+		r := &job.rangeHolder.Ranges[i]
+		if rangeHasData(r) || m.failedAttempts(job, r.Start.Time) >= job.MaxRetryAttempts {
+			continue
+		}
+		var result *DataHistoryJobResult
+		switch job.DataType {
+		case dataHistoryCandleDataType:
+			result = m.processCandleData(job, exch, r)
+		case dataHistoryTradeDataType:
+			result = m.processTradeData(job, exch, r)
+		default:
+			return errInvalidDataType
+		}
+		requests++
+		err := datahistoryjob.InsertResults(convertJobResultToDBResult(result))
+		if err != nil {
+			return err
+		}
+		job.Results[result.IntervalStartDate] = append(job.Results[result.IntervalStartDate], *result)
+		if m.cfg.Verbose {
+			log.Debugf(log.DataHistory, "job %v %v-%v status: %v %v\n",
+				job.Nickname,
+				result.IntervalStartDate,
+				result.IntervalEndDate,
+				result.Status,
+				result.Result)
+		}
+	}
+
+	complete, exhausted := true, true
+	for i := range job.rangeHolder.Ranges {
+		if rangeHasData(&job.rangeHolder.Ranges[i]) {
+			continue
+		}
+		complete = false
+		if m.failedAttempts(job, job.rangeHolder.Ranges[i].Start.Time) < job.MaxRetryAttempts {
+			exhausted = false
+		}
+	}
+	switch {
+	case complete:
+		job.Status = dataHistoryStatusComplete
+	case exhausted:
+		job.Status = dataHistoryStatusFailed
+	default:
+		return nil
+	}
+	log.Infof(log.DataHistory, "job %v finished with status %v\n", job.Nickname, job.Status)
+	return datahistoryjob.Upsert(m.convertJobToDBModel(job))
+}
+
+// checkExistingData flags which intervals already have data stored so only
+// gaps are retrieved
+func (m *dataHistoryManager) checkExistingData(job *DataHistoryJob) error {
+	var candles []kline.Candle
+	switch job.DataType {
+	case dataHistoryCandleDataType:
+		stored, err := kline.LoadFromDatabase(job.Exchange, job.Pair, job.Asset, job.Interval, job.StartDate, job.EndDate)
+		if err != nil {
+			// no stored data returns an error, the full range is fetched
+			return nil
+		}
+		candles = stored.Candles
+	case dataHistoryTradeDataType:
+		trades, err := trade.GetTradesInRange(job.Exchange, job.Asset.String(), job.Pair.Base.String(), job.Pair.Quote.String(), job.StartDate, job.EndDate)
+		if err != nil {
+			return nil
+		}
+		if len(trades) == 0 {
+			return nil
+		}
+		stored, err := trade.ConvertTradesToCandles(job.Interval, trades...)
+		if err != nil {
+			return err
+		}
+		candles = stored.Candles
+	default:
+		return errInvalidDataType
+	}
+	// missing data is expected and is what the job will fill
+	_ = job.rangeHolder.VerifyResultsHaveData(candles)
+	return nil
+}
+
+func (m *dataHistoryManager) processCandleData(job *DataHistoryJob, exch exchange.IBotExchange, r *kline.IntervalRange) *DataHistoryJobResult {
+	result := newDataHistoryJobResult(job, r)
+	candles, err := exchange.GetHistoricCandlesWithResample(exch, job.Pair, job.Asset, r.Start.Time, r.End.Time, job.Interval, true)
+	if err != nil {
+		result.Result = err.Error()
+		return result
+	}
+	valid, issues := m.validateCandles(candles.Candles)
+	if len(valid) == 0 {
+		result.Result = errNoCandleData.Error()
+		if len(issues) > 0 {
+			result.Result += ", " + issues.Error()
+		}
+		return result
+	}
+	candles.Candles = valid
+	candles.Exchange = job.Exchange
+	_, err = kline.StoreInDatabase(&candles, false)
+	if err != nil {
+		result.Result = err.Error()
+		return result
+	}
+	return m.completeResult(job, r, result, valid, issues)
+}
+
+func (m *dataHistoryManager) processTradeData(job *DataHistoryJob, exch exchange.IBotExchange, r *kline.IntervalRange) *DataHistoryJobResult {
+	result := newDataHistoryJobResult(job, r)
+	trades, err := exch.GetHistoricTrades(job.Pair, job.Asset, r.Start.Time, r.End.Time)
+	if err != nil {
+		result.Result = err.Error()
+		return result
+	}
+	trades = trade.FilterTradesByTime(trades, r.Start.Time, r.End.Time)
+	if len(trades) == 0 {
+		result.Result = "no trade data returned"
+		return result
+	}
+	err = trade.SaveTradesToDatabase(trades...)
+	if err != nil {
+		result.Result = err.Error()
+		return result
+	}
+	candles, err := trade.ConvertTradesToCandles(job.Interval, trades...)
+	if err != nil {
+		result.Result = err.Error()
+		return result
+	}
+	return m.completeResult(job, r, result, candles.Candles, nil)
+}
+
+// completeResult flags intervals which now have data and sets the result
+// status based on whether the range has been filled
+func (m *dataHistoryManager) completeResult(job *DataHistoryJob, r *kline.IntervalRange, result *DataHistoryJobResult, candles []kline.Candle, issues common.Errors) *DataHistoryJobResult {
+	_ = job.rangeHolder.VerifyResultsHaveData(candles)
+	var missing int
+	for i := range r.Intervals {
+		if !r.Intervals[i].HasData {
+			missing++
+		}
+	}
+	if missing == 0 {
+		result.Status = dataHistoryStatusComplete
+	} else {
+		result.Result = fmt.Sprintf("%d of %d intervals missing data", missing, len(r.Intervals))
+	}
+	if len(issues) > 0 {
+		if result.Result != "" {
+			result.Result += ", "
+		}
+		result.Result += "validation issues: " + issues.Error()
+	}
+	return result
+}
+
+// validateCandles removes candles with inconsistent OHLC values and flags
+// runs of zero volume candles and closes which deviate from the previous
+// close by more than the configured percentage. Flagged candles are kept
+func (m *dataHistoryManager) validateCandles(candles []kline.Candle) ([]kline.Candle, common.Errors) {
+	sorted := make([]kline.Candle, len(candles))
+	copy(sorted, candles)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	var issues common.Errors
+	valid := make([]kline.Candle, 0, len(sorted))
+	var zeroVolumeRun int64
+	var zeroVolumeStart time.Time
+	var prevClose float64
+	for i := range sorted {
+		c := &sorted[i]
+		if c.Low < 0 || c.Volume < 0 || c.High < c.Low ||
+			c.Open > c.High || c.Open < c.Low ||
+			c.Close > c.High || c.Close < c.Low {
+			issues = append(issues, fmt.Errorf("%v %w", c.Time, errCandleInvalidOHLC))
+			continue
+		}
+		valid = append(valid, *c)
+
+		if c.Volume == 0 {
+			if zeroVolumeRun == 0 {
+				zeroVolumeStart = c.Time
+			}
+			zeroVolumeRun++
+			if zeroVolumeRun == m.cfg.MaxZeroVolumeRun {
+				issues = append(issues, fmt.Errorf("%v %d %w",
+					zeroVolumeStart,
+					zeroVolumeRun,
+					errCandleZeroVolumeRun))
+			}
+		} else {
+			zeroVolumeRun = 0
+		}
+
+		if prevClose > 0 {
+			deviation := math.Abs(c.Close-prevClose) / prevClose * 100
+			if deviation > m.cfg.MaxPriceDeviation {
+				issues = append(issues, fmt.Errorf("%v %w by %.2f%%", c.Time, errCandlePriceDeviation, deviation))
+			}
+		}
+		prevClose = c.Close
+	}
+	return valid, issues
+}
+
+// failedAttempts returns the number of failed results for a range
+func (m *dataHistoryManager) failedAttempts(job *DataHistoryJob, start time.Time) int64 {
+	var failed int64
+	results := job.Results[start.UTC()]
+	for i := range results {
+		if results[i].Status == dataHistoryStatusFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+func rangeHasData(r *kline.IntervalRange) bool {
+	for i := range r.Intervals {
+		if !r.Intervals[i].HasData {
+			return false
+		}
+	}
+	return true
+}
+
+func newDataHistoryJobResult(job *DataHistoryJob, r *kline.IntervalRange) *DataHistoryJobResult {
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Errorln(log.DataHistory, err)
+	}
+	return &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: r.Start.Time.UTC(),
+		IntervalEndDate:   r.End.Time.UTC(),
+		Status:            dataHistoryStatusFailed,
+		Date:              time.Now(),
+	}
+}
+
+func (m *dataHistoryManager) convertDBModelToJob(dbJob *datahistoryjob.DataHistoryJob) (*DataHistoryJob, error) {
+	id, err := uuid.FromString(dbJob.ID)
+	if err != nil {
+		return nil, err
+	}
+	p, err := currency.NewPairFromStrings(dbJob.Base, dbJob.Quote)
+	if err != nil {
+		return nil, err
+	}
+	return &DataHistoryJob{
+		ID:               id,
+		Nickname:         dbJob.Nickname,
+		Exchange:         dbJob.ExchangeName,
+		Asset:            asset.Item(dbJob.Asset),
+		Pair:             p,
+		StartDate:        dbJob.StartDate.UTC(),
+		EndDate:          dbJob.EndDate.UTC(),
+		Interval:         kline.Interval(time.Duration(dbJob.Interval) * time.Second),
+		RunBatchLimit:    dbJob.BatchSize,
+		RequestSizeLimit: dbJob.RequestSizeLimit,
+		DataType:         dataHistoryDataType(dbJob.DataType),
+		MaxRetryAttempts: dbJob.MaxRetryAttempts,
+		Status:           dataHistoryStatus(dbJob.Status),
+		CreatedDate:      dbJob.CreatedDate,
+	}, nil
+}
+
+func (m *dataHistoryManager) convertJobToDBModel(job *DataHistoryJob) *datahistoryjob.DataHistoryJob {
+	return &datahistoryjob.DataHistoryJob{
+		ID:               job.ID.String(),
+		Nickname:         job.Nickname,
+		ExchangeName:     job.Exchange,
+		Asset:            job.Asset.String(),
+		Base:             job.Pair.Base.String(),
+		Quote:            job.Pair.Quote.String(),
+		StartDate:        job.StartDate,
+		EndDate:          job.EndDate,
+		Interval:         int64(job.Interval.Duration().Seconds()),
+		DataType:         int64(job.DataType),
+		RequestSizeLimit: job.RequestSizeLimit,
+		BatchSize:        job.RunBatchLimit,
+		MaxRetryAttempts: job.MaxRetryAttempts,
+		Status:           int64(job.Status),
+		CreatedDate:      job.CreatedDate,
+	}
+}
+
+func convertDBResultToJobResult(dbResult *datahistoryjob.DataHistoryJobResult) (*DataHistoryJobResult, error) {
+	id, err := uuid.FromString(dbResult.ID)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := uuid.FromString(dbResult.JobID)
+	if err != nil {
+		return nil, err
+	}
+	return &DataHistoryJobResult{
+		ID:                id,
+		JobID:             jobID,
+		IntervalStartDate: dbResult.IntervalStartDate.UTC(),
+		IntervalEndDate:   dbResult.IntervalEndDate.UTC(),
+		Status:            dataHistoryStatus(dbResult.Status),
+		Result:            dbResult.Result,
+		Date:              dbResult.Date,
+	}, nil
+}
+
+func convertJobResultToDBResult(result *DataHistoryJobResult) *datahistoryjob.DataHistoryJobResult {
+	return &datahistoryjob.DataHistoryJobResult{
+		ID:                result.ID.String(),
+		JobID:             result.JobID.String(),
+		Result:            result.Result,
+		Status:            int64(result.Status),
+		IntervalStartDate: result.IntervalStartDate,
+		IntervalEndDate:   result.IntervalEndDate,
+		Date:              result.Date,
+	}
+}
+
+// String implements the stringer interface
+func (d dataHistoryStatus) String() string {
+	switch d {
+	case dataHistoryStatusActive:
+		return "active"
+	case dataHistoryStatusFailed:
+		return "failed"
+	case dataHistoryStatusComplete:
+		return "complete"
+	case dataHistoryStatusRemoved:
+		return "removed"
+	}
+	return "unknown"
+}
+
+// String implements the stringer interface
+func (d dataHistoryDataType) String() string {
+	switch d {
+	case dataHistoryCandleDataType:
+		return "candles"
+	case dataHistoryTradeDataType:
+		return "trades"
+	}
+	return "unknown"
+}
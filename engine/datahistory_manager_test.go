@@ -0,0 +1,272 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func dataHistoryManagerTestSetup(t *testing.T) *Engine {
+	t.Helper()
+	bot := RPCTestSetup(t)
+	if bot.GetExchangeByName(fakePassExchange) == nil {
+		err := addPassingFakeExchange(testExchange, bot)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dbexchange.Insert(dbexchange.Details{Name: fakePassExchange, UUID: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bot.Config.DataHistory = config.DataHistoryConfig{
+		CheckInterval:     time.Hour,
+		MaxJobsPerCycle:   5,
+		MaxZeroVolumeRun:  3,
+		MaxPriceDeviation: 50,
+	}
+	err = bot.DataHistoryManager.Start(bot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bot
+}
+
+func newTestDataHistoryJob(nickname string) *DataHistoryJob {
+	end := time.Now().Truncate(time.Hour)
+	return &DataHistoryJob{
+		Nickname:         nickname,
+		Exchange:         fakePassExchange,
+		Asset:            asset.Spot,
+		Pair:             currency.NewPair(currency.BTC, currency.USD),
+		StartDate:        end.Add(-time.Hour * 6),
+		EndDate:          end,
+		Interval:         kline.OneHour,
+		RequestSizeLimit: 2,
+		RunBatchLimit:    2,
+	}
+}
+
+func TestDataHistoryManagerStartStop(t *testing.T) {
+	var m dataHistoryManager
+	if err := m.Start(nil); !errors.Is(err, errDataHistoryNilEngine) {
+		t.Errorf("received %v expected %v", err, errDataHistoryNilEngine)
+	}
+	if err := m.Start(&Engine{}); !errors.Is(err, errDatabaseNotConnected) {
+		t.Errorf("received %v expected %v", err, errDatabaseNotConnected)
+	}
+	if err := m.Stop(); !errors.Is(err, subsystem.ErrSubSystemNotStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemNotStarted)
+	}
+
+	bot := dataHistoryManagerTestSetup(t)
+	defer CleanRPCTest(t, bot)
+	if err := bot.DataHistoryManager.Start(bot); !errors.Is(err, subsystem.ErrSubSystemAlreadyStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemAlreadyStarted)
+	}
+	if err := bot.DataHistoryManager.Stop(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDataHistoryManagerUpsertJob(t *testing.T) {
+	bot := dataHistoryManagerTestSetup(t)
+	defer CleanRPCTest(t, bot)
+	m := &bot.DataHistoryManager
+	defer func() {
+		if err := m.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	if err := m.UpsertJob(nil, false); !errors.Is(err, errNilJob) {
+		t.Errorf("received %v expected %v", err, errNilJob)
+	}
+	if err := m.UpsertJob(&DataHistoryJob{}, false); !errors.Is(err, errNicknameUnset) {
+		t.Errorf("received %v expected %v", err, errNicknameUnset)
+	}
+	job := newTestDataHistoryJob("upsert")
+	job.Exchange = "nope"
+	if err := m.UpsertJob(job, false); !errors.Is(err, errExchangeNotLoaded) {
+		t.Errorf("received %v expected %v", err, errExchangeNotLoaded)
+	}
+	job = newTestDataHistoryJob("upsert")
+	job.StartDate, job.EndDate = job.EndDate, job.StartDate
+	if err := m.UpsertJob(job, false); !errors.Is(err, errInvalidTimes) {
+		t.Errorf("received %v expected %v", err, errInvalidTimes)
+	}
+	job = newTestDataHistoryJob("upsert")
+	job.DataType = 1337
+	if err := m.UpsertJob(job, false); !errors.Is(err, errInvalidDataType) {
+		t.Errorf("received %v expected %v", err, errInvalidDataType)
+	}
+
+	job = newTestDataHistoryJob("upsert")
+	if err := m.UpsertJob(job, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.UpsertJob(newTestDataHistoryJob("upsert"), true); !errors.Is(err, errNicknameInUse) {
+		t.Errorf("received %v expected %v", err, errNicknameInUse)
+	}
+	update := newTestDataHistoryJob("upsert")
+	update.MaxRetryAttempts = 10
+	if err := m.UpsertJob(update, false); err != nil {
+		t.Fatal(err)
+	}
+	if update.ID != job.ID {
+		t.Errorf("received %v expected %v", update.ID, job.ID)
+	}
+	stored, err := m.GetByNickname("UPSERT", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.MaxRetryAttempts != 10 {
+		t.Errorf("received %v expected %v", stored.MaxRetryAttempts, 10)
+	}
+
+	if err = m.DeleteJob("upsert"); err != nil {
+		t.Fatal(err)
+	}
+	active, err := m.GetActiveJobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 0 {
+		t.Errorf("received %v active jobs expected 0", len(active))
+	}
+	stored, err = m.GetByNickname("upsert", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != dataHistoryStatusRemoved {
+		t.Errorf("received %v expected %v", stored.Status, dataHistoryStatusRemoved)
+	}
+	if _, err = m.GetByNickname("missing", false); !errors.Is(err, errJobNotFound) {
+		t.Errorf("received %v expected %v", err, errJobNotFound)
+	}
+}
+
+func TestDataHistoryManagerRunJob(t *testing.T) {
+	bot := dataHistoryManagerTestSetup(t)
+	defer CleanRPCTest(t, bot)
+	m := &bot.DataHistoryManager
+
+	job := newTestDataHistoryJob("runjob")
+	if err := m.UpsertJob(job, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// six intervals split into three ranges, two ranges are processed per run
+	m.runJobs()
+	stored, err := m.GetByNickname("runjob", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != dataHistoryStatusActive {
+		t.Errorf("received %v expected %v", stored.Status, dataHistoryStatusActive)
+	}
+	if len(stored.Results) != 2 {
+		t.Errorf("received %v results expected 2", len(stored.Results))
+	}
+	if len(job.Results) != 0 {
+		t.Error("expected jobs to be processed on a copy")
+	}
+	m.runJobs()
+	stored, err = m.GetByNickname("runjob", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != dataHistoryStatusComplete {
+		t.Errorf("received %v expected %v", stored.Status, dataHistoryStatusComplete)
+	}
+	active, err := m.GetActiveJobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 0 {
+		t.Errorf("received %v active jobs expected 0", len(active))
+	}
+	if err = m.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	// stored data is detected so a new job over the same range requires no
+	// requests after a restart
+	if err = m.Start(bot); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = m.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	job = newTestDataHistoryJob("rerun")
+	if err = m.UpsertJob(job, false); err != nil {
+		t.Fatal(err)
+	}
+	m.runJobs()
+	stored, err = m.GetByNickname("rerun", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != dataHistoryStatusComplete {
+		t.Errorf("received %v expected %v", stored.Status, dataHistoryStatusComplete)
+	}
+	if len(stored.Results) != 0 {
+		t.Errorf("received %v results expected 0", len(stored.Results))
+	}
+	stored, err = m.GetByNickname("runjob", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != dataHistoryStatusComplete || len(stored.Results) != 3 {
+		t.Errorf("unexpected stored job %+v", stored)
+	}
+}
+
+func TestValidateCandles(t *testing.T) {
+	t.Parallel()
+	m := dataHistoryManager{cfg: config.DataHistoryConfig{
+		MaxZeroVolumeRun:  2,
+		MaxPriceDeviation: 50,
+	}}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := []kline.Candle{
+		{Time: start.Add(time.Hour * 4), Open: 10, High: 31, Low: 9, Close: 30, Volume: 1},
+		{Time: start, Open: 10, High: 11, Low: 9, Close: 10, Volume: 1},
+		{Time: start.Add(time.Hour), Open: 10, High: 9, Low: 11, Close: 10, Volume: 1},
+		{Time: start.Add(time.Hour * 2), Open: 10, High: 11, Low: 9, Close: 10},
+		{Time: start.Add(time.Hour * 3), Open: 10, High: 11, Low: 9, Close: 10},
+	}
+	valid, issues := m.validateCandles(candles)
+	if len(valid) != 4 {
+		t.Fatalf("received %v valid candles expected 4", len(valid))
+	}
+	if !valid[0].Time.Equal(start) {
+		t.Error("expected candles to be sorted")
+	}
+	if len(issues) != 3 {
+		t.Fatalf("received %v issues expected 3: %v", len(issues), issues)
+	}
+	if !errors.Is(issues[0], errCandleInvalidOHLC) {
+		t.Errorf("received %v expected %v", issues[0], errCandleInvalidOHLC)
+	}
+	if !errors.Is(issues[1], errCandleZeroVolumeRun) {
+		t.Errorf("received %v expected %v", issues[1], errCandleZeroVolumeRun)
+	}
+	if !errors.Is(issues[2], errCandlePriceDeviation) {
+		t.Errorf("received %v expected %v", issues[2], errCandlePriceDeviation)
+	}
+}
@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// dataHistoryStatus is the status of a job or an individual job interval
+type dataHistoryStatus int64
+
+// dataHistoryDataType is the type of data a job retrieves
+type dataHistoryDataType int64
+
+// Data history job and result statuses
+const (
+	dataHistoryStatusActive dataHistoryStatus = iota
+	dataHistoryStatusFailed
+	dataHistoryStatusComplete
+	dataHistoryStatusRemoved
+)
+
+// Data history data types
+const (
+	dataHistoryCandleDataType dataHistoryDataType = iota
+	dataHistoryTradeDataType
+)
+
+const (
+	defaultDataHistoryRequestSizeLimit = 500
+	defaultDataHistoryRunBatchLimit    = 10
+	defaultDataHistoryMaxRetryAttempts = 3
+)
+
+var (
+	errNilJob                  = errors.New("nil job received")
+	errNicknameUnset           = errors.New("job nickname unset")
+	errNicknameInUse           = errors.New("job nickname already in use")
+	errJobNotFound             = errors.New("job not found")
+	errInvalidDataType         = errors.New("invalid data type")
+	errDatabaseNotConnected    = errors.New("database is not connected")
+	errDataHistoryNilEngine    = errors.New("data history manager cannot start with nil engine")
+	errNoCandleData            = errors.New("no candle data returned")
+	errCandleInvalidOHLC       = errors.New("candle open, high, low and close values are inconsistent")
+	errCandleZeroVolumeRun     = errors.New("consecutive zero volume candles")
+	errCandlePriceDeviation    = errors.New("candle close deviates from previous close")
+	errJobMustBeActiveOrFailed = errors.New("job must be active or failed")
+)
+
+// dataHistoryManager retrieves and validates candle and trade data for
+// configured jobs, filling any gaps found in the database. Job progress is
+// stored in the database so jobs resume where they left off after a restart
+type dataHistoryManager struct {
+	bot      *Engine
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	cfg      config.DataHistoryConfig
+
+	m    sync.Mutex
+	jobs []*DataHistoryJob
+}
+
+// DataHistoryJob defines a range of candle or trade data to retrieve and
+// store for an exchange, pair and asset
+type DataHistoryJob struct {
+	ID        uuid.UUID
+	Nickname  string
+	Exchange  string
+	Asset     asset.Item
+	Pair      currency.Pair
+	StartDate time.Time
+	EndDate   time.Time
+	Interval  kline.Interval
+	// RunBatchLimit is the number of requests made to the exchange each time
+	// the job is processed, keeping jobs from exhausting rate limits
+	RunBatchLimit int64
+	// RequestSizeLimit is the number of candles requested per API call
+	RequestSizeLimit int64
+	DataType         dataHistoryDataType
+	MaxRetryAttempts int64
+	Status           dataHistoryStatus
+	CreatedDate      time.Time
+	// Results are keyed by the start of the interval range they cover
+	Results     map[time.Time][]DataHistoryJobResult
+	rangeHolder *kline.IntervalRangeHolder
+}
+
+// DataHistoryJobResult is the outcome of processing a single interval range
+// of a job
+type DataHistoryJobResult struct {
+	ID                uuid.UUID
+	JobID             uuid.UUID
+	IntervalStartDate time.Time
+	IntervalEndDate   time.Time
+	Status            dataHistoryStatus
+	Result            string
+	Date              time.Time
+}
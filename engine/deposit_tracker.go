@@ -0,0 +1,243 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	depositDataStore "github.com/thrasher-corp/gocryptotrader/database/repository/deposit"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const depositTrackerEventType = "deposit"
+
+var errDepositTrackerNilEngine = errors.New("deposit tracker cannot start with nil engine")
+
+// DepositTrackingStarted returns whether deposit tracking is running
+func (d *DepositAddressManager) DepositTrackingStarted() bool {
+	return atomic.LoadInt32(&d.started) == 1
+}
+
+// StartDepositTracking polls exchange deposit history for the currencies in
+// the deposit address store, storing deposits when database support is
+// enabled and notifying the comms relayer when a deposit to a stored address
+// is credited
+func (d *DepositAddressManager) StartDepositTracking(bot *Engine) error {
+	if bot == nil {
+		return errDepositTrackerNilEngine
+	}
+	if !atomic.CompareAndSwapInt32(&d.started, 0, 1) {
+		return fmt.Errorf("deposit tracker %w", subsystem.ErrSubSystemAlreadyStarted)
+	}
+	log.Debugln(log.Global, "Deposit tracker starting...")
+	d.bot = bot
+	d.cfg = bot.Config.DepositTracker
+	d.trackingStart = time.Now()
+	d.deposits = make(map[string]bool)
+	d.shutdown = make(chan struct{})
+	d.wg.Add(1)
+	go d.runDepositTracking()
+	log.Debugln(log.Global, "Deposit tracker started.")
+	return nil
+}
+
+// StopDepositTracking stops polling exchange deposit history
+func (d *DepositAddressManager) StopDepositTracking() error {
+	if atomic.LoadInt32(&d.started) == 0 {
+		return fmt.Errorf("deposit tracker %w", subsystem.ErrSubSystemNotStarted)
+	}
+	defer func() {
+		atomic.CompareAndSwapInt32(&d.started, 1, 0)
+	}()
+	log.Debugln(log.Global, "Deposit tracker shutting down...")
+	close(d.shutdown)
+	d.wg.Wait()
+	log.Debugln(log.Global, "Deposit tracker shutdown.")
+	return nil
+}
+
+func (d *DepositAddressManager) runDepositTracking() {
+	tick := time.NewTicker(d.cfg.PollInterval)
+	defer func() {
+		tick.Stop()
+		d.wg.Done()
+	}()
+	for {
+		select {
+		case <-d.shutdown:
+			return
+		case <-tick.C:
+			d.checkDeposits()
+		}
+	}
+}
+
+// checkDeposits fetches deposit history for every exchange and currency in
+// the deposit address store
+func (d *DepositAddressManager) checkDeposits() {
+	for exchName, addresses := range d.Store.getAll() {
+		exch := d.bot.GetExchangeByName(exchName)
+		if exch == nil {
+			continue
+		}
+		for code, address := range addresses {
+			history, err := exch.GetDepositHistory(currency.NewCode(code))
+			if err != nil {
+				if !errors.Is(err, common.ErrFunctionNotSupported) &&
+					!errors.Is(err, common.ErrNotYetImplemented) {
+					log.Errorf(log.Global, "Deposit tracker unable to get %v %v deposit history: %v\n",
+						exch.GetName(), code, err)
+				}
+				continue
+			}
+			for i := range history {
+				d.updateDeposit(exch.GetName(), code, address, &history[i])
+			}
+		}
+	}
+}
+
+// updateDeposit stores the deposit and notifies the comms relayer if it has
+// been credited since it was last seen. Deposits reported to an address other
+// than the stored deposit address are ignored, as are deposits made before
+// tracking started which have not been seen before
+func (d *DepositAddressManager) updateDeposit(exchName, code, address string, h *exchange.DepositHistory) {
+	if h.CryptoToAddress != "" && h.CryptoToAddress != address {
+		return
+	}
+	transferID := h.TransferID
+	if transferID == "" {
+		transferID = h.CryptoTxID
+	}
+	if transferID == "" {
+		return
+	}
+
+	key := strings.ToLower(exchName) + code + transferID
+	credited := depositCredited(h.Status)
+	wasCredited, seen := d.deposits[key]
+	useDB := d.bot.DatabaseManager.Started()
+	if !seen && useDB {
+		stored, err := depositDataStore.GetByTransferID(exchName, code, transferID)
+		switch {
+		case err == nil:
+			wasCredited, seen = stored.Credited, true
+		case !errors.Is(err, depositDataStore.ErrDepositNotFound):
+			log.Errorf(log.Global, "Deposit tracker unable to load %v %v deposit %v: %v\n",
+				exchName, code, transferID, err)
+		}
+	}
+	if !seen && h.Timestamp.Before(d.trackingStart) {
+		// Historic deposits are recorded without notification
+		wasCredited = credited
+	}
+	d.deposits[key] = credited
+
+	if useDB {
+		err := depositDataStore.Upsert(&depositDataStore.Deposit{
+			Exchange:      exchName,
+			TransferID:    transferID,
+			Currency:      code,
+			Amount:        h.Amount,
+			Fee:           h.Fee,
+			Status:        h.Status,
+			Address:       address,
+			TxID:          h.CryptoTxID,
+			Confirmations: h.Confirmations,
+			Credited:      credited,
+			DepositedAt:   h.Timestamp,
+		})
+		if err != nil {
+			log.Errorf(log.Global, "Deposit tracker unable to store %v %v deposit %v: %v\n",
+				exchName, code, transferID, err)
+		}
+	}
+
+	if !credited || wasCredited {
+		return
+	}
+	msg := fmt.Sprintf("Deposit of %v %v to %v on %v credited", h.Amount, code, address, exchName)
+	if h.CryptoTxID != "" {
+		msg += " tx ID: " + h.CryptoTxID
+	}
+	log.Infoln(log.Global, msg)
+	d.bot.CommsManager.PushEvent(base.Event{
+		Type:    depositTrackerEventType,
+		Message: msg,
+	})
+}
+
+// depositCredited returns whether an exchange deposit status marks the
+// deposit as credited to the account
+func depositCredited(status string) bool {
+	words := transferStatusWords(status)
+	for i := range words {
+		if transferFailedWords[words[i]] {
+			return false
+		}
+	}
+	for i := range words {
+		if transferCompletedWords[words[i]] {
+			return true
+		}
+	}
+	return false
+}
+
+func parseDepositHistory(ret []exchange.DepositHistory, exchName string, limit int) *gctrpc.DepositEventsByExchangeResponse {
+	v := &gctrpc.DepositEventsByExchangeResponse{Exchange: exchName}
+	for x := range ret {
+		if limit > 0 && x >= limit {
+			return v
+		}
+		depositedAt, err := ptypes.TimestampProto(ret[x].Timestamp)
+		if err != nil {
+			log.Errorf(log.Global, "failed to convert time: %v", err)
+		}
+		v.Deposits = append(v.Deposits, &gctrpc.DepositEvent{
+			TransferId:    ret[x].TransferID,
+			Currency:      ret[x].Currency,
+			Amount:        ret[x].Amount,
+			Fee:           ret[x].Fee,
+			Status:        ret[x].Status,
+			Address:       ret[x].CryptoToAddress,
+			TxId:          ret[x].CryptoTxID,
+			Confirmations: ret[x].Confirmations,
+			Credited:      depositCredited(ret[x].Status),
+			DepositedAt:   depositedAt,
+		})
+	}
+	return v
+}
+
+func parseDepositEvents(ret []depositDataStore.Deposit, exchName string) *gctrpc.DepositEventsByExchangeResponse {
+	v := &gctrpc.DepositEventsByExchangeResponse{Exchange: exchName}
+	for x := range ret {
+		depositedAt, err := ptypes.TimestampProto(ret[x].DepositedAt)
+		if err != nil {
+			log.Errorf(log.Global, "failed to convert time: %v", err)
+		}
+		v.Deposits = append(v.Deposits, &gctrpc.DepositEvent{
+			TransferId:    ret[x].TransferID,
+			Currency:      ret[x].Currency,
+			Amount:        ret[x].Amount,
+			Fee:           ret[x].Fee,
+			Status:        ret[x].Status,
+			Address:       ret[x].Address,
+			TxId:          ret[x].TxID,
+			Confirmations: ret[x].Confirmations,
+			Credited:      ret[x].Credited,
+			DepositedAt:   depositedAt,
+		})
+	}
+	return v
+}
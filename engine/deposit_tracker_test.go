@@ -0,0 +1,145 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	depositDataStore "github.com/thrasher-corp/gocryptotrader/database/repository/deposit"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+)
+
+func TestDepositTrackingStartStop(t *testing.T) {
+	t.Parallel()
+	var d DepositAddressManager
+	if err := d.StartDepositTracking(nil); !errors.Is(err, errDepositTrackerNilEngine) {
+		t.Errorf("received %v expected %v", err, errDepositTrackerNilEngine)
+	}
+	if err := d.StopDepositTracking(); !errors.Is(err, subsystem.ErrSubSystemNotStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemNotStarted)
+	}
+
+	bot := &Engine{Config: &config.Config{
+		DepositTracker: config.DepositTrackerConfig{PollInterval: time.Hour},
+	}}
+	if err := d.StartDepositTracking(bot); err != nil {
+		t.Fatal(err)
+	}
+	if !d.DepositTrackingStarted() {
+		t.Error("expected deposit tracking to be started")
+	}
+	if err := d.StartDepositTracking(bot); !errors.Is(err, subsystem.ErrSubSystemAlreadyStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemAlreadyStarted)
+	}
+	if err := d.StopDepositTracking(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckDeposits(t *testing.T) {
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+
+	d := DepositAddressManager{
+		bot:           bot,
+		trackingStart: time.Now().Add(-time.Minute),
+		deposits:      make(map[string]bool),
+	}
+	d.Store.Seed(map[string]map[string]string{
+		fakePassExchange: {"BTC": "fakeAddress"},
+	})
+	d.checkDeposits()
+
+	v, err := depositDataStore.GetByTransferID(fakePassExchange, "BTC", "fakeDeposit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Credited {
+		t.Error("expected deposit to be credited")
+	}
+	if v.TxID != "fakeDepositTx" || v.Address != "fakeAddress" || v.Confirmations != 6 {
+		t.Errorf("unexpected stored deposit %+v", v)
+	}
+
+	s := RPCServer{Engine: bot}
+	resp, err := s.DepositEventsByExchange(context.Background(), &gctrpc.DepositEventsByExchangeRequest{
+		Exchange: fakePassExchange,
+		Currency: "BTC",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Deposits) != 1 || resp.Deposits[0].TransferId != "fakeDeposit" || !resp.Deposits[0].Credited {
+		t.Errorf("unexpected deposit events %+v", resp.Deposits)
+	}
+
+	_, err = s.DepositEventsByExchange(context.Background(), &gctrpc.DepositEventsByExchangeRequest{})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Errorf("received %v expected %v", err, errExchangeNotLoaded)
+	}
+}
+
+func TestUpdateDeposit(t *testing.T) {
+	t.Parallel()
+	d := DepositAddressManager{
+		bot:           &Engine{},
+		trackingStart: time.Now(),
+		deposits:      make(map[string]bool),
+	}
+
+	d.updateDeposit("test", "BTC", "addr", &exchange.DepositHistory{
+		TransferID:      "other",
+		Status:          "Success",
+		CryptoToAddress: "otherAddr",
+		Timestamp:       time.Now(),
+	})
+	if len(d.deposits) != 0 {
+		t.Error("expected deposit to another address to be ignored")
+	}
+
+	d.updateDeposit("test", "BTC", "addr", &exchange.DepositHistory{
+		TransferID: "1",
+		Status:     "Pending",
+		Timestamp:  time.Now(),
+	})
+	if credited, ok := d.deposits["testBTC1"]; !ok || credited {
+		t.Errorf("expected pending deposit to be recorded, received %v %v", credited, ok)
+	}
+	d.updateDeposit("test", "BTC", "addr", &exchange.DepositHistory{
+		TransferID: "1",
+		Status:     "Success",
+		Timestamp:  time.Now(),
+	})
+	if !d.deposits["testBTC1"] {
+		t.Error("expected deposit to be credited")
+	}
+
+	d.updateDeposit("test", "BTC", "addr", &exchange.DepositHistory{
+		CryptoTxID: "historic",
+		Status:     "Success",
+		Timestamp:  d.trackingStart.Add(-time.Hour),
+	})
+	if !d.deposits["testBTChistoric"] {
+		t.Error("expected historic deposit to be recorded by tx ID")
+	}
+}
+
+func TestDepositCredited(t *testing.T) {
+	t.Parallel()
+	for status, expected := range map[string]bool{
+		"Success":                      true,
+		"Credited but cannot withdraw": true,
+		"Pending":                      false,
+		"Waiting for confirmation":     false,
+		"Failed":                       false,
+		"":                             false,
+	} {
+		if credited := depositCredited(status); credited != expected {
+			t.Errorf("%q received %v expected %v", status, credited, expected)
+		}
+	}
+}
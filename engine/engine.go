@@ -37,8 +37,16 @@ type Engine struct {
 	OrderManager                orderManager
 	PortfolioManager            portfolioManager
 	CommsManager                commsManager
+	CandleManager               candleManager
+	DataHistoryManager          dataHistoryManager
+	WithdrawalTracker           withdrawalTracker
+	Rebalancer                  rebalancer
+	PortfolioHistoryManager     portfolioHistoryManager
+	ConsolidatedOrderbooks      consolidatedOrderbookManager
 	exchangeManager             exchangeManager
 	DepositAddressManager       *DepositAddressManager
+	withdrawalApprovals         withdrawalApprovals
+	rpcTokenMtx                 sync.RWMutex
 	Settings                    Settings
 	Uptime                      time.Time
 	ServicesWG                  sync.WaitGroup
@@ -180,6 +188,42 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 		b.Settings.EnableDeprecatedRPC = b.Config.RemoteControl.DeprecatedRPC.Enabled
 	}
 
+	if flagSet["candlemanager"] {
+		b.Settings.EnableCandleManager = s.EnableCandleManager
+	} else {
+		b.Settings.EnableCandleManager = b.Config.CandleManager.Enabled
+	}
+
+	if flagSet["datahistorymanager"] {
+		b.Settings.EnableDataHistoryManager = s.EnableDataHistoryManager
+	} else {
+		b.Settings.EnableDataHistoryManager = b.Config.DataHistory.Enabled
+	}
+
+	if flagSet["withdrawaltracker"] {
+		b.Settings.EnableWithdrawalTracker = s.EnableWithdrawalTracker
+	} else {
+		b.Settings.EnableWithdrawalTracker = b.Config.WithdrawalTracker.Enabled
+	}
+
+	if flagSet["deposittracker"] {
+		b.Settings.EnableDepositTracker = s.EnableDepositTracker
+	} else {
+		b.Settings.EnableDepositTracker = b.Config.DepositTracker.Enabled
+	}
+
+	if flagSet["rebalancer"] {
+		b.Settings.EnableRebalancer = s.EnableRebalancer
+	} else {
+		b.Settings.EnableRebalancer = b.Config.Rebalancer.Enabled
+	}
+
+	if flagSet["portfoliohistory"] {
+		b.Settings.EnablePortfolioHistory = s.EnablePortfolioHistory
+	} else {
+		b.Settings.EnablePortfolioHistory = b.Config.PortfolioHistory.Enabled
+	}
+
 	if flagSet["maxvirtualmachines"] {
 		maxMachines := uint8(s.MaxVirtualMachines)
 		b.GctScriptManager.MaxVirtualMachines = &maxMachines
@@ -296,6 +340,12 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable deposit address manager: %v\n", s.EnableDepositAddressManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket routine: %v\n", s.EnableWebsocketRoutine)
 	gctlog.Debugf(gctlog.Global, "\t Enable NTP client: %v", s.EnableNTPClient)
+	gctlog.Debugf(gctlog.Global, "\t Enable candle manager: %v", s.EnableCandleManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable data history manager: %v", s.EnableDataHistoryManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable withdrawal tracker: %v", s.EnableWithdrawalTracker)
+	gctlog.Debugf(gctlog.Global, "\t Enable deposit tracker: %v", s.EnableDepositTracker)
+	gctlog.Debugf(gctlog.Global, "\t Enable rebalancer: %v", s.EnableRebalancer)
+	gctlog.Debugf(gctlog.Global, "\t Enable portfolio history: %v", s.EnablePortfolioHistory)
 	gctlog.Debugf(gctlog.Global, "\t Enable Database manager: %v", s.EnableDatabaseManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable dispatcher: %v", s.EnableDispatcher)
 	gctlog.Debugf(gctlog.Global, "\t Dispatch package max worker amount: %d", s.DispatchMaxWorkerAmount)
@@ -456,6 +506,11 @@ func (bot *Engine) Start() error {
 	if bot.Settings.EnableDepositAddressManager {
 		bot.DepositAddressManager = new(DepositAddressManager)
 		go bot.DepositAddressManager.Sync()
+		if bot.Settings.EnableDepositTracker {
+			if err = bot.DepositAddressManager.StartDepositTracking(bot); err != nil {
+				gctlog.Errorf(gctlog.Global, "Deposit tracker unable to start: %v", err)
+			}
+		}
 	}
 
 	if bot.Settings.EnableOrderManager {
@@ -488,6 +543,36 @@ func (bot *Engine) Start() error {
 		go EventManger(bot.Settings.Verbose, &bot.CommsManager)
 	}
 
+	if bot.Settings.EnableCandleManager {
+		if err = bot.CandleManager.Start(&bot.Config.CandleManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle manager unable to start: %v", err)
+		}
+	}
+
+	if bot.Settings.EnableDataHistoryManager {
+		if err = bot.DataHistoryManager.Start(bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Data history manager unable to start: %v", err)
+		}
+	}
+
+	if bot.Settings.EnableWithdrawalTracker {
+		if err = bot.WithdrawalTracker.Start(bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdrawal tracker unable to start: %v", err)
+		}
+	}
+
+	if bot.Settings.EnableRebalancer {
+		if err = bot.Rebalancer.Start(bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Rebalancer unable to start: %v", err)
+		}
+	}
+
+	if bot.Settings.EnablePortfolioHistory {
+		if err = bot.PortfolioHistoryManager.Start(bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio history manager unable to start: %v", err)
+		}
+	}
+
 	if bot.Settings.EnableWebsocketRoutine {
 		go bot.WebsocketRoutine()
 	}
@@ -517,6 +602,37 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.DataHistoryManager.Started() {
+		if err := bot.DataHistoryManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Data history manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.WithdrawalTracker.Started() {
+		if err := bot.WithdrawalTracker.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdrawal tracker unable to stop. Error: %v", err)
+		}
+	}
+	if bot.Rebalancer.Started() {
+		if err := bot.Rebalancer.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Rebalancer unable to stop. Error: %v", err)
+		}
+	}
+	if bot.PortfolioHistoryManager.Started() {
+		if err := bot.PortfolioHistoryManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio history manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.DepositAddressManager != nil && bot.DepositAddressManager.DepositTrackingStarted() {
+		if err := bot.DepositAddressManager.StopDepositTracking(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Deposit tracker unable to stop. Error: %v", err)
+		}
+	}
+	bot.ConsolidatedOrderbooks.Stop()
+	if bot.CandleManager.Started() {
+		if err := bot.CandleManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.OrderManager.Started() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
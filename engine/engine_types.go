@@ -35,6 +35,12 @@ type Settings struct {
 	EnableGCTScriptManager      bool
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
+	EnableCandleManager         bool
+	EnableDataHistoryManager    bool
+	EnableWithdrawalTracker     bool
+	EnableDepositTracker        bool
+	EnableRebalancer            bool
+	EnablePortfolioHistory      bool
 	EventManagerDelay           time.Duration
 	Verbose                     bool
 
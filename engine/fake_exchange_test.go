@@ -183,7 +183,28 @@ func (h *FakePassingExchange) GetOrderInfo(_ string, _ currency.Pair, _ asset.It
 	}, nil
 }
 func (h *FakePassingExchange) GetWithdrawalsHistory(_ currency.Code) ([]exchange.WithdrawalHistory, error) {
-	return nil, nil
+	return []exchange.WithdrawalHistory{
+		{
+			TransferID: "fake",
+			Status:     "Completed",
+			Fee:        0.001,
+			CryptoTxID: "fakeTx",
+		},
+	}, nil
+}
+func (h *FakePassingExchange) GetDepositHistory(_ currency.Code) ([]exchange.DepositHistory, error) {
+	return []exchange.DepositHistory{
+		{
+			TransferID:      "fakeDeposit",
+			Status:          "Success",
+			Timestamp:       time.Now(),
+			Currency:        "BTC",
+			Amount:          1,
+			CryptoToAddress: "fakeAddress",
+			CryptoTxID:      "fakeDepositTx",
+			Confirmations:   6,
+		},
+	}, nil
 }
 func (h *FakePassingExchange) GetDepositAddress(_ currency.Code, _ string) (string, error) {
 	return "", nil
@@ -234,13 +255,29 @@ func (h *FakePassingExchange) SupportsAsset(_ asset.Item) bool
 func (h *FakePassingExchange) GetHistoricCandles(_ currency.Pair, _ asset.Item, _, _ time.Time, _ kline.Interval) (kline.Item, error) {
 	return kline.Item{}, nil
 }
-func (h *FakePassingExchange) GetHistoricCandlesExtended(_ currency.Pair, _ asset.Item, _, _ time.Time, _ kline.Interval) (kline.Item, error) {
-	return kline.Item{}, nil
+func (h *FakePassingExchange) GetHistoricCandlesExtended(p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	resp := kline.Item{
+		Exchange: fakePassExchange,
+		Pair:     p,
+		Asset:    a,
+		Interval: interval,
+	}
+	for t := start; t.Before(end); t = t.Add(interval.Duration()) {
+		resp.Candles = append(resp.Candles, kline.Candle{
+			Time:   t,
+			Open:   1,
+			High:   2,
+			Low:    0.5,
+			Close:  1.5,
+			Volume: 1,
+		})
+	}
+	return resp, nil
 }
 func (h *FakePassingExchange) DisableRateLimiter() error { return nil }
 func (h *FakePassingExchange) EnableRateLimiter() error  { return nil }
 func (h *FakePassingExchange) WithdrawCryptocurrencyFunds(_ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
-	return nil, nil
+	return &withdraw.ExchangeResponse{ID: "fake", Status: "complete"}, nil
 }
 func (h *FakePassingExchange) WithdrawFiatFunds(_ *withdraw.Request) (*withdraw.ExchangeResponse, error) {
 	return nil, nil
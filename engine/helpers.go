@@ -646,6 +646,25 @@ func (bot *Engine) GetExchangeCryptocurrencyDepositAddress(exchName, accountID s
 	return exch.GetDepositAddress(item, accountID)
 }
 
+// GetExchangeCryptocurrencyDepositAddressTag returns the address tag which
+// must accompany deposits to an exchange's cryptocurrency deposit address,
+// empty when none is required or the exchange does not return tags
+func (bot *Engine) GetExchangeCryptocurrencyDepositAddressTag(exchName, accountID string, item currency.Code) (string, error) {
+	if bot.DepositAddressManager != nil {
+		return bot.DepositAddressManager.GetDepositAddressTagByExchange(exchName, item), nil
+	}
+
+	exch := bot.GetExchangeByName(exchName)
+	if exch == nil {
+		return "", ErrExchangeNotFound
+	}
+	tag, err := exch.GetDepositAddressTag(item, accountID)
+	if errors.Is(err, common.ErrFunctionNotSupported) {
+		return "", nil
+	}
+	return tag, err
+}
+
 // GetExchangeCryptocurrencyDepositAddresses obtains an exchanges deposit cryptocurrency list
 func (bot *Engine) GetExchangeCryptocurrencyDepositAddresses() map[string]map[string]string {
 	result := make(map[string]map[string]string)
@@ -680,6 +699,37 @@ func (bot *Engine) GetExchangeCryptocurrencyDepositAddresses() map[string]map[st
 	return result
 }
 
+// GetExchangeCryptocurrencyDepositAddressTags obtains the address tags for the
+// supplied deposit addresses from exchanges which return them. Currencies
+// which deposit without a tag are omitted
+func (bot *Engine) GetExchangeCryptocurrencyDepositAddressTags(addresses map[string]map[string]string) map[string]map[string]string {
+	result := make(map[string]map[string]string)
+	for exchName, cryptoAddr := range addresses {
+		exch := bot.GetExchangeByName(exchName)
+		if exch == nil {
+			continue
+		}
+		tags := make(map[string]string)
+		for cryptocurrency := range cryptoAddr {
+			tag, err := exch.GetDepositAddressTag(currency.NewCode(cryptocurrency), "")
+			if errors.Is(err, common.ErrFunctionNotSupported) {
+				break
+			}
+			if err != nil {
+				log.Errorf(log.Global, "%s failed to get %s deposit address tag. Err: %s\n", exchName, cryptocurrency, err)
+				continue
+			}
+			if tag != "" {
+				tags[cryptocurrency] = tag
+			}
+		}
+		if len(tags) > 0 {
+			result[exchName] = tags
+		}
+	}
+	return result
+}
+
 // FormatCurrency is a method that formats and returns a currency pair
 // based on the user currency display preferences
 func (bot *Engine) FormatCurrency(p currency.Pair) currency.Pair {
@@ -7,6 +7,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"math/big"
 	"net"
 	"os"
@@ -905,3 +906,37 @@ func TestCheckAndGenCerts(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGetExchangeCryptocurrencyDepositAddressTag(t *testing.T) {
+	bot := CreateTestBot(t)
+	_, err := bot.GetExchangeCryptocurrencyDepositAddressTag("fake", "", currency.XRP)
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received %v expected %v", err, ErrExchangeNotFound)
+	}
+	// exchanges which do not return tags are treated as requiring none
+	tag, err := bot.GetExchangeCryptocurrencyDepositAddressTag(testExchange, "", currency.XRP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag != "" {
+		t.Errorf("received tag %v expected none", tag)
+	}
+	tags := bot.GetExchangeCryptocurrencyDepositAddressTags(map[string]map[string]string{
+		testExchange: {"XRP": testBTCAddress},
+	})
+	if len(tags) != 0 {
+		t.Errorf("unexpected tags %v", tags)
+	}
+
+	bot.DepositAddressManager = new(DepositAddressManager)
+	bot.DepositAddressManager.Store.SeedTags(map[string]map[string]string{
+		testExchange: {"XRP": "1337"},
+	})
+	tag, err = bot.GetExchangeCryptocurrencyDepositAddressTag(testExchange, "", currency.XRP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag != "1337" {
+		t.Errorf("received tag %v expected %v", tag, "1337")
+	}
+}
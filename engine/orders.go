@@ -123,10 +123,43 @@ func (o *orderStore) Add(det *order.Detail) error {
 	orders := o.Orders[strings.ToLower(det.Exchange)]
 	orders = append(orders, det)
 	o.Orders[strings.ToLower(det.Exchange)] = orders
-
+	publishOrderUpdate(det)
 	return nil
 }
 
+// updateStatus refreshes the status and fill amounts of a tracked order,
+// returns false if the order is not tracked or its status is unchanged
+func (o *orderStore) updateStatus(det *order.Detail) bool {
+	od, err := o.GetByExchangeAndID(det.Exchange, det.ID)
+	if err != nil {
+		return false
+	}
+	o.m.Lock()
+	defer o.m.Unlock()
+	if od.Status == det.Status {
+		return false
+	}
+	od.Status = det.Status
+	od.ExecutedAmount = det.ExecutedAmount
+	od.RemainingAmount = det.RemainingAmount
+	od.LastUpdated = time.Now()
+	publishOrderUpdate(od)
+	return true
+}
+
+// publishOrderUpdate relays a new or updated order to order update
+// subscribers
+func publishOrderUpdate(det *order.Detail) {
+	err := order.PublishUpdate(det)
+	if err != nil {
+		log.Errorf(log.OrderMgr,
+			"Order manager: Unable to publish %s order ID=%v update: %s",
+			det.Exchange,
+			det.ID,
+			err)
+	}
+}
+
 // Started returns the status of the orderManager
 func (o *orderManager) Started() bool {
 	return atomic.LoadInt32(&o.started) == 1
@@ -279,6 +312,7 @@ func (o *orderManager) Cancel(cancel *order.Cancel) error {
 	}
 
 	od.Status = order.Cancelled
+	publishOrderUpdate(od)
 	msg := fmt.Sprintf("Order manager: Exchange %s order ID=%v cancelled.",
 		od.Exchange, od.ID)
 	log.Debugln(log.OrderMgr, msg)
@@ -567,6 +601,11 @@ func (o *orderManager) processOrders() {
 					})
 					continue
 				}
+				if o.orderStore.updateStatus(ord) {
+					log.Debugf(log.OrderMgr,
+						"Order manager: Exchange %s order ID=%v status changed to %v.",
+						ord.Exchange, ord.ID, ord.Status)
+				}
 			}
 		}
 	}
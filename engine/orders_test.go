@@ -197,6 +197,40 @@ func TestExists(t *testing.T) {
 	}
 }
 
+func TestUpdateStatus(t *testing.T) {
+	bot := OrdersSetup(t)
+	o := &order.Detail{
+		Exchange: testExchange,
+		ID:       "TestUpdateStatus",
+		Status:   order.New,
+	}
+	if bot.OrderManager.orderStore.updateStatus(o) {
+		t.Error("Expected untracked order to be ignored")
+	}
+	err := bot.OrderManager.orderStore.Add(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bot.OrderManager.orderStore.updateStatus(&order.Detail{
+		Exchange: testExchange,
+		ID:       "TestUpdateStatus",
+		Status:   order.New,
+	}) {
+		t.Error("Expected unchanged status to be ignored")
+	}
+	if !bot.OrderManager.orderStore.updateStatus(&order.Detail{
+		Exchange:       testExchange,
+		ID:             "TestUpdateStatus",
+		Status:         order.Filled,
+		ExecutedAmount: 1,
+	}) {
+		t.Fatal("Expected status to be updated")
+	}
+	if o.Status != order.Filled || o.ExecutedAmount != 1 {
+		t.Errorf("unexpected order %+v", o)
+	}
+}
+
 func TestCancelOrder(t *testing.T) {
 	bot := OrdersSetup(t)
 	err := bot.OrderManager.Cancel(nil)
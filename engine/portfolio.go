@@ -34,6 +34,9 @@ func (p *portfolioManager) Start() error {
 	log.Debugln(log.PortfolioMgr, "Portfolio manager starting...")
 	Bot.Portfolio = &portfolio.Portfolio
 	Bot.Portfolio.Seed(Bot.Config.Portfolio)
+	if err := portfolio.SetupProviders(Bot.Config.PortfolioProviders); err != nil {
+		log.Errorf(log.PortfolioMgr, "Portfolio manager unable to setup balance providers: %v\n", err)
+	}
 	p.shutdown = make(chan struct{})
 	portfolio.Verbose = Bot.Settings.Verbose
 
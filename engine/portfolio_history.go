@@ -0,0 +1,319 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+func (p *portfolioHistoryManager) Started() bool {
+	return atomic.LoadInt32(&p.started) == 1
+}
+
+func (p *portfolioHistoryManager) Start(bot *Engine) error {
+	if bot == nil {
+		return errPortfolioHistoryNilEngine
+	}
+	if !atomic.CompareAndSwapInt32(&p.started, 0, 1) {
+		return fmt.Errorf("portfolio history manager %w", subsystem.ErrSubSystemAlreadyStarted)
+	}
+	if !bot.DatabaseManager.Started() {
+		atomic.CompareAndSwapInt32(&p.started, 1, 0)
+		return fmt.Errorf("portfolio history manager %w", errDatabaseNotConnected)
+	}
+	log.Debugln(log.Global, "Portfolio history manager starting...")
+	p.bot = bot
+	p.cfg = bot.Config.PortfolioHistory
+	p.shutdown = make(chan struct{})
+	p.wg.Add(1)
+	go p.run()
+	log.Debugln(log.Global, "Portfolio history manager started.")
+	return nil
+}
+
+func (p *portfolioHistoryManager) Stop() error {
+	if atomic.LoadInt32(&p.started) == 0 {
+		return fmt.Errorf("portfolio history manager %w", subsystem.ErrSubSystemNotStarted)
+	}
+	defer func() {
+		atomic.CompareAndSwapInt32(&p.started, 1, 0)
+	}()
+	log.Debugln(log.Global, "Portfolio history manager shutting down...")
+	close(p.shutdown)
+	p.wg.Wait()
+	log.Debugln(log.Global, "Portfolio history manager shutdown.")
+	return nil
+}
+
+func (p *portfolioHistoryManager) run() {
+	tick := time.NewTicker(p.cfg.SnapshotInterval)
+	defer func() {
+		tick.Stop()
+		p.wg.Done()
+	}()
+	for {
+		select {
+		case <-p.shutdown:
+			return
+		case <-tick.C:
+			if err := p.takeSnapshot(); err != nil {
+				log.Errorf(log.Global, "Portfolio history manager unable to store snapshot: %v\n", err)
+			}
+		}
+	}
+}
+
+// fiatCurrency returns the configured valuation currency, falling back to the
+// fiat display currency
+func (p *portfolioHistoryManager) fiatCurrency() currency.Code {
+	if p.cfg.FiatCurrency != "" {
+		return currency.NewCode(p.cfg.FiatCurrency)
+	}
+	return p.bot.Config.Currency.FiatDisplayCurrency
+}
+
+// takeSnapshot values the portfolio and stores it
+func (p *portfolioHistoryManager) takeSnapshot() error {
+	s, err := p.bot.ValuePortfolio(p.fiatCurrency())
+	if err != nil {
+		return err
+	}
+	if p.bot.Settings.Verbose {
+		log.Debugf(log.Global, "Portfolio valued at %.8f %s\n", s.TotalValue, s.FiatCurrency)
+	}
+	return portfoliosnapshot.Insert(s)
+}
+
+// ValuePortfolio values the portfolio's exchange holdings and on-chain
+// addresses in the fiat currency, with the realised and unrealised profit and
+// loss of each currency calculated from the fills of orders tracked by the
+// order manager and each exchange's order history
+func (bot *Engine) ValuePortfolio(fiat currency.Code) (*portfoliosnapshot.Snapshot, error) {
+	if !fiat.IsFiatCurrency() {
+		return nil, fmt.Errorf("%v %w", fiat, errNotFiatCurrency)
+	}
+	orders := bot.taxOrders(taxReportOptions{
+		End:                    time.Now(),
+		IncludeExchangeHistory: true,
+	})
+	return bot.valueHoldings(portfolio.GetPortfolio().GetPortfolioSummary().Totals, orders, fiat), nil
+}
+
+func (bot *Engine) valueHoldings(coins []portfolio.Coin, orders []order.Detail, fiat currency.Code) *portfoliosnapshot.Snapshot {
+	fills := bot.portfolioFills(orders, fiat)
+	s := &portfoliosnapshot.Snapshot{
+		FiatCurrency: fiat.Upper().String(),
+		Timestamp:    time.Now(),
+	}
+	for i := range coins {
+		code := coins[i].Coin.Upper().String()
+		price, err := bot.fiatPrice(coins[i].Coin, fiat)
+		if err != nil {
+			log.Warnf(log.Global, "Portfolio history unable to value %s in %s: %v\n", code, fiat, err)
+		}
+		h := portfoliosnapshot.Holding{
+			Currency: code,
+			Amount:   coins[i].Balance,
+			Price:    price,
+			Value:    coins[i].Balance * price,
+		}
+		if f, ok := fills[code]; ok {
+			basis := calculateCostBasis(f)
+			h.RealisedPNL = basis.realised
+			if price > 0 {
+				// Holdings acquired outside of tracked orders have no known cost
+				h.UnrealisedPNL = (price - basis.averageCost) * math.Min(basis.amount, h.Amount)
+			}
+			delete(fills, code)
+		}
+		s.Holdings = append(s.Holdings, h)
+	}
+	// Currencies which have been sold off still carry realised profit or loss
+	for code, f := range fills {
+		basis := calculateCostBasis(f)
+		if basis.realised == 0 {
+			continue
+		}
+		s.Holdings = append(s.Holdings, portfoliosnapshot.Holding{
+			Currency:    code,
+			RealisedPNL: basis.realised,
+		})
+	}
+	sort.Slice(s.Holdings, func(i, j int) bool {
+		return s.Holdings[i].Currency < s.Holdings[j].Currency
+	})
+	for i := range s.Holdings {
+		s.TotalValue += s.Holdings[i].Value
+		s.RealisedPNL += s.Holdings[i].RealisedPNL
+		s.UnrealisedPNL += s.Holdings[i].UnrealisedPNL
+	}
+	return s
+}
+
+// portfolioFills returns the order fills of each base currency with prices
+// converted to the fiat currency at the time of each fill. Fills quoted in
+// currencies which cannot be valued at that time are skipped
+func (bot *Engine) portfolioFills(orders []order.Detail, fiat currency.Code) map[string][]portfolioFill {
+	p := &taxPricer{
+		bot:   bot,
+		fiat:  fiat.Upper().String(),
+		cache: make(map[string]float64),
+	}
+	fills := make(map[string][]portfolioFill)
+	for i := range orders {
+		trades := orders[i].Trades
+		if len(trades) == 0 {
+			if orders[i].ExecutedAmount <= 0 {
+				continue
+			}
+			trades = []order.TradeHistory{{
+				Price:     orders[i].Price,
+				Amount:    orders[i].ExecutedAmount,
+				Side:      orders[i].Side,
+				Timestamp: orders[i].Date,
+			}}
+		}
+		base := orders[i].Pair.Base.Upper().String()
+		for j := range trades {
+			rate, err := p.fillRate(orders[i].Pair.Quote, trades[j].Timestamp)
+			if err != nil {
+				log.Warnf(log.Global, "Portfolio history unable to value %s %s fill: %v\n", orders[i].Exchange, orders[i].ID, err)
+				continue
+			}
+			side := trades[j].Side
+			if side == "" {
+				side = orders[i].Side
+			}
+			fills[base] = append(fills[base], portfolioFill{
+				side:      side,
+				price:     trades[j].Price * rate,
+				amount:    trades[j].Amount,
+				timestamp: trades[j].Timestamp,
+			})
+		}
+	}
+	return fills
+}
+
+// fillRate returns the fiat value of one unit of a fill's quote currency at
+// the time of the fill. Fiat and USDT quotes are converted at current rates
+// as they hold their value, other quotes require a stored daily candle so
+// past fills are not valued at today's price
+func (p *taxPricer) fillRate(quote currency.Code, at time.Time) (float64, error) {
+	fiat := currency.NewCode(p.fiat)
+	if quote.IsFiatCurrency() || quote.Match(fiat) || quote.Match(currency.USDT) {
+		key := quote.Upper().String()
+		if rate, ok := p.cache[key]; ok {
+			return rate, nil
+		}
+		if quote.Match(currency.USDT) {
+			quote = currency.USD
+		}
+		rate, err := p.bot.fiatPrice(quote, fiat)
+		if err != nil {
+			return 0, err
+		}
+		p.cache[key] = rate
+		return rate, nil
+	}
+	day := at.UTC().Truncate(kline.OneDay.Duration())
+	key := quote.Upper().String() + day.Format("2006-01-02")
+	if rate, ok := p.cache[key]; ok {
+		return rate, nil
+	}
+	rate := p.historicPrice(quote, day)
+	if rate == 0 {
+		return 0, fmt.Errorf("%s on %s %w", quote, day.Format("2006-01-02"), errNoPortfolioPrice)
+	}
+	p.cache[key] = rate
+	return rate, nil
+}
+
+// calculateCostBasis applies fills in time order using the average cost
+// method. Sales beyond the tracked amount have no known cost and are ignored
+func calculateCostBasis(fills []portfolioFill) costBasis {
+	sort.SliceStable(fills, func(i, j int) bool {
+		return fills[i].timestamp.Before(fills[j].timestamp)
+	})
+	var c costBasis
+	for i := range fills {
+		switch fills[i].side {
+		case order.Buy, order.Bid:
+			total := c.amount + fills[i].amount
+			if total <= 0 {
+				continue
+			}
+			c.averageCost = (c.averageCost*c.amount + fills[i].price*fills[i].amount) / total
+			c.amount = total
+		case order.Sell, order.Ask:
+			sold := math.Min(fills[i].amount, c.amount)
+			c.realised += (fills[i].price - c.averageCost) * sold
+			c.amount -= sold
+		}
+	}
+	return c
+}
+
+// fiatPrice returns the price of one unit of the currency in the fiat
+// currency. Fiat currencies are converted using the foreign exchange
+// providers and cryptocurrencies are priced from the average last price of
+// live tickers quoted in the fiat currency, USD or USDT
+func (bot *Engine) fiatPrice(code, fiat currency.Code) (float64, error) {
+	if code.Match(fiat) {
+		return 1, nil
+	}
+	if code.IsFiatCurrency() {
+		return currency.ConvertCurrency(1, code, fiat)
+	}
+	for _, quote := range []currency.Code{fiat, currency.USD, currency.USDT} {
+		if code.Match(quote) {
+			continue
+		}
+		last := bot.averageLastPrice(currency.NewPair(code, quote))
+		if last == 0 {
+			continue
+		}
+		rate, err := bot.fiatPrice(quote, fiat)
+		if err != nil {
+			return 0, err
+		}
+		return last * rate, nil
+	}
+	if code.Match(currency.USDT) {
+		// Without a ticker assume the stablecoin holds its peg
+		return bot.fiatPrice(currency.USD, fiat)
+	}
+	return 0, fmt.Errorf("%s %w", code, errNoPortfolioPrice)
+}
+
+// averageLastPrice returns the average last spot price of the pair across
+// the loaded exchanges, or zero when no ticker is available
+func (bot *Engine) averageLastPrice(p currency.Pair) float64 {
+	var total float64
+	var count int
+	exchanges := bot.GetExchanges()
+	for i := range exchanges {
+		t, err := ticker.GetTicker(exchanges[i].GetName(), p, asset.Spot)
+		if err != nil || t.Last <= 0 {
+			continue
+		}
+		total += t.Last
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
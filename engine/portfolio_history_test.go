@@ -0,0 +1,195 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+func TestPortfolioHistoryStartStop(t *testing.T) {
+	t.Parallel()
+	var p portfolioHistoryManager
+	if err := p.Start(nil); !errors.Is(err, errPortfolioHistoryNilEngine) {
+		t.Errorf("received %v expected %v", err, errPortfolioHistoryNilEngine)
+	}
+	if err := p.Stop(); !errors.Is(err, subsystem.ErrSubSystemNotStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemNotStarted)
+	}
+	bot := &Engine{Config: &config.Config{
+		PortfolioHistory: config.PortfolioHistoryConfig{SnapshotInterval: time.Hour},
+	}}
+	if err := p.Start(bot); !errors.Is(err, errDatabaseNotConnected) {
+		t.Errorf("received %v expected %v", err, errDatabaseNotConnected)
+	}
+	if p.Started() {
+		t.Error("expected portfolio history manager to remain stopped")
+	}
+}
+
+func TestPortfolioHistoryFiatCurrency(t *testing.T) {
+	t.Parallel()
+	p := portfolioHistoryManager{bot: &Engine{Config: &config.Config{
+		Currency: config.CurrencyConfig{FiatDisplayCurrency: currency.AUD},
+	}}}
+	if fiat := p.fiatCurrency(); !fiat.Match(currency.AUD) {
+		t.Errorf("received %v expected %v", fiat, currency.AUD)
+	}
+	p.cfg.FiatCurrency = "EUR"
+	if fiat := p.fiatCurrency(); !fiat.Match(currency.EUR) {
+		t.Errorf("received %v expected %v", fiat, currency.EUR)
+	}
+	if _, err := p.bot.ValuePortfolio(currency.BTC); !errors.Is(err, errNotFiatCurrency) {
+		t.Errorf("received %v expected %v", err, errNotFiatCurrency)
+	}
+}
+
+func TestCalculateCostBasis(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	basis := calculateCostBasis([]portfolioFill{
+		{side: order.Sell, price: 30, amount: 2, timestamp: now.Add(time.Hour * 2)},
+		{side: order.Buy, price: 10, amount: 1, timestamp: now},
+		{side: order.Bid, price: 20, amount: 1, timestamp: now.Add(time.Hour)},
+		{side: order.Ask, price: 40, amount: 5, timestamp: now.Add(time.Hour * 3)},
+	})
+	// Both units cost 15 on average and are sold at 30, the later sale has
+	// nothing left to sell
+	if basis.realised != 30 {
+		t.Errorf("received %v expected %v", basis.realised, 30)
+	}
+	if basis.amount != 0 || basis.averageCost != 15 {
+		t.Errorf("received amount %v average cost %v expected 0 15", basis.amount, basis.averageCost)
+	}
+}
+
+func TestValueHoldings(t *testing.T) {
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+
+	pnlTest := currency.NewCode("PNLTEST")
+	pnlStable := currency.NewCode("PNLSTABLE")
+	pnlGone := currency.NewCode("PNLGONE")
+	for p, last := range map[currency.Pair]float64{
+		currency.NewPair(pnlTest, currency.USD):    100,
+		currency.NewPair(pnlStable, currency.USDT): 10,
+	} {
+		err := ticker.ProcessTicker(&ticker.Price{
+			ExchangeName: fakePassExchange,
+			Pair:         p,
+			AssetType:    asset.Spot,
+			Last:         last,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := time.Now()
+	orders := []order.Detail{
+		{
+			Pair:           currency.NewPair(pnlTest, currency.USD),
+			Side:           order.Buy,
+			Price:          50,
+			ExecutedAmount: 2,
+			Date:           now.Add(-time.Hour),
+		},
+		{
+			Pair: currency.NewPair(pnlTest, currency.USD),
+			Side: order.Sell,
+			Trades: []order.TradeHistory{
+				{Price: 80, Amount: 1, Timestamp: now},
+			},
+		},
+		{
+			Pair:           currency.NewPair(pnlStable, currency.USDT),
+			Side:           order.Buy,
+			Price:          5,
+			ExecutedAmount: 1,
+			Date:           now,
+		},
+		{
+			Pair: currency.NewPair(pnlGone, currency.USD),
+			Trades: []order.TradeHistory{
+				{Side: order.Buy, Price: 10, Amount: 1, Timestamp: now.Add(-time.Hour)},
+				{Side: order.Sell, Price: 15, Amount: 1, Timestamp: now},
+			},
+		},
+		{
+			// Without a stored candle the quote cannot be valued at the
+			// time of the fill, so the fill is skipped rather than valued
+			// at the current price
+			Pair:           currency.NewPair(pnlStable, pnlTest),
+			Side:           order.Buy,
+			Price:          0.01,
+			ExecutedAmount: 1,
+			Date:           now.Add(-time.Hour * 48),
+		},
+	}
+
+	s := bot.valueHoldings([]portfolio.Coin{
+		{Coin: pnlTest, Balance: 3},
+		{Coin: pnlStable, Balance: 1},
+		{Coin: currency.NewCode("PNLNONE"), Balance: 1},
+	}, orders, currency.USD)
+
+	if s.FiatCurrency != "USD" {
+		t.Errorf("received %v expected %v", s.FiatCurrency, "USD")
+	}
+	if s.TotalValue != 310 || s.RealisedPNL != 35 || s.UnrealisedPNL != 55 {
+		t.Errorf("received value %v realised %v unrealised %v expected 310 35 55",
+			s.TotalValue, s.RealisedPNL, s.UnrealisedPNL)
+	}
+	expected := []portfoliosnapshot.Holding{
+		{Currency: "PNLGONE", RealisedPNL: 5},
+		{Currency: "PNLNONE", Amount: 1},
+		{Currency: "PNLSTABLE", Amount: 1, Price: 10, Value: 10, UnrealisedPNL: 5},
+		{Currency: "PNLTEST", Amount: 3, Price: 100, Value: 300, RealisedPNL: 30, UnrealisedPNL: 50},
+	}
+	if len(s.Holdings) != len(expected) {
+		t.Fatalf("received %+v expected %+v", s.Holdings, expected)
+	}
+	for i := range expected {
+		if s.Holdings[i] != expected[i] {
+			t.Errorf("holding %d received %+v expected %+v", i, s.Holdings[i], expected[i])
+		}
+	}
+
+	if err := portfoliosnapshot.Insert(s); err != nil {
+		t.Fatal(err)
+	}
+	srv := RPCServer{Engine: bot}
+	resp, err := srv.GetPortfolioHistory(context.Background(), &gctrpc.GetPortfolioHistoryRequest{
+		FiatCurrency: "usd",
+		Start:        now.UTC().Add(-time.Hour).Format(common.SimpleTimeFormat),
+		End:          now.UTC().Add(time.Hour).Format(common.SimpleTimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.History) != 1 {
+		t.Fatalf("received %v points expected 1", len(resp.History))
+	}
+	if resp.History[0].TotalValue != 310 || len(resp.History[0].Holdings) != len(expected) {
+		t.Errorf("unexpected history point %+v", resp.History[0])
+	}
+
+	_, err = srv.GetPortfolioHistory(context.Background(), &gctrpc.GetPortfolioHistoryRequest{
+		Start: now.UTC().Format(common.SimpleTimeFormat),
+		End:   now.UTC().Add(-time.Hour).Format(common.SimpleTimeFormat),
+	})
+	if !errors.Is(err, errInvalidTimes) {
+		t.Errorf("received %v expected %v", err, errInvalidTimes)
+	}
+}
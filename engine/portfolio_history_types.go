@@ -0,0 +1,44 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	errPortfolioHistoryNilEngine = errors.New("portfolio history manager cannot start with nil engine")
+	errNotFiatCurrency           = errors.New("currency is not a fiat currency")
+	errNoPortfolioPrice          = errors.New("no price available")
+)
+
+// portfolioHistoryManager periodically values the portfolio's exchange
+// holdings and on-chain addresses in a fiat currency and stores the result
+// for charting
+type portfolioHistoryManager struct {
+	bot      *Engine
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	cfg      config.PortfolioHistoryConfig
+}
+
+// portfolioFill is a trade of a currency with its price converted to the
+// valuation fiat currency
+type portfolioFill struct {
+	side      order.Side
+	price     float64
+	amount    float64
+	timestamp time.Time
+}
+
+// costBasis is the average cost of the amount of a currency acquired through
+// order fills and the profit realised selling it
+type costBasis struct {
+	amount      float64
+	averageCost float64
+	realised    float64
+}
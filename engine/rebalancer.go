@@ -0,0 +1,378 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+func (r *rebalancer) Started() bool {
+	return atomic.LoadInt32(&r.started) == 1
+}
+
+func (r *rebalancer) Start(bot *Engine) error {
+	if bot == nil {
+		return errRebalancerNilEngine
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 0, 1) {
+		return fmt.Errorf("rebalancer %w", subsystem.ErrSubSystemAlreadyStarted)
+	}
+	log.Debugln(log.Global, "Rebalancer starting...")
+	r.bot = bot
+	r.cfg = bot.Config.Rebalancer
+	r.shutdown = make(chan struct{})
+	r.wg.Add(1)
+	go r.run()
+	log.Debugln(log.Global, "Rebalancer started.")
+	return nil
+}
+
+func (r *rebalancer) Stop() error {
+	if atomic.LoadInt32(&r.started) == 0 {
+		return fmt.Errorf("rebalancer %w", subsystem.ErrSubSystemNotStarted)
+	}
+	defer func() {
+		atomic.CompareAndSwapInt32(&r.started, 1, 0)
+	}()
+	log.Debugln(log.Global, "Rebalancer shutting down...")
+	close(r.shutdown)
+	r.wg.Wait()
+	log.Debugln(log.Global, "Rebalancer shutdown.")
+	return nil
+}
+
+func (r *rebalancer) run() {
+	tick := time.NewTicker(r.cfg.CheckInterval)
+	defer func() {
+		tick.Stop()
+		r.wg.Done()
+	}()
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-tick.C:
+			r.checkTargets()
+		}
+	}
+}
+
+// checkTargets plans every rebalance target and notifies the comms relayer of
+// plans which require transfers
+func (r *rebalancer) checkTargets() {
+	for i := range r.cfg.Targets {
+		code := currency.NewCode(r.cfg.Targets[i].Currency)
+		plan, err := r.bot.PlanRebalance(code)
+		if err != nil {
+			log.Errorf(log.Global, "Rebalancer unable to plan %v: %v\n", code, err)
+			continue
+		}
+		if len(plan.Transfers) == 0 {
+			continue
+		}
+		msg := "Rebalance proposed: " + plan.describe()
+		log.Infoln(log.Global, msg)
+		r.bot.CommsManager.PushEvent(base.Event{
+			Type:    rebalancerEventType,
+			Message: msg,
+		})
+	}
+}
+
+// PlanRebalance returns the transfers which move the currency's combined
+// balance across exchanges to its configured target allocation, paying the
+// lowest withdrawal fees available
+func (bot *Engine) PlanRebalance(code currency.Code) (*RebalancePlan, error) {
+	target, err := bot.rebalanceTarget(code)
+	if err != nil {
+		return nil, err
+	}
+	balances := make([]rebalanceBalance, 0, len(target.Allocations))
+	for exchName, share := range target.Allocations {
+		var b *rebalanceBalance
+		b, err = bot.getRebalanceBalance(exchName, code)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s %w", exchName, code, err)
+		}
+		b.share = share
+		balances = append(balances, *b)
+	}
+
+	plan := planRebalance(code, target.MinimumTransfer, balances)
+	for i := range plan.Transfers {
+		plan.Transfers[i].Address, err = bot.GetExchangeCryptocurrencyDepositAddress(plan.Transfers[i].To, "", code)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s deposit address %w", plan.Transfers[i].To, code, err)
+		}
+		plan.Transfers[i].AddressTag, err = bot.GetExchangeCryptocurrencyDepositAddressTag(plan.Transfers[i].To, "", code)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s deposit address tag %w", plan.Transfers[i].To, code, err)
+		}
+	}
+	plan.ID = plan.hash()
+	return plan, nil
+}
+
+// ExecuteRebalance submits the plan's transfers as withdrawals requested by
+// the supplied user. Withdrawals are subject to the withdrawal policy so may
+// be held for approval. Submission stops at the first failed transfer
+func (bot *Engine) ExecuteRebalance(plan *RebalancePlan, requestedBy string) ([]*withdraw.Response, error) {
+	if plan == nil {
+		return nil, errNilRebalancePlan
+	}
+	responses := make([]*withdraw.Response, 0, len(plan.Transfers))
+	for i := range plan.Transfers {
+		t := &plan.Transfers[i]
+		resp, err := bot.SubmitWithdrawal(&withdraw.Request{
+			Exchange:    t.From,
+			Currency:    t.Currency,
+			Description: "Rebalance to " + t.To,
+			Amount:      t.Amount,
+			Type:        withdraw.Crypto,
+			RequestedBy: requestedBy,
+			Crypto: withdraw.CryptoRequest{
+				Address:    t.Address,
+				AddressTag: t.AddressTag,
+				FeeAmount:  t.Fee,
+			},
+		})
+		if err != nil {
+			audit.Event(requestedBy, rebalancerEventType,
+				fmt.Sprintf("Rebalance transfer %s failed: %v", t.describe(), err))
+			return responses, fmt.Errorf("%s to %s transfer %w", t.From, t.To, err)
+		}
+		audit.Event(requestedBy, rebalancerEventType,
+			fmt.Sprintf("Rebalance transfer %s submitted as withdrawal %s", t.describe(), resp.ID))
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+func (bot *Engine) rebalanceTarget(code currency.Code) (*config.RebalanceTarget, error) {
+	for i := range bot.Config.Rebalancer.Targets {
+		if strings.EqualFold(bot.Config.Rebalancer.Targets[i].Currency, code.String()) {
+			return &bot.Config.Rebalancer.Targets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w %s", errNoRebalanceTarget, code)
+}
+
+// getRebalanceBalance returns the exchange's spot balance of the currency and
+// its withdrawal fee. Stored holdings are used when available
+func (bot *Engine) getRebalanceBalance(exchName string, code currency.Code) (*rebalanceBalance, error) {
+	exch := bot.GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	holdings, err := account.GetHoldings(exchName, asset.Spot)
+	if err != nil {
+		holdings, err = exch.UpdateAccountInfo(asset.Spot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b := &rebalanceBalance{exchange: exch.GetName()}
+	for i := range holdings.Accounts {
+		if holdings.Accounts[i].AssetType != asset.Spot {
+			continue
+		}
+		for j := range holdings.Accounts[i].Currencies {
+			if !holdings.Accounts[i].Currencies[j].CurrencyName.Match(code) {
+				continue
+			}
+			b.total += holdings.Accounts[i].Currencies[j].TotalValue
+			b.free += holdings.Accounts[i].Currencies[j].TotalValue - holdings.Accounts[i].Currencies[j].Hold
+		}
+	}
+
+	b.fee, b.feeErr = exch.GetFeeByType(&exchange.FeeBuilder{
+		FeeType: exchange.CryptocurrencyWithdrawalFee,
+		Pair:    currency.Pair{Base: code},
+		Amount:  b.free,
+	})
+	if b.feeErr != nil {
+		log.Warnf(log.Global, "Rebalancer unable to get %s %s withdrawal fee, excluding as a transfer source: %v\n",
+			b.exchange, code, b.feeErr)
+	}
+	return b, nil
+}
+
+// planRebalance matches exchanges holding more than their share of the
+// combined balance with those holding less. Each exchange below its target
+// is paid by the lowest fee exchange able to cover the whole shortfall, or
+// failing that by the lowest fee exchanges in turn. Transfers smaller than
+// the minimum are not planned
+func planRebalance(code currency.Code, minimum float64, balances []rebalanceBalance) *RebalancePlan {
+	plan := &RebalancePlan{
+		Currency: code,
+		Balances: make(map[string]float64, len(balances)),
+		Targets:  make(map[string]float64, len(balances)),
+	}
+	var shares float64
+	for i := range balances {
+		plan.Total += balances[i].total
+		shares += balances[i].share
+	}
+	if shares <= 0 {
+		return plan
+	}
+
+	var sources, sinks []rebalancePosition
+	for i := range balances {
+		target := plan.Total * balances[i].share / shares
+		plan.Balances[balances[i].exchange] = balances[i].total
+		plan.Targets[balances[i].exchange] = target
+		diff := balances[i].total - target
+		switch {
+		case diff > 0 && balances[i].feeErr == nil:
+			sources = append(sources, rebalancePosition{
+				exchange: balances[i].exchange,
+				amount:   math.Min(diff, balances[i].free),
+				fee:      balances[i].fee,
+			})
+		case diff < 0:
+			sinks = append(sinks, rebalancePosition{
+				exchange: balances[i].exchange,
+				amount:   -diff,
+			})
+		}
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		if sources[i].fee != sources[j].fee {
+			return sources[i].fee < sources[j].fee
+		}
+		return sources[i].exchange < sources[j].exchange
+	})
+	sort.Slice(sinks, func(i, j int) bool {
+		if sinks[i].amount != sinks[j].amount {
+			return sinks[i].amount > sinks[j].amount
+		}
+		return sinks[i].exchange < sinks[j].exchange
+	})
+
+	for i := range sinks {
+		for sinks[i].amount > 0 && sinks[i].amount >= minimum {
+			src := cheapestRebalanceSource(sources, sinks[i].amount, minimum)
+			if src == -1 {
+				break
+			}
+			amount := math.Min(sinks[i].amount, sources[src].amount-sources[src].fee)
+			plan.Transfers = append(plan.Transfers, RebalanceTransfer{
+				From:     sources[src].exchange,
+				To:       sinks[i].exchange,
+				Currency: code,
+				Amount:   amount,
+				Fee:      sources[src].fee,
+			})
+			plan.TotalFees += sources[src].fee
+			sources[src].amount -= amount + sources[src].fee
+			sinks[i].amount -= amount
+		}
+	}
+	return plan
+}
+
+// cheapestRebalanceSource returns the index of the first source, sorted by
+// fee, able to send the full amount after fees, otherwise the first able to
+// send at least the minimum. -1 is returned when no source can
+func cheapestRebalanceSource(sources []rebalancePosition, amount, minimum float64) int {
+	fallback := -1
+	for i := range sources {
+		sendable := sources[i].amount - sources[i].fee
+		if sendable <= 0 {
+			continue
+		}
+		if sendable >= amount {
+			return i
+		}
+		if fallback == -1 && sendable >= minimum {
+			fallback = i
+		}
+	}
+	return fallback
+}
+
+// hash returns an identifier for the plan's transfers, which changes when any
+// transfer's exchanges, amount, fee or destination changes
+func (p *RebalancePlan) hash() string {
+	var b strings.Builder
+	b.WriteString(p.Currency.String())
+	for i := range p.Transfers {
+		fmt.Fprintf(&b, "|%s|%s|%s|%s|%s|%s",
+			p.Transfers[i].From,
+			p.Transfers[i].To,
+			strconv.FormatFloat(p.Transfers[i].Amount, 'f', -1, 64),
+			strconv.FormatFloat(p.Transfers[i].Fee, 'f', -1, 64),
+			p.Transfers[i].Address,
+			p.Transfers[i].AddressTag)
+	}
+	return crypto.HexEncodeToString(crypto.GetSHA256([]byte(b.String()))[:8])
+}
+
+func (p *RebalancePlan) describe() string {
+	transfers := make([]string, len(p.Transfers))
+	for i := range p.Transfers {
+		transfers[i] = p.Transfers[i].describe()
+	}
+	return fmt.Sprintf("plan %s %v %v transfers costing %v %v in fees: %s",
+		p.ID,
+		p.Currency,
+		len(p.Transfers),
+		p.TotalFees,
+		p.Currency,
+		strings.Join(transfers, ", "))
+}
+
+func (t *RebalanceTransfer) describe() string {
+	return fmt.Sprintf("%v %v from %s to %s", t.Amount, t.Currency, t.From, t.To)
+}
+
+func parseRebalancePlan(plan *RebalancePlan) *gctrpc.RebalancePlanResponse {
+	resp := &gctrpc.RebalancePlanResponse{
+		Id:        plan.ID,
+		Currency:  plan.Currency.String(),
+		Total:     plan.Total,
+		TotalFees: plan.TotalFees,
+	}
+	exchanges := make([]string, 0, len(plan.Balances))
+	for exch := range plan.Balances {
+		exchanges = append(exchanges, exch)
+	}
+	sort.Strings(exchanges)
+	for i := range exchanges {
+		resp.Balances = append(resp.Balances, &gctrpc.RebalanceBalance{
+			Exchange: exchanges[i],
+			Balance:  plan.Balances[exchanges[i]],
+			Target:   plan.Targets[exchanges[i]],
+		})
+	}
+	for i := range plan.Transfers {
+		resp.Transfers = append(resp.Transfers, &gctrpc.RebalanceTransfer{
+			From:       plan.Transfers[i].From,
+			To:         plan.Transfers[i].To,
+			Amount:     plan.Transfers[i].Amount,
+			Fee:        plan.Transfers[i].Fee,
+			Address:    plan.Transfers[i].Address,
+			AddressTag: plan.Transfers[i].AddressTag,
+		})
+	}
+	return resp
+}
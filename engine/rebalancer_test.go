@@ -0,0 +1,290 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+func TestRebalancerStartStop(t *testing.T) {
+	t.Parallel()
+	var r rebalancer
+	if err := r.Start(nil); !errors.Is(err, errRebalancerNilEngine) {
+		t.Errorf("received %v expected %v", err, errRebalancerNilEngine)
+	}
+	if err := r.Stop(); !errors.Is(err, subsystem.ErrSubSystemNotStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemNotStarted)
+	}
+
+	bot := &Engine{Config: &config.Config{
+		Rebalancer: config.RebalancerConfig{CheckInterval: time.Hour},
+	}}
+	if err := r.Start(bot); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Start(bot); !errors.Is(err, subsystem.ErrSubSystemAlreadyStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemAlreadyStarted)
+	}
+	if err := r.Stop(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPlanRebalanceTransfers(t *testing.T) {
+	t.Parallel()
+	balances := []rebalanceBalance{
+		{exchange: "a", share: 1, total: 11, free: 11, fee: 0.5},
+		{exchange: "b", share: 1, total: 6, free: 6, fee: 0.1},
+		{exchange: "c", share: 1, total: 2, free: 2, fee: 0.1},
+		{exchange: "d", share: 1, total: 1, free: 1, fee: 0.1},
+	}
+
+	plan := planRebalance(currency.BTC, 0, balances)
+	if plan.Total != 20 || plan.Targets["a"] != 5 {
+		t.Errorf("unexpected plan total %v target %v", plan.Total, plan.Targets["a"])
+	}
+	expected := []RebalanceTransfer{
+		{From: "a", To: "d", Currency: currency.BTC, Amount: 4, Fee: 0.5},
+		{From: "b", To: "c", Currency: currency.BTC, Amount: 1 - 0.1, Fee: 0.1},
+		{From: "a", To: "c", Currency: currency.BTC, Amount: 1, Fee: 0.5},
+	}
+	if len(plan.Transfers) != len(expected) {
+		t.Fatalf("received %+v expected %+v", plan.Transfers, expected)
+	}
+	for i := range expected {
+		if plan.Transfers[i] != expected[i] {
+			t.Errorf("transfer %d received %+v expected %+v", i, plan.Transfers[i], expected[i])
+		}
+	}
+	if plan.TotalFees != 0.5+0.1+0.5 {
+		t.Errorf("received %v expected %v", plan.TotalFees, 0.5+0.1+0.5)
+	}
+
+	plan = planRebalance(currency.BTC, 1, balances)
+	if len(plan.Transfers) != 2 || plan.Transfers[1].From != "a" || plan.Transfers[1].To != "c" {
+		t.Errorf("expected transfers below the minimum to be skipped, received %+v", plan.Transfers)
+	}
+
+	// Exchanges with unknown fees or funds on hold cannot pay for transfers
+	balances[0].feeErr = errors.New("test")
+	balances[1].free = 0
+	plan = planRebalance(currency.BTC, 0, balances)
+	if len(plan.Transfers) != 0 {
+		t.Errorf("expected no transfers, received %+v", plan.Transfers)
+	}
+
+	plan = planRebalance(currency.BTC, 0, []rebalanceBalance{{exchange: "a", total: 1}})
+	if len(plan.Transfers) != 0 {
+		t.Errorf("expected no transfers without shares, received %+v", plan.Transfers)
+	}
+}
+
+func TestRebalancePlanRPC(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+	bot.Config.Withdrawal = config.WithdrawalConfig{}
+	bot.Config.Rebalancer = config.RebalancerConfig{
+		CheckInterval: time.Hour,
+		Targets: []config.RebalanceTarget{
+			{
+				Currency: "BTC",
+				Allocations: map[string]float64{
+					fakePassExchange: 1,
+					testExchange:     1,
+				},
+			},
+		},
+	}
+	bot.DepositAddressManager = new(DepositAddressManager)
+	bot.DepositAddressManager.Store.Seed(map[string]map[string]string{
+		testExchange: {"BTC": approvalTestAddress},
+	})
+	for exch, amount := range map[string]float64{fakePassExchange: 10, testExchange: 0} {
+		err := account.Process(&account.Holdings{
+			Exchange: exch,
+			Accounts: []account.SubAccount{
+				{
+					AssetType: asset.Spot,
+					Currencies: []account.Balance{
+						{CurrencyName: currency.BTC, TotalValue: amount},
+						{CurrencyName: currency.XRP, TotalValue: amount},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := RPCServer{Engine: bot}
+	plan, err := s.GetRebalancePlan(context.Background(), &gctrpc.RebalancePlanRequest{Currency: "btc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Transfers) != 1 {
+		t.Fatalf("received %v transfers expected 1", len(plan.Transfers))
+	}
+	if plan.Transfers[0].From != fakePassExchange || plan.Transfers[0].Amount != 5 ||
+		plan.Transfers[0].Address != approvalTestAddress {
+		t.Errorf("unexpected transfer %+v", plan.Transfers[0])
+	}
+
+	_, err = s.GetRebalancePlan(context.Background(), &gctrpc.RebalancePlanRequest{Currency: "ltc"})
+	if !errors.Is(err, errNoRebalanceTarget) {
+		t.Errorf("received %v expected %v", err, errNoRebalanceTarget)
+	}
+
+	bot.Config.Rebalancer.Targets = append(bot.Config.Rebalancer.Targets, config.RebalanceTarget{
+		Currency:    "XRP",
+		Allocations: map[string]float64{fakePassExchange: 1, testExchange: 1},
+	})
+	bot.DepositAddressManager.Store.Seed(map[string]map[string]string{
+		testExchange: {"BTC": approvalTestAddress, "XRP": approvalTestAddress},
+	})
+	bot.DepositAddressManager.Store.SeedTags(map[string]map[string]string{
+		testExchange: {"XRP": "1337"},
+	})
+	plan, err = s.GetRebalancePlan(context.Background(), &gctrpc.RebalancePlanRequest{Currency: "xrp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Transfers) != 1 || plan.Transfers[0].AddressTag != "1337" {
+		t.Errorf("unexpected transfers %+v", plan.Transfers)
+	}
+	withdrawals, err := bot.ExecuteRebalance(&RebalancePlan{
+		Currency: currency.XRP,
+		Transfers: []RebalanceTransfer{
+			{From: fakePassExchange, To: testExchange, Currency: currency.XRP, Amount: 1, Address: approvalTestAddress, AddressTag: "1337"},
+		},
+	}, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(withdrawals) != 1 || withdrawals[0].RequestDetails.Crypto.AddressTag != "1337" {
+		t.Errorf("unexpected withdrawals %+v", withdrawals)
+	}
+	plan, err = s.GetRebalancePlan(context.Background(), &gctrpc.RebalancePlanRequest{Currency: "btc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.Transfers[0].AddressTag != "" {
+		t.Errorf("received tag %v expected none", plan.Transfers[0].AddressTag)
+	}
+
+	if plan.Id == "" {
+		t.Error("plan ID should be set")
+	}
+	reviewed, err := s.GetRebalancePlan(context.Background(), &gctrpc.RebalancePlanRequest{Currency: "btc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reviewed.Id != plan.Id {
+		t.Errorf("received plan ID %v expected %v", reviewed.Id, plan.Id)
+	}
+
+	_, err = s.ExecuteRebalancePlan(context.Background(), &gctrpc.ExecuteRebalancePlanRequest{Currency: "btc", PlanId: plan.Id})
+	if !errors.Is(err, errRebalancerNotStarted) {
+		t.Errorf("received %v expected %v", err, errRebalancerNotStarted)
+	}
+	if err = bot.Rebalancer.Start(bot); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = bot.Rebalancer.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	ctx := context.WithValue(context.Background(), rpcUserKey{}, "admin")
+	_, err = s.ExecuteRebalancePlan(ctx, &gctrpc.ExecuteRebalancePlanRequest{Currency: "btc"})
+	if !errors.Is(err, errRebalancePlanIDUnset) {
+		t.Errorf("received %v expected %v", err, errRebalancePlanIDUnset)
+	}
+
+	// balances which move after review change the plan, so it is refused
+	err = account.Process(&account.Holdings{
+		Exchange: testExchange,
+		Accounts: []account.SubAccount{
+			{
+				AssetType: asset.Spot,
+				Currencies: []account.Balance{
+					{CurrencyName: currency.BTC, TotalValue: 2},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.ExecuteRebalancePlan(ctx, &gctrpc.ExecuteRebalancePlanRequest{Currency: "btc", PlanId: plan.Id})
+	if !errors.Is(err, errRebalancePlanChanged) {
+		t.Errorf("received %v expected %v", err, errRebalancePlanChanged)
+	}
+
+	plan, err = s.GetRebalancePlan(context.Background(), &gctrpc.RebalancePlanRequest{Currency: "btc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.ExecuteRebalancePlan(ctx, &gctrpc.ExecuteRebalancePlanRequest{Currency: "btc", PlanId: plan.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != "" || resp.Plan.Id != plan.Id || resp.Plan.Transfers[0].Amount != 4 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+	if len(resp.Withdrawals) != 1 || resp.Withdrawals[0].Id == "" {
+		t.Fatalf("unexpected withdrawals %+v", resp.Withdrawals)
+	}
+
+	events, err := modelSQLite.AuditEvents(qm.Where("type = ?", rebalancerEventType),
+		qm.OrderBy("id desc"), qm.Limit(1)).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Identifier != "admin" ||
+		!strings.Contains(events[0].Message, resp.Withdrawals[0].Id) {
+		t.Errorf("unexpected audit events %+v", events)
+	}
+}
+
+func TestExecuteRebalancePartial(t *testing.T) {
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+	bot.Config.Withdrawal = config.WithdrawalConfig{}
+
+	// submission stops at the failed transfer and returns those submitted
+	withdrawals, err := bot.ExecuteRebalance(&RebalancePlan{
+		Currency: currency.BTC,
+		Transfers: []RebalanceTransfer{
+			{From: fakePassExchange, To: testExchange, Currency: currency.BTC, Amount: 1, Address: approvalTestAddress},
+			{From: "fake", To: testExchange, Currency: currency.BTC, Amount: 1, Address: approvalTestAddress},
+			{From: fakePassExchange, To: testExchange, Currency: currency.BTC, Amount: 1, Address: approvalTestAddress},
+		},
+	}, "admin")
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received %v expected %v", err, ErrExchangeNotFound)
+	}
+	if len(withdrawals) != 1 || withdrawals[0].ID == uuid.Nil {
+		t.Errorf("unexpected withdrawals %+v", withdrawals)
+	}
+}
@@ -0,0 +1,79 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+const rebalancerEventType = "rebalance"
+
+var (
+	errRebalancerNilEngine  = errors.New("rebalancer cannot start with nil engine")
+	errRebalancerNotStarted = errors.New("rebalancer not started")
+	errNoRebalanceTarget    = errors.New("no rebalance target configured for currency")
+	errNilRebalancePlan     = errors.New("rebalance plan is nil")
+	errRebalancePlanIDUnset = errors.New("rebalance plan ID must be set")
+	errRebalancePlanChanged = errors.New("rebalance plan has changed since it was reviewed")
+)
+
+// rebalancer periodically compares exchange balances against the configured
+// target allocations and proposes transfers to restore them. Plans are only
+// executed on request
+type rebalancer struct {
+	bot      *Engine
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	cfg      config.RebalancerConfig
+}
+
+// RebalanceTransfer is a planned withdrawal from one exchange to the deposit
+// address of another. AddressTag is set when the receiving exchange credits
+// deposits by tag or memo
+type RebalanceTransfer struct {
+	From       string
+	To         string
+	Currency   currency.Code
+	Amount     float64
+	Fee        float64
+	Address    string
+	AddressTag string
+}
+
+// RebalancePlan holds the balances of a currency across exchanges, the
+// balance each exchange should hold and the transfers which move it there.
+// ID identifies the plan's transfers so a reviewed plan can be executed
+// unchanged
+type RebalancePlan struct {
+	ID        string
+	Currency  currency.Code
+	Total     float64
+	Balances  map[string]float64
+	Targets   map[string]float64
+	Transfers []RebalanceTransfer
+	TotalFees float64
+}
+
+// rebalanceBalance is an exchange's balance of the currency being rebalanced,
+// its share of the combined balance and the withdrawal fee it charges
+type rebalanceBalance struct {
+	exchange string
+	share    float64
+	total    float64
+	free     float64
+	fee      float64
+	// feeErr is set when the withdrawal fee is unknown and the exchange
+	// cannot be used as a transfer source
+	feeErr error
+}
+
+// rebalancePosition is the amount an exchange holds above or below its
+// target
+type rebalancePosition struct {
+	exchange string
+	amount   float64
+	fee      float64
+}
@@ -313,6 +313,9 @@ func (bot *Engine) WebsocketDataHandler(exchName string, data interface{}) error
 				d.AssetType,
 				d)
 		}
+		if bot.CandleManager.Started() {
+			return bot.CandleManager.processKline(&d)
+		}
 	case *orderbook.Base:
 		if bot.Settings.EnableExchangeSyncManager && bot.ExchangeCurrencyPairManager != nil {
 			bot.ExchangeCurrencyPairManager.update(exchName,
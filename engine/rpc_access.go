@@ -0,0 +1,215 @@
+package engine
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"google.golang.org/grpc"
+)
+
+// rpcRole returns the authenticated caller's role from the context
+func rpcRole(ctx context.Context) auth.Role {
+	role, _ := ctx.Value(rpcRoleKey{}).(auth.Role)
+	return role
+}
+
+// rpcUserRole returns the role of an additional remote control user, which
+// defaults to read only
+func rpcUserRole(u *config.RPCUser) auth.Role {
+	if u.Role == "" {
+		return auth.RoleReadOnly
+	}
+	return u.Role
+}
+
+// remoteControlRole returns the role of the remote control user by username
+func (bot *Engine) remoteControlRole(username string) (auth.Role, bool) {
+	if username == bot.Config.RemoteControl.Username {
+		return auth.RoleAdmin, true
+	}
+	for i := range bot.Config.RemoteControl.Users {
+		if bot.Config.RemoteControl.Users[i].Username == username {
+			return rpcUserRole(&bot.Config.RemoteControl.Users[i]), true
+		}
+	}
+	return "", false
+}
+
+// rpcPermission returns the permission required by the remote procedure
+func rpcPermission(fullMethod string) auth.Permission {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if p, ok := rpcPermissions[method]; ok {
+		return p
+	}
+	return auth.PermissionAdmin
+}
+
+// authoriseRPC checks the caller's role grants the permission required by
+// the remote procedure. Privileged calls and denied requests are audited
+func authoriseRPC(ctx context.Context, fullMethod string) error {
+	username := rpcUsername(ctx)
+	required := rpcPermission(fullMethod)
+	if !rpcRole(ctx).Allows(required) {
+		audit.Event(username, rpcAccessEventType, "Denied "+fullMethod)
+		return fmt.Errorf("%s %w, %s requires %s", username, errRPCPermissionDenied, fullMethod, required)
+	}
+	if required != auth.PermissionRead {
+		audit.Event(username, rpcAccessEventType, "Called "+fullMethod)
+	}
+	return nil
+}
+
+// authoriseUnaryRPC is a unary server interceptor enforcing role permissions
+func authoriseUnaryRPC(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := authoriseRPC(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authoriseStreamRPC is a stream server interceptor enforcing role
+// permissions
+func authoriseStreamRPC(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := authoriseRPC(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// validRPCToken returns the identity and role of an unexpired API token
+func (bot *Engine) validRPCToken(token string) (string, auth.Role, error) {
+	hash := hashRPCToken(token)
+	bot.rpcTokenMtx.RLock()
+	defer bot.rpcTokenMtx.RUnlock()
+	for i := range bot.Config.RemoteControl.Tokens {
+		t := &bot.Config.RemoteControl.Tokens[i]
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(t.Hash)) != 1 {
+			continue
+		}
+		if !time.Now().Before(t.Expires) {
+			return "", "", fmt.Errorf("%s %w", t.Name, errRPCTokenExpired)
+		}
+		return rpcTokenIdentityPrefix + t.Name, t.Role, nil
+	}
+	return "", "", errRPCTokenInvalid
+}
+
+// rpcPrincipal returns the remote control user behind the caller's
+// identity. API tokens resolve to the user who created them, so one user
+// holding several tokens is still treated as one person
+func (bot *Engine) rpcPrincipal(identity string) (string, error) {
+	name := strings.TrimPrefix(identity, rpcTokenIdentityPrefix)
+	if name == identity {
+		return identity, nil
+	}
+	bot.rpcTokenMtx.RLock()
+	defer bot.rpcTokenMtx.RUnlock()
+	for i := range bot.Config.RemoteControl.Tokens {
+		if bot.Config.RemoteControl.Tokens[i].Name != name {
+			continue
+		}
+		if bot.Config.RemoteControl.Tokens[i].Owner == "" {
+			return "", fmt.Errorf("%s %w", name, errRPCTokenOwnerUnset)
+		}
+		return bot.Config.RemoteControl.Tokens[i].Owner, nil
+	}
+	return "", fmt.Errorf("%s %w", name, errRPCTokenNotFound)
+}
+
+// CreateRPCToken generates an API token with the role which expires at the
+// time, owned by the creating user or, when created with a token, that
+// token's owner. Only the token's hash is stored, so it cannot be retrieved
+// later. The config is saved so the token survives a restart
+func (bot *Engine) CreateRPCToken(name, creator string, role auth.Role, expires time.Time) (string, error) {
+	if name == "" {
+		return "", errRPCTokenNameUnset
+	}
+	owner, err := bot.rpcPrincipal(creator)
+	if err != nil {
+		return "", err
+	}
+	if owner == "" {
+		return "", fmt.Errorf("%s %w", name, errRPCTokenOwnerUnset)
+	}
+	role, err = auth.ParseRole(string(role))
+	if err != nil {
+		return "", err
+	}
+	if !expires.After(time.Now()) {
+		return "", errRPCTokenExpiry
+	}
+	b := make([]byte, rpcTokenLength)
+	if _, err = rand.Read(b); err != nil {
+		return "", err
+	}
+	token := crypto.HexEncodeToString(b)
+
+	bot.rpcTokenMtx.Lock()
+	defer bot.rpcTokenMtx.Unlock()
+	for i := range bot.Config.RemoteControl.Tokens {
+		if bot.Config.RemoteControl.Tokens[i].Name == name {
+			return "", fmt.Errorf("%s %w", name, errRPCTokenExists)
+		}
+	}
+	bot.Config.RemoteControl.Tokens = append(bot.Config.RemoteControl.Tokens, config.RPCToken{
+		Name:    name,
+		Hash:    hashRPCToken(token),
+		Owner:   owner,
+		Role:    role,
+		Expires: expires.UTC(),
+	})
+	err = bot.Config.SaveConfigToFile(bot.Settings.ConfigFile)
+	if err != nil {
+		// an unsaved token would be lost on restart so it is not issued
+		bot.Config.RemoteControl.Tokens = bot.Config.RemoteControl.Tokens[:len(bot.Config.RemoteControl.Tokens)-1]
+		return "", fmt.Errorf("%s %w: %v", name, errRPCTokenNotSaved, err)
+	}
+	return token, nil
+}
+
+// RevokeRPCToken removes the API token by name and saves the config so the
+// token is not restored on restart. The token stays revoked for this session
+// even when the config cannot be saved
+func (bot *Engine) RevokeRPCToken(name string) error {
+	bot.rpcTokenMtx.Lock()
+	defer bot.rpcTokenMtx.Unlock()
+	for i := range bot.Config.RemoteControl.Tokens {
+		if bot.Config.RemoteControl.Tokens[i].Name != name {
+			continue
+		}
+		bot.Config.RemoteControl.Tokens = append(bot.Config.RemoteControl.Tokens[:i],
+			bot.Config.RemoteControl.Tokens[i+1:]...)
+		err := bot.Config.SaveConfigToFile(bot.Settings.ConfigFile)
+		if err != nil {
+			return fmt.Errorf("%s %w: %v", name, errRPCTokenNotSaved, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s %w", name, errRPCTokenNotFound)
+}
+
+// GetRPCTokens returns the API tokens sorted by name
+func (bot *Engine) GetRPCTokens() []config.RPCToken {
+	bot.rpcTokenMtx.RLock()
+	tokens := make([]config.RPCToken, len(bot.Config.RemoteControl.Tokens))
+	copy(tokens, bot.Config.RemoteControl.Tokens)
+	bot.rpcTokenMtx.RUnlock()
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].Name < tokens[j].Name
+	})
+	return tokens
+}
+
+// hashRPCToken returns the hex encoded SHA256 hash stored for an API token
+func hashRPCToken(token string) string {
+	return crypto.HexEncodeToString(crypto.GetSHA256([]byte(token)))
+}
@@ -0,0 +1,289 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuthenticateClientRoles(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: &config.Config{}}
+	bot.Settings.ConfigFile = filepath.Join(t.TempDir(), "config.json")
+	bot.Config.RemoteControl.Username = "admin"
+	bot.Config.RemoteControl.Password = "pass"
+	bot.Config.RemoteControl.Users = []config.RPCUser{
+		{Username: "bob", Password: "bob:pass"},
+		{Username: "alice", Password: "alicepass", Role: auth.RoleTrader},
+	}
+
+	authCtx := func(header string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", header))
+	}
+	basic := func(user, pass string) string {
+		return "Basic " + crypto.Base64Encode([]byte(user+":"+pass))
+	}
+
+	for _, tt := range []struct {
+		header string
+		user   string
+		role   auth.Role
+	}{
+		{basic("admin", "pass"), "admin", auth.RoleAdmin},
+		{basic("bob", "bob:pass"), "bob", auth.RoleReadOnly},
+		{basic("alice", "alicepass"), "alice", auth.RoleTrader},
+	} {
+		ctx, err := bot.authenticateClient(authCtx(tt.header))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rpcUsername(ctx) != tt.user || rpcRole(ctx) != tt.role {
+			t.Errorf("received %v %v expected %v %v", rpcUsername(ctx), rpcRole(ctx), tt.user, tt.role)
+		}
+	}
+
+	token, err := bot.CreateRPCToken("ci", "admin", auth.RoleWithdrawer, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, err := bot.authenticateClient(authCtx("Bearer " + token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rpcUsername(ctx) != "token:ci" || rpcRole(ctx) != auth.RoleWithdrawer {
+		t.Errorf("received %v %v expected token:ci withdrawer", rpcUsername(ctx), rpcRole(ctx))
+	}
+	_, err = bot.authenticateClient(authCtx("Bearer invalid"))
+	if !errors.Is(err, errRPCTokenInvalid) {
+		t.Errorf("received %v expected %v", err, errRPCTokenInvalid)
+	}
+	bot.Config.RemoteControl.Tokens[0].Expires = time.Now().Add(-time.Second)
+	_, err = bot.authenticateClient(authCtx("Bearer " + token))
+	if !errors.Is(err, errRPCTokenExpired) {
+		t.Errorf("received %v expected %v", err, errRPCTokenExpired)
+	}
+	if _, err = bot.authenticateClient(authCtx("Basic " + crypto.Base64Encode([]byte("admin")))); err == nil {
+		t.Error("expected error for missing password")
+	}
+	if _, err = bot.authenticateClient(authCtx("Digest abc")); err == nil {
+		t.Error("expected error for unsupported authorization")
+	}
+}
+
+func TestAuthoriseRPC(t *testing.T) {
+	t.Parallel()
+	roleCtx := func(role auth.Role) context.Context {
+		ctx := context.WithValue(context.Background(), rpcUserKey{}, "user")
+		return context.WithValue(ctx, rpcRoleKey{}, role)
+	}
+	for _, tt := range []struct {
+		role    auth.Role
+		method  string
+		allowed bool
+	}{
+		{auth.RoleReadOnly, "/gctrpc.GoCryptoTrader/GetInfo", true},
+		{auth.RoleReadOnly, "/gctrpc.GoCryptoTrader/SubmitOrder", false},
+		{auth.RoleReadOnly, "/gctrpc.GoCryptoTrader/GetConfig", false},
+		{auth.RoleTrader, "/gctrpc.GoCryptoTrader/SubmitOrder", true},
+		{auth.RoleTrader, "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds", false},
+		{auth.RoleWithdrawer, "/gctrpc.GoCryptoTrader/ApproveWithdrawal", true},
+		{auth.RoleWithdrawer, "/gctrpc.GoCryptoTrader/CancelOrder", false},
+		{auth.RoleWithdrawer, "/gctrpc.GoCryptoTrader/CreateAPIToken", false},
+		{auth.RoleAdmin, "/gctrpc.GoCryptoTrader/CreateAPIToken", true},
+		{"", "/gctrpc.GoCryptoTrader/GetInfo", false},
+	} {
+		err := authoriseRPC(roleCtx(tt.role), tt.method)
+		if tt.allowed && err != nil {
+			t.Errorf("%q %s received %v expected nil", tt.role, tt.method, err)
+		}
+		if !tt.allowed && !errors.Is(err, errRPCPermissionDenied) {
+			t.Errorf("%q %s received %v expected %v", tt.role, tt.method, err, errRPCPermissionDenied)
+		}
+	}
+
+	var called bool
+	_, err := authoriseUnaryRPC(roleCtx(auth.RoleReadOnly), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder"},
+		func(context.Context, interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})
+	if !errors.Is(err, errRPCPermissionDenied) || called {
+		t.Errorf("received %v expected %v without calling the handler", err, errRPCPermissionDenied)
+	}
+}
+
+func TestRPCPermissionsKnownMethods(t *testing.T) {
+	t.Parallel()
+	known := make(map[string]bool)
+	for _, m := range gctrpc.GoCryptoTrader_ServiceDesc.Methods {
+		known[m.MethodName] = true
+	}
+	for _, s := range gctrpc.GoCryptoTrader_ServiceDesc.Streams {
+		known[s.StreamName] = true
+	}
+	for method := range rpcPermissions {
+		if !known[method] {
+			t.Errorf("permission set for unknown method %s", method)
+		}
+	}
+}
+
+func TestRPCTokens(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: &config.Config{}}
+	bot.Settings.ConfigFile = filepath.Join(t.TempDir(), "config.json")
+	expires := time.Now().Add(time.Hour)
+	_, err := bot.CreateRPCToken("", "admin", auth.RoleAdmin, expires)
+	if !errors.Is(err, errRPCTokenNameUnset) {
+		t.Errorf("received %v expected %v", err, errRPCTokenNameUnset)
+	}
+	_, err = bot.CreateRPCToken("a", "admin", "root", expires)
+	if !errors.Is(err, auth.ErrInvalidRole) {
+		t.Errorf("received %v expected %v", err, auth.ErrInvalidRole)
+	}
+	_, err = bot.CreateRPCToken("a", "admin", auth.RoleAdmin, time.Now())
+	if !errors.Is(err, errRPCTokenExpiry) {
+		t.Errorf("received %v expected %v", err, errRPCTokenExpiry)
+	}
+	token, err := bot.CreateRPCToken("b", "admin", auth.RoleTrader, expires)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(token) != rpcTokenLength*2 {
+		t.Errorf("received token length %v expected %v", len(token), rpcTokenLength*2)
+	}
+	if bot.Config.RemoteControl.Tokens[0].Hash == token {
+		t.Error("token should not be stored in plain text")
+	}
+	if _, err = bot.CreateRPCToken("c", "", auth.RoleAdmin, expires); !errors.Is(err, errRPCTokenOwnerUnset) {
+		t.Errorf("received %v expected %v", err, errRPCTokenOwnerUnset)
+	}
+	if _, err = bot.CreateRPCToken("c", "token:missing", auth.RoleAdmin, expires); !errors.Is(err, errRPCTokenNotFound) {
+		t.Errorf("received %v expected %v", err, errRPCTokenNotFound)
+	}
+	_, err = bot.CreateRPCToken("b", "admin", auth.RoleTrader, expires)
+	if !errors.Is(err, errRPCTokenExists) {
+		t.Errorf("received %v expected %v", err, errRPCTokenExists)
+	}
+	if _, err = bot.CreateRPCToken("a", "admin", auth.RoleAdmin, expires); err != nil {
+		t.Fatal(err)
+	}
+	var saved config.Config
+	if err = saved.ReadConfigFromFile(bot.Settings.ConfigFile, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.RemoteControl.Tokens) != 2 {
+		t.Errorf("received %v saved tokens expected %v", len(saved.RemoteControl.Tokens), 2)
+	}
+
+	s := RPCServer{Engine: bot}
+	resp, err := s.GetAPITokens(context.Background(), &gctrpc.GetAPITokensRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Tokens) != 2 || resp.Tokens[0].Name != "a" || resp.Tokens[1].Role != "trader" || resp.Tokens[1].Expired {
+		t.Errorf("unexpected tokens %+v", resp.Tokens)
+	}
+
+	_, err = s.RevokeAPIToken(context.Background(), &gctrpc.RevokeAPITokenRequest{Name: "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.RevokeAPIToken(context.Background(), &gctrpc.RevokeAPITokenRequest{Name: "b"})
+	if !errors.Is(err, errRPCTokenNotFound) {
+		t.Errorf("received %v expected %v", err, errRPCTokenNotFound)
+	}
+	if _, _, err = bot.validRPCToken(token); !errors.Is(err, errRPCTokenInvalid) {
+		t.Errorf("received %v expected %v", err, errRPCTokenInvalid)
+	}
+	saved = config.Config{}
+	if err = saved.ReadConfigFromFile(bot.Settings.ConfigFile, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.RemoteControl.Tokens) != 1 || saved.RemoteControl.Tokens[0].Name != "a" {
+		t.Errorf("unexpected saved tokens %+v", saved.RemoteControl.Tokens)
+	}
+
+	// tokens created with a token are owned by that token's owner
+	ctx := context.WithValue(context.Background(), rpcUserKey{}, "token:a")
+	created, err := s.CreateAPIToken(ctx, &gctrpc.CreateAPITokenRequest{
+		Name:    "c",
+		Role:    "Withdrawer",
+		Expires: expires.UTC().Format(common.SimpleTimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Token == "" || created.Role != "withdrawer" {
+		t.Errorf("unexpected response %+v", created)
+	}
+	owner, err := bot.rpcPrincipal("token:c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner != "admin" {
+		t.Errorf("received %v expected %v", owner, "admin")
+	}
+}
+
+func TestRPCTokensSaveError(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: &config.Config{}}
+	dir := t.TempDir()
+	bot.Settings.ConfigFile = filepath.Join(dir, "config.json")
+	expires := time.Now().Add(time.Hour)
+	if _, err := bot.CreateRPCToken("a", "admin", auth.RoleAdmin, expires); err != nil {
+		t.Fatal(err)
+	}
+
+	// a directory in place of the config file cannot be written to
+	bot.Settings.ConfigFile = dir
+	s := RPCServer{Engine: bot}
+	ctx := context.WithValue(context.Background(), rpcUserKey{}, "admin")
+	_, err := s.CreateAPIToken(ctx, &gctrpc.CreateAPITokenRequest{
+		Name:    "b",
+		Role:    "admin",
+		Expires: expires.UTC().Format(common.SimpleTimeFormat),
+	})
+	if !errors.Is(err, errRPCTokenNotSaved) {
+		t.Errorf("received %v expected %v", err, errRPCTokenNotSaved)
+	}
+	if len(bot.Config.RemoteControl.Tokens) != 1 {
+		t.Errorf("received %v tokens expected %v", len(bot.Config.RemoteControl.Tokens), 1)
+	}
+	_, err = s.RevokeAPIToken(ctx, &gctrpc.RevokeAPITokenRequest{Name: "a"})
+	if !errors.Is(err, errRPCTokenNotSaved) {
+		t.Errorf("received %v expected %v", err, errRPCTokenNotSaved)
+	}
+	if len(bot.Config.RemoteControl.Tokens) != 0 {
+		t.Errorf("received %v tokens expected %v", len(bot.Config.RemoteControl.Tokens), 0)
+	}
+	if _, err = os.Stat(filepath.Join(dir, "config.json")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestScriptAuthor(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	s.Config.RemoteControl.Username = "admin"
+	if a := s.scriptAuthor(context.Background()); a != "admin" {
+		t.Errorf("received %v expected %v", a, "admin")
+	}
+	ctx := context.WithValue(context.Background(), rpcUserKey{}, "alice")
+	if a := s.scriptAuthor(ctx); a != "alice" {
+		t.Errorf("received %v expected %v", a, "alice")
+	}
+}
@@ -0,0 +1,109 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+)
+
+const (
+	// rpcAccessEventType is the audit event type of privileged remote
+	// procedure calls and denied requests
+	rpcAccessEventType = "rpc"
+	// rpcTokenLength is the number of random bytes in a generated API token
+	rpcTokenLength = 32
+	// rpcTokenIdentityPrefix prefixes the name of an API token when used as
+	// the caller's identity, keeping it distinct from usernames
+	rpcTokenIdentityPrefix = "token:"
+)
+
+var (
+	errRPCPermissionDenied = errors.New("permission denied")
+	errRPCTokenInvalid     = errors.New("invalid API token")
+	errRPCTokenExpired     = errors.New("API token has expired")
+	errRPCTokenNotFound    = errors.New("API token not found")
+	errRPCTokenNameUnset   = errors.New("API token name unset")
+	errRPCTokenExists      = errors.New("API token name already in use")
+	errRPCTokenExpiry      = errors.New("API token expiry must be in the future")
+	errRPCTokenOwnerUnset  = errors.New("API token has no owner")
+	errRPCTokenNotSaved    = errors.New("API token change could not be saved to the config file")
+)
+
+// rpcRoleKey is the context key for the authenticated caller's role
+type rpcRoleKey struct{}
+
+// rpcPermissions holds the permission required by each remote procedure.
+// Remote procedures not listed require the admin permission
+var rpcPermissions = map[string]auth.Permission{
+	"GetInfo":                           auth.PermissionRead,
+	"GetSubsystems":                     auth.PermissionRead,
+	"GetRPCEndpoints":                   auth.PermissionRead,
+	"GetCommunicationRelayers":          auth.PermissionRead,
+	"GetExchanges":                      auth.PermissionRead,
+	"GetExchangeInfo":                   auth.PermissionRead,
+	"GetTicker":                         auth.PermissionRead,
+	"GetTickers":                        auth.PermissionRead,
+	"GetOrderbook":                      auth.PermissionRead,
+	"GetOrderbooks":                     auth.PermissionRead,
+	"GetAccountInfo":                    auth.PermissionRead,
+	"UpdateAccountInfo":                 auth.PermissionRead,
+	"GetAccountInfoStream":              auth.PermissionRead,
+	"GetPortfolio":                      auth.PermissionRead,
+	"GetPortfolioSummary":               auth.PermissionRead,
+	"GetForexProviders":                 auth.PermissionRead,
+	"GetForexRates":                     auth.PermissionRead,
+	"GetOrders":                         auth.PermissionRead,
+	"GetOrder":                          auth.PermissionRead,
+	"SimulateOrder":                     auth.PermissionRead,
+	"WhaleBomb":                         auth.PermissionRead,
+	"GetEvents":                         auth.PermissionRead,
+	"GetCryptocurrencyDepositAddresses": auth.PermissionRead,
+	"GetCryptocurrencyDepositAddress":   auth.PermissionRead,
+	"WithdrawalEventByID":               auth.PermissionRead,
+	"WithdrawalEventsByExchange":        auth.PermissionRead,
+	"WithdrawalEventsByDate":            auth.PermissionRead,
+	"WithdrawalEventsByApprovalStatus":  auth.PermissionRead,
+	"DepositEventsByExchange":           auth.PermissionRead,
+	"GetRebalancePlan":                  auth.PermissionRead,
+	"GetPortfolioHistory":               auth.PermissionRead,
+	"GetTaxReport":                      auth.PermissionRead,
+	"GetLoggerDetails":                  auth.PermissionRead,
+	"GetExchangePairs":                  auth.PermissionRead,
+	"GetOrderbookStream":                auth.PermissionRead,
+	"GetExchangeOrderbookStream":        auth.PermissionRead,
+	"GetConsolidatedOrderbookStream":    auth.PermissionRead,
+	"GetTickerStream":                   auth.PermissionRead,
+	"GetExchangeTickerStream":           auth.PermissionRead,
+	"GetAuditEvent":                     auth.PermissionRead,
+	"GCTScriptStatus":                   auth.PermissionRead,
+	"GCTScriptListAll":                  auth.PermissionRead,
+	"GCTScriptListVersions":             auth.PermissionRead,
+	"GCTScriptDiffVersions":             auth.PermissionRead,
+	"GCTScriptListSchedules":            auth.PermissionRead,
+	"GetHistoricCandles":                auth.PermissionRead,
+	"GetExchangeAssets":                 auth.PermissionRead,
+	"WebsocketGetInfo":                  auth.PermissionRead,
+	"WebsocketGetSubscriptions":         auth.PermissionRead,
+	"GetRecentTrades":                   auth.PermissionRead,
+	"GetHistoricTrades":                 auth.PermissionRead,
+	"GetSavedTrades":                    auth.PermissionRead,
+	"FindMissingSavedCandleIntervals":   auth.PermissionRead,
+	"FindMissingSavedTradeIntervals":    auth.PermissionRead,
+	"GetDataHistoryJobDetails":          auth.PermissionRead,
+	"GetActiveDataHistoryJobs":          auth.PermissionRead,
+	"GetDataHistoryJobsBetween":         auth.PermissionRead,
+	"GetOrderbookAnalytics":             auth.PermissionRead,
+
+	"SubmitOrder":       auth.PermissionTrade,
+	"CancelOrder":       auth.PermissionTrade,
+	"CancelBatchOrders": auth.PermissionTrade,
+	"CancelAllOrders":   auth.PermissionTrade,
+	"AddEvent":          auth.PermissionTrade,
+	"RemoveEvent":       auth.PermissionTrade,
+
+	"WithdrawFiatFunds":           auth.PermissionWithdraw,
+	"WithdrawCryptocurrencyFunds": auth.PermissionWithdraw,
+	"ApproveWithdrawal":           auth.PermissionWithdraw,
+	"RejectWithdrawal":            auth.PermissionWithdraw,
+	"ExecuteRebalancePlan":        auth.PermissionWithdraw,
+}
@@ -0,0 +1,194 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// newTLSReloader loads the server certificate and, when client certificates
+// are enabled, the client CA from the TLS directory
+func newTLSReloader(targetDir string, cfg *config.GRPCClientCertConfig) (*tlsReloader, error) {
+	t := &tlsReloader{
+		certFile:   filepath.Join(targetDir, "cert.pem"),
+		keyFile:    filepath.Join(targetDir, "key.pem"),
+		clientAuth: tls.NoClientCert,
+	}
+	if cfg.Enabled {
+		t.caFile = cfg.CAFile
+		if t.caFile == "" {
+			t.caFile = filepath.Join(targetDir, defaultClientCAFile)
+		}
+		t.clientAuth = tls.VerifyClientCertIfGiven
+		if cfg.Required {
+			t.clientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	if _, err := t.reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// reload loads any certificate, key or CA file modified since it was last
+// loaded, returning whether anything changed. The previous certificates are
+// kept when loading fails, such as part way through a rotation
+func (t *tlsReloader) reload() (bool, error) {
+	certModTime, err := modTime(t.certFile)
+	if err != nil {
+		return false, err
+	}
+	keyModTime, err := modTime(t.keyFile)
+	if err != nil {
+		return false, err
+	}
+	var caModTime time.Time
+	if t.caFile != "" {
+		caModTime, err = modTime(t.caFile)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	t.m.Lock()
+	defer t.m.Unlock()
+	var changed bool
+	if t.cert == nil || !certModTime.Equal(t.certModTime) || !keyModTime.Equal(t.keyModTime) {
+		cert, err := tls.LoadX509KeyPair(t.certFile, t.keyFile)
+		if err != nil {
+			return false, fmt.Errorf("unable to load gRPC TLS key pair: %w", err)
+		}
+		t.cert = &cert
+		t.certModTime = certModTime
+		t.keyModTime = keyModTime
+		changed = true
+	}
+	if t.caFile != "" && (t.caPool == nil || !caModTime.Equal(t.caModTime)) {
+		pemData, err := ioutil.ReadFile(t.caFile)
+		if err != nil {
+			return changed, fmt.Errorf("unable to open client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pemData) {
+			return changed, fmt.Errorf("%s %w", t.caFile, errClientCAInvalid)
+		}
+		t.caPool = pool
+		t.caModTime = caModTime
+		changed = true
+	}
+	return changed, nil
+}
+
+// getConfigForClient returns the TLS config for a new connection using the
+// latest certificates on disk
+func (t *tlsReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	changed, err := t.reload()
+	if err != nil {
+		log.Errorf(log.GRPCSys, "gRPC server using previous TLS certificates: %v\n", err)
+	} else if changed {
+		log.Infoln(log.GRPCSys, "gRPC server TLS certificates reloaded.")
+	}
+	t.m.RLock()
+	defer t.m.RUnlock()
+	return &tls.Config{
+		Certificates: []tls.Certificate{*t.cert},
+		ClientCAs:    t.caPool,
+		ClientAuth:   t.clientAuth,
+		NextProtos:   []string{"h2"},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// serverConfig returns the gRPC server TLS config
+func (t *tlsReloader) serverConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: t.getConfigForClient,
+		MinVersion:         tls.VersionTLS12,
+	}
+}
+
+// proxyConfig returns the TLS config the gRPC proxy uses to connect to the
+// gRPC server. The server is trusted when it presents the currently loaded
+// certificate, so the proxy keeps working after the certificate is rotated.
+// When client certificates are enabled the proxy presents the grpcproxy
+// client certificate from the TLS directory
+func (t *tlsReloader) proxyConfig(targetDir string) (*tls.Config, error) {
+	cfg := &tls.Config{
+		InsecureSkipVerify: true, // nolint:gosec // verified by VerifyPeerCertificate
+		MinVersion:         tls.VersionTLS12,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			t.m.RLock()
+			defer t.m.RUnlock()
+			if len(rawCerts) == 0 || len(t.cert.Certificate) == 0 ||
+				!bytes.Equal(rawCerts[0], t.cert.Certificate[0]) {
+				return errServerCertMismatch
+			}
+			return nil
+		},
+	}
+	if t.clientAuth == tls.NoClientCert {
+		return cfg, nil
+	}
+	certFile := filepath.Join(targetDir, proxyClientCertName+".pem")
+	keyFile := filepath.Join(targetDir, proxyClientCertName+"_key.pem")
+	if !file.Exists(certFile) || !file.Exists(keyFile) {
+		if t.clientAuth == tls.RequireAndVerifyClientCert {
+			return nil, fmt.Errorf("%w, issue one named %s with gen_cert", errProxyClientCertAbsent, proxyClientCertName)
+		}
+		return cfg, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Certificates = []tls.Certificate{cert}
+	return cfg, nil
+}
+
+// certificateIdentity returns the common name of the verified client
+// certificate presented on the connection, if any
+func certificateIdentity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName, true
+}
+
+// certificateUser returns the role of the remote control user named by a
+// client certificate
+func (bot *Engine) certificateUser(commonName string) (auth.Role, error) {
+	if commonName == "" {
+		return "", errNoCertificateUser
+	}
+	role, ok := bot.remoteControlRole(commonName)
+	if !ok {
+		return "", fmt.Errorf("%s %w", commonName, errNoCertificateUser)
+	}
+	return role, nil
+}
+
+func modTime(path string) (time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
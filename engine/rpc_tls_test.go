@@ -0,0 +1,254 @@
+package engine
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// writeTestCert signs a certificate with the parent, or itself when the
+// parent is nil, and writes the certificate and key files
+func writeTestCert(t *testing.T, certFile, keyFile, commonName string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = file.Write(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})); err != nil {
+		t.Fatal(err)
+	}
+	if err = file.Write(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b})); err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestTLSReloader(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-tls-reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, err = newTLSReloader(dir, &config.GRPCClientCertConfig{})
+	if err == nil {
+		t.Fatal("expected error without certificate files")
+	}
+	if err = genCert(dir); err != nil {
+		t.Fatal(err)
+	}
+	_, err = newTLSReloader(dir, &config.GRPCClientCertConfig{Enabled: true})
+	if err == nil {
+		t.Fatal("expected error without client CA file")
+	}
+
+	writeTestCert(t, filepath.Join(dir, defaultClientCAFile), filepath.Join(dir, "ca_key.pem"), "ca", true, nil, nil)
+	r, err := newTLSReloader(dir, &config.GRPCClientCertConfig{Enabled: true, Required: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := r.getConfigForClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ClientAuth != tls.RequireAndVerifyClientCert || cfg.ClientCAs == nil {
+		t.Errorf("unexpected client auth %v", cfg.ClientAuth)
+	}
+	original := cfg.Certificates[0].Certificate[0]
+
+	// Rotate the server certificate
+	if err = genCert(dir); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Minute)
+	for _, f := range []string{"cert.pem", "key.pem"} {
+		if err = os.Chtimes(filepath.Join(dir, f), future, future); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfg, err = r.getConfigForClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(cfg.Certificates[0].Certificate[0]) == string(original) {
+		t.Error("expected server certificate to be reloaded")
+	}
+
+	// A broken rotation keeps serving the loaded certificate
+	if err = file.Write(filepath.Join(dir, "key.pem"), []byte("invalid")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chtimes(filepath.Join(dir, "key.pem"), future.Add(time.Minute), future.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	rotated := cfg.Certificates[0].Certificate[0]
+	cfg, err = r.getConfigForClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(cfg.Certificates[0].Certificate[0]) != string(rotated) {
+		t.Error("expected previous server certificate to be kept")
+	}
+
+	_, err = r.proxyConfig(dir)
+	if !errors.Is(err, errProxyClientCertAbsent) {
+		t.Errorf("received %v expected %v", err, errProxyClientCertAbsent)
+	}
+}
+
+func TestCertificateUser(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: &config.Config{}}
+	bot.Config.RemoteControl.Username = "admin"
+	bot.Config.RemoteControl.Users = []config.RPCUser{{Username: "bob"}, {Username: "alice", Role: auth.RoleTrader}}
+	for name, expected := range map[string]auth.Role{"admin": auth.RoleAdmin, "bob": auth.RoleReadOnly, "alice": auth.RoleTrader} {
+		role, err := bot.certificateUser(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if role != expected {
+			t.Errorf("%s received %v expected %v", name, role, expected)
+		}
+	}
+	if _, err := bot.certificateUser("mallory"); !errors.Is(err, errNoCertificateUser) {
+		t.Errorf("received %v expected %v", err, errNoCertificateUser)
+	}
+}
+
+func TestClientCertificateAuthentication(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-mtls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = genCert(dir); err != nil {
+		t.Fatal(err)
+	}
+	ca, caKey := writeTestCert(t, filepath.Join(dir, defaultClientCAFile), filepath.Join(dir, "ca_key.pem"), "ca", true, nil, nil)
+	writeTestCert(t, filepath.Join(dir, "admin.pem"), filepath.Join(dir, "admin_key.pem"), "admin", false, ca, caKey)
+	writeTestCert(t, filepath.Join(dir, "mallory.pem"), filepath.Join(dir, "mallory_key.pem"), "mallory", false, ca, caKey)
+	writeTestCert(t, filepath.Join(dir, "self.pem"), filepath.Join(dir, "self_key.pem"), "admin", false, nil, nil)
+
+	bot := &Engine{Config: &config.Config{}}
+	bot.Config.RemoteControl.Username = "admin"
+	bot.Config.RemoteControl.Password = "pass"
+	r, err := newTLSReloader(dir, &config.GRPCClientCertConfig{Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(r.serverConfig())),
+		grpc.ChainUnaryInterceptor(grpcauth.UnaryServerInterceptor(bot.authenticateClient), authoriseUnaryRPC),
+	)
+	gctrpc.RegisterGoCryptoTraderServer(server, &RPCServer{Engine: bot, certs: r})
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(lis) // nolint:errcheck // stopped below
+	defer server.Stop()
+
+	serverPEM, err := ioutil.ReadFile(filepath.Join(dir, "cert.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(serverPEM)
+	call := func(clientCert string, creds credentials.PerRPCCredentials) error {
+		cfg := &tls.Config{RootCAs: pool, ServerName: "localhost", MinVersion: tls.VersionTLS12}
+		if clientCert != "" {
+			cert, err := tls.LoadX509KeyPair(filepath.Join(dir, clientCert+".pem"), filepath.Join(dir, clientCert+"_key.pem"))
+			if err != nil {
+				return err
+			}
+			cfg.Certificates = []tls.Certificate{cert}
+		}
+		opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(cfg))}
+		if creds != nil {
+			opts = append(opts, grpc.WithPerRPCCredentials(creds))
+		}
+		conn, err := grpc.Dial(lis.Addr().String(), opts...)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		_, err = gctrpc.NewGoCryptoTraderClient(conn).GetAPITokens(ctx, &gctrpc.GetAPITokensRequest{})
+		return err
+	}
+
+	if err = call("admin", nil); err != nil {
+		t.Errorf("client certificate: %v", err)
+	}
+	if err = call("", auth.BasicAuth{Username: "admin", Password: "pass"}); err != nil {
+		t.Errorf("optional client certificate with basic auth: %v", err)
+	}
+	if err = call("mallory", nil); err == nil {
+		t.Error("expected error for certificate without a user")
+	}
+	if err = call("self", nil); err == nil {
+		t.Error("expected error for certificate not signed by the CA")
+	}
+	if err = call("", nil); err == nil {
+		t.Error("expected error without credentials")
+	}
+
+	proxyCfg, err := r.proxyConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := tls.Dial("tcp", lis.Addr().String(), proxyCfg)
+	if err != nil {
+		t.Fatalf("proxy should trust the loaded server certificate: %v", err)
+	}
+	conn.Close()
+}
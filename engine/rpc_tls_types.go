@@ -0,0 +1,43 @@
+package engine
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultClientCAFile is the CA certificate file in the TLS directory used
+	// to verify client certificates
+	defaultClientCAFile = "ca.pem"
+	// proxyClientCertName is the name of the client certificate and key files
+	// in the TLS directory presented by the gRPC proxy when client
+	// certificates are enabled
+	proxyClientCertName = "grpcproxy"
+)
+
+var (
+	errClientCAInvalid       = errors.New("no certificates found in client CA file")
+	errNoCertificateUser     = errors.New("no remote control user for client certificate")
+	errServerCertMismatch    = errors.New("gRPC server certificate does not match the loaded certificate")
+	errProxyClientCertAbsent = errors.New("gRPC proxy client certificate missing")
+)
+
+// tlsReloader serves the gRPC server certificate and client CA pool,
+// reloading them when their files change on disk so certificates can be
+// rotated without restarting the engine
+type tlsReloader struct {
+	certFile   string
+	keyFile    string
+	caFile     string
+	clientAuth tls.ClientAuthType
+
+	m           sync.RWMutex
+	cert        *tls.Certificate
+	caPool      *x509.CertPool
+	certModTime time.Time
+	keyModTime  time.Time
+	caModTime   time.Time
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -28,7 +29,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	depositDataStore "github.com/thrasher-corp/gocryptotrader/database/repository/deposit"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptversion"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -43,6 +47,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/taxlot"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
 	"google.golang.org/grpc"
@@ -62,12 +67,14 @@ var (
 	errDispatchSystem       = errors.New("dispatch system offline")
 	errCurrencyNotEnabled   = errors.New("currency not enabled")
 	errCurrencyPairInvalid  = errors.New("currency provided is not found in the available pairs list")
+	errCurrencyNotSpecified = errors.New("currency not specified")
 )
 
 // RPCServer struct
 type RPCServer struct {
 	*Engine
 	gctrpc.UnimplementedGoCryptoTraderServer
+	certs *tlsReloader
 }
 
 func (bot *Engine) authenticateClient(ctx context.Context) (context.Context, error) {
@@ -78,26 +85,77 @@ func (bot *Engine) authenticateClient(ctx context.Context) (context.Context, err
 
 	authStr, ok := md["authorization"]
 	if !ok {
-		return ctx, fmt.Errorf("authorization header missing")
+		commonName, hasCert := certificateIdentity(ctx)
+		if !hasCert {
+			return ctx, fmt.Errorf("authorization header missing")
+		}
+		role, err := bot.certificateUser(commonName)
+		if err != nil {
+			return ctx, err
+		}
+		ctx = context.WithValue(ctx, rpcUserKey{}, commonName)
+		return context.WithValue(ctx, rpcRoleKey{}, role), nil
 	}
 
-	if !strings.Contains(authStr[0], "Basic") {
-		return ctx, fmt.Errorf("basic not found in authorization header")
-	}
+	var username string
+	var role auth.Role
+	switch {
+	case strings.HasPrefix(authStr[0], "Bearer "):
+		var err error
+		username, role, err = bot.validRPCToken(strings.TrimPrefix(authStr[0], "Bearer "))
+		if err != nil {
+			return ctx, err
+		}
+	case strings.Contains(authStr[0], "Basic"):
+		fields := strings.Split(authStr[0], " ")
+		if len(fields) != 2 {
+			return ctx, fmt.Errorf("malformed authorization header")
+		}
+		decoded, err := crypto.Base64Decode(fields[1])
+		if err != nil {
+			return ctx, fmt.Errorf("unable to base64 decode authorization header")
+		}
 
-	decoded, err := crypto.Base64Decode(strings.Split(authStr[0], " ")[1])
-	if err != nil {
-		return ctx, fmt.Errorf("unable to base64 decode authorization header")
+		creds := strings.SplitN(string(decoded), ":", 2)
+		if len(creds) != 2 {
+			return ctx, fmt.Errorf("username/password mismatch")
+		}
+		username = creds[0]
+		role, ok = bot.validRPCCredentials(username, creds[1])
+		if !ok {
+			return ctx, fmt.Errorf("username/password mismatch")
+		}
+	default:
+		return ctx, fmt.Errorf("basic or bearer not found in authorization header")
 	}
 
-	username := strings.Split(string(decoded), ":")[0]
-	password := strings.Split(string(decoded), ":")[1]
+	ctx = context.WithValue(ctx, rpcUserKey{}, username)
+	return context.WithValue(ctx, rpcRoleKey{}, role), nil
+}
+
+// rpcUserKey is the context key for the authenticated gRPC username
+type rpcUserKey struct{}
 
-	if username != bot.Config.RemoteControl.Username || password != bot.Config.RemoteControl.Password {
-		return ctx, fmt.Errorf("username/password mismatch")
+// validRPCCredentials checks the credentials against the primary remote
+// control user, who is an admin, and any additional users, returning the
+// user's role
+func (bot *Engine) validRPCCredentials(username, password string) (auth.Role, bool) {
+	if username == bot.Config.RemoteControl.Username && password == bot.Config.RemoteControl.Password {
+		return auth.RoleAdmin, true
 	}
+	for x := range bot.Config.RemoteControl.Users {
+		if username == bot.Config.RemoteControl.Users[x].Username &&
+			password == bot.Config.RemoteControl.Users[x].Password {
+			return rpcUserRole(&bot.Config.RemoteControl.Users[x]), true
+		}
+	}
+	return "", false
+}
 
-	return ctx, nil
+// rpcUsername returns the authenticated gRPC username from the context
+func rpcUsername(ctx context.Context) string {
+	username, _ := ctx.Value(rpcUserKey{}).(string)
+	return username
 }
 
 // StartRPCServer starts a gRPC server with TLS auth
@@ -116,18 +174,19 @@ func StartRPCServer(engine *Engine) {
 		return
 	}
 
-	creds, err := credentials.NewServerTLSFromFile(filepath.Join(targetDir, "cert.pem"), filepath.Join(targetDir, "key.pem"))
+	certs, err := newTLSReloader(targetDir, &engine.Config.RemoteControl.GRPC.ClientCertificates)
 	if err != nil {
 		log.Errorf(log.GRPCSys, "gRPC server could not load TLS keys: %s\n", err)
 		return
 	}
 
 	opts := []grpc.ServerOption{
-		grpc.Creds(creds),
-		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(engine.authenticateClient)),
+		grpc.Creds(credentials.NewTLS(certs.serverConfig())),
+		grpc.ChainUnaryInterceptor(grpcauth.UnaryServerInterceptor(engine.authenticateClient), authoriseUnaryRPC),
+		grpc.ChainStreamInterceptor(grpcauth.StreamServerInterceptor(engine.authenticateClient), authoriseStreamRPC),
 	}
 	server := grpc.NewServer(opts...)
-	s := RPCServer{Engine: engine}
+	s := RPCServer{Engine: engine, certs: certs}
 	gctrpc.RegisterGoCryptoTraderServer(server, &s)
 
 	go func() {
@@ -148,20 +207,16 @@ func StartRPCServer(engine *Engine) {
 func (s *RPCServer) StartRPCRESTProxy() {
 	log.Debugf(log.GRPCSys, "gRPC proxy server support enabled. Starting gRPC proxy server on http://%v.\n", s.Config.RemoteControl.GRPC.GRPCProxyListenAddress)
 
-	targetDir := utils.GetTLSDir(s.Settings.DataDir)
-	creds, err := credentials.NewClientTLSFromFile(filepath.Join(targetDir, "cert.pem"), "")
+	tlsConfig, err := s.certs.proxyConfig(utils.GetTLSDir(s.Settings.DataDir))
 	if err != nil {
 		log.Errorf(log.GRPCSys, "Unabled to start gRPC proxy. Err: %s\n", err)
 		return
 	}
 
+	// Clients authenticate with their own basic or bearer authorisation
+	// header, which the proxy forwards to the gRPC server
 	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
-			Username: s.Config.RemoteControl.Username,
-			Password: s.Config.RemoteControl.Password,
-		}),
-	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
 	err = gctrpc.RegisterGoCryptoTraderHandlerFromEndpoint(context.Background(),
 		mux, s.Config.RemoteControl.GRPC.ListenAddress, opts)
 	if err != nil {
@@ -1316,7 +1371,7 @@ func (s *RPCServer) GetCryptocurrencyDepositAddress(_ context.Context, r *gctrpc
 
 // WithdrawCryptocurrencyFunds withdraws cryptocurrency funds specified by
 // exchange
-func (s *RPCServer) WithdrawCryptocurrencyFunds(_ context.Context, r *gctrpc.WithdrawCryptoRequest) (*gctrpc.WithdrawResponse, error) {
+func (s *RPCServer) WithdrawCryptocurrencyFunds(ctx context.Context, r *gctrpc.WithdrawCryptoRequest) (*gctrpc.WithdrawResponse, error) {
 	exch := s.GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
@@ -1328,6 +1383,7 @@ func (s *RPCServer) WithdrawCryptocurrencyFunds(_ context.Context, r *gctrpc.Wit
 		Currency:    currency.NewCode(strings.ToUpper(r.Currency)),
 		Type:        withdraw.Crypto,
 		Description: r.Description,
+		RequestedBy: rpcUsername(ctx),
 		Crypto: withdraw.CryptoRequest{
 			Address:    r.Address,
 			AddressTag: r.AddressTag,
@@ -1347,7 +1403,7 @@ func (s *RPCServer) WithdrawCryptocurrencyFunds(_ context.Context, r *gctrpc.Wit
 }
 
 // WithdrawFiatFunds withdraws fiat funds specified by exchange
-func (s *RPCServer) WithdrawFiatFunds(_ context.Context, r *gctrpc.WithdrawFiatRequest) (*gctrpc.WithdrawResponse, error) {
+func (s *RPCServer) WithdrawFiatFunds(ctx context.Context, r *gctrpc.WithdrawFiatRequest) (*gctrpc.WithdrawResponse, error) {
 	exch := s.GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errExchangeNotLoaded
@@ -1372,6 +1428,7 @@ func (s *RPCServer) WithdrawFiatFunds(_ context.Context, r *gctrpc.WithdrawFiatR
 		Currency:    currency.NewCode(strings.ToUpper(r.Currency)),
 		Type:        withdraw.Fiat,
 		Description: r.Description,
+		RequestedBy: rpcUsername(ctx),
 		Fiat: withdraw.FiatRequest{
 			Bank: *bankAccount,
 		},
@@ -1412,6 +1469,10 @@ func (s *RPCServer) WithdrawalEventByID(_ context.Context, r *gctrpc.WithdrawalE
 				Amount:      v.RequestDetails.Amount,
 				Type:        int32(v.RequestDetails.Type),
 			},
+			ApprovalStatus: v.ApprovalStatus,
+			RequestedBy:    v.RequestDetails.RequestedBy,
+			Approvals:      parseWithdrawalApprovals(v.Approvals),
+			TrackingStatus: v.TrackingStatus,
 		},
 	}
 	createdAtPtype, err := ptypes.TimestampProto(v.CreatedAt)
@@ -1432,6 +1493,7 @@ func (s *RPCServer) WithdrawalEventByID(_ context.Context, r *gctrpc.WithdrawalE
 			Address:    v.RequestDetails.Crypto.Address,
 			AddressTag: v.RequestDetails.Crypto.AddressTag,
 			Fee:        v.RequestDetails.Crypto.FeeAmount,
+			TxId:       v.Exchange.TxID,
 		}
 	} else if v.RequestDetails.Type == withdraw.Fiat {
 		if v.RequestDetails.Fiat != (withdraw.FiatRequest{}) {
@@ -1504,6 +1566,306 @@ func (s *RPCServer) WithdrawalEventsByDate(_ context.Context, r *gctrpc.Withdraw
 	return parseMultipleEvents(ret), nil
 }
 
+// DepositEventsByExchange returns deposits stored by the deposit tracker, or
+// the exchange's deposit history when database support is disabled
+func (s *RPCServer) DepositEventsByExchange(_ context.Context, r *gctrpc.DepositEventsByExchangeRequest) (*gctrpc.DepositEventsByExchangeResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	if !s.Config.Database.Enabled {
+		if r.Currency == "" {
+			return nil, errCurrencyNotSpecified
+		}
+		ret, err := exch.GetDepositHistory(currency.NewCode(strings.ToUpper(r.Currency)))
+		if err != nil {
+			return nil, err
+		}
+		return parseDepositHistory(ret, exch.GetName(), int(r.Limit)), nil
+	}
+	ret, err := depositDataStore.GetByExchange(exch.GetName(), r.Currency, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return parseDepositEvents(ret, exch.GetName()), nil
+}
+
+// WithdrawalEventsByApprovalStatus returns withdrawal requests by approval
+// status, defaulting to requests pending approval
+func (s *RPCServer) WithdrawalEventsByApprovalStatus(_ context.Context, r *gctrpc.WithdrawalEventsByApprovalStatusRequest) (*gctrpc.WithdrawalEventsByExchangeResponse, error) {
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	status := strings.ToLower(r.Status)
+	if status == "" {
+		status = withdraw.ApprovalPending
+	}
+	ret, err := WithdrawalEventsByApprovalStatus(status, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return parseMultipleEvents(ret), nil
+}
+
+// ApproveWithdrawal approves a pending withdrawal request as the
+// authenticated user, submitting it once it has the required approvals
+func (s *RPCServer) ApproveWithdrawal(ctx context.Context, r *gctrpc.WithdrawalApprovalRequest) (*gctrpc.WithdrawResponse, error) {
+	resp, err := s.ApprovePendingWithdrawal(r.Id, rpcUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{
+		Id:     resp.ID.String(),
+		Status: resp.Exchange.Status,
+	}, nil
+}
+
+// RejectWithdrawal rejects a pending withdrawal request as the authenticated
+// user
+func (s *RPCServer) RejectWithdrawal(ctx context.Context, r *gctrpc.WithdrawalApprovalRequest) (*gctrpc.WithdrawResponse, error) {
+	resp, err := s.RejectPendingWithdrawal(r.Id, rpcUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{
+		Id:     resp.ID.String(),
+		Status: resp.Exchange.Status,
+	}, nil
+}
+
+// GetRebalancePlan returns the transfers which would restore a currency's
+// target allocation across exchanges without submitting them
+func (s *RPCServer) GetRebalancePlan(ctx context.Context, r *gctrpc.RebalancePlanRequest) (*gctrpc.RebalancePlanResponse, error) {
+	plan, err := s.PlanRebalance(currency.NewCode(strings.ToUpper(r.Currency)))
+	if err != nil {
+		return nil, err
+	}
+	audit.Event(rpcUsername(ctx), rebalancerEventType, "Rebalance plan requested: "+plan.describe())
+	return parseRebalancePlan(plan), nil
+}
+
+// ExecuteRebalancePlan submits the transfers of the reviewed rebalance plan
+// as withdrawals requested by the authenticated user. The plan is refused when
+// its transfers have changed since it was returned by GetRebalancePlan. When a
+// transfer fails after others were submitted, the submitted withdrawals are
+// returned with the error
+func (s *RPCServer) ExecuteRebalancePlan(ctx context.Context, r *gctrpc.ExecuteRebalancePlanRequest) (*gctrpc.ExecuteRebalancePlanResponse, error) {
+	if !s.Rebalancer.Started() {
+		return nil, errRebalancerNotStarted
+	}
+	if r.PlanId == "" {
+		return nil, errRebalancePlanIDUnset
+	}
+	plan, err := s.PlanRebalance(currency.NewCode(strings.ToUpper(r.Currency)))
+	if err != nil {
+		return nil, err
+	}
+	if plan.ID != r.PlanId {
+		return nil, fmt.Errorf("%s %w, review plan %s", r.PlanId, errRebalancePlanChanged, plan.ID)
+	}
+	username := rpcUsername(ctx)
+	audit.Event(username, rebalancerEventType, "Rebalance plan executed: "+plan.describe())
+	withdrawals, err := s.ExecuteRebalance(plan, username)
+	if err != nil && len(withdrawals) == 0 {
+		return nil, err
+	}
+	resp := &gctrpc.ExecuteRebalancePlanResponse{Plan: parseRebalancePlan(plan)}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	for i := range withdrawals {
+		resp.Withdrawals = append(resp.Withdrawals, &gctrpc.WithdrawResponse{
+			Id:     withdrawals[i].ID.String(),
+			Status: withdrawals[i].Exchange.Status,
+		})
+	}
+	return resp, nil
+}
+
+// GetPortfolioHistory returns the stored portfolio valuations taken between
+// the start and end times, oldest first. The fiat currency defaults to the
+// portfolio history manager's valuation currency
+func (s *RPCServer) GetPortfolioHistory(_ context.Context, r *gctrpc.GetPortfolioHistoryRequest) (*gctrpc.GetPortfolioHistoryResponse, error) {
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", r.Start, errInvalidTimes)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", r.End, errInvalidTimes)
+	}
+	if start.After(end) {
+		return nil, errInvalidTimes
+	}
+	fiat := r.FiatCurrency
+	if fiat == "" {
+		fiat = s.Config.PortfolioHistory.FiatCurrency
+	}
+	if fiat == "" {
+		fiat = s.Config.Currency.FiatDisplayCurrency.String()
+	}
+	snapshots, err := portfoliosnapshot.GetInRange(fiat, start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPortfolioHistoryResponse{FiatCurrency: strings.ToUpper(fiat)}
+	for i := range snapshots {
+		point := &gctrpc.PortfolioHistoryPoint{
+			Timestamp:     snapshots[i].Timestamp.UTC().Format(common.SimpleTimeFormatWithTimezone),
+			TotalValue:    snapshots[i].TotalValue,
+			RealisedPnl:   snapshots[i].RealisedPNL,
+			UnrealisedPnl: snapshots[i].UnrealisedPNL,
+		}
+		for j := range snapshots[i].Holdings {
+			point.Holdings = append(point.Holdings, &gctrpc.PortfolioHoldingValue{
+				Currency:      snapshots[i].Holdings[j].Currency,
+				Amount:        snapshots[i].Holdings[j].Amount,
+				Price:         snapshots[i].Holdings[j].Price,
+				Value:         snapshots[i].Holdings[j].Value,
+				RealisedPnl:   snapshots[i].Holdings[j].RealisedPNL,
+				UnrealisedPnl: snapshots[i].Holdings[j].UnrealisedPNL,
+			})
+		}
+		resp.History = append(resp.History, point)
+	}
+	return resp, nil
+}
+
+// GetTaxReport calculates the realised gains of disposals made in the tax
+// year using the lot matching method, valued in the fiat currency. Lots
+// acquired before the tax year are included in the matching
+func (s *RPCServer) GetTaxReport(_ context.Context, r *gctrpc.GetTaxReportRequest) (*gctrpc.GetTaxReportResponse, error) {
+	if r.Year <= 0 {
+		return nil, errInvalidTaxYear
+	}
+	method, err := taxlot.ParseMethod(r.Method)
+	if err != nil {
+		return nil, err
+	}
+	yearStart := r.YearStart
+	if yearStart == "" {
+		yearStart = defaultTaxYearStart
+	}
+	monthDay, err := time.Parse("01-02", yearStart)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", yearStart, errInvalidTaxYearStart)
+	}
+	start, end, err := taxlot.TaxYear(int(r.Year), monthDay.Month(), monthDay.Day(), time.UTC)
+	if err != nil {
+		return nil, err
+	}
+	fiat := r.FiatCurrency
+	if fiat == "" {
+		fiat = s.Config.PortfolioHistory.FiatCurrency
+	}
+	if fiat == "" {
+		fiat = s.Config.Currency.FiatDisplayCurrency.String()
+	}
+	fiatCode := currency.NewCode(fiat).Upper()
+	events, err := s.TaxLotEvents(fiatCode, taxReportOptions{
+		End:                    end,
+		IncludeExchangeHistory: r.IncludeExchangeHistory,
+	})
+	if err != nil {
+		return nil, err
+	}
+	report, err := taxlot.Calculate(events, method)
+	if err != nil {
+		return nil, err
+	}
+	disposals, summary := report.DisposalsBetween(start, end)
+	resp := &gctrpc.GetTaxReportResponse{
+		FiatCurrency:  fiatCode.String(),
+		Method:        string(method),
+		Start:         start.Format(common.SimpleTimeFormat),
+		End:           end.Format(common.SimpleTimeFormat),
+		TotalProceeds: summary.Proceeds,
+		TotalCost:     summary.Cost,
+		TotalGain:     summary.Gain,
+	}
+	for i := range disposals {
+		var acquired string
+		if !disposals[i].Acquired.IsZero() {
+			acquired = disposals[i].Acquired.UTC().Format(common.SimpleTimeFormatWithTimezone)
+		}
+		resp.Disposals = append(resp.Disposals, &gctrpc.TaxDisposal{
+			Currency: disposals[i].Currency.String(),
+			Exchange: disposals[i].Exchange,
+			Amount:   disposals[i].Amount,
+			Acquired: acquired,
+			Disposed: disposals[i].Disposed.UTC().Format(common.SimpleTimeFormatWithTimezone),
+			Proceeds: disposals[i].Proceeds,
+			Cost:     disposals[i].Cost,
+			Gain:     disposals[i].Gain,
+		})
+	}
+	for i := range report.OpenLots {
+		resp.OpenLots = append(resp.OpenLots, &gctrpc.TaxLot{
+			Currency: report.OpenLots[i].Currency.String(),
+			Exchange: report.OpenLots[i].Exchange,
+			Amount:   report.OpenLots[i].Amount,
+			Cost:     report.OpenLots[i].Cost,
+			Acquired: report.OpenLots[i].Acquired.UTC().Format(common.SimpleTimeFormatWithTimezone),
+		})
+	}
+	return resp, nil
+}
+
+// CreateAPIToken generates a scoped API token. The token is only returned in
+// this response
+func (s *RPCServer) CreateAPIToken(ctx context.Context, r *gctrpc.CreateAPITokenRequest) (*gctrpc.CreateAPITokenResponse, error) {
+	expires, err := time.Parse(common.SimpleTimeFormat, r.Expires)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", r.Expires, errInvalidTimes)
+	}
+	token, err := s.CreateRPCToken(r.Name, rpcUsername(ctx), auth.Role(r.Role), expires)
+	if err != nil {
+		return nil, err
+	}
+	audit.Event(rpcUsername(ctx), rpcAccessEventType, "Created API token "+r.Name+" with role "+strings.ToLower(r.Role))
+	return &gctrpc.CreateAPITokenResponse{
+		Name:    r.Name,
+		Token:   token,
+		Role:    strings.ToLower(r.Role),
+		Expires: expires.Format(common.SimpleTimeFormat),
+	}, nil
+}
+
+// RevokeAPIToken removes an API token by name
+func (s *RPCServer) RevokeAPIToken(ctx context.Context, r *gctrpc.RevokeAPITokenRequest) (*gctrpc.GenericResponse, error) {
+	if err := s.RevokeRPCToken(r.Name); err != nil {
+		return nil, err
+	}
+	audit.Event(rpcUsername(ctx), rpcAccessEventType, "Revoked API token "+r.Name)
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// GetAPITokens returns the name, role and expiry of each API token
+func (s *RPCServer) GetAPITokens(_ context.Context, _ *gctrpc.GetAPITokensRequest) (*gctrpc.GetAPITokensResponse, error) {
+	tokens := s.GetRPCTokens()
+	resp := &gctrpc.GetAPITokensResponse{}
+	now := time.Now()
+	for i := range tokens {
+		resp.Tokens = append(resp.Tokens, &gctrpc.APIToken{
+			Name:    tokens[i].Name,
+			Role:    string(tokens[i].Role),
+			Expires: tokens[i].Expires.UTC().Format(common.SimpleTimeFormat),
+			Expired: !now.Before(tokens[i].Expires),
+		})
+	}
+	return resp, nil
+}
+
+// scriptAuthor returns the authenticated caller recorded as the author of
+// script versions, defaulting to the remote control username for calls made
+// without an authenticated context
+func (s *RPCServer) scriptAuthor(ctx context.Context) string {
+	if username := rpcUsername(ctx); username != "" {
+		return username
+	}
+	return s.Config.RemoteControl.Username
+}
+
 // GetLoggerDetails returns a loggers details
 func (s *RPCServer) GetLoggerDetails(_ context.Context, r *gctrpc.GetLoggerDetailsRequest) (*gctrpc.GetLoggerDetailsResponse, error) {
 	levels, err := log.Level(r.Logger)
@@ -1753,6 +2115,112 @@ func (s *RPCServer) GetExchangeOrderbookStream(r *gctrpc.GetExchangeOrderbookStr
 	}
 }
 
+// GetConsolidatedOrderbookStream streams a single orderbook merged from every
+// exchange trading the requested pair, prices are converted to the requested
+// quote currency
+func (s *RPCServer) GetConsolidatedOrderbookStream(r *gctrpc.GetConsolidatedOrderbookStreamRequest, stream gctrpc.GoCryptoTrader_GetConsolidatedOrderbookStreamServer) error {
+	if r.Pair == nil {
+		return errCurrencyPairUnset
+	}
+	if r.Depth < 0 {
+		return errInvalidArguments
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return err
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	sub, err := s.ConsolidatedOrderbooks.Subscribe(s.Engine, p, a, r.Exchanges)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = sub.Release()
+		if err != nil {
+			log.Errorln(log.GRPCSys, err)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-sub.Pipe.C:
+			if !ok {
+				return errDispatchSystem
+			}
+			ob, ok := (*data.(*interface{})).(ConsolidatedOrderbook)
+			if !ok {
+				return fmt.Errorf("unexpected consolidated orderbook type %T", data)
+			}
+			err = stream.Send(consolidatedOrderbookToRPC(&ob, r.Depth))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// consolidatedOrderbookToRPC converts a consolidated book limiting each side
+// to depth levels, zero depth returns all levels
+func consolidatedOrderbookToRPC(ob *ConsolidatedOrderbook, depth int64) *gctrpc.ConsolidatedOrderbookResponse {
+	bids, asks := ob.Bids, ob.Asks
+	if depth > 0 && int64(len(bids)) > depth {
+		bids = bids[:depth]
+	}
+	if depth > 0 && int64(len(asks)) > depth {
+		asks = asks[:depth]
+	}
+	resp := &gctrpc.ConsolidatedOrderbookResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: ob.Pair.Delimiter,
+			Base:      ob.Pair.Base.String(),
+			Quote:     ob.Pair.Quote.String(),
+		},
+		AssetType:   ob.Asset.String(),
+		Bids:        make([]*gctrpc.ConsolidatedOrderbookItem, len(bids)),
+		Asks:        make([]*gctrpc.ConsolidatedOrderbookItem, len(asks)),
+		Venues:      make([]*gctrpc.ConsolidatedOrderbookVenue, len(ob.Venues)),
+		LastUpdated: ob.LastUpdated.Unix(),
+	}
+	for i := range bids {
+		resp.Bids[i] = &gctrpc.ConsolidatedOrderbookItem{
+			Exchange:         bids[i].Exchange,
+			Pair:             bids[i].Pair.String(),
+			Price:            bids[i].Price,
+			Amount:           bids[i].Amount,
+			OriginalPrice:    bids[i].OriginalPrice,
+			FeeAdjustedPrice: bids[i].FeeAdjustedPrice,
+		}
+	}
+	for i := range asks {
+		resp.Asks[i] = &gctrpc.ConsolidatedOrderbookItem{
+			Exchange:         asks[i].Exchange,
+			Pair:             asks[i].Pair.String(),
+			Price:            asks[i].Price,
+			Amount:           asks[i].Amount,
+			OriginalPrice:    asks[i].OriginalPrice,
+			FeeAdjustedPrice: asks[i].FeeAdjustedPrice,
+		}
+	}
+	for i := range ob.Venues {
+		resp.Venues[i] = &gctrpc.ConsolidatedOrderbookVenue{
+			Exchange:    ob.Venues[i].Exchange,
+			Pair:        ob.Venues[i].Pair.String(),
+			Rate:        ob.Venues[i].Rate,
+			Fee:         ob.Venues[i].Fee,
+			LastUpdated: ob.Venues[i].LastUpdated.Unix(),
+			Subscribed:  ob.Venues[i].Subscribed,
+		}
+	}
+	return resp
+}
+
 // GetTickerStream streams the requested updated ticker
 func (s *RPCServer) GetTickerStream(r *gctrpc.GetTickerStreamRequest, stream gctrpc.GoCryptoTrader_GetTickerStreamServer) error {
 	if r.Exchange == "" {
@@ -1943,7 +2411,7 @@ func (s *RPCServer) GetHistoricCandles(_ context.Context, r *gctrpc.GetHistoricC
 
 	var klineItem kline.Item
 	if r.UseDb {
-		klineItem, err = kline.LoadFromDatabase(r.Exchange,
+		klineItem, err = kline.LoadFromDatabaseWithResample(r.Exchange,
 			pair,
 			a,
 			interval,
@@ -1953,19 +2421,13 @@ func (s *RPCServer) GetHistoricCandles(_ context.Context, r *gctrpc.GetHistoricC
 			return nil, err
 		}
 	} else {
-		if r.ExRequest {
-			klineItem, err = exch.GetHistoricCandlesExtended(pair,
-				a,
-				UTCStartTime,
-				UTCEndTime,
-				interval)
-		} else {
-			klineItem, err = exch.GetHistoricCandles(pair,
-				a,
-				UTCStartTime,
-				UTCEndTime,
-				interval)
-		}
+		klineItem, err = exchange.GetHistoricCandlesWithResample(exch,
+			pair,
+			a,
+			UTCStartTime,
+			UTCEndTime,
+			interval,
+			r.ExRequest)
 	}
 
 	if err != nil {
@@ -2176,11 +2638,16 @@ func (s *RPCServer) GCTScriptStop(_ context.Context, r *gctrpc.GCTScriptStopRequ
 }
 
 // GCTScriptUpload upload a new script to ScriptPath
-func (s *RPCServer) GCTScriptUpload(_ context.Context, r *gctrpc.GCTScriptUploadRequest) (*gctrpc.GenericResponse, error) {
+func (s *RPCServer) GCTScriptUpload(ctx context.Context, r *gctrpc.GCTScriptUploadRequest) (*gctrpc.GenericResponse, error) {
 	if !s.GctScriptManager.Started() {
 		return &gctrpc.GenericResponse{Status: gctscript.ErrScriptingDisabled.Error()}, nil
 	}
 
+	signer, err := s.GctScriptManager.VerifySignature(r.Data, r.Signature)
+	if err != nil {
+		return nil, err
+	}
+
 	fPath := filepath.Join(gctscript.ScriptPath, r.ScriptName)
 	var fPathExits = fPath
 	if filepath.Ext(fPath) == ".zip" {
@@ -2215,6 +2682,7 @@ func (s *RPCServer) GCTScriptUpload(_ context.Context, r *gctrpc.GCTScriptUpload
 	if err != nil {
 		return nil, err
 	}
+	scripts := []string{fPath}
 
 	_, err = newFile.Write(r.Data)
 	if err != nil {
@@ -2249,6 +2717,7 @@ func (s *RPCServer) GCTScriptUpload(_ context.Context, r *gctrpc.GCTScriptUpload
 			}
 			return &gctrpc.GenericResponse{Status: gctscript.ErrScriptFailedValidation, Data: strings.Join(failedFiles, ", ")}, nil
 		}
+		scripts = files
 	} else {
 		err = s.GctScriptManager.Validate(fPath)
 		if err != nil {
@@ -2260,12 +2729,194 @@ func (s *RPCServer) GCTScriptUpload(_ context.Context, r *gctrpc.GCTScriptUpload
 		}
 	}
 
+	author := s.scriptAuthor(ctx)
+	for x := range scripts {
+		if filepath.Ext(scripts[x]) != common.GctExt {
+			continue
+		}
+		data, err := ioutil.ReadFile(scripts[x])
+		if err != nil {
+			return nil, err
+		}
+		v, err := s.GctScriptManager.RecordVersion(gctscript.ScriptName(scripts[x]), data, author, r.Signature, signer)
+		if err != nil {
+			if errors.Is(err, database.ErrDatabaseSupportDisabled) && signer == "" {
+				log.Warnf(log.GCTScriptMgr, "Script %s version history not recorded: %v", filepath.Base(scripts[x]), err)
+				continue
+			}
+			return &gctrpc.GenericResponse{Status: MsgStatusError, Data: err.Error()}, nil
+		}
+		log.Infof(log.GCTScriptMgr, "Script %s version %d uploaded by %s", v.ScriptName, v.Version, v.Author)
+	}
+
 	return &gctrpc.GenericResponse{
 		Status: MsgStatusOK,
 		Data:   fmt.Sprintf("script %s written", newFile.Name()),
 	}, nil
 }
 
+// GCTScriptListVersions lists the stored versions of a script, newest first
+func (s *RPCServer) GCTScriptListVersions(_ context.Context, r *gctrpc.GCTScriptListVersionsRequest) (*gctrpc.GCTScriptListVersionsResponse, error) {
+	if !s.GctScriptManager.Started() {
+		return nil, gctscript.ErrScriptingDisabled
+	}
+	versions, err := s.GctScriptManager.Versions(r.ScriptName)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GCTScriptListVersionsResponse{}
+	for x := range versions {
+		resp.Versions = append(resp.Versions, scriptVersionToRPC(&versions[x]))
+	}
+	return resp, nil
+}
+
+// GCTScriptDiffVersions returns a unified diff between two versions of a
+// script, version zero is the latest version
+func (s *RPCServer) GCTScriptDiffVersions(_ context.Context, r *gctrpc.GCTScriptDiffVersionsRequest) (*gctrpc.GCTScriptDiffVersionsResponse, error) {
+	if !s.GctScriptManager.Started() {
+		return nil, gctscript.ErrScriptingDisabled
+	}
+	diff, err := s.GctScriptManager.Diff(r.ScriptName, r.FromVersion, r.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GCTScriptDiffVersionsResponse{Diff: diff}, nil
+}
+
+// GCTScriptRollback restores a previous version of a script, recording it as
+// the latest version
+func (s *RPCServer) GCTScriptRollback(ctx context.Context, r *gctrpc.GCTScriptRollbackRequest) (*gctrpc.GCTScriptVersion, error) {
+	if !s.GctScriptManager.Started() {
+		return nil, gctscript.ErrScriptingDisabled
+	}
+	author := s.scriptAuthor(ctx)
+	v, err := s.GctScriptManager.Rollback(r.ScriptName, r.Version, author)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof(log.GCTScriptMgr, "Script %s rolled back to version %d as version %d by %s", v.ScriptName, r.Version, v.Version, v.Author)
+	return scriptVersionToRPC(v), nil
+}
+
+func scriptVersionToRPC(v *scriptversion.Version) *gctrpc.GCTScriptVersion {
+	return &gctrpc.GCTScriptVersion{
+		ScriptName: v.ScriptName,
+		Version:    v.Version,
+		Hash:       v.Hash,
+		Author:     v.Author,
+		CreatedAt:  v.CreatedAt.Format(common.SimpleTimeFormatWithTimezone),
+		Signed:     v.Signer != "",
+		Signer:     v.Signer,
+	}
+}
+
+// GCTScriptAddSchedule adds or replaces a scheduled script run
+func (s *RPCServer) GCTScriptAddSchedule(_ context.Context, r *gctrpc.GCTScriptSchedule) (*gctrpc.GenericResponse, error) {
+	if !s.GctScriptManager.Started() {
+		return nil, gctscript.ErrScriptingDisabled
+	}
+	schedule := &gctscript.Schedule{
+		Name:      r.Name,
+		Script:    r.ScriptName,
+		Cron:      r.Cron,
+		Exchanges: r.Exchanges,
+	}
+	var err error
+	if r.RunAt != "" {
+		schedule.RunAt, err = time.Parse(common.SimpleTimeFormat, r.RunAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for x := range r.SkipWindows {
+		var w gctscript.Window
+		w.Cron = r.SkipWindows[x].Cron
+		if r.SkipWindows[x].Duration != "" {
+			w.Duration, err = time.ParseDuration(r.SkipWindows[x].Duration)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if r.SkipWindows[x].Start != "" {
+			w.Start, err = time.Parse(common.SimpleTimeFormat, r.SkipWindows[x].Start)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if r.SkipWindows[x].End != "" {
+			w.End, err = time.Parse(common.SimpleTimeFormat, r.SkipWindows[x].End)
+			if err != nil {
+				return nil, err
+			}
+		}
+		schedule.SkipWindows = append(schedule.SkipWindows, w)
+	}
+	err = s.GctScriptManager.AddSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{
+		Status: MsgStatusSuccess,
+		Data:   fmt.Sprintf("%s next run %s", schedule.Name, schedule.NextRun.Format(common.SimpleTimeFormat)),
+	}, nil
+}
+
+// GCTScriptRemoveSchedule removes a scheduled script run
+func (s *RPCServer) GCTScriptRemoveSchedule(_ context.Context, r *gctrpc.GCTScriptRemoveScheduleRequest) (*gctrpc.GenericResponse, error) {
+	if !s.GctScriptManager.Started() {
+		return nil, gctscript.ErrScriptingDisabled
+	}
+	err := s.GctScriptManager.RemoveSchedule(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: r.Name + " removed"}, nil
+}
+
+// GCTScriptListSchedules lists scheduled script runs
+func (s *RPCServer) GCTScriptListSchedules(_ context.Context, _ *gctrpc.GCTScriptListSchedulesRequest) (*gctrpc.GCTScriptListSchedulesResponse, error) {
+	if !s.GctScriptManager.Started() {
+		return nil, gctscript.ErrScriptingDisabled
+	}
+	schedules := s.GctScriptManager.Schedules()
+	resp := &gctrpc.GCTScriptListSchedulesResponse{}
+	for x := range schedules {
+		schedule := &gctrpc.GCTScriptSchedule{
+			Name:       schedules[x].Name,
+			ScriptName: schedules[x].Script,
+			Cron:       schedules[x].Cron,
+			RunAt:      formatScheduleTime(schedules[x].RunAt),
+			Exchanges:  schedules[x].Exchanges,
+			Enabled:    schedules[x].Enabled,
+			LastRun:    formatScheduleTime(schedules[x].LastRun),
+			LastStatus: schedules[x].LastStatus,
+			NextRun:    formatScheduleTime(schedules[x].NextRun),
+		}
+		for i := range schedules[x].SkipWindows {
+			w := &schedules[x].SkipWindows[i]
+			window := &gctrpc.GCTScriptScheduleWindow{
+				Cron:  w.Cron,
+				Start: formatScheduleTime(w.Start),
+				End:   formatScheduleTime(w.End),
+			}
+			if w.Duration > 0 {
+				window.Duration = w.Duration.String()
+			}
+			schedule.SkipWindows = append(schedule.SkipWindows, window)
+		}
+		resp.Schedules = append(resp.Schedules, schedule)
+	}
+	return resp, nil
+}
+
+func formatScheduleTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(common.SimpleTimeFormat)
+}
+
 // GCTScriptReadScript read a script and return contents
 func (s *RPCServer) GCTScriptReadScript(_ context.Context, r *gctrpc.GCTScriptReadScriptRequest) (*gctrpc.GCTScriptQueryResponse, error) {
 	if !s.GctScriptManager.Started() {
@@ -3102,3 +3753,240 @@ func checkParams(exchName string, e exchange.IBotExchange, a asset.Item, p curre
 	}
 	return fmt.Errorf("%v %w", p, errCurrencyPairInvalid)
 }
+
+// UpsertDataHistoryJob adds or updates a data history job for the data history
+// manager to process
+func (s *RPCServer) UpsertDataHistoryJob(_ context.Context, r *gctrpc.UpsertDataHistoryJobRequest) (*gctrpc.UpsertDataHistoryJobResponse, error) {
+	if r == nil || r.Pair == nil {
+		return nil, errInvalidArguments
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", r.StartDate, errInvalidTimes)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", r.EndDate, errInvalidTimes)
+	}
+	job := DataHistoryJob{
+		Nickname: r.Nickname,
+		Exchange: r.Exchange,
+		Asset:    a,
+		Pair: currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		},
+		StartDate:        start,
+		EndDate:          end,
+		Interval:         kline.Interval(r.Interval),
+		RunBatchLimit:    r.BatchSize,
+		RequestSizeLimit: r.RequestSizeLimit,
+		DataType:         dataHistoryDataType(r.DataType),
+		MaxRetryAttempts: r.MaxRetryAttempts,
+		Status:           dataHistoryStatusActive,
+	}
+	err = s.DataHistoryManager.UpsertJob(&job, r.InsertOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.UpsertDataHistoryJobResponse{
+		JobId:   job.ID.String(),
+		Message: "successfully upserted job: " + job.Nickname,
+	}, nil
+}
+
+// GetDataHistoryJobDetails returns a data history job and, if requested,
+// the result of every interval range processed
+func (s *RPCServer) GetDataHistoryJobDetails(_ context.Context, r *gctrpc.GetDataHistoryJobDetailsRequest) (*gctrpc.DataHistoryJob, error) {
+	if r == nil || r.Nickname == "" {
+		return nil, errNicknameUnset
+	}
+	job, err := s.DataHistoryManager.GetByNickname(r.Nickname, r.FullDetails)
+	if err != nil {
+		return nil, err
+	}
+	return dataHistoryJobToRPC(job), nil
+}
+
+// GetActiveDataHistoryJobs returns all data history jobs still being
+// processed
+func (s *RPCServer) GetActiveDataHistoryJobs(_ context.Context, _ *gctrpc.GetInfoRequest) (*gctrpc.DataHistoryJobs, error) {
+	jobs, err := s.DataHistoryManager.GetActiveJobs()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.DataHistoryJobs{}
+	for i := range jobs {
+		resp.Results = append(resp.Results, dataHistoryJobToRPC(&jobs[i]))
+	}
+	return resp, nil
+}
+
+// GetDataHistoryJobsBetween returns all data history jobs created between
+// the supplied dates
+func (s *RPCServer) GetDataHistoryJobsBetween(_ context.Context, r *gctrpc.GetDataHistoryJobsBetweenRequest) (*gctrpc.DataHistoryJobs, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", r.StartDate, errInvalidTimes)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", r.EndDate, errInvalidTimes)
+	}
+	jobs, err := s.DataHistoryManager.GetJobsBetween(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.DataHistoryJobs{}
+	for i := range jobs {
+		resp.Results = append(resp.Results, dataHistoryJobToRPC(&jobs[i]))
+	}
+	return resp, nil
+}
+
+// DeleteDataHistoryJob stops a data history job from being processed
+func (s *RPCServer) DeleteDataHistoryJob(_ context.Context, r *gctrpc.DeleteDataHistoryJobRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil || r.Nickname == "" {
+		return nil, errNicknameUnset
+	}
+	err := s.DataHistoryManager.DeleteJob(r.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{
+		Status: "success",
+		Data:   "deleted job: " + r.Nickname,
+	}, nil
+}
+
+func dataHistoryJobToRPC(job *DataHistoryJob) *gctrpc.DataHistoryJob {
+	resp := &gctrpc.DataHistoryJob{
+		Id:       job.ID.String(),
+		Nickname: job.Nickname,
+		Exchange: job.Exchange,
+		Asset:    job.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: job.Pair.Delimiter,
+			Base:      job.Pair.Base.String(),
+			Quote:     job.Pair.Quote.String(),
+		},
+		StartDate:        job.StartDate.Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:          job.EndDate.Format(common.SimpleTimeFormatWithTimezone),
+		Interval:         int64(job.Interval.Duration()),
+		RequestSizeLimit: job.RequestSizeLimit,
+		DataType:         job.DataType.String(),
+		MaxRetryAttempts: job.MaxRetryAttempts,
+		BatchSize:        job.RunBatchLimit,
+		Status:           job.Status.String(),
+	}
+	var starts []time.Time
+	for k := range job.Results {
+		starts = append(starts, k)
+	}
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i].Before(starts[j])
+	})
+	for i := range starts {
+		results := job.Results[starts[i]]
+		for j := range results {
+			resp.JobResults = append(resp.JobResults, &gctrpc.DataHistoryJobResult{
+				StartDate: results[j].IntervalStartDate.Format(common.SimpleTimeFormatWithTimezone),
+				EndDate:   results[j].IntervalEndDate.Format(common.SimpleTimeFormatWithTimezone),
+				Status:    results[j].Status.String(),
+				Message:   results[j].Result,
+				RunDate:   results[j].Date.Format(common.SimpleTimeFormatWithTimezone),
+			})
+		}
+	}
+	return resp
+}
+
+// GetOrderbookAnalytics returns analytics calculated directly against the
+// stored orderbook depth
+func (s *RPCServer) GetOrderbookAnalytics(_ context.Context, r *gctrpc.GetOrderbookAnalyticsRequest) (*gctrpc.GetOrderbookAnalyticsResponse, error) {
+	if r == nil || r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	err = checkParams(r.Exchange, s.GetExchangeByName(r.Exchange), a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	depth, err := orderbook.GetDepth(r.Exchange, p, a)
+	if err != nil {
+		return nil, err
+	}
+	analytics, err := depth.GetAnalytics(&orderbook.AnalyticsRequest{
+		LiquidityPercentage: r.LiquidityPercentage,
+		ImbalanceLevels:     int(r.ImbalanceLevels),
+		ImpactAmounts:       r.ImpactAmounts,
+		DepthAmounts:        r.DepthAmounts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetOrderbookAnalyticsResponse{
+		Exchange:           r.Exchange,
+		Pair:               r.Pair,
+		AssetType:          a.String(),
+		BestBid:            analytics.BestBid,
+		BestAsk:            analytics.BestAsk,
+		MidPrice:           analytics.MidPrice,
+		MicroPrice:         analytics.MicroPrice,
+		SpreadBasisPoints:  analytics.SpreadBasisPoints,
+		Imbalance:          analytics.Imbalance,
+		BidLiquidityAmount: analytics.Liquidity.BidAmount,
+		BidLiquidityValue:  analytics.Liquidity.BidValue,
+		AskLiquidityAmount: analytics.Liquidity.AskAmount,
+		AskLiquidityValue:  analytics.Liquidity.AskValue,
+		BuyImpact:          fillResultsToRPC(analytics.BuyImpact),
+		SellImpact:         fillResultsToRPC(analytics.SellImpact),
+	}
+	for i := range analytics.BidDepth {
+		resp.BidDepth = append(resp.BidDepth, &gctrpc.OrderbookDepthLevel{
+			Amount: analytics.BidDepth[i].Amount,
+			Price:  analytics.BidDepth[i].Price,
+		})
+	}
+	for i := range analytics.AskDepth {
+		resp.AskDepth = append(resp.AskDepth, &gctrpc.OrderbookDepthLevel{
+			Amount: analytics.AskDepth[i].Amount,
+			Price:  analytics.AskDepth[i].Price,
+		})
+	}
+	return resp, nil
+}
+
+func fillResultsToRPC(results []orderbook.FillResult) []*gctrpc.OrderbookFillResult {
+	resp := make([]*gctrpc.OrderbookFillResult, len(results))
+	for i := range results {
+		resp[i] = &gctrpc.OrderbookFillResult{
+			Amount:            results[i].Amount,
+			FilledAmount:      results[i].FilledAmount,
+			Cost:              results[i].Cost,
+			AveragePrice:      results[i].AveragePrice,
+			WorstPrice:        results[i].WorstPrice,
+			ImpactBasisPoints: results[i].ImpactBasisPoints,
+			FullyFilled:       results[i].FullyFilled,
+		}
+	}
+	return resp
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
@@ -25,9 +26,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/thrasher-corp/goose"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -1100,3 +1103,220 @@ func TestCheckVars(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestDataHistoryJobRPC(t *testing.T) {
+	engerino := dataHistoryManagerTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	defer func() {
+		if err := engerino.DataHistoryManager.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.UpsertDataHistoryJob(context.Background(), &gctrpc.UpsertDataHistoryJobRequest{})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received %v expected %v", err, errInvalidArguments)
+	}
+	end := time.Now().Truncate(time.Hour).UTC()
+	resp, err := s.UpsertDataHistoryJob(context.Background(), &gctrpc.UpsertDataHistoryJobRequest{
+		Nickname: "rpcjob",
+		Exchange: fakePassExchange,
+		Asset:    asset.Spot.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: currency.DashDelimiter,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+		},
+		StartDate: end.Add(-time.Hour * 24).Format(common.SimpleTimeFormat),
+		EndDate:   end.Format(common.SimpleTimeFormat),
+		Interval:  int64(kline.OneHour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JobId == "" {
+		t.Error("expected job id")
+	}
+
+	job, err := s.GetDataHistoryJobDetails(context.Background(), &gctrpc.GetDataHistoryJobDetailsRequest{Nickname: "rpcjob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Id != resp.JobId || job.Status != dataHistoryStatusActive.String() {
+		t.Errorf("unexpected job %v", job)
+	}
+	active, err := s.GetActiveDataHistoryJobs(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active.Results) != 1 {
+		t.Errorf("received %v active jobs expected 1", len(active.Results))
+	}
+	between, err := s.GetDataHistoryJobsBetween(context.Background(), &gctrpc.GetDataHistoryJobsBetweenRequest{
+		StartDate: time.Now().Add(-time.Hour).UTC().Format(common.SimpleTimeFormat),
+		EndDate:   time.Now().Add(time.Hour).UTC().Format(common.SimpleTimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(between.Results) != 1 {
+		t.Errorf("received %v jobs expected 1", len(between.Results))
+	}
+
+	_, err = s.DeleteDataHistoryJob(context.Background(), &gctrpc.DeleteDataHistoryJobRequest{})
+	if !errors.Is(err, errNicknameUnset) {
+		t.Errorf("received %v expected %v", err, errNicknameUnset)
+	}
+	_, err = s.DeleteDataHistoryJob(context.Background(), &gctrpc.DeleteDataHistoryJobRequest{Nickname: "rpcjob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetOrderbookAnalytics(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetOrderbookAnalytics(context.Background(), &gctrpc.GetOrderbookAnalyticsRequest{})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received %v expected %v", err, errCurrencyPairUnset)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	req := &gctrpc.GetOrderbookAnalyticsRequest{
+		Exchange: testExchange,
+		Pair: &gctrpc.CurrencyPair{
+			Base:  cp.Base.String(),
+			Quote: cp.Quote.String(),
+		},
+		AssetType:           asset.Spot.String(),
+		LiquidityPercentage: 1,
+		ImpactAmounts:       []float64{1, 2},
+		DepthAmounts:        []float64{2},
+	}
+	err = (&orderbook.Base{
+		Exchange: testExchange,
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Bids:     []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+		Asks:     []orderbook.Item{{Price: 101, Amount: 1}, {Price: 102, Amount: 1}},
+	}).Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.GetOrderbookAnalytics(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.MidPrice != 100 || resp.SpreadBasisPoints != 200 {
+		t.Errorf("unexpected response %v", resp)
+	}
+	if len(resp.BuyImpact) != 2 || !resp.BuyImpact[1].FullyFilled || resp.BuyImpact[1].WorstPrice != 102 {
+		t.Errorf("unexpected buy impact %v", resp.BuyImpact)
+	}
+	if len(resp.BidDepth) != 1 || resp.BidDepth[0].Price != 98 {
+		t.Errorf("unexpected bid depth %v", resp.BidDepth)
+	}
+}
+
+func TestGetConsolidatedOrderbookStream(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	err := s.GetConsolidatedOrderbookStream(&gctrpc.GetConsolidatedOrderbookStreamRequest{}, nil)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received %v expected %v", err, errCurrencyPairUnset)
+	}
+	err = s.GetConsolidatedOrderbookStream(&gctrpc.GetConsolidatedOrderbookStreamRequest{
+		Pair:  &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Depth: -1,
+	}, nil)
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received %v expected %v", err, errInvalidArguments)
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	resp := consolidatedOrderbookToRPC(&ConsolidatedOrderbook{
+		Pair:  cp,
+		Asset: asset.Spot,
+		Bids: []ConsolidatedItem{
+			{Exchange: "a", Pair: cp, Price: 2, Amount: 1},
+			{Exchange: "b", Pair: cp, Price: 1, Amount: 1},
+		},
+		Asks:   []ConsolidatedItem{{Exchange: "a", Pair: cp, Price: 3, Amount: 1}},
+		Venues: []ConsolidatedVenue{{Exchange: "a", Pair: cp, Rate: 1}, {Exchange: "b", Pair: cp, Rate: 1}},
+	}, 1)
+	if len(resp.Bids) != 1 || resp.Bids[0].Exchange != "a" || len(resp.Asks) != 1 || len(resp.Venues) != 2 {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+func TestAuthenticateClient(t *testing.T) {
+	bot := &Engine{Config: &config.Config{}}
+	bot.Config.RemoteControl.Username = "admin"
+	bot.Config.RemoteControl.Password = "pass"
+	bot.Config.RemoteControl.Users = []config.RPCUser{{Username: "bob", Password: "bobpass"}}
+
+	authCtx := func(user, pass string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"authorization", "Basic "+crypto.Base64Encode([]byte(user+":"+pass))))
+	}
+
+	ctx, err := bot.authenticateClient(authCtx("admin", "pass"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user := rpcUsername(ctx); user != "admin" {
+		t.Errorf("received %v expected %v", user, "admin")
+	}
+	ctx, err = bot.authenticateClient(authCtx("bob", "bobpass"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user := rpcUsername(ctx); user != "bob" {
+		t.Errorf("received %v expected %v", user, "bob")
+	}
+	_, err = bot.authenticateClient(authCtx("bob", "pass"))
+	if err == nil {
+		t.Error("expected error for mismatched password")
+	}
+}
+
+func TestWithdrawalApprovalRPC(t *testing.T) {
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+	s := RPCServer{Engine: bot}
+
+	resp, err := bot.SubmitWithdrawal(newApprovalTestRequest(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bot.Config.Withdrawal.RequiredApprovals = 1
+
+	_, err = s.ApproveWithdrawal(context.Background(), &gctrpc.WithdrawalApprovalRequest{Id: resp.ID.String()})
+	if !errors.Is(err, errApproverUnset) {
+		t.Errorf("received %v expected %v", err, errApproverUnset)
+	}
+
+	pending, err := s.WithdrawalEventsByApprovalStatus(context.Background(), &gctrpc.WithdrawalEventsByApprovalStatusRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending.Event) != 1 || pending.Event[0].RequestedBy != "alice" {
+		t.Fatalf("unexpected pending withdrawals %v", pending.Event)
+	}
+
+	ctx := context.WithValue(context.Background(), rpcUserKey{}, "bob")
+	approved, err := s.ApproveWithdrawal(ctx, &gctrpc.WithdrawalApprovalRequest{Id: resp.ID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved.Status != "complete" {
+		t.Errorf("received %v expected %v", approved.Status, "complete")
+	}
+
+	_, err = s.RejectWithdrawal(ctx, &gctrpc.WithdrawalApprovalRequest{Id: resp.ID.String()})
+	if !errors.Is(err, errWithdrawalNotPending) {
+		t.Errorf("received %v expected %v", err, errWithdrawalNotPending)
+	}
+}
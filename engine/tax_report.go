@@ -0,0 +1,294 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	depositDataStore "github.com/thrasher-corp/gocryptotrader/database/repository/deposit"
+	withdrawDataStore "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/taxlot"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// TaxLotEvents returns the order fills, credited deposits and completed
+// withdrawals before the end time as tax lot events valued in the fiat
+// currency. Orders are taken from the order manager and optionally each
+// exchange's order history. Deposits and withdrawals require a database
+// connection and are skipped without one
+func (bot *Engine) TaxLotEvents(fiat currency.Code, opts taxReportOptions) ([]taxlot.Event, error) {
+	if !fiat.IsFiatCurrency() {
+		return nil, fmt.Errorf("%s %w", fiat, errNotFiatCurrency)
+	}
+	p := &taxPricer{
+		bot:   bot,
+		fiat:  fiat.Upper().String(),
+		cache: make(map[string]float64),
+	}
+	var events []taxlot.Event
+	orders := bot.taxOrders(opts)
+	for i := range orders {
+		events = append(events, p.orderEvents(&orders[i], opts.End)...)
+	}
+	if !bot.DatabaseManager.Started() {
+		log.Warnln(log.Global, "Tax report database not connected, deposits and withdrawals are excluded")
+		return events, nil
+	}
+	exchanges := bot.GetExchanges()
+	for i := range exchanges {
+		name := exchanges[i].GetName()
+		deposits, err := depositDataStore.GetByExchange(name, "", 0)
+		if err != nil {
+			log.Warnf(log.Global, "Tax report unable to load %s deposits: %v\n", name, err)
+		}
+		for j := range deposits {
+			if e, ok := p.depositEvent(&deposits[j], opts.End); ok {
+				events = append(events, e)
+			}
+		}
+		withdrawals, err := withdrawDataStore.GetEventsByExchange(name, 0)
+		if err != nil && !errors.Is(err, withdrawDataStore.ErrNoResults) {
+			log.Warnf(log.Global, "Tax report unable to load %s withdrawals: %v\n", name, err)
+		}
+		for j := range withdrawals {
+			if e, ok := withdrawalEvent(withdrawals[j], opts.End); ok {
+				events = append(events, e)
+			}
+		}
+	}
+	return events, nil
+}
+
+// taxOrders returns the orders tracked by the order manager and, when
+// requested, the spot order history of each authenticated exchange not
+// already tracked
+func (bot *Engine) taxOrders(opts taxReportOptions) []order.Detail {
+	orders, _ := bot.OrderManager.GetOrdersSnapshot("")
+	if !opts.IncludeExchangeHistory {
+		return orders
+	}
+	seen := make(map[string]bool, len(orders))
+	for i := range orders {
+		seen[strings.ToLower(orders[i].Exchange)+orders[i].ID] = true
+	}
+	exchanges := bot.GetExchanges()
+	for i := range exchanges {
+		if !exchanges[i].GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+			continue
+		}
+		pairs, err := exchanges[i].GetEnabledPairs(asset.Spot)
+		if err != nil || len(pairs) == 0 {
+			continue
+		}
+		history, err := exchanges[i].GetOrderHistory(&order.GetOrdersRequest{
+			Type:      order.AnyType,
+			Side:      order.AnySide,
+			EndTime:   opts.End,
+			Pairs:     pairs,
+			AssetType: asset.Spot,
+		})
+		if err != nil {
+			log.Warnf(log.Global, "Tax report unable to get %s order history: %v\n", exchanges[i].GetName(), err)
+			continue
+		}
+		for j := range history {
+			if history[j].Exchange == "" {
+				history[j].Exchange = exchanges[i].GetName()
+			}
+			key := strings.ToLower(history[j].Exchange) + history[j].ID
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			orders = append(orders, history[j])
+		}
+	}
+	return orders
+}
+
+// orderEvents converts the fills of an order into events for the base
+// currency and, when quoted in a cryptocurrency, the opposing events for the
+// quote currency. Fees are valued in their fee asset, defaulting to the quote
+func (p *taxPricer) orderEvents(o *order.Detail, end time.Time) []taxlot.Event {
+	if o.Pair.IsEmpty() || o.Pair.Base.IsFiatCurrency() {
+		return nil
+	}
+	fills := o.Trades
+	if len(fills) == 0 {
+		if o.ExecutedAmount <= 0 {
+			return nil
+		}
+		fills = []order.TradeHistory{{
+			Price:     o.Price,
+			Amount:    o.ExecutedAmount,
+			Fee:       o.Fee,
+			Side:      o.Side,
+			Timestamp: o.Date,
+		}}
+	}
+	var events []taxlot.Event
+	for i := range fills {
+		if fills[i].Amount <= 0 || !fills[i].Timestamp.Before(end) {
+			continue
+		}
+		side := fills[i].Side
+		if side == "" {
+			side = o.Side
+		}
+		var baseType, quoteType taxlot.EventType
+		switch side {
+		case order.Buy, order.Bid:
+			baseType, quoteType = taxlot.Buy, taxlot.Sell
+		case order.Sell, order.Ask:
+			baseType, quoteType = taxlot.Sell, taxlot.Buy
+		default:
+			continue
+		}
+		rate, err := p.price(o.Pair.Quote, fills[i].Timestamp)
+		if err != nil {
+			log.Warnf(log.Global, "Tax report unable to value %s %s fill: %v\n", o.Exchange, o.ID, err)
+			continue
+		}
+		var fee float64
+		if fills[i].Fee > 0 {
+			feeRate := rate
+			if fills[i].FeeAsset != "" {
+				feeRate, err = p.price(currency.NewCode(fills[i].FeeAsset), fills[i].Timestamp)
+				if err != nil {
+					log.Warnf(log.Global, "Tax report unable to value %s %s fee: %v\n", o.Exchange, o.ID, err)
+				}
+			}
+			fee = fills[i].Fee * feeRate
+		}
+		events = append(events, taxlot.Event{
+			Exchange:  o.Exchange,
+			ID:        o.ID,
+			Currency:  o.Pair.Base,
+			Type:      baseType,
+			Amount:    fills[i].Amount,
+			Price:     fills[i].Price * rate,
+			Fee:       fee,
+			Timestamp: fills[i].Timestamp,
+		})
+		if o.Pair.Quote.IsFiatCurrency() || fills[i].Price <= 0 {
+			continue
+		}
+		events = append(events, taxlot.Event{
+			Exchange:  o.Exchange,
+			ID:        o.ID,
+			Currency:  o.Pair.Quote,
+			Type:      quoteType,
+			Amount:    fills[i].Amount * fills[i].Price,
+			Price:     rate,
+			Timestamp: fills[i].Timestamp,
+		})
+	}
+	return events
+}
+
+// depositEvent converts a credited deposit into an event valued at the time
+// it was deposited
+func (p *taxPricer) depositEvent(d *depositDataStore.Deposit, end time.Time) (taxlot.Event, bool) {
+	code := currency.NewCode(d.Currency)
+	if !d.Credited || d.Amount <= 0 || code.IsFiatCurrency() || !d.DepositedAt.Before(end) {
+		return taxlot.Event{}, false
+	}
+	price, err := p.price(code, d.DepositedAt)
+	if err != nil {
+		log.Warnf(log.Global, "Tax report unable to value %s deposit %s, using zero cost: %v\n", d.Exchange, d.TransferID, err)
+	}
+	return taxlot.Event{
+		Exchange:   d.Exchange,
+		ID:         d.TransferID,
+		Currency:   code,
+		Type:       taxlot.Deposit,
+		Amount:     d.Amount,
+		Price:      price,
+		TransferID: d.TxID,
+		Timestamp:  d.DepositedAt,
+	}, true
+}
+
+// withdrawalEvent converts a completed cryptocurrency withdrawal into an event
+func withdrawalEvent(w *withdraw.Response, end time.Time) (taxlot.Event, bool) {
+	if w.TrackingStatus != withdraw.TrackingCompleted ||
+		w.RequestDetails.Amount <= 0 ||
+		w.RequestDetails.Currency.IsFiatCurrency() ||
+		!w.CreatedAt.Before(end) {
+		return taxlot.Event{}, false
+	}
+	return taxlot.Event{
+		Exchange:   w.Exchange.Name,
+		ID:         w.Exchange.ID,
+		Currency:   w.RequestDetails.Currency,
+		Type:       taxlot.Withdrawal,
+		Amount:     w.RequestDetails.Amount,
+		TransferID: w.Exchange.TxID,
+		Timestamp:  w.CreatedAt,
+	}, true
+}
+
+// price returns the fiat value of one unit of the currency at the time.
+// Fiat currencies are converted at current foreign exchange rates.
+// Cryptocurrencies use the daily close of stored candles quoted in the fiat
+// currency, USD or USDT, falling back to live ticker prices
+func (p *taxPricer) price(code currency.Code, at time.Time) (float64, error) {
+	fiat := currency.NewCode(p.fiat)
+	if code.IsFiatCurrency() || code.Match(fiat) {
+		return p.bot.fiatPrice(code, fiat)
+	}
+	day := at.UTC().Truncate(kline.OneDay.Duration())
+	key := code.Upper().String() + day.Format("2006-01-02")
+	if price, ok := p.cache[key]; ok {
+		return price, nil
+	}
+	price := p.historicPrice(code, day)
+	if price == 0 {
+		var err error
+		price, err = p.bot.fiatPrice(code, fiat)
+		if err != nil {
+			return 0, err
+		}
+		log.Warnf(log.Global, "Tax report has no stored %s candles for %s, using current price\n", code, day.Format("2006-01-02"))
+	}
+	p.cache[key] = price
+	return price, nil
+}
+
+// historicPrice returns the fiat value of the currency from the first stored
+// daily candle found on the day, or zero when there is none
+func (p *taxPricer) historicPrice(code currency.Code, day time.Time) float64 {
+	if !p.bot.DatabaseManager.Started() {
+		return 0
+	}
+	exchanges := p.bot.GetExchanges()
+	for _, quote := range []currency.Code{currency.NewCode(p.fiat), currency.USD, currency.USDT} {
+		if code.Match(quote) {
+			continue
+		}
+		for i := range exchanges {
+			k, err := kline.LoadFromDatabase(exchanges[i].GetName(),
+				currency.NewPair(code, quote),
+				asset.Spot,
+				kline.OneDay,
+				day,
+				day.Add(kline.OneDay.Duration()))
+			if err != nil || len(k.Candles) == 0 || k.Candles[0].Close <= 0 {
+				continue
+			}
+			rate, err := p.bot.fiatPrice(quote, currency.NewCode(p.fiat))
+			if err != nil {
+				continue
+			}
+			return k.Candles[0].Close * rate
+		}
+	}
+	return 0
+}
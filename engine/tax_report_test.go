@@ -0,0 +1,140 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/taxlot"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+func TestWithdrawalEvent(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	w := &withdraw.Response{
+		Exchange:       withdraw.ExchangeResponse{Name: "test", ID: "1", TxID: "tx"},
+		RequestDetails: withdraw.Request{Currency: currency.BTC, Amount: 1},
+		CreatedAt:      now,
+	}
+	if _, ok := withdrawalEvent(w, now.Add(time.Hour)); ok {
+		t.Error("expected untracked withdrawal to be skipped")
+	}
+	w.TrackingStatus = withdraw.TrackingCompleted
+	e, ok := withdrawalEvent(w, now.Add(time.Hour))
+	if !ok {
+		t.Fatal("expected completed withdrawal event")
+	}
+	if e.Type != taxlot.Withdrawal || e.TransferID != "tx" || e.Amount != 1 {
+		t.Errorf("unexpected event %+v", e)
+	}
+	if _, ok = withdrawalEvent(w, now); ok {
+		t.Error("expected withdrawal at the end time to be skipped")
+	}
+}
+
+func TestGetTaxReport(t *testing.T) {
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+
+	taxTest := currency.NewCode("TAXTEST")
+	taxAlt := currency.NewCode("TAXALT")
+	err := ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: fakePassExchange,
+		Pair:         currency.NewPair(taxTest, currency.USD),
+		AssetType:    asset.Spot,
+		Last:         100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bot.OrderManager.orderStore.Orders = map[string][]*order.Detail{
+		fakePassExchange: {
+			{
+				Exchange:       fakePassExchange,
+				ID:             "1",
+				Pair:           currency.NewPair(taxTest, currency.USD),
+				Side:           order.Buy,
+				Price:          50,
+				ExecutedAmount: 2,
+				Date:           time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Exchange: fakePassExchange,
+				ID:       "2",
+				Pair:     currency.NewPair(taxTest, currency.USD),
+				Side:     order.Sell,
+				Trades: []order.TradeHistory{
+					{Price: 80, Amount: 1, Fee: 2, Timestamp: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			{
+				// Paid for with TAXTEST, disposing of the remaining unit
+				Exchange: fakePassExchange,
+				ID:       "3",
+				Pair:     currency.NewPair(taxAlt, taxTest),
+				Side:     order.Buy,
+				Trades: []order.TradeHistory{
+					{Price: 0.1, Amount: 10, Timestamp: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+		},
+	}
+
+	srv := RPCServer{Engine: bot}
+	resp, err := srv.GetTaxReport(context.Background(), &gctrpc.GetTaxReportRequest{
+		FiatCurrency: "usd",
+		Method:       "fifo",
+		Year:         2021,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FiatCurrency != "USD" || resp.Method != "fifo" {
+		t.Errorf("unexpected report %+v", resp)
+	}
+	if len(resp.Disposals) != 2 {
+		t.Fatalf("received %v disposals expected %v", len(resp.Disposals), 2)
+	}
+	// Sold at 80 less a 2 fee and swapped at the current TAXTEST price of 100,
+	// each unit cost 50
+	if resp.TotalProceeds != 178 || resp.TotalCost != 100 || resp.TotalGain != 78 {
+		t.Errorf("received proceeds %v cost %v gain %v expected 178 100 78",
+			resp.TotalProceeds, resp.TotalCost, resp.TotalGain)
+	}
+	if len(resp.OpenLots) != 1 || resp.OpenLots[0].Currency != "TAXALT" || resp.OpenLots[0].Cost != 100 {
+		t.Errorf("unexpected open lots %+v", resp.OpenLots)
+	}
+
+	resp, err = srv.GetTaxReport(context.Background(), &gctrpc.GetTaxReportRequest{
+		FiatCurrency: "usd",
+		Year:         2020,
+		YearStart:    "02-15",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Disposals) != 1 || resp.Start != "2020-02-15 00:00:00" {
+		t.Errorf("unexpected report %+v", resp)
+	}
+
+	_, err = srv.GetTaxReport(context.Background(), &gctrpc.GetTaxReportRequest{})
+	if !errors.Is(err, errInvalidTaxYear) {
+		t.Errorf("received %v expected %v", err, errInvalidTaxYear)
+	}
+	_, err = srv.GetTaxReport(context.Background(), &gctrpc.GetTaxReportRequest{Year: 2021, YearStart: "april"})
+	if !errors.Is(err, errInvalidTaxYearStart) {
+		t.Errorf("received %v expected %v", err, errInvalidTaxYearStart)
+	}
+	_, err = srv.GetTaxReport(context.Background(), &gctrpc.GetTaxReportRequest{Year: 2021, FiatCurrency: "btc"})
+	if !errors.Is(err, errNotFiatCurrency) {
+		t.Errorf("received %v expected %v", err, errNotFiatCurrency)
+	}
+}
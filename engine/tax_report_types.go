@@ -0,0 +1,31 @@
+package engine
+
+import (
+	"errors"
+	"time"
+)
+
+// defaultTaxYearStart is the month and day tax years begin on when unset
+const defaultTaxYearStart = "01-01"
+
+var (
+	errInvalidTaxYearStart = errors.New("tax year start must be in MM-DD format")
+	errInvalidTaxYear      = errors.New("tax year must be set")
+)
+
+// taxPricer values currencies in the reporting fiat currency, caching
+// historic prices by day
+type taxPricer struct {
+	bot   *Engine
+	fiat  string
+	cache map[string]float64
+}
+
+// taxReportOptions define which events are collected for a tax report
+type taxReportOptions struct {
+	// End excludes events from this time onwards
+	End time.Time
+	// IncludeExchangeHistory fetches order history from each exchange in
+	// addition to the orders tracked by the order manager
+	IncludeExchangeHistory bool
+}
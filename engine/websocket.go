@@ -10,7 +10,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -26,20 +28,22 @@ var (
 
 type wsCommandHandler struct {
 	authRequired bool
-	handler      func(client *WebsocketClient, data interface{}) error
+	// permission is required by authenticated requests
+	permission auth.Permission
+	handler    func(client *WebsocketClient, data interface{}) error
 }
 
 var wsHandlers = map[string]wsCommandHandler{
 	"auth":             {authRequired: false, handler: wsAuth},
-	"getconfig":        {authRequired: true, handler: wsGetConfig},
-	"saveconfig":       {authRequired: true, handler: wsSaveConfig},
-	"getaccountinfo":   {authRequired: true, handler: wsGetAccountInfo},
+	"getconfig":        {authRequired: true, permission: auth.PermissionAdmin, handler: wsGetConfig},
+	"saveconfig":       {authRequired: true, permission: auth.PermissionAdmin, handler: wsSaveConfig},
+	"getaccountinfo":   {authRequired: true, permission: auth.PermissionRead, handler: wsGetAccountInfo},
 	"gettickers":       {authRequired: false, handler: wsGetTickers},
 	"getticker":        {authRequired: false, handler: wsGetTicker},
 	"getorderbooks":    {authRequired: false, handler: wsGetOrderbooks},
 	"getorderbook":     {authRequired: false, handler: wsGetOrderbook},
 	"getexchangerates": {authRequired: false, handler: wsGetExchangeRates},
-	"getportfolio":     {authRequired: true, handler: wsGetPortfolio},
+	"getportfolio":     {authRequired: true, permission: auth.PermissionRead, handler: wsGetPortfolio},
 }
 
 // NewWebsocketHub Creates a new websocket hub
@@ -138,6 +142,18 @@ func (c *WebsocketClient) read() {
 				continue
 			}
 
+			if result.authRequired {
+				if !c.role.Allows(result.permission) {
+					log.Warnf(log.WebsocketMgr, "Websocket: request %s by %s denied, %s permission required\n", evt.Event, c.username, result.permission)
+					audit.Event(c.username, rpcAccessEventType, "Denied websocket "+req)
+					c.SendWebsocketMessage(WebsocketEventResponse{Event: evt.Event, Error: "permission denied"})
+					continue
+				}
+				if result.permission != auth.PermissionRead {
+					audit.Event(c.username, rpcAccessEventType, "Called websocket "+req)
+				}
+			}
+
 			err = result.handler(c, dataJSON)
 			if err != nil {
 				log.Errorf(log.WebsocketMgr, "websocket: request %s failed. Error %s\n", evt.Event, err)
@@ -256,17 +272,18 @@ func wsAuth(client *WebsocketClient, data interface{}) error {
 		Event: "auth",
 	}
 
-	var auth WebsocketAuth
-	err := json.Unmarshal(data.([]byte), &auth)
+	var creds WebsocketAuth
+	err := json.Unmarshal(data.([]byte), &creds)
 	if err != nil {
 		wsResp.Error = err.Error()
 		client.SendWebsocketMessage(wsResp)
 		return err
 	}
 
-	hashPW := crypto.HexEncodeToString(crypto.GetSHA256([]byte(Bot.Config.RemoteControl.Password)))
-	if auth.Username == Bot.Config.RemoteControl.Username && auth.Password == hashPW {
+	if username, role, ok := wsCredentials(&creds); ok {
 		client.Authenticated = true
+		client.username = username
+		client.role = role
 		wsResp.Data = WebsocketResponseSuccess
 		log.Debugln(log.WebsocketMgr,
 			"websocket: client authenticated successfully")
@@ -290,6 +307,33 @@ func wsAuth(client *WebsocketClient, data interface{}) error {
 	return nil
 }
 
+// wsCredentials checks a websocket client's API token or username and SHA256
+// hashed password against the remote control users, returning the identity
+// and role
+func wsCredentials(creds *WebsocketAuth) (string, auth.Role, bool) {
+	if creds.Token != "" {
+		username, role, err := Bot.validRPCToken(creds.Token)
+		if err != nil {
+			log.Debugf(log.WebsocketMgr, "websocket: %v\n", err)
+			return "", "", false
+		}
+		return username, role, true
+	}
+	if creds.Username == Bot.Config.RemoteControl.Username &&
+		creds.Password == crypto.HexEncodeToString(crypto.GetSHA256([]byte(Bot.Config.RemoteControl.Password))) {
+		return creds.Username, auth.RoleAdmin, true
+	}
+	for i := range Bot.Config.RemoteControl.Users {
+		user := &Bot.Config.RemoteControl.Users[i]
+		if creds.Username != user.Username ||
+			creds.Password != crypto.HexEncodeToString(crypto.GetSHA256([]byte(user.Password))) {
+			continue
+		}
+		return user.Username, rpcUserRole(user), true
+	}
+	return "", "", false
+}
+
 func wsGetConfig(client *WebsocketClient, data interface{}) error {
 	wsResp := WebsocketEventResponse{
 		Event: "GetConfig",
@@ -1,12 +1,17 @@
 package engine
 
-import "github.com/gorilla/websocket"
+import (
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+)
 
 // WebsocketClient stores information related to the websocket client
 type WebsocketClient struct {
 	Hub           *WebsocketHub
 	Conn          *websocket.Conn
 	Authenticated bool
+	username      string
+	role          auth.Role
 	authFailures  int
 	Send          chan []byte
 }
@@ -42,8 +47,10 @@ type WebsocketOrderbookTickerRequest struct {
 	AssetType string `json:"assetType"`
 }
 
-// WebsocketAuth is a struct used for
+// WebsocketAuth is a struct used for authenticating a websocket client with
+// a username and SHA256 hashed password or an API token
 type WebsocketAuth struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	Token    string `json:"token,omitempty"`
 }
@@ -22,7 +22,8 @@ const (
 )
 
 // SubmitWithdrawal performs validation and submits a new withdraw request to
-// exchange
+// exchange. Requests made with an API token are recorded as requested by the
+// token's owner
 func (bot *Engine) SubmitWithdrawal(req *withdraw.Request) (*withdraw.Response, error) {
 	if req == nil {
 		return nil, withdraw.ErrRequestCannotBeNil
@@ -33,6 +34,12 @@ func (bot *Engine) SubmitWithdrawal(req *withdraw.Request) (*withdraw.Response,
 		return nil, ErrExchangeNotFound
 	}
 
+	requestedBy, err := bot.rpcPrincipal(req.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+	req.RequestedBy = requestedBy
+
 	resp := &withdraw.Response{
 		Exchange: withdraw.ExchangeResponse{
 			Name: req.Exchange,
@@ -40,33 +47,23 @@ func (bot *Engine) SubmitWithdrawal(req *withdraw.Request) (*withdraw.Response,
 		RequestDetails: *req,
 	}
 
-	var err error
 	if bot.Settings.EnableDryRun {
 		log.Warnln(log.Global, "Dry run enabled, no withdrawal request will be submitted or have an event created")
 		resp.ID = withdraw.DryRunID
 		resp.Exchange.Status = "dryrun"
 		resp.Exchange.ID = withdraw.DryRunID.String()
 	} else {
-		var ret *withdraw.ExchangeResponse
-		if req.Type == withdraw.Fiat {
-			ret, err = exch.WithdrawFiatFunds(req)
-			if err != nil {
-				resp.Exchange.ID = StatusError
-				resp.Exchange.Status = err.Error()
-			} else {
-				resp.Exchange.Status = ret.Status
-				resp.Exchange.ID = ret.ID
-			}
-		} else if req.Type == withdraw.Crypto {
-			ret, err = exch.WithdrawCryptocurrencyFunds(req)
-			if err != nil {
-				resp.Exchange.ID = StatusError
-				resp.Exchange.Status = err.Error()
-			} else {
-				resp.Exchange.Status = ret.Status
-				resp.Exchange.ID = ret.ID
-			}
+		bot.withdrawalApprovals.m.Lock()
+		defer bot.withdrawalApprovals.m.Unlock()
+
+		err = bot.checkWithdrawalPolicy(req)
+		if err != nil {
+			return nil, err
+		}
+		if bot.requiresApproval(req) {
+			return bot.queueWithdrawal(resp)
 		}
+		err = submitToExchange(exch, resp)
 		withdrawDataStore.Event(resp)
 	}
 	if err == nil {
@@ -75,6 +72,32 @@ func (bot *Engine) SubmitWithdrawal(req *withdraw.Request) (*withdraw.Response,
 	return resp, nil
 }
 
+// submitToExchange sends the request to the exchange and records the
+// exchange response or error status. Accepted withdrawals are marked as
+// pending for the withdrawal tracker
+func submitToExchange(exch exchange.IBotExchange, resp *withdraw.Response) error {
+	var ret *withdraw.ExchangeResponse
+	var err error
+	switch resp.RequestDetails.Type {
+	case withdraw.Fiat:
+		ret, err = exch.WithdrawFiatFunds(&resp.RequestDetails)
+	case withdraw.Crypto:
+		ret, err = exch.WithdrawCryptocurrencyFunds(&resp.RequestDetails)
+	default:
+		return withdraw.ErrInvalidRequest
+	}
+	if err != nil {
+		resp.Exchange.ID = StatusError
+		resp.Exchange.Status = err.Error()
+		return err
+	}
+	resp.Exchange.Status = ret.Status
+	resp.Exchange.ID = ret.ID
+	resp.Exchange.TxID = ret.TxID
+	resp.TrackingStatus = withdraw.TrackingPending
+	return nil
+}
+
 // WithdrawalEventByID returns a withdrawal request by ID
 func WithdrawalEventByID(id string) (*withdraw.Response, error) {
 	v := withdraw.Cache.Get(id)
@@ -105,6 +128,22 @@ func WithdrawalEventByExchangeID(exchange, id string) (*withdraw.Response, error
 	return withdrawDataStore.GetEventByExchangeID(exchange, id)
 }
 
+func parseWithdrawalApprovals(approvals []withdraw.Approval) []*gctrpc.WithdrawalApproval {
+	var resp []*gctrpc.WithdrawalApproval
+	for x := range approvals {
+		createdAt, err := ptypes.TimestampProto(approvals[x].CreatedAt)
+		if err != nil {
+			log.Errorf(log.Global, "failed to convert time: %v", err)
+		}
+		resp = append(resp, &gctrpc.WithdrawalApproval{
+			Username:  approvals[x].Username,
+			Approved:  approvals[x].Approved,
+			CreatedAt: createdAt,
+		})
+	}
+	return resp
+}
+
 func parseMultipleEvents(ret []*withdraw.Response) *gctrpc.WithdrawalEventsByExchangeResponse {
 	v := &gctrpc.WithdrawalEventsByExchangeResponse{}
 	for x := range ret {
@@ -121,6 +160,10 @@ func parseMultipleEvents(ret []*withdraw.Response) *gctrpc.WithdrawalEventsByExc
 				Amount:      ret[x].RequestDetails.Amount,
 				Type:        int32(ret[x].RequestDetails.Type),
 			},
+			ApprovalStatus: ret[x].ApprovalStatus,
+			RequestedBy:    ret[x].RequestDetails.RequestedBy,
+			Approvals:      parseWithdrawalApprovals(ret[x].Approvals),
+			TrackingStatus: ret[x].TrackingStatus,
 		}
 
 		createdAtPtype, err := ptypes.TimestampProto(ret[x].CreatedAt)
@@ -141,6 +184,7 @@ func parseMultipleEvents(ret []*withdraw.Response) *gctrpc.WithdrawalEventsByExc
 				Address:    ret[x].RequestDetails.Crypto.Address,
 				AddressTag: ret[x].RequestDetails.Crypto.AddressTag,
 				Fee:        ret[x].RequestDetails.Crypto.FeeAmount,
+				TxId:       ret[x].Exchange.TxID,
 			}
 		} else if ret[x].RequestDetails.Type == withdraw.Fiat {
 			if ret[x].RequestDetails.Fiat != (withdraw.FiatRequest{}) {
@@ -211,6 +255,10 @@ func parseSingleEvents(ret *withdraw.Response) *gctrpc.WithdrawalEventsByExchang
 			Amount:      ret.RequestDetails.Amount,
 			Type:        int32(ret.RequestDetails.Type),
 		},
+		ApprovalStatus: ret.ApprovalStatus,
+		RequestedBy:    ret.RequestDetails.RequestedBy,
+		Approvals:      parseWithdrawalApprovals(ret.Approvals),
+		TrackingStatus: ret.TrackingStatus,
 	}
 	createdAtPtype, err := ptypes.TimestampProto(ret.CreatedAt)
 	if err != nil {
@@ -230,6 +278,7 @@ func parseSingleEvents(ret *withdraw.Response) *gctrpc.WithdrawalEventsByExchang
 			Address:    ret.RequestDetails.Crypto.Address,
 			AddressTag: ret.RequestDetails.Crypto.AddressTag,
 			Fee:        ret.RequestDetails.Crypto.FeeAmount,
+			TxId:       ret.Exchange.TxID,
 		}
 	} else if ret.RequestDetails.Type == withdraw.Fiat {
 		if ret.RequestDetails.Fiat != (withdraw.FiatRequest{}) {
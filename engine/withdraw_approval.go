@@ -0,0 +1,189 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	withdrawDataStore "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var (
+	errAddressNotWhiteListed  = errors.New("address is not whitelisted for withdrawals of this currency")
+	errDailyLimitExceeded     = errors.New("withdrawal exceeds daily limit")
+	errWithdrawalNotPending   = errors.New("withdrawal is not pending approval")
+	errRequesterCannotApprove = errors.New("withdrawal requester cannot approve or reject their own request")
+	errApproverUnset          = errors.New("approver username unset")
+)
+
+// withdrawalApprovals serialises policy checks and approvals. Pending
+// requests and their approvals are only stored in the withdrawal history so
+// they survive restarts
+type withdrawalApprovals struct {
+	m sync.Mutex
+}
+
+// checkWithdrawalPolicy ensures crypto withdrawals are sent to an address
+// whitelisted for the requested currency and that the request does not exceed
+// the currency's daily limit. Pending requests count towards the limit.
+func (bot *Engine) checkWithdrawalPolicy(req *withdraw.Request) error {
+	policy := &bot.Config.Withdrawal
+	if !policy.Enabled {
+		return nil
+	}
+
+	code := req.Currency.Upper()
+	if req.Type == withdraw.Crypto && !portfolio.IsWhiteListedFor(req.Crypto.Address, code) {
+		return fmt.Errorf("%w: %v %v", errAddressNotWhiteListed, code, req.Crypto.Address)
+	}
+
+	limit, ok := policy.DailyLimits[code.String()]
+	if !ok {
+		return nil
+	}
+	total, err := withdrawDataStore.GetTotalByCurrency(code.String(), time.Now().Add(-time.Hour*24))
+	if err != nil {
+		return err
+	}
+	if total+req.Amount > limit {
+		return fmt.Errorf("%w: %v %v requested with %v of %v used in the last 24 hours",
+			errDailyLimitExceeded, req.Amount, code, total, limit)
+	}
+	return nil
+}
+
+// requiresApproval returns whether the request amount is above the approval
+// threshold for its currency
+func (bot *Engine) requiresApproval(req *withdraw.Request) bool {
+	policy := &bot.Config.Withdrawal
+	if !policy.Enabled {
+		return false
+	}
+	threshold, ok := policy.ApprovalThresholds[req.Currency.Upper().String()]
+	return ok && req.Amount > threshold
+}
+
+func (bot *Engine) requiredApprovals() int {
+	if bot.Config.Withdrawal.RequiredApprovals < 1 {
+		return 1
+	}
+	return bot.Config.Withdrawal.RequiredApprovals
+}
+
+// queueWithdrawal stores the request as pending approval without submitting
+// it to the exchange
+func (bot *Engine) queueWithdrawal(resp *withdraw.Response) (*withdraw.Response, error) {
+	resp.ApprovalStatus = withdraw.ApprovalPending
+	resp.Exchange.Status = withdraw.ApprovalPending
+	err := withdrawDataStore.AddEvent(resp)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof(log.Global, "Withdrawal %v of %v %v on %v requires %d approvals before submission\n",
+		resp.ID,
+		resp.RequestDetails.Amount,
+		resp.RequestDetails.Currency,
+		resp.Exchange.Name,
+		bot.requiredApprovals())
+	return resp, nil
+}
+
+// ApprovePendingWithdrawal records an approval from the supplied user and
+// submits the withdrawal to the exchange once it has the required number of
+// approvals. Approvals made with an API token count as its owner's approval
+func (bot *Engine) ApprovePendingWithdrawal(id, username string) (*withdraw.Response, error) {
+	return bot.actionWithdrawal(id, username, true)
+}
+
+// RejectPendingWithdrawal records a rejection from the supplied user, which
+// cancels the pending withdrawal
+func (bot *Engine) RejectPendingWithdrawal(id, username string) (*withdraw.Response, error) {
+	return bot.actionWithdrawal(id, username, false)
+}
+
+func (bot *Engine) actionWithdrawal(id, username string, approved bool) (*withdraw.Response, error) {
+	if username == "" {
+		return nil, errApproverUnset
+	}
+	username, err := bot.rpcPrincipal(username)
+	if err != nil {
+		return nil, err
+	}
+
+	bot.withdrawalApprovals.m.Lock()
+	defer bot.withdrawalApprovals.m.Unlock()
+
+	resp, err := withdrawDataStore.GetEventByUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ApprovalStatus != withdraw.ApprovalPending {
+		return nil, fmt.Errorf("%w: %v", errWithdrawalNotPending, id)
+	}
+	if strings.EqualFold(username, resp.RequestDetails.RequestedBy) {
+		return nil, errRequesterCannotApprove
+	}
+
+	err = withdrawDataStore.AddApproval(id, username, approved)
+	if err != nil {
+		return nil, err
+	}
+	resp.Approvals = append(resp.Approvals, withdraw.Approval{
+		Username:  username,
+		Approved:  approved,
+		CreatedAt: time.Now(),
+	})
+
+	if !approved {
+		resp.ApprovalStatus = withdraw.ApprovalRejected
+		resp.Exchange.Status = withdraw.ApprovalRejected
+		err = withdrawDataStore.UpdateEvent(resp)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	var count int
+	for x := range resp.Approvals {
+		if resp.Approvals[x].Approved {
+			count++
+		}
+	}
+	if count < bot.requiredApprovals() {
+		return resp, nil
+	}
+
+	exch := bot.GetExchangeByName(resp.Exchange.Name)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+
+	// The request is rebuilt from the stored withdrawal details, which do
+	// not include the request's exchange name
+	resp.RequestDetails.Exchange = exch.GetName()
+	resp.ApprovalStatus = withdraw.ApprovalApproved
+	err = submitToExchange(exch, resp)
+	if err != nil {
+		log.Errorf(log.Global, "Approved withdrawal %v failed: %v\n", id, err)
+	}
+	errUpdate := withdrawDataStore.UpdateEvent(resp)
+	if errUpdate != nil {
+		return nil, errUpdate
+	}
+	if err == nil {
+		withdraw.Cache.Add(resp.ID, resp)
+	}
+	return resp, nil
+}
+
+// WithdrawalEventsByApprovalStatus returns withdrawal requests by approval
+// status
+func WithdrawalEventsByApprovalStatus(status string, limit int) ([]*withdraw.Response, error) {
+	return withdrawDataStore.GetEventsByApprovalStatus(status, limit)
+}
@@ -0,0 +1,259 @@
+package engine
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const approvalTestAddress = "approval-test-address"
+
+func withdrawalApprovalTestSetup(t *testing.T) *Engine {
+	t.Helper()
+	bot := RPCTestSetup(t)
+	if bot.GetExchangeByName(fakePassExchange) == nil {
+		err := addPassingFakeExchange(testExchange, bot)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbexchange.ResetExchangeCache()
+	err = dbexchange.Insert(dbexchange.Details{Name: fakePassExchange, UUID: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// API tokens created by the tests save the config
+	bot.Settings.ConfigFile = filepath.Join(t.TempDir(), "config.json")
+	bot.Config.Withdrawal = config.WithdrawalConfig{
+		Enabled:            true,
+		DailyLimits:        map[string]float64{"BTC": 10},
+		ApprovalThresholds: map[string]float64{"BTC": 1},
+		RequiredApprovals:  2,
+	}
+
+	addresses := portfolio.Portfolio.Addresses
+	portfolio.Portfolio.Addresses = append(portfolio.Portfolio.Addresses, portfolio.Address{
+		Address:     approvalTestAddress,
+		CoinType:    currency.BTC,
+		WhiteListed: true,
+	})
+	t.Cleanup(func() { portfolio.Portfolio.Addresses = addresses })
+	return bot
+}
+
+func newApprovalTestRequest(amount float64) *withdraw.Request {
+	return &withdraw.Request{
+		Exchange:    fakePassExchange,
+		Currency:    currency.BTC,
+		Amount:      amount,
+		Type:        withdraw.Crypto,
+		RequestedBy: "alice",
+		Crypto: withdraw.CryptoRequest{
+			Address: approvalTestAddress,
+		},
+	}
+}
+
+func TestCheckWithdrawalPolicy(t *testing.T) {
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+
+	req := newApprovalTestRequest(1)
+	req.Crypto.Address = "not-whitelisted"
+	_, err := bot.SubmitWithdrawal(req)
+	if !errors.Is(err, errAddressNotWhiteListed) {
+		t.Errorf("received %v expected %v", err, errAddressNotWhiteListed)
+	}
+
+	req = newApprovalTestRequest(1)
+	req.Currency = currency.LTC
+	_, err = bot.SubmitWithdrawal(req)
+	if !errors.Is(err, errAddressNotWhiteListed) {
+		t.Errorf("received %v expected %v", err, errAddressNotWhiteListed)
+	}
+
+	resp, err := bot.SubmitWithdrawal(newApprovalTestRequest(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ApprovalStatus != "" || resp.Exchange.Status != "complete" {
+		t.Errorf("expected request at threshold to be submitted, received %+v", resp)
+	}
+
+	_, err = bot.SubmitWithdrawal(newApprovalTestRequest(9.5))
+	if !errors.Is(err, errDailyLimitExceeded) {
+		t.Errorf("received %v expected %v", err, errDailyLimitExceeded)
+	}
+
+	bot.Config.Withdrawal.Enabled = false
+	req = newApprovalTestRequest(20)
+	req.Crypto.Address = "not-whitelisted"
+	_, err = bot.SubmitWithdrawal(req)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWithdrawalApproval(t *testing.T) {
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+
+	resp, err := bot.SubmitWithdrawal(newApprovalTestRequest(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ApprovalStatus != withdraw.ApprovalPending {
+		t.Fatalf("received %v expected %v", resp.ApprovalStatus, withdraw.ApprovalPending)
+	}
+	id := resp.ID.String()
+
+	_, err = bot.ApprovePendingWithdrawal(id, "")
+	if !errors.Is(err, errApproverUnset) {
+		t.Errorf("received %v expected %v", err, errApproverUnset)
+	}
+	_, err = bot.ApprovePendingWithdrawal(id, "alice")
+	if !errors.Is(err, errRequesterCannotApprove) {
+		t.Errorf("received %v expected %v", err, errRequesterCannotApprove)
+	}
+
+	resp, err = bot.ApprovePendingWithdrawal(id, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ApprovalStatus != withdraw.ApprovalPending {
+		t.Errorf("received %v expected %v", resp.ApprovalStatus, withdraw.ApprovalPending)
+	}
+	_, err = bot.ApprovePendingWithdrawal(id, "bob")
+	if err == nil {
+		t.Error("expected error approving twice as the same user")
+	}
+
+	// API tokens act as their owner so cannot add approvals of their own
+	expires := time.Now().Add(time.Hour)
+	for name, owner := range map[string]string{"bobtoken": "bob", "alicetoken": "alice"} {
+		if _, err = bot.CreateRPCToken(name, owner, auth.RoleWithdrawer, expires); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = bot.ApprovePendingWithdrawal(id, "token:bobtoken")
+	if err == nil {
+		t.Error("expected error approving with a token owned by an approver")
+	}
+	_, err = bot.ApprovePendingWithdrawal(id, "token:alicetoken")
+	if !errors.Is(err, errRequesterCannotApprove) {
+		t.Errorf("received %v expected %v", err, errRequesterCannotApprove)
+	}
+	bot.Config.RemoteControl.Tokens = append(bot.Config.RemoteControl.Tokens, config.RPCToken{Name: "unowned"})
+	_, err = bot.ApprovePendingWithdrawal(id, "token:unowned")
+	if !errors.Is(err, errRPCTokenOwnerUnset) {
+		t.Errorf("received %v expected %v", err, errRPCTokenOwnerUnset)
+	}
+
+	resp, err = bot.ApprovePendingWithdrawal(id, "carol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ApprovalStatus != withdraw.ApprovalApproved || resp.Exchange.ID != "fake" {
+		t.Errorf("expected approved and submitted withdrawal, received %+v", resp)
+	}
+
+	stored, err := WithdrawalEventByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.ApprovalStatus != withdraw.ApprovalApproved {
+		t.Errorf("received %v expected %v", stored.ApprovalStatus, withdraw.ApprovalApproved)
+	}
+
+	_, err = bot.RejectPendingWithdrawal(id, "dave")
+	if !errors.Is(err, errWithdrawalNotPending) {
+		t.Errorf("received %v expected %v", err, errWithdrawalNotPending)
+	}
+
+	req := newApprovalTestRequest(3)
+	req.RequestedBy = "token:bobtoken"
+	resp, err = bot.SubmitWithdrawal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.RequestDetails.RequestedBy != "bob" {
+		t.Errorf("received %v expected %v", resp.RequestDetails.RequestedBy, "bob")
+	}
+	_, err = bot.RejectPendingWithdrawal(resp.ID.String(), "bob")
+	if !errors.Is(err, errRequesterCannotApprove) {
+		t.Errorf("received %v expected %v", err, errRequesterCannotApprove)
+	}
+	resp, err = bot.RejectPendingWithdrawal(resp.ID.String(), "carol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ApprovalStatus != withdraw.ApprovalRejected {
+		t.Errorf("received %v expected %v", resp.ApprovalStatus, withdraw.ApprovalRejected)
+	}
+
+	pending, err := WithdrawalEventsByApprovalStatus(withdraw.ApprovalRejected, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 {
+		t.Errorf("expected 1 rejected withdrawal, received %v", len(pending))
+	}
+}
+
+func TestWithdrawalApprovalAfterRestart(t *testing.T) {
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+
+	req := newApprovalTestRequest(2)
+	req.Crypto.AddressTag = "approval-test-tag"
+	req.Crypto.FeeAmount = 0.001
+	resp, err := bot.SubmitWithdrawal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := resp.ID.String()
+	if _, err = bot.ApprovePendingWithdrawal(id, "bob"); err != nil {
+		t.Fatal(err)
+	}
+
+	// approvals are only held in the database so they survive a restart
+	if err = bot.DatabaseManager.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	bot.withdrawalApprovals = withdrawalApprovals{}
+	if err = bot.DatabaseManager.Start(bot); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err = bot.ApprovePendingWithdrawal(id, "carol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ApprovalStatus != withdraw.ApprovalApproved || resp.Exchange.ID != "fake" {
+		t.Errorf("expected approved and submitted withdrawal, received %+v", resp)
+	}
+	if len(resp.Approvals) != 2 {
+		t.Errorf("received %v approvals expected 2", len(resp.Approvals))
+	}
+	submitted := resp.RequestDetails
+	if submitted.Exchange != fakePassExchange ||
+		submitted.Amount != 2 ||
+		submitted.Crypto.Address != approvalTestAddress ||
+		submitted.Crypto.AddressTag != "approval-test-tag" ||
+		submitted.Crypto.FeeAmount != 0.001 {
+		t.Errorf("unexpected submitted request %+v", submitted)
+	}
+}
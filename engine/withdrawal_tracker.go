@@ -0,0 +1,249 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	withdrawDataStore "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+func (w *withdrawalTracker) Started() bool {
+	return atomic.LoadInt32(&w.started) == 1
+}
+
+func (w *withdrawalTracker) Start(bot *Engine) error {
+	if bot == nil {
+		return errWithdrawalTrackerNilEngine
+	}
+	if !atomic.CompareAndSwapInt32(&w.started, 0, 1) {
+		return fmt.Errorf("withdrawal tracker %w", subsystem.ErrSubSystemAlreadyStarted)
+	}
+	if !bot.DatabaseManager.Started() {
+		atomic.CompareAndSwapInt32(&w.started, 1, 0)
+		return fmt.Errorf("withdrawal tracker %w", errDatabaseNotConnected)
+	}
+	log.Debugln(log.Global, "Withdrawal tracker starting...")
+	w.bot = bot
+	w.cfg = bot.Config.WithdrawalTracker
+	w.shutdown = make(chan struct{})
+	w.wg.Add(1)
+	go w.run()
+	log.Debugln(log.Global, "Withdrawal tracker started.")
+	return nil
+}
+
+func (w *withdrawalTracker) Stop() error {
+	if atomic.LoadInt32(&w.started) == 0 {
+		return fmt.Errorf("withdrawal tracker %w", subsystem.ErrSubSystemNotStarted)
+	}
+	defer func() {
+		atomic.CompareAndSwapInt32(&w.started, 1, 0)
+	}()
+	log.Debugln(log.Global, "Withdrawal tracker shutting down...")
+	close(w.shutdown)
+	w.wg.Wait()
+	log.Debugln(log.Global, "Withdrawal tracker shutdown.")
+	return nil
+}
+
+func (w *withdrawalTracker) run() {
+	tick := time.NewTicker(w.cfg.PollInterval)
+	defer func() {
+		tick.Stop()
+		w.wg.Done()
+	}()
+	w.checkWithdrawals()
+	for {
+		select {
+		case <-w.shutdown:
+			return
+		case <-tick.C:
+			w.checkWithdrawals()
+		}
+	}
+}
+
+// checkWithdrawals fetches withdrawal history once per exchange and currency
+// of the pending withdrawals and updates each withdrawal found in it
+func (w *withdrawalTracker) checkWithdrawals() {
+	pending, err := withdrawDataStore.GetEventsByTrackingStatus(withdraw.TrackingPending, 0)
+	if err != nil {
+		if !errors.Is(err, withdrawDataStore.ErrNoResults) {
+			log.Errorf(log.Global, "Withdrawal tracker unable to load pending withdrawals: %v\n", err)
+		}
+		return
+	}
+
+	history := make(map[string][]exchange.WithdrawalHistory)
+	// matched holds the history entries already matched to a withdrawal this
+	// cycle so that no entry updates more than one withdrawal
+	matched := make(map[string]map[int]bool)
+	failed := make(map[string]error)
+	for i := range pending {
+		resp := pending[i]
+		if time.Since(resp.CreatedAt) > w.cfg.MaxAge {
+			w.update(resp, withdraw.TrackingExpired)
+			continue
+		}
+
+		key := resp.Exchange.Name + resp.RequestDetails.Currency.Upper().String()
+		if historyErr, ok := failed[key]; ok {
+			if errors.Is(historyErr, common.ErrFunctionNotSupported) || errors.Is(historyErr, common.ErrNotYetImplemented) {
+				w.update(resp, withdraw.TrackingUnsupported)
+			}
+			continue
+		}
+		h, ok := history[key]
+		if !ok {
+			h, err = w.getHistory(resp)
+			if err != nil {
+				log.Errorf(log.Global, "Withdrawal tracker unable to get %v %v withdrawal history: %v\n",
+					resp.Exchange.Name, resp.RequestDetails.Currency, err)
+				failed[key] = err
+				if errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented) {
+					w.update(resp, withdraw.TrackingUnsupported)
+				}
+				continue
+			}
+			history[key] = h
+			matched[key] = make(map[int]bool)
+		}
+
+		match := matchWithdrawal(resp, h, matched[key])
+		if match == nil || match.Status == resp.Exchange.Status {
+			continue
+		}
+		resp.Exchange.Status = match.Status
+		if match.CryptoTxID != "" {
+			resp.Exchange.TxID = match.CryptoTxID
+		}
+		if match.Fee > 0 {
+			resp.RequestDetails.Crypto.FeeAmount = match.Fee
+		}
+		w.update(resp, withdrawalTrackingStatus(match.Status))
+	}
+}
+
+func (w *withdrawalTracker) getHistory(resp *withdraw.Response) ([]exchange.WithdrawalHistory, error) {
+	exch := w.bot.GetExchangeByName(resp.Exchange.Name)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	return exch.GetWithdrawalsHistory(resp.RequestDetails.Currency)
+}
+
+// update stores the withdrawal with its new tracking status and notifies the
+// comms relayer once the withdrawal is no longer pending
+func (w *withdrawalTracker) update(resp *withdraw.Response, status string) {
+	resp.TrackingStatus = status
+	err := withdrawDataStore.UpdateTracking(resp)
+	if err != nil {
+		log.Errorf(log.Global, "Withdrawal tracker unable to update withdrawal %v: %v\n", resp.ID, err)
+		return
+	}
+	withdraw.Cache.Add(resp.ID.String(), resp)
+	if status == withdraw.TrackingPending {
+		return
+	}
+
+	msg := fmt.Sprintf("Withdrawal %v of %v %v on %v %v",
+		resp.ID,
+		resp.RequestDetails.Amount,
+		resp.RequestDetails.Currency,
+		resp.Exchange.Name,
+		status)
+	if resp.Exchange.Status != "" {
+		msg += " with exchange status: " + resp.Exchange.Status
+	}
+	if resp.Exchange.TxID != "" {
+		msg += " tx ID: " + resp.Exchange.TxID
+	}
+	log.Infoln(log.Global, msg)
+	w.bot.CommsManager.PushEvent(base.Event{
+		Type:    withdrawalTrackerEventType,
+		Message: msg,
+	})
+}
+
+// matchWithdrawal finds the withdrawal in exchange history by the ID the
+// exchange returned on submission. Exchanges which do not return an ID are
+// matched by address and an amount within the withdrawal fee, preferring the
+// closest amount and then the earliest entry. Entries in matched are skipped
+// and the returned entry is added to it
+func matchWithdrawal(resp *withdraw.Response, history []exchange.WithdrawalHistory, matched map[int]bool) *exchange.WithdrawalHistory {
+	for i := range history {
+		if !matched[i] && resp.Exchange.ID != "" && history[i].TransferID == resp.Exchange.ID {
+			matched[i] = true
+			return &history[i]
+		}
+	}
+	if resp.RequestDetails.Type != withdraw.Crypto {
+		return nil
+	}
+	best := -1
+	for i := range history {
+		if matched[i] ||
+			history[i].TransferID != "" ||
+			history[i].CryptoToAddress != resp.RequestDetails.Crypto.Address ||
+			history[i].Timestamp.Before(resp.CreatedAt.Add(-time.Minute)) ||
+			!withdrawalAmountMatches(resp.RequestDetails.Amount, history[i].Amount,
+				math.Max(history[i].Fee, resp.RequestDetails.Crypto.FeeAmount)) {
+			continue
+		}
+		if best == -1 {
+			best = i
+			continue
+		}
+		diff := math.Abs(history[i].Amount - resp.RequestDetails.Amount)
+		bestDiff := math.Abs(history[best].Amount - resp.RequestDetails.Amount)
+		if diff < bestDiff || (diff == bestDiff && history[i].Timestamp.Before(history[best].Timestamp)) {
+			best = i
+		}
+	}
+	if best == -1 {
+		return nil
+	}
+	matched[best] = true
+	return &history[best]
+}
+
+// withdrawalAmountMatches returns whether the amount recorded in exchange
+// history is the requested amount, allowing for exchanges which record the
+// amount with the fee added or deducted
+func withdrawalAmountMatches(requested, recorded, fee float64) bool {
+	return math.Abs(recorded-requested) <= fee+requested*withdrawalAmountTolerance
+}
+
+// withdrawalTrackingStatus classifies an exchange withdrawal status as
+// completed, failed or still pending
+func withdrawalTrackingStatus(status string) string {
+	words := transferStatusWords(status)
+	for i := range words {
+		if transferFailedWords[words[i]] {
+			return withdraw.TrackingFailed
+		}
+	}
+	for i := range words {
+		if transferCompletedWords[words[i]] {
+			return withdraw.TrackingCompleted
+		}
+	}
+	return withdraw.TrackingPending
+}
+
+func transferStatusWords(status string) []string {
+	return strings.FieldsFunc(strings.ToLower(status), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+}
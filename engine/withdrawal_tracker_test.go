@@ -0,0 +1,175 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	withdrawDataStore "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/engine/subsystem"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+func TestWithdrawalTrackerStartStop(t *testing.T) {
+	var w withdrawalTracker
+	if err := w.Start(nil); !errors.Is(err, errWithdrawalTrackerNilEngine) {
+		t.Errorf("received %v expected %v", err, errWithdrawalTrackerNilEngine)
+	}
+	if err := w.Start(&Engine{}); !errors.Is(err, errDatabaseNotConnected) {
+		t.Errorf("received %v expected %v", err, errDatabaseNotConnected)
+	}
+	if err := w.Stop(); !errors.Is(err, subsystem.ErrSubSystemNotStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemNotStarted)
+	}
+
+	bot := RPCTestSetup(t)
+	defer CleanRPCTest(t, bot)
+	bot.Config.WithdrawalTracker = config.WithdrawalTrackerConfig{
+		PollInterval: time.Hour,
+		MaxAge:       time.Hour,
+	}
+	if err := bot.WithdrawalTracker.Start(bot); err != nil {
+		t.Fatal(err)
+	}
+	if err := bot.WithdrawalTracker.Start(bot); !errors.Is(err, subsystem.ErrSubSystemAlreadyStarted) {
+		t.Errorf("received %v expected %v", err, subsystem.ErrSubSystemAlreadyStarted)
+	}
+	if err := bot.WithdrawalTracker.Stop(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWithdrawalTrackerCheckWithdrawals(t *testing.T) {
+	bot := withdrawalApprovalTestSetup(t)
+	defer CleanRPCTest(t, bot)
+	bot.Config.Withdrawal = config.WithdrawalConfig{}
+
+	resp, err := bot.SubmitWithdrawal(newApprovalTestRequest(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.TrackingStatus != withdraw.TrackingPending {
+		t.Fatalf("received %v expected %v", resp.TrackingStatus, withdraw.TrackingPending)
+	}
+
+	w := withdrawalTracker{
+		bot: bot,
+		cfg: config.WithdrawalTrackerConfig{MaxAge: time.Hour},
+	}
+	w.checkWithdrawals()
+	v, err := withdrawDataStore.GetEventByUUID(resp.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.TrackingStatus != withdraw.TrackingCompleted {
+		t.Errorf("received %v expected %v", v.TrackingStatus, withdraw.TrackingCompleted)
+	}
+	if v.Exchange.Status != "Completed" {
+		t.Errorf("received %v expected %v", v.Exchange.Status, "Completed")
+	}
+	if v.Exchange.TxID != "fakeTx" {
+		t.Errorf("received %v expected %v", v.Exchange.TxID, "fakeTx")
+	}
+	if v.RequestDetails.Crypto.FeeAmount != 0.001 {
+		t.Errorf("received %v expected %v", v.RequestDetails.Crypto.FeeAmount, 0.001)
+	}
+
+	resp, err = bot.SubmitWithdrawal(newApprovalTestRequest(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.cfg.MaxAge = -time.Minute
+	w.checkWithdrawals()
+	v, err = withdrawDataStore.GetEventByUUID(resp.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.TrackingStatus != withdraw.TrackingExpired {
+		t.Errorf("received %v expected %v", v.TrackingStatus, withdraw.TrackingExpired)
+	}
+}
+
+func TestMatchWithdrawal(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	resp := &withdraw.Response{
+		Exchange: withdraw.ExchangeResponse{ID: "1"},
+		RequestDetails: withdraw.Request{
+			Amount: 1,
+			Type:   withdraw.Crypto,
+			Crypto: withdraw.CryptoRequest{Address: "addr"},
+		},
+		CreatedAt: now,
+	}
+	history := []exchange.WithdrawalHistory{
+		{TransferID: "2", Status: "a"},
+		{TransferID: "1", Status: "b"},
+	}
+	if m := matchWithdrawal(resp, history, map[int]bool{}); m == nil || m.Status != "b" {
+		t.Errorf("expected withdrawal to match by transfer ID, received %+v", m)
+	}
+
+	resp.Exchange.ID = ""
+	history = append(history,
+		exchange.WithdrawalHistory{CryptoToAddress: "addr", Amount: 1, Timestamp: now.Add(-time.Hour), Status: "c"},
+		exchange.WithdrawalHistory{CryptoToAddress: "addr", Amount: 1, Timestamp: now, Status: "d"},
+	)
+	if m := matchWithdrawal(resp, history, map[int]bool{}); m == nil || m.Status != "d" {
+		t.Errorf("expected withdrawal to match by address and amount, received %+v", m)
+	}
+
+	resp.RequestDetails.Amount = 2
+	if m := matchWithdrawal(resp, history, map[int]bool{}); m != nil {
+		t.Errorf("expected no match, received %+v", m)
+	}
+
+	// history which records the amount less the fee still matches, but each
+	// entry matches only one withdrawal per cycle
+	resp.RequestDetails.Amount = 1.0005
+	resp.RequestDetails.Crypto.FeeAmount = 0.0005
+	history = []exchange.WithdrawalHistory{
+		{CryptoToAddress: "addr", Amount: 1, Timestamp: now.Add(time.Second), Status: "e"},
+		{CryptoToAddress: "addr", Amount: 0.9, Timestamp: now, Status: "f"},
+		{CryptoToAddress: "addr", Amount: 1, Timestamp: now, Status: "g"},
+	}
+	matched := make(map[int]bool)
+	if m := matchWithdrawal(resp, history, matched); m == nil || m.Status != "g" {
+		t.Errorf("expected earliest withdrawal within the fee to match, received %+v", m)
+	}
+	if m := matchWithdrawal(resp, history, matched); m == nil || m.Status != "e" {
+		t.Errorf("expected next unmatched withdrawal to match, received %+v", m)
+	}
+	if m := matchWithdrawal(resp, history, matched); m != nil {
+		t.Errorf("expected no match once entries are matched, received %+v", m)
+	}
+
+	// an entry's own fee also allows for the difference
+	resp.RequestDetails.Crypto.FeeAmount = 0
+	history[0].Fee = 0.0005
+	if m := matchWithdrawal(resp, history, map[int]bool{}); m == nil || m.Status != "e" {
+		t.Errorf("expected withdrawal to match within the recorded fee, received %+v", m)
+	}
+}
+
+func TestWithdrawalTrackingStatus(t *testing.T) {
+	t.Parallel()
+	for status, expected := range map[string]string{
+		"Completed":          withdraw.TrackingCompleted,
+		"COMPLETE: 0xabc":    withdraw.TrackingCompleted,
+		"Success":            withdraw.TrackingCompleted,
+		"Failure":            withdraw.TrackingFailed,
+		"CANCELED":           withdraw.TrackingFailed,
+		"Rejected":           withdraw.TrackingFailed,
+		"Awaiting Approval":  withdraw.TrackingPending,
+		"Processing":         withdraw.TrackingPending,
+		"incomplete":         withdraw.TrackingPending,
+		"":                   withdraw.TrackingPending,
+		"completed_rejected": withdraw.TrackingFailed,
+	} {
+		if received := withdrawalTrackingStatus(status); received != expected {
+			t.Errorf("%q received %v expected %v", status, received, expected)
+		}
+	}
+}
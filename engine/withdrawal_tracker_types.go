@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+const (
+	withdrawalTrackerEventType = "withdrawal"
+	// withdrawalAmountTolerance is the relative difference allowed between
+	// requested and recorded amounts for exchange rounding
+	withdrawalAmountTolerance = 1e-8
+)
+
+var errWithdrawalTrackerNilEngine = errors.New("withdrawal tracker cannot start with nil engine")
+
+// Words found in exchange withdrawal and deposit statuses which mark a
+// transfer as finished. Statuses matching neither set are treated as still
+// pending
+var (
+	transferCompletedWords = map[string]bool{
+		"complete":   true,
+		"completed":  true,
+		"confirmed":  true,
+		"credited":   true,
+		"done":       true,
+		"finished":   true,
+		"success":    true,
+		"successful": true,
+		"succeeded":  true,
+	}
+	transferFailedWords = map[string]bool{
+		"cancel":    true,
+		"canceled":  true,
+		"cancelled": true,
+		"error":     true,
+		"fail":      true,
+		"failed":    true,
+		"failure":   true,
+		"refunded":  true,
+		"rejected":  true,
+	}
+)
+
+// withdrawalTracker polls exchange withdrawal history for withdrawals that
+// have been accepted by an exchange, storing the exchange status, fee and
+// transaction ID until the withdrawal completes, fails or expires
+type withdrawalTracker struct {
+	bot      *Engine
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	cfg      config.WithdrawalTrackerConfig
+}
@@ -219,6 +219,11 @@ func (a *Alphapoint) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Wit
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (a *Alphapoint) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	return nil, common.ErrNotYetImplemented
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (a *Alphapoint) GetRecentTrades(_ currency.Pair, _ asset.Item) ([]trade.Data, error) {
 	return nil, common.ErrNotYetImplemented
@@ -870,20 +870,63 @@ func (b *Binance) WithdrawStatus(c currency.Code, status string, startTime, endT
 	return response.WithdrawList, nil
 }
 
+// DepositHistory gets the status of recent deposits
+// status `param` used as string to prevent default value 0 (for int) interpreting as DepositPending status
+func (b *Binance) DepositHistory(c currency.Code, status string, startTime, endTime int64) ([]DepositHistoryResponse, error) {
+	var response struct {
+		Success     bool                     `json:"success"`
+		DepositList []DepositHistoryResponse `json:"depositList"`
+	}
+
+	params := url.Values{}
+	params.Set("asset", c.String())
+
+	if status != "" {
+		i, err := strconv.Atoi(status)
+		if err != nil {
+			return response.DepositList, fmt.Errorf("wrong param (status): %s. Error: %v", status, err)
+		}
+
+		switch i {
+		case DepositPending, DepositSuccess, DepositCredited:
+		default:
+			return response.DepositList, fmt.Errorf("wrong param (status): %s", status)
+		}
+
+		params.Set("status", status)
+	}
+
+	if startTime > 0 {
+		params.Set("startTime", strconv.FormatInt(startTime, 10))
+	}
+
+	if endTime > 0 {
+		params.Set("endTime", strconv.FormatInt(endTime, 10))
+	}
+
+	if err := b.SendAuthHTTPRequest(exchange.RestSpotSupplementary, http.MethodGet, depositHistory, params, spotDefaultRate, &response); err != nil {
+		return response.DepositList, err
+	}
+
+	return response.DepositList, nil
+}
+
 // GetDepositAddressForCurrency retrieves the wallet address for a given currency
 func (b *Binance) GetDepositAddressForCurrency(currency string) (string, error) {
-	resp := struct {
-		Address    string `json:"address"`
-		Success    bool   `json:"success"`
-		AddressTag string `json:"addressTag"`
-	}{}
+	resp, err := b.GetDepositAddressDetails(currency)
+	return resp.Address, err
+}
 
+// GetDepositAddressDetails retrieves the wallet address and address tag for a
+// given currency
+func (b *Binance) GetDepositAddressDetails(currency string) (DepositAddress, error) {
+	var resp DepositAddress
 	params := url.Values{}
 	params.Set("asset", currency)
 	params.Set("status", "true")
 	params.Set("recvWindow", "10000")
 
-	return resp.Address,
+	return resp,
 		b.SendAuthHTTPRequest(exchange.RestSpotSupplementary, http.MethodGet, depositAddress, params, spotDefaultRate, &resp)
 }
 
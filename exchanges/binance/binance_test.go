@@ -1953,6 +1953,17 @@ func TestWithdrawHistory(t *testing.T) {
 	}
 }
 
+func TestDepositHistory(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetDepositHistory(currency.BTC)
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("GetDepositHistory() error", err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("GetDepositHistory() expecting an error when no keys are set")
+	}
+}
+
 func TestWithdrawFiat(t *testing.T) {
 	t.Parallel()
 	_, err := b.WithdrawFiatFunds(&withdraw.Request{})
@@ -1982,6 +1993,20 @@ func TestGetDepositAddress(t *testing.T) {
 	}
 }
 
+func TestGetDepositAddressTag(t *testing.T) {
+	t.Parallel()
+	if mockTests {
+		t.Skip("no mock data recorded for the deposit address endpoint")
+	}
+	_, err := b.GetDepositAddressTag(currency.BTC, "")
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("GetDepositAddressTag() error", err)
+	case !areTestAPIKeysSet() && err == nil:
+		t.Error("GetDepositAddressTag() error cannot be nil")
+	}
+}
+
 func TestWSSubscriptionHandling(t *testing.T) {
 	t.Parallel()
 	pressXToJSON := []byte(`{
@@ -645,6 +645,51 @@ type WithdrawStatusResponse struct {
 	Network        string  `json:"network"`
 }
 
+// deposit status codes description
+const (
+	DepositPending  = 0
+	DepositSuccess  = 1
+	DepositCredited = 6
+)
+
+// DepositHistoryResponse defines a deposit history response
+type DepositHistoryResponse struct {
+	InsertTime int64   `json:"insertTime"`
+	Amount     float64 `json:"amount"`
+	Asset      string  `json:"asset"`
+	Address    string  `json:"address"`
+	AddressTag string  `json:"addressTag"`
+	TxID       string  `json:"txId"`
+	Status     int64   `json:"status"`
+}
+
+// depositStatusNames maps DepositHistoryResponse statuses to their
+// documented names
+var depositStatusNames = map[int64]string{
+	DepositPending:  "Pending",
+	DepositSuccess:  "Success",
+	DepositCredited: "Credited but cannot withdraw",
+}
+
+// withdrawalStatusNames maps WithdrawStatusResponse statuses to their
+// documented names
+var withdrawalStatusNames = map[int64]string{
+	EmailSent:        "Email Sent",
+	Cancelled:        "Cancelled",
+	AwaitingApproval: "Awaiting Approval",
+	Rejected:         "Rejected",
+	Processing:       "Processing",
+	Failure:          "Failure",
+	Completed:        "Completed",
+}
+
+// DepositAddress stores the deposit address and address tag for a currency
+type DepositAddress struct {
+	Address    string `json:"address"`
+	Success    bool   `json:"success"`
+	AddressTag string `json:"addressTag"`
+}
+
 // UserAccountStream contains a key to maintain an authorised
 // websocket connection
 type UserAccountStream struct {
@@ -674,8 +674,12 @@ func (b *Binance) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdr
 	}
 
 	for i := range w {
+		status, ok := withdrawalStatusNames[w[i].Status]
+		if !ok {
+			status = strconv.FormatInt(w[i].Status, 10)
+		}
 		resp = append(resp, exchange.WithdrawalHistory{
-			Status:          strconv.FormatInt(w[i].Status, 10),
+			Status:          status,
 			TransferID:      w[i].ID,
 			Currency:        w[i].Asset,
 			Amount:          w[i].Amount,
@@ -689,6 +693,32 @@ func (b *Binance) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdr
 	return resp, nil
 }
 
+// GetDepositHistory returns previous deposits data
+func (b *Binance) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	d, err := b.DepositHistory(c, "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range d {
+		status, ok := depositStatusNames[d[i].Status]
+		if !ok {
+			status = strconv.FormatInt(d[i].Status, 10)
+		}
+		resp = append(resp, exchange.DepositHistory{
+			Status:          status,
+			TransferID:      d[i].TxID,
+			Currency:        d[i].Asset,
+			Amount:          d[i].Amount,
+			CryptoToAddress: d[i].Address,
+			CryptoTxID:      d[i].TxID,
+			Timestamp:       time.Unix(d[i].InsertTime/1000, 0),
+		})
+	}
+
+	return resp, nil
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (b *Binance) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var resp []trade.Data
@@ -1106,6 +1136,13 @@ func (b *Binance) GetDepositAddress(cryptocurrency currency.Code, _ string) (str
 	return b.GetDepositAddressForCurrency(cryptocurrency.String())
 }
 
+// GetDepositAddressTag returns the address tag which must accompany deposits
+// to the currency's deposit address, empty when none is required
+func (b *Binance) GetDepositAddressTag(cryptocurrency currency.Code, _ string) (string, error) {
+	resp, err := b.GetDepositAddressDetails(cryptocurrency.String())
+	return resp.AddressTag, err
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
 func (b *Binance) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
@@ -369,7 +369,7 @@ type MovementHistory struct {
 	TxID             int64   `json:"txid"`
 	Currency         string  `json:"currency"`
 	Method           string  `json:"method"`
-	Type             string  `json:"withdrawal"`
+	Type             string  `json:"type"`
 	Amount           float64 `json:"amount,string"`
 	Description      string  `json:"description"`
 	Address          string  `json:"address"`
@@ -522,6 +522,39 @@ func (b *Bitfinex) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withd
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (b *Bitfinex) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	history, err := b.GetMovementHistory(c.String(), "", time.Time{}, time.Time{}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var ts float64
+	for i := range history {
+		if !strings.EqualFold(history[i].Type, "deposit") {
+			continue
+		}
+		ts, err = strconv.ParseFloat(history[i].Timestamp, 64)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, exchange.DepositHistory{
+			Status:          history[i].Status,
+			TransferID:      strconv.FormatInt(history[i].ID, 10),
+			Description:     history[i].Description,
+			Timestamp:       time.Unix(int64(ts), 0),
+			Currency:        history[i].Currency,
+			Amount:          history[i].Amount,
+			Fee:             history[i].Fee,
+			TransferType:    history[i].Method,
+			CryptoToAddress: history[i].Address,
+			CryptoTxID:      strconv.FormatInt(history[i].TxID, 10),
+		})
+	}
+
+	return resp, nil
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (b *Bitfinex) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	return b.GetHistoricTrades(p, assetType, time.Now().Add(-time.Hour), time.Now())
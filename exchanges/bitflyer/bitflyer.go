@@ -195,8 +195,8 @@ func (b *Bitflyer) GetCryptoDepositAddress() {
 	// Needs to be updated
 }
 
-// GetDepositHistory returns a full history of deposits
-func (b *Bitflyer) GetDepositHistory() {
+// GetAccountDepositHistory returns a full history of deposits
+func (b *Bitflyer) GetAccountDepositHistory() {
 	// Needs to be updated
 }
 
@@ -317,6 +317,11 @@ func (b *Bitflyer) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withd
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (b *Bitflyer) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	return nil, common.ErrNotYetImplemented
+}
+
 // GetRecentTrades returns recent historic trades
 func (b *Bitflyer) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var err error
@@ -339,6 +339,11 @@ func (b *Bithumb) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdr
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (b *Bithumb) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	return nil, common.ErrNotYetImplemented
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (b *Bithumb) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var err error
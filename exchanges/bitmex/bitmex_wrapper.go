@@ -451,6 +451,11 @@ func (b *Bitmex) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdra
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (b *Bitmex) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	return nil, common.ErrNotYetImplemented
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (b *Bitmex) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	return b.GetHistoricTrades(p, assetType, time.Now().Add(-time.Hour), time.Now())
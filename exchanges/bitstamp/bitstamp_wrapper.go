@@ -410,6 +410,11 @@ func (b *Bitstamp) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withd
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (b *Bitstamp) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	return nil, common.ErrNotYetImplemented
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (b *Bitstamp) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var err error
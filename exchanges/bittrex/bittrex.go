@@ -381,9 +381,9 @@ func (b *Bittrex) GetWithdrawalHistory(currency string) (WithdrawalHistory, erro
 	return history, nil
 }
 
-// GetDepositHistory is used to retrieve your deposit history. If currency is
+// GetAccountDepositHistory is used to retrieve your deposit history. If currency is
 // is omitted it will return the entire deposit history
-func (b *Bittrex) GetDepositHistory(currency string) (DepositHistory, error) {
+func (b *Bittrex) GetAccountDepositHistory(currency string) (DepositHistory, error) {
 	var history DepositHistory
 	values := url.Values{}
 
@@ -230,15 +230,15 @@ func TestGetwithdrawalHistory(t *testing.T) {
 	}
 }
 
-func TestGetDepositHistory(t *testing.T) {
+func TestGetAccountDepositHistory(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetDepositHistory("")
+	_, err := b.GetAccountDepositHistory("")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetDepositHistory(currPair)
+	_, err = b.GetAccountDepositHistory(currPair)
 	if err == nil {
 		t.Error("Expected error")
 	}
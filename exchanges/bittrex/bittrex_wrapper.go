@@ -383,6 +383,35 @@ func (b *Bittrex) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdr
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+// Bittrex only returns deposits once they have been credited
+func (b *Bittrex) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	history, err := b.GetAccountDepositHistory(c.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var ts time.Time
+	for i := range history.Result {
+		ts, err = parseTime(history.Result[i].LastUpdated)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, exchange.DepositHistory{
+			Status:          "Completed",
+			TransferID:      strconv.FormatInt(history.Result[i].ID, 10),
+			Timestamp:       ts,
+			Currency:        history.Result[i].Currency,
+			Amount:          history.Result[i].Amount,
+			CryptoToAddress: history.Result[i].CryptoAddress,
+			CryptoTxID:      history.Result[i].TxID,
+			Confirmations:   history.Result[i].Confirmations,
+		})
+	}
+
+	return resp, nil
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (b *Bittrex) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var err error
@@ -456,6 +456,11 @@ func (c *CoinbasePro) GetWithdrawalsHistory(cur currency.Code) (resp []exchange.
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (c *CoinbasePro) GetDepositHistory(cur currency.Code) (resp []exchange.DepositHistory, err error) {
+	return nil, common.ErrNotYetImplemented
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (c *CoinbasePro) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var err error
@@ -519,6 +519,11 @@ func (c *Coinbene) GetWithdrawalsHistory(cur currency.Code) (resp []exchange.Wit
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (c *Coinbene) GetDepositHistory(cur currency.Code) (resp []exchange.DepositHistory, err error) {
+	return nil, common.ErrNotYetImplemented
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (c *Coinbene) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var err error
@@ -1087,6 +1087,13 @@ func (b *Base) GetSubscriptions() ([]stream.ChannelSubscription, error) {
 	return b.Websocket.GetSubscriptions(), nil
 }
 
+// GetDepositAddressTag returns the address tag or memo which must accompany
+// deposits to the currency's deposit address. This is overridable by
+// exchanges which return tags
+func (b *Base) GetDepositAddressTag(cryptocurrency currency.Code, accountID string) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
 // AuthenticateWebsocket sends an authentication message to the websocket
 func (b *Base) AuthenticateWebsocket() error {
 	return common.ErrFunctionNotSupported
@@ -1128,6 +1135,69 @@ func (b *Base) ValidateKline(pair currency.Pair, a asset.Item, interval kline.In
 	return nil
 }
 
+// GetClosestLowerEnabledInterval returns the largest enabled kline interval
+// which is smaller than the requested interval and divides into it evenly
+func (b *Base) GetClosestLowerEnabledInterval(interval kline.Interval) (kline.Interval, error) {
+	var closest kline.Interval
+	for i := range kline.SupportedIntervals {
+		if kline.SupportedIntervals[i] >= interval ||
+			interval%kline.SupportedIntervals[i] != 0 ||
+			!b.klineIntervalEnabled(kline.SupportedIntervals[i]) {
+			continue
+		}
+		if kline.SupportedIntervals[i] > closest {
+			closest = kline.SupportedIntervals[i]
+		}
+	}
+	if closest == 0 {
+		return 0, fmt.Errorf("%s %s %w", b.Name, interval.Short(), ErrNoResampleIntervalEnabled)
+	}
+	return closest, nil
+}
+
+// GetHistoricCandlesWithResample returns candles for the requested interval.
+// When the exchange does not support the interval, candles are requested at
+// the closest lower enabled interval and resampled to the requested interval
+func GetHistoricCandlesWithResample(exch IBotExchange, p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval, extended bool) (kline.Item, error) {
+	getCandles := exch.GetHistoricCandles
+	if extended {
+		getCandles = exch.GetHistoricCandlesExtended
+	}
+	ret, err := getCandles(p, a, start, end, interval)
+	if err == nil {
+		return ret, nil
+	}
+	var klineErr *kline.ErrorKline
+	if !errors.As(err, &klineErr) ||
+		klineErr.Interval == 0 ||
+		klineErr.Asset != "" ||
+		!klineErr.Pair.IsEmpty() {
+		return kline.Item{}, err
+	}
+
+	lower, err := exch.GetBase().GetClosestLowerEnabledInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	// request whole intervals so the first and last resampled candles are
+	// not built from partial data
+	alignedStart := start.Truncate(interval.Duration())
+	alignedEnd := end.Truncate(interval.Duration())
+	if alignedEnd.Before(end) {
+		alignedEnd = alignedEnd.Add(interval.Duration())
+	}
+	ret, err = getCandles(p, a, alignedStart, alignedEnd, lower)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	resampled, err := ret.ConvertToNewInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	resampled.RemoveOutsideRange(alignedStart, alignedEnd)
+	return *resampled, nil
+}
+
 // AddTradesToBuffer is a helper function that will only
 // add trades to the buffer if it is allowed
 func (b *Base) AddTradesToBuffer(trades ...trade.Data) error {
@@ -2105,6 +2105,13 @@ func TestAuthenticateWebsocket(t *testing.T) {
 	}
 }
 
+func TestGetDepositAddressTag(t *testing.T) {
+	b := Base{}
+	if _, err := b.GetDepositAddressTag(currency.XRP, ""); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("received %v expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
 func TestKlineIntervalEnabled(t *testing.T) {
 	b := Base{}
 	if b.klineIntervalEnabled(kline.EightHour) {
@@ -2112,6 +2119,33 @@ func TestKlineIntervalEnabled(t *testing.T) {
 	}
 }
 
+func TestGetClosestLowerEnabledInterval(t *testing.T) {
+	b := Base{Name: "test"}
+	b.Features.Enabled.Kline.Intervals = map[string]bool{
+		kline.OneMin.Word():     true,
+		kline.FiveMin.Word():    true,
+		kline.FifteenMin.Word(): true,
+	}
+	_, err := b.GetClosestLowerEnabledInterval(kline.OneMin)
+	if !errors.Is(err, ErrNoResampleIntervalEnabled) {
+		t.Errorf("received %v expected %v", err, ErrNoResampleIntervalEnabled)
+	}
+	i, err := b.GetClosestLowerEnabledInterval(kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != kline.FifteenMin {
+		t.Errorf("received %v expected %v", i, kline.FifteenMin)
+	}
+	i, err = b.GetClosestLowerEnabledInterval(kline.TenMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != kline.FiveMin {
+		t.Errorf("received %v expected %v", i, kline.FiveMin)
+	}
+}
+
 func TestFormatExchangeKlineInterval(t *testing.T) {
 	b := Base{}
 	if b.FormatExchangeKlineInterval(kline.EightHour) != "28800" {
@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"errors"
 	"sync"
 	"time"
 
@@ -14,6 +15,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 )
 
+var (
+	// ErrNoResampleIntervalEnabled is returned when no enabled kline interval
+	// can be resampled into the requested interval
+	ErrNoResampleIntervalEnabled = errors.New("no enabled kline interval can be resampled into the requested interval")
+)
+
 // Endpoint authentication types
 const (
 	RestAuthentication      uint8 = 0
@@ -144,6 +151,23 @@ type WithdrawalHistory struct {
 	BankTo          string
 }
 
+// DepositHistory holds exchange deposit history data
+type DepositHistory struct {
+	Status            string
+	TransferID        string
+	Description       string
+	Timestamp         time.Time
+	Currency          string
+	Amount            float64
+	Fee               float64
+	TransferType      string
+	CryptoFromAddress string
+	CryptoToAddress   string
+	CryptoTxID        string
+	Confirmations     int64
+	BankFrom          string
+}
+
 // Features stores the supported and enabled features
 // for the exchange
 type Features struct {
@@ -1068,6 +1068,17 @@ func TestGetDepositAddress(t *testing.T) {
 	}
 }
 
+func TestGetDepositAddressTag(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys required but not set, skipping test")
+	}
+	_, err := f.GetDepositAddressTag(currency.NewCode("FTT"), "")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetFundingHistory(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() {
@@ -456,6 +456,32 @@ func (f *FTX) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdrawal
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (f *FTX) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	history, err := f.FetchDepositHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range history {
+		if !strings.EqualFold(history[i].Coin, c.String()) {
+			continue
+		}
+		resp = append(resp, exchange.DepositHistory{
+			Status:        history[i].Status,
+			TransferID:    strconv.FormatInt(history[i].ID, 10),
+			Timestamp:     history[i].Time,
+			Currency:      history[i].Coin,
+			Amount:        history[i].Size,
+			Fee:           history[i].Fee,
+			CryptoTxID:    history[i].TxID,
+			Confirmations: history[i].Confirmations,
+		})
+	}
+
+	return resp, nil
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (f *FTX) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	return f.GetHistoricTrades(p, assetType, time.Now().Add(-time.Hour), time.Now())
@@ -743,6 +769,16 @@ func (f *FTX) GetDepositAddress(cryptocurrency currency.Code, _ string) (string,
 	return a.Address, nil
 }
 
+// GetDepositAddressTag returns the tag which must accompany deposits to the
+// currency's deposit address, empty when none is required
+func (f *FTX) GetDepositAddressTag(cryptocurrency currency.Code, _ string) (string, error) {
+	a, err := f.FetchDepositAddress(cryptocurrency.String())
+	if err != nil {
+		return "", err
+	}
+	return a.Tag, nil
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
 func (f *FTX) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
@@ -447,6 +447,11 @@ func (g *Gemini) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdra
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (g *Gemini) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	return nil, common.ErrNotYetImplemented
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (g *Gemini) GetRecentTrades(currencyPair currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	return g.GetHistoricTrades(currencyPair, assetType, time.Time{}, time.Time{})
@@ -2104,6 +2104,16 @@ func TestQueryDepositAddress(t *testing.T) {
 	}
 }
 
+func TestGetDepositAddressTag(t *testing.T) {
+	_, err := h.GetDepositAddressTag(currency.BTC, "")
+	if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+	if areTestAPIKeysSet() && err != nil {
+		t.Error(err)
+	}
+}
+
 func TestQueryWithdrawQuota(t *testing.T) {
 	_, err := h.QueryWithdrawQuotas(currency.BTC.Lower().String())
 	if !areTestAPIKeysSet() && err == nil {
@@ -757,6 +757,11 @@ func (h *HUOBI) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdraw
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (h *HUOBI) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	return nil, common.ErrNotYetImplemented
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (h *HUOBI) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var err error
@@ -1168,6 +1173,13 @@ func (h *HUOBI) GetDepositAddress(cryptocurrency currency.Code, accountID string
 	return resp.Address, err
 }
 
+// GetDepositAddressTag returns the address tag which must accompany deposits
+// to the currency's deposit address, empty when none is required
+func (h *HUOBI) GetDepositAddressTag(cryptocurrency currency.Code, _ string) (string, error) {
+	resp, err := h.QueryDepositAddress(cryptocurrency.Lower().String())
+	return resp.AddressTag, err
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
 func (h *HUOBI) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
@@ -214,6 +214,74 @@ func (k *Item) RemoveOutsideRange(start, end time.Time) {
 	k.Candles = newCandles
 }
 
+// ConvertToNewInterval resamples candles into a larger interval which must be
+// a multiple of the current interval. New candles are aligned to the start of
+// the new interval. Empty entries added by FillMissingDataWithEmptyEntries are
+// ignored so an interval without any underlying data produces no candle and
+// can be highlighted with IntervalRangeHolder.VerifyResultsHaveData
+func (k *Item) ConvertToNewInterval(newInterval Interval) (*Item, error) {
+	if k.Interval <= 0 || newInterval <= 0 {
+		return nil, ErrUnsetInterval
+	}
+	if newInterval <= k.Interval {
+		return nil, fmt.Errorf("%w: %s to %s",
+			ErrCanOnlyDownscaleCandles,
+			k.Interval.Short(),
+			newInterval.Short())
+	}
+	if newInterval%k.Interval != 0 {
+		return nil, fmt.Errorf("%w: %s to %s",
+			ErrWholeNumberScaling,
+			k.Interval.Short(),
+			newInterval.Short())
+	}
+
+	candles := make([]Candle, len(k.Candles))
+	copy(candles, k.Candles)
+	sort.Slice(candles, func(i, j int) bool {
+		return candles[i].Time.Before(candles[j].Time)
+	})
+
+	resampled := Item{
+		Exchange: k.Exchange,
+		Pair:     k.Pair,
+		Asset:    k.Asset,
+		Interval: newInterval,
+	}
+	for i := range candles {
+		if candles[i].isEmpty() {
+			continue
+		}
+		start := candles[i].Time.Truncate(newInterval.Duration())
+		last := len(resampled.Candles) - 1
+		if last < 0 || !resampled.Candles[last].Time.Equal(start) {
+			resampled.Candles = append(resampled.Candles, Candle{
+				Time:   start,
+				Open:   candles[i].Open,
+				High:   candles[i].High,
+				Low:    candles[i].Low,
+				Close:  candles[i].Close,
+				Volume: candles[i].Volume,
+			})
+			continue
+		}
+		if candles[i].High > resampled.Candles[last].High {
+			resampled.Candles[last].High = candles[i].High
+		}
+		if candles[i].Low < resampled.Candles[last].Low {
+			resampled.Candles[last].Low = candles[i].Low
+		}
+		resampled.Candles[last].Close = candles[i].Close
+		resampled.Candles[last].Volume += candles[i].Volume
+	}
+	return &resampled, nil
+}
+
+// isEmpty returns whether the candle is a placeholder without any data
+func (c *Candle) isEmpty() bool {
+	return c.Open == 0 && c.High == 0 && c.Low == 0 && c.Close == 0 && c.Volume == 0
+}
+
 // SortCandlesByTimestamp sorts candles by timestamp
 func (k *Item) SortCandlesByTimestamp(desc bool) {
 	sort.Slice(k.Candles, func(i, j int) bool {
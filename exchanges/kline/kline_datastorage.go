@@ -45,6 +45,32 @@ func LoadFromDatabase(exchange string, pair currency.Pair, a asset.Item, interva
 	return ret, nil
 }
 
+// LoadFromDatabaseWithResample returns Item from database seeded data. When
+// there is no data stored for the requested interval, the largest stored
+// interval which divides evenly into it is loaded and resampled instead
+func LoadFromDatabaseWithResample(exchange string, pair currency.Pair, a asset.Item, interval Interval, start, end time.Time) (Item, error) {
+	ret, err := LoadFromDatabase(exchange, pair, a, interval, start, end)
+	if err == nil {
+		return ret, nil
+	}
+	alignedStart := start.Truncate(interval.Duration())
+	for i := len(SupportedIntervals) - 1; i >= 0; i-- {
+		if SupportedIntervals[i] >= interval || interval%SupportedIntervals[i] != 0 {
+			continue
+		}
+		lower, lowerErr := LoadFromDatabase(exchange, pair, a, SupportedIntervals[i], alignedStart, end)
+		if lowerErr != nil {
+			continue
+		}
+		resampled, convertErr := lower.ConvertToNewInterval(interval)
+		if convertErr != nil {
+			return Item{}, convertErr
+		}
+		return *resampled, nil
+	}
+	return Item{}, err
+}
+
 // StoreInDatabase returns Item from database seeded data
 func StoreInDatabase(in *Item, force bool) (uint64, error) {
 	if in.Exchange == "" {
@@ -616,6 +616,14 @@ func TestLoadFromDatabase(t *testing.T) {
 				t.Fatalf("uncorrect data returned: %v", ret.Exchange)
 			}
 
+			ret, err = LoadFromDatabaseWithResample(testExchanges[0].Name, p, asset.Spot, SevenDay, start, end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ret.Interval != SevenDay || len(ret.Candles) == 0 {
+				t.Fatalf("unexpected resampled data returned: %v %v", ret.Interval, len(ret.Candles))
+			}
+
 			err = testhelpers.CloseDatabase(dbConn)
 			if err != nil {
 				t.Error(err)
@@ -784,6 +792,65 @@ func TestIntervalsPerYear(t *testing.T) {
 	}
 }
 
+func TestConvertToNewInterval(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	old := &Item{
+		Exchange: "test",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+	}
+	_, err := old.ConvertToNewInterval(OneHour)
+	if !errors.Is(err, ErrUnsetInterval) {
+		t.Errorf("received %v expected %v", err, ErrUnsetInterval)
+	}
+
+	old.Interval = OneHour
+	_, err = old.ConvertToNewInterval(OneMin)
+	if !errors.Is(err, ErrCanOnlyDownscaleCandles) {
+		t.Errorf("received %v expected %v", err, ErrCanOnlyDownscaleCandles)
+	}
+	_, err = old.ConvertToNewInterval(OneHour + ThirtyMin)
+	if !errors.Is(err, ErrWholeNumberScaling) {
+		t.Errorf("received %v expected %v", err, ErrWholeNumberScaling)
+	}
+
+	// an out of order first interval, an empty second interval and a partial
+	// third interval
+	old.Candles = []Candle{
+		{Time: start.Add(time.Hour), Open: 2, High: 5, Low: 1, Close: 4, Volume: 2},
+		{Time: start, Open: 1, High: 3, Low: 0.5, Close: 2, Volume: 1},
+		{Time: start.Add(time.Hour * 2)},
+		{Time: start.Add(time.Hour * 3)},
+		{Time: start.Add(time.Hour * 4), Open: 6, High: 7, Low: 5, Close: 6.5, Volume: 3},
+	}
+	newItem, err := old.ConvertToNewInterval(TwoHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newItem.Interval != TwoHour || newItem.Exchange != old.Exchange {
+		t.Errorf("unexpected item details %+v", newItem)
+	}
+	if len(newItem.Candles) != 2 {
+		t.Fatalf("received %v candles expected 2", len(newItem.Candles))
+	}
+	expected := Candle{Time: start, Open: 1, High: 5, Low: 0.5, Close: 4, Volume: 3}
+	if newItem.Candles[0] != expected {
+		t.Errorf("received %+v expected %+v", newItem.Candles[0], expected)
+	}
+	if !newItem.Candles[1].Time.Equal(start.Add(time.Hour*4)) || newItem.Candles[1].Close != 6.5 {
+		t.Errorf("unexpected candle %+v", newItem.Candles[1])
+	}
+	if !old.Candles[0].Time.Equal(start.Add(time.Hour)) {
+		t.Error("original candles should not be modified")
+	}
+
+	dates := CalculateCandleDateRanges(start, start.Add(time.Hour*6), TwoHour, 0)
+	err = dates.VerifyResultsHaveData(newItem.Candles)
+	if !errors.Is(err, ErrMissingCandleData) {
+		t.Errorf("received %v expected %v", err, ErrMissingCandleData)
+	}
+}
+
 // The purpose of this benchmark is to highlight that requesting
 // '.Unix()` frequently is a slow process
 func BenchmarkJustifyIntervalTimeStoringUnixValues1(b *testing.B) {
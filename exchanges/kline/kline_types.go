@@ -41,6 +41,14 @@ const (
 var (
 	// ErrMissingCandleData is an error for missing candle data
 	ErrMissingCandleData = errors.New("missing candle data")
+	// ErrUnsetInterval is an error for an interval which has not been set
+	ErrUnsetInterval = errors.New("interval not set")
+	// ErrCanOnlyDownscaleCandles is an error for converting candles to an
+	// interval which is smaller than or equal to the current interval
+	ErrCanOnlyDownscaleCandles = errors.New("candles can only be converted to a larger interval")
+	// ErrWholeNumberScaling is an error for converting candles to an interval
+	// which is not a multiple of the current interval
+	ErrWholeNumberScaling = errors.New("new interval must be a multiple of the current interval")
 	// SupportedIntervals is a list of all supported intervals
 	SupportedIntervals = []Interval{
 		FifteenSecond,
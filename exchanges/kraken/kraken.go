@@ -1135,6 +1135,26 @@ func (k *Kraken) WithdrawStatus(c currency.Code, method string) ([]WithdrawStatu
 	return response.Result, GetError(response.Error)
 }
 
+// DepositStatus gets the status of recent deposits
+func (k *Kraken) DepositStatus(c currency.Code, method string) ([]DepositStatusResponse, error) {
+	var response struct {
+		Error  []string                `json:"error"`
+		Result []DepositStatusResponse `json:"result"`
+	}
+
+	params := url.Values{}
+	params.Set("asset", c.String())
+	if method != "" {
+		params.Set("method", method)
+	}
+
+	if err := k.SendAuthenticatedHTTPRequest(exchange.RestSpot, krakenDepositStatus, params, &response); err != nil {
+		return response.Result, err
+	}
+
+	return response.Result, GetError(response.Error)
+}
+
 // WithdrawCancel sends a withdrawal cancelation request
 func (k *Kraken) WithdrawCancel(c currency.Code, refID string) (bool, error) {
 	var response struct {
@@ -1075,6 +1075,17 @@ func TestWithdrawStatus(t *testing.T) {
 	}
 }
 
+// TestDepositStatus wrapper test
+func TestDepositStatus(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetDepositHistory(currency.BTC)
+	if areTestAPIKeysSet() && err != nil {
+		t.Error("GetDepositHistory() error", err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("GetDepositHistory() error cannot be nil")
+	}
+}
+
 // TestWithdrawCancel wrapper test
 func TestWithdrawCancel(t *testing.T) {
 	_, err := k.WithdrawCancel(currency.BTC, "")
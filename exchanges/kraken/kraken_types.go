@@ -36,6 +36,7 @@ const (
 	krakenDepositMethods   = "DepositMethods"
 	krakenDepositAddresses = "DepositAddresses"
 	krakenWithdrawStatus   = "WithdrawStatus"
+	krakenDepositStatus    = "DepositStatus"
 	krakenWithdrawCancel   = "WithdrawCancel"
 	krakenWebsocketToken   = "GetWebSocketsToken"
 
@@ -475,6 +476,20 @@ type WithdrawStatusResponse struct {
 	Status string  `json:"status"`
 }
 
+// DepositStatusResponse defines a deposit status response
+type DepositStatusResponse struct {
+	Method string  `json:"method"`
+	Aclass string  `json:"aclass"`
+	Asset  string  `json:"asset"`
+	Refid  string  `json:"refid"`
+	TxID   string  `json:"txid"`
+	Info   string  `json:"info"`
+	Amount float64 `json:"amount,string"`
+	Fee    float64 `json:"fee,string"`
+	Time   float64 `json:"time"`
+	Status string  `json:"status"`
+}
+
 // WebsocketSubscriptionEventRequest handles WS subscription events
 type WebsocketSubscriptionEventRequest struct {
 	Event        string                       `json:"event"`           // subscribe
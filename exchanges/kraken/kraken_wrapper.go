@@ -643,6 +643,29 @@ func (k *Kraken) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdra
 	return
 }
 
+// GetDepositHistory returns previous deposits data
+func (k *Kraken) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	deposits, err := k.DepositStatus(c, "")
+	if err != nil {
+		return nil, err
+	}
+	for i := range deposits {
+		resp = append(resp, exchange.DepositHistory{
+			Status:          deposits[i].Status,
+			TransferID:      deposits[i].Refid,
+			Timestamp:       time.Unix(int64(deposits[i].Time), 0),
+			Amount:          deposits[i].Amount,
+			Fee:             deposits[i].Fee,
+			TransferType:    deposits[i].Method,
+			CryptoToAddress: deposits[i].Info,
+			CryptoTxID:      deposits[i].TxID,
+			Currency:        c.String(),
+		})
+	}
+
+	return resp, nil
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (k *Kraken) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var err error
@@ -198,6 +198,14 @@ type GetAccountDepositHistoryResponse struct {
 	TransactionID string    `json:"txid"`
 }
 
+// depositStatusNames maps GetAccountDepositHistoryResponse statuses to their
+// documented meanings
+var depositStatusNames = map[int64]string{
+	0: "Waiting for confirmation",
+	1: "Credited",
+	2: "Success",
+}
+
 // GetSpotTradingAccountResponse response data for GetSpotTradingAccount
 type GetSpotTradingAccountResponse struct {
 	Available string `json:"available"`
@@ -478,6 +478,33 @@ func (o *OKGroup) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withdr
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (o *OKGroup) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	history, err := o.GetAccountDepositHistory(c.Lower().String())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range history {
+		status, ok := depositStatusNames[history[i].Status]
+		if !ok {
+			status = strconv.FormatInt(history[i].Status, 10)
+		}
+		resp = append(resp, exchange.DepositHistory{
+			Status:            status,
+			TransferID:        history[i].TransactionID,
+			Timestamp:         history[i].Timestamp,
+			Currency:          history[i].Currency,
+			Amount:            history[i].Amount,
+			CryptoFromAddress: history[i].From,
+			CryptoToAddress:   history[i].To,
+			CryptoTxID:        history[i].TransactionID,
+		})
+	}
+
+	return resp, nil
+}
+
 // GetActiveOrders retrieves any orders that are active/open
 func (o *OKGroup) GetActiveOrders(req *order.GetOrdersRequest) (resp []order.Detail, err error) {
 	err = req.Validate()
@@ -0,0 +1,61 @@
+package order
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+)
+
+var (
+	updates UpdateFeed
+
+	errNilDetail = errors.New("order detail is nil")
+)
+
+// UpdateFeed relays tracked orders to live subscribers via the dispatch
+// system each time an order is added or its status changes
+type UpdateFeed struct {
+	sync.Mutex
+	mux        *dispatch.Mux
+	id         uuid.UUID
+	subscribed int32
+}
+
+// SubscribeToUpdates returns a dispatch pipe which receives a Detail each time
+// an order is published through PublishUpdate
+func SubscribeToUpdates() (dispatch.Pipe, error) {
+	updates.Lock()
+	defer updates.Unlock()
+	if updates.mux == nil {
+		updates.mux = dispatch.GetNewMux()
+		id, err := updates.mux.GetID()
+		if err != nil {
+			updates.mux = nil
+			return dispatch.Pipe{}, err
+		}
+		updates.id = id
+	}
+	pipe, err := updates.mux.Subscribe(updates.id)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	atomic.StoreInt32(&updates.subscribed, 1)
+	return pipe, nil
+}
+
+// PublishUpdate relays a copy of the order detail to all update subscribers,
+// nothing is published until the feed has been subscribed to
+func PublishUpdate(d *Detail) error {
+	if d == nil {
+		return errNilDetail
+	}
+	if atomic.LoadInt32(&updates.subscribed) == 0 {
+		return nil
+	}
+	updates.Lock()
+	defer updates.Unlock()
+	return updates.mux.Publish([]uuid.UUID{updates.id}, d)
+}
@@ -0,0 +1,44 @@
+package order
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestSubscribeToUpdates(t *testing.T) {
+	if err := PublishUpdate(nil); !errors.Is(err, errNilDetail) {
+		t.Errorf("received %v expected %v", err, errNilDetail)
+	}
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pipe, err := SubscribeToUpdates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+	if atomic.LoadInt32(&updates.subscribed) != 1 {
+		t.Error("expected feed to be subscribed")
+	}
+	err = PublishUpdate(&Detail{
+		Exchange:  "test",
+		ID:        "1337",
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Status:    Cancelled,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
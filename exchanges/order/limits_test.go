@@ -317,3 +317,20 @@ func TestConformToAmount(t *testing.T) {
 		t.Fatal("unexpected amount", val)
 	}
 }
+
+func TestLevels(t *testing.T) {
+	t.Parallel()
+	var tt *Limits
+	if tt.Levels() != (MinMaxLevel{}) {
+		t.Fatal("expected empty levels for nil limits")
+	}
+
+	tt = &Limits{minPrice: 1, maxPrice: 10, stepIncrementSizeAmount: 0.1, maxTotalOrders: 5}
+	levels := tt.Levels()
+	if levels.MinPrice != 1 ||
+		levels.MaxPrice != 10 ||
+		levels.StepAmount != 0.1 ||
+		levels.MaxTotalOrders != 5 {
+		t.Errorf("unexpected levels %+v", levels)
+	}
+}
@@ -0,0 +1,359 @@
+package orderbook
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// basisPoints is the multiplier used to convert a ratio to basis points
+const basisPoints = 10000
+
+var (
+	errNoLiquidity           = errors.New("no liquidity on orderbook side")
+	errInsufficientLiquidity = errors.New("insufficient liquidity to reach amount")
+	errInvalidPercentage     = errors.New("percentage must be greater than zero")
+	errInvalidLevels         = errors.New("levels cannot be negative")
+)
+
+// Liquidity defines the amount and value held on each side of the book
+type Liquidity struct {
+	BidAmount float64
+	BidValue  float64
+	AskAmount float64
+	AskValue  float64
+}
+
+// FillResult defines the outcome of walking the book to fill an amount
+type FillResult struct {
+	// Amount is the requested base amount
+	Amount float64
+	// FilledAmount is the base amount the book can fill
+	FilledAmount float64
+	// Cost is the quote value of the filled amount
+	Cost float64
+	// AveragePrice is the volume weighted average price of the fill
+	AveragePrice float64
+	// WorstPrice is the last price level touched
+	WorstPrice float64
+	// ImpactBasisPoints is the difference between the average price and the
+	// mid price in basis points
+	ImpactBasisPoints float64
+	FullyFilled       bool
+}
+
+// DepthLevel defines the price level at which the cumulative amount is
+// reached, price is zero when the book does not hold the amount
+type DepthLevel struct {
+	Amount float64
+	Price  float64
+}
+
+// AnalyticsRequest defines the optional calculations for GetAnalytics
+type AnalyticsRequest struct {
+	// LiquidityPercentage is the distance from the mid price to sum liquidity
+	// within, zero skips the calculation
+	LiquidityPercentage float64
+	// ImbalanceLevels is the amount of levels used to calculate imbalance,
+	// zero uses the full book
+	ImbalanceLevels int
+	// ImpactAmounts are base amounts used to build the impact cost curves
+	ImpactAmounts []float64
+	// DepthAmounts are cumulative base amounts to find price levels for
+	DepthAmounts []float64
+}
+
+// Analytics defines a snapshot of depth analytics calculated under a single
+// lock
+type Analytics struct {
+	BestBid           float64
+	BestAsk           float64
+	MidPrice          float64
+	MicroPrice        float64
+	SpreadBasisPoints float64
+	Imbalance         float64
+	Liquidity         Liquidity
+	BuyImpact         []FillResult
+	SellImpact        []FillResult
+	BidDepth          []DepthLevel
+	AskDepth          []DepthLevel
+}
+
+// GetMidPrice returns the average of the best bid and best ask prices
+func (d *Depth) GetMidPrice() (float64, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.midPrice()
+}
+
+// GetMicroPrice returns the mid price weighted by the amounts at the best bid
+// and best ask, leaning towards the side with less liquidity
+func (d *Depth) GetMicroPrice() (float64, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.microPrice()
+}
+
+// GetSpreadBasisPoints returns the spread between the best bid and best ask
+// as basis points of the mid price
+func (d *Depth) GetSpreadBasisPoints() (float64, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.spreadBasisPoints()
+}
+
+// GetLiquidityWithinPercentageOfMid returns the amount and value of bids and
+// asks priced within a percentage of the mid price
+func (d *Depth) GetLiquidityWithinPercentageOfMid(percentage float64) (Liquidity, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.liquidityWithin(percentage)
+}
+
+// GetImbalance returns the bid and ask amount imbalance between -1 and 1 over
+// the supplied amount of levels, a positive value indicates more bids. Zero
+// levels uses the full book
+func (d *Depth) GetImbalance(levels int) (float64, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.imbalance(levels)
+}
+
+// GetVWAPForAmount walks the asks when buying or the bids when selling and
+// returns the volume weighted average price to fill the base amount
+func (d *Depth) GetVWAPForAmount(amount float64, buy bool) (*FillResult, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	mid, err := d.midPrice()
+	if err != nil {
+		return nil, err
+	}
+	return d.fill(amount, buy, mid)
+}
+
+// GetImpactCostCurve returns the fill result for each base amount
+func (d *Depth) GetImpactCostCurve(amounts []float64, buy bool) ([]FillResult, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.impactCurve(amounts, buy)
+}
+
+// GetPriceAtCumulativeDepth returns the price level at which the cumulative
+// base amount from the top of the bids or asks reaches the supplied amount
+func (d *Depth) GetPriceAtCumulativeDepth(amount float64, bid bool) (float64, error) {
+	if amount <= 0 {
+		return 0, errAmountInvalid
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	ll := d.side(bid)
+	if ll.head == nil {
+		return 0, errNoLiquidity
+	}
+	price := ll.priceAtCumulative(amount)
+	if price == 0 {
+		return 0, fmt.Errorf("%w %v", errInsufficientLiquidity, amount)
+	}
+	return price, nil
+}
+
+// GetAnalytics returns all depth analytics calculated against the same state
+// of the book
+func (d *Depth) GetAnalytics(req *AnalyticsRequest) (*Analytics, error) {
+	if req == nil {
+		req = &AnalyticsRequest{}
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	mid, err := d.midPrice()
+	if err != nil {
+		return nil, err
+	}
+	a := &Analytics{
+		BestBid:  d.bids.head.value.Price,
+		BestAsk:  d.asks.head.value.Price,
+		MidPrice: mid,
+	}
+	a.MicroPrice, err = d.microPrice()
+	if err != nil {
+		return nil, err
+	}
+	a.SpreadBasisPoints, err = d.spreadBasisPoints()
+	if err != nil {
+		return nil, err
+	}
+	a.Imbalance, err = d.imbalance(req.ImbalanceLevels)
+	if err != nil {
+		return nil, err
+	}
+	if req.LiquidityPercentage != 0 {
+		a.Liquidity, err = d.liquidityWithin(req.LiquidityPercentage)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(req.ImpactAmounts) != 0 {
+		a.BuyImpact, err = d.impactCurve(req.ImpactAmounts, true)
+		if err != nil {
+			return nil, err
+		}
+		a.SellImpact, err = d.impactCurve(req.ImpactAmounts, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for x := range req.DepthAmounts {
+		if req.DepthAmounts[x] <= 0 {
+			return nil, errAmountInvalid
+		}
+		a.BidDepth = append(a.BidDepth, DepthLevel{
+			Amount: req.DepthAmounts[x],
+			Price:  d.bids.priceAtCumulative(req.DepthAmounts[x]),
+		})
+		a.AskDepth = append(a.AskDepth, DepthLevel{
+			Amount: req.DepthAmounts[x],
+			Price:  d.asks.priceAtCumulative(req.DepthAmounts[x]),
+		})
+	}
+	return a, nil
+}
+
+// side returns the bids or asks linked list
+func (d *Depth) side(bid bool) *linkedList {
+	if bid {
+		return &d.bids.linkedList
+	}
+	return &d.asks.linkedList
+}
+
+func (d *Depth) midPrice() (float64, error) {
+	if d.bids.head == nil || d.asks.head == nil {
+		return 0, errNoLiquidity
+	}
+	return (d.bids.head.value.Price + d.asks.head.value.Price) / 2, nil
+}
+
+func (d *Depth) microPrice() (float64, error) {
+	if d.bids.head == nil || d.asks.head == nil {
+		return 0, errNoLiquidity
+	}
+	bid, ask := d.bids.head.value, d.asks.head.value
+	total := bid.Amount + ask.Amount
+	if total == 0 {
+		return (bid.Price + ask.Price) / 2, nil
+	}
+	return (bid.Price*ask.Amount + ask.Price*bid.Amount) / total, nil
+}
+
+func (d *Depth) spreadBasisPoints() (float64, error) {
+	mid, err := d.midPrice()
+	if err != nil {
+		return 0, err
+	}
+	return (d.asks.head.value.Price - d.bids.head.value.Price) / mid * basisPoints, nil
+}
+
+func (d *Depth) liquidityWithin(percentage float64) (Liquidity, error) {
+	if percentage <= 0 {
+		return Liquidity{}, errInvalidPercentage
+	}
+	mid, err := d.midPrice()
+	if err != nil {
+		return Liquidity{}, err
+	}
+	var l Liquidity
+	floor := mid * (1 - percentage/100)
+	l.BidAmount, l.BidValue = d.bids.amountWhile(func(price float64) bool { return price >= floor })
+	ceiling := mid * (1 + percentage/100)
+	l.AskAmount, l.AskValue = d.asks.amountWhile(func(price float64) bool { return price <= ceiling })
+	return l, nil
+}
+
+func (d *Depth) imbalance(levels int) (float64, error) {
+	if levels < 0 {
+		return 0, errInvalidLevels
+	}
+	if d.bids.head == nil && d.asks.head == nil {
+		return 0, errNoLiquidity
+	}
+	bidAmount := d.bids.levelsAmount(levels)
+	askAmount := d.asks.levelsAmount(levels)
+	if bidAmount+askAmount == 0 {
+		return 0, nil
+	}
+	return (bidAmount - askAmount) / (bidAmount + askAmount), nil
+}
+
+func (d *Depth) impactCurve(amounts []float64, buy bool) ([]FillResult, error) {
+	mid, err := d.midPrice()
+	if err != nil {
+		return nil, err
+	}
+	curve := make([]FillResult, len(amounts))
+	for x := range amounts {
+		var result *FillResult
+		result, err = d.fill(amounts[x], buy, mid)
+		if err != nil {
+			return nil, err
+		}
+		curve[x] = *result
+	}
+	return curve, nil
+}
+
+// fill walks the asks when buying or the bids when selling until the base
+// amount is filled or the side is exhausted
+func (d *Depth) fill(amount float64, buy bool, mid float64) (*FillResult, error) {
+	if amount <= 0 {
+		return nil, errAmountInvalid
+	}
+	r := &FillResult{Amount: amount}
+	for tip := d.side(!buy).head; tip != nil && r.FilledAmount < amount; tip = tip.next {
+		take := math.Min(tip.value.Amount, amount-r.FilledAmount)
+		r.FilledAmount += take
+		r.Cost += take * tip.value.Price
+		r.WorstPrice = tip.value.Price
+	}
+	if r.FilledAmount == 0 {
+		return nil, errNoLiquidity
+	}
+	r.FullyFilled = r.FilledAmount >= amount
+	r.AveragePrice = r.Cost / r.FilledAmount
+	r.ImpactBasisPoints = math.Abs(r.AveragePrice-mid) / mid * basisPoints
+	return r, nil
+}
+
+// amountWhile returns the liquidity and value of the levels from the top of
+// the list while the price condition holds
+func (ll *linkedList) amountWhile(within func(price float64) bool) (liquidity, value float64) {
+	for tip := ll.head; tip != nil && within(tip.value.Price); tip = tip.next {
+		liquidity += tip.value.Amount
+		value += tip.value.Amount * tip.value.Price
+	}
+	return
+}
+
+// levelsAmount returns the total amount of the top levels, zero levels
+// returns the amount of the full list
+func (ll *linkedList) levelsAmount(levels int) float64 {
+	var amount float64
+	var count int
+	for tip := ll.head; tip != nil && (levels == 0 || count < levels); tip = tip.next {
+		amount += tip.value.Amount
+		count++
+	}
+	return amount
+}
+
+// priceAtCumulative returns the price of the level at which the cumulative
+// amount is reached, zero is returned if the list does not hold the amount
+func (ll *linkedList) priceAtCumulative(amount float64) float64 {
+	var cumulative float64
+	for tip := ll.head; tip != nil; tip = tip.next {
+		cumulative += tip.value.Amount
+		if cumulative >= amount {
+			return tip.value.Price
+		}
+	}
+	return 0
+}
@@ -0,0 +1,199 @@
+package orderbook
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func newAnalyticsDepth() *Depth {
+	d := newDepth(id)
+	d.bids.load([]Item{
+		{Price: 99, Amount: 1},
+		{Price: 98, Amount: 2},
+		{Price: 90, Amount: 5},
+	}, d.stack)
+	d.asks.load([]Item{
+		{Price: 101, Amount: 3},
+		{Price: 102, Amount: 1},
+		{Price: 110, Amount: 5},
+	}, d.stack)
+	return d
+}
+
+func floatEquals(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestGetMidAndMicroPrice(t *testing.T) {
+	t.Parallel()
+	d := newDepth(id)
+	if _, err := d.GetMidPrice(); !errors.Is(err, errNoLiquidity) {
+		t.Fatalf("received %v expected %v", err, errNoLiquidity)
+	}
+	if _, err := d.GetMicroPrice(); !errors.Is(err, errNoLiquidity) {
+		t.Fatalf("received %v expected %v", err, errNoLiquidity)
+	}
+
+	d = newAnalyticsDepth()
+	mid, err := d.GetMidPrice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mid != 100 {
+		t.Errorf("received %v expected %v", mid, 100)
+	}
+	micro, err := d.GetMicroPrice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// more ask liquidity at the top pulls the micro price towards the bid
+	if !floatEquals(micro, 99.5) {
+		t.Errorf("received %v expected %v", micro, 99.5)
+	}
+	spread, err := d.GetSpreadBasisPoints()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !floatEquals(spread, 200) {
+		t.Errorf("received %v expected %v", spread, 200)
+	}
+}
+
+func TestGetLiquidityWithinPercentageOfMid(t *testing.T) {
+	t.Parallel()
+	d := newAnalyticsDepth()
+	if _, err := d.GetLiquidityWithinPercentageOfMid(0); !errors.Is(err, errInvalidPercentage) {
+		t.Fatalf("received %v expected %v", err, errInvalidPercentage)
+	}
+	l, err := d.GetLiquidityWithinPercentageOfMid(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.BidAmount != 3 || l.BidValue != 295 {
+		t.Errorf("unexpected bid liquidity %+v", l)
+	}
+	if l.AskAmount != 4 || l.AskValue != 405 {
+		t.Errorf("unexpected ask liquidity %+v", l)
+	}
+}
+
+func TestGetImbalance(t *testing.T) {
+	t.Parallel()
+	d := newAnalyticsDepth()
+	if _, err := d.GetImbalance(-1); !errors.Is(err, errInvalidLevels) {
+		t.Fatalf("received %v expected %v", err, errInvalidLevels)
+	}
+	imbalance, err := d.GetImbalance(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !floatEquals(imbalance, -0.5) {
+		t.Errorf("received %v expected %v", imbalance, -0.5)
+	}
+	imbalance, err = d.GetImbalance(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !floatEquals(imbalance, -1.0/17) {
+		t.Errorf("received %v expected %v", imbalance, -1.0/17)
+	}
+}
+
+func TestGetVWAPForAmount(t *testing.T) {
+	t.Parallel()
+	d := newAnalyticsDepth()
+	if _, err := d.GetVWAPForAmount(0, true); !errors.Is(err, errAmountInvalid) {
+		t.Fatalf("received %v expected %v", err, errAmountInvalid)
+	}
+	r, err := d.GetVWAPForAmount(4, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.FullyFilled || r.Cost != 405 || r.WorstPrice != 102 {
+		t.Errorf("unexpected buy fill %+v", r)
+	}
+	if !floatEquals(r.AveragePrice, 101.25) || !floatEquals(r.ImpactBasisPoints, 125) {
+		t.Errorf("unexpected buy fill %+v", r)
+	}
+	r, err = d.GetVWAPForAmount(10, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.FullyFilled || r.FilledAmount != 8 || r.WorstPrice != 90 {
+		t.Errorf("unexpected sell fill %+v", r)
+	}
+
+	curve, err := d.GetImpactCostCurve([]float64{1, 4, 9}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(curve) != 3 {
+		t.Fatalf("received %v results expected 3", len(curve))
+	}
+	for x := 1; x < len(curve); x++ {
+		if curve[x].ImpactBasisPoints < curve[x-1].ImpactBasisPoints {
+			t.Error("expected impact to increase with size")
+		}
+	}
+	if _, err = newDepth(id).GetImpactCostCurve([]float64{1}, true); !errors.Is(err, errNoLiquidity) {
+		t.Errorf("received %v expected %v", err, errNoLiquidity)
+	}
+}
+
+func TestGetPriceAtCumulativeDepth(t *testing.T) {
+	t.Parallel()
+	d := newAnalyticsDepth()
+	price, err := d.GetPriceAtCumulativeDepth(3, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price != 98 {
+		t.Errorf("received %v expected %v", price, 98)
+	}
+	price, err = d.GetPriceAtCumulativeDepth(3.5, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price != 102 {
+		t.Errorf("received %v expected %v", price, 102)
+	}
+	if _, err = d.GetPriceAtCumulativeDepth(100, false); !errors.Is(err, errInsufficientLiquidity) {
+		t.Errorf("received %v expected %v", err, errInsufficientLiquidity)
+	}
+	if _, err = newDepth(id).GetPriceAtCumulativeDepth(1, true); !errors.Is(err, errNoLiquidity) {
+		t.Errorf("received %v expected %v", err, errNoLiquidity)
+	}
+}
+
+func TestGetAnalytics(t *testing.T) {
+	t.Parallel()
+	if _, err := newDepth(id).GetAnalytics(nil); !errors.Is(err, errNoLiquidity) {
+		t.Fatalf("received %v expected %v", err, errNoLiquidity)
+	}
+	d := newAnalyticsDepth()
+	a, err := d.GetAnalytics(&AnalyticsRequest{
+		LiquidityPercentage: 2,
+		ImbalanceLevels:     1,
+		ImpactAmounts:       []float64{1, 4},
+		DepthAmounts:        []float64{3, 100},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.BestBid != 99 || a.BestAsk != 101 || a.MidPrice != 100 {
+		t.Errorf("unexpected top of book %+v", a)
+	}
+	if len(a.BuyImpact) != 2 || len(a.SellImpact) != 2 {
+		t.Errorf("unexpected impact curves %+v %+v", a.BuyImpact, a.SellImpact)
+	}
+	if len(a.BidDepth) != 2 || a.BidDepth[0].Price != 98 || a.BidDepth[1].Price != 0 {
+		t.Errorf("unexpected bid depth %+v", a.BidDepth)
+	}
+	if a.Liquidity.AskAmount != 4 {
+		t.Errorf("received %v expected %v", a.Liquidity.AskAmount, 4)
+	}
+	if _, err = d.GetAnalytics(&AnalyticsRequest{DepthAmounts: []float64{-1}}); !errors.Is(err, errAmountInvalid) {
+		t.Errorf("received %v expected %v", err, errAmountInvalid)
+	}
+}
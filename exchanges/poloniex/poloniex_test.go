@@ -251,6 +251,20 @@ func TestGetOrderHistory(t *testing.T) {
 	}
 }
 
+func TestGetDepositHistory(t *testing.T) {
+	t.Parallel()
+	if mockTests {
+		t.Skip("deposit history requests include the current time and cannot be mocked")
+	}
+	_, err := p.GetDepositHistory(currency.BTC)
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Errorf("Could not get deposit history: %s", err)
+	case !areTestAPIKeysSet() && err == nil:
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
 func TestGetOrderStatus(t *testing.T) {
 	t.Parallel()
 
@@ -433,6 +433,32 @@ func (p *Poloniex) GetWithdrawalsHistory(c currency.Code) (resp []exchange.Withd
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetDepositHistory returns previous deposits data
+func (p *Poloniex) GetDepositHistory(c currency.Code) (resp []exchange.DepositHistory, err error) {
+	history, err := p.GetDepositsWithdrawals("", "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range history.Deposits {
+		if !strings.EqualFold(history.Deposits[i].Currency, c.String()) {
+			continue
+		}
+		resp = append(resp, exchange.DepositHistory{
+			Status:          history.Deposits[i].Status,
+			TransferID:      history.Deposits[i].TransactionID,
+			Timestamp:       time.Unix(history.Deposits[i].Timestamp, 0),
+			Currency:        history.Deposits[i].Currency,
+			Amount:          history.Deposits[i].Amount,
+			CryptoToAddress: history.Deposits[i].Address,
+			CryptoTxID:      history.Deposits[i].TransactionID,
+			Confirmations:   history.Deposits[i].Confirmations,
+		})
+	}
+
+	return resp, nil
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (p *Poloniex) GetRecentTrades(currencyPair currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	return p.GetHistoricTrades(currencyPair, assetType, time.Now().Add(-time.Minute*15), time.Now())
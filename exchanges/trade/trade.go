@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	tradesql "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -27,21 +28,18 @@ func (p *Processor) setup(wg *sync.WaitGroup) {
 	go p.Run(wg)
 }
 
-// AddTradesToBuffer will push trade data onto the buffer
+// AddTradesToBuffer will push trade data onto the buffer and relay it to any
+// live trade feed subscribers
 func AddTradesToBuffer(exchangeName string, data ...Data) error {
-	if database.DB == nil || database.DB.Config == nil || !database.DB.Config.Enabled {
+	saveToDatabase := database.DB != nil && database.DB.Config != nil && database.DB.Config.Enabled
+	relayToFeed := atomic.LoadInt32(&feed.subscribed) == 1
+	if !saveToDatabase && !relayToFeed {
 		return nil
 	}
 	if len(data) == 0 {
 		return nil
 	}
 	var errs common.Errors
-	if atomic.AddInt32(&processor.started, 0) == 0 {
-		var wg sync.WaitGroup
-		wg.Add(1)
-		processor.setup(&wg)
-		wg.Wait()
-	}
 	var validDatas []Data
 	for i := range data {
 		if data[i].Price == 0 ||
@@ -74,15 +72,62 @@ func AddTradesToBuffer(exchangeName string, data ...Data) error {
 		data[i].ID = uu
 		validDatas = append(validDatas, data[i])
 	}
-	processor.mutex.Lock()
-	processor.buffer = append(processor.buffer, validDatas...)
-	processor.mutex.Unlock()
+	if relayToFeed && len(validDatas) > 0 {
+		err := feed.publish(validDatas)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if saveToDatabase {
+		if atomic.AddInt32(&processor.started, 0) == 0 {
+			var wg sync.WaitGroup
+			wg.Add(1)
+			processor.setup(&wg)
+			wg.Wait()
+		}
+		processor.mutex.Lock()
+		processor.buffer = append(processor.buffer, validDatas...)
+		processor.mutex.Unlock()
+	}
 	if len(errs) > 0 {
 		return errs
 	}
 	return nil
 }
 
+// SubscribeToFeed returns a dispatch pipe which receives every batch of valid
+// trades sent through AddTradesToBuffer as []Data, regardless of whether the
+// database is enabled
+func SubscribeToFeed() (dispatch.Pipe, error) {
+	feed.Lock()
+	defer feed.Unlock()
+	if feed.mux == nil {
+		feed.mux = dispatch.GetNewMux()
+		id, err := feed.mux.GetID()
+		if err != nil {
+			feed.mux = nil
+			return dispatch.Pipe{}, err
+		}
+		feed.id = id
+	}
+	pipe, err := feed.mux.Subscribe(feed.id)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	atomic.StoreInt32(&feed.subscribed, 1)
+	return pipe, nil
+}
+
+// publish relays trades to all feed subscribers
+func (f *Feed) publish(trades []Data) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.mux == nil {
+		return nil
+	}
+	return f.mux.Publish([]uuid.UUID{f.id}, &trades)
+}
+
 // Run will save trade data to the database in batches
 func (p *Processor) Run(wg *sync.WaitGroup) {
 	wg.Done()
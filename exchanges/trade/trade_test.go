@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -249,3 +250,37 @@ func TestGetTradesInRange(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestSubscribeToFeed(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pipe, err := SubscribeToFeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+	if atomic.LoadInt32(&feed.subscribed) != 1 {
+		t.Error("expected feed to be subscribed")
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	err = AddTradesToBuffer("test!", Data{
+		Timestamp:    time.Now(),
+		Exchange:     "test!",
+		CurrencyPair: cp,
+		AssetType:    asset.Spot,
+		Price:        1337,
+		Amount:       -1,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -16,6 +17,7 @@ const DefaultProcessorIntervalTime = time.Second * 15
 
 var (
 	processor Processor
+	feed      Feed
 	// BufferProcessorIntervalTime is the interval to save trade buffer data to the database.
 	// Change this by changing the runtime param `-tradeprocessinginterval=15s`
 	BufferProcessorIntervalTime = DefaultProcessorIntervalTime
@@ -43,6 +45,15 @@ type Processor struct {
 	buffer                  []Data
 }
 
+// Feed relays validated trades to live subscribers such as candle builders
+// via the dispatch system
+type Feed struct {
+	sync.Mutex
+	mux        *dispatch.Mux
+	id         uuid.UUID
+	subscribed int32
+}
+
 // ByDate sorts trades by date ascending
 type ByDate []Data
 
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Role groups the permissions granted to a remote control user or API token
+type Role string
+
+// Permission is required to call a remote procedure
+type Permission string
+
+// Supported roles
+const (
+	// RoleReadOnly may query the engine and exchanges
+	RoleReadOnly Role = "readonly"
+	// RoleTrader may also submit and cancel orders
+	RoleTrader Role = "trader"
+	// RoleWithdrawer may also request, approve and reject withdrawals
+	RoleWithdrawer Role = "withdrawer"
+	// RoleAdmin may call every remote procedure
+	RoleAdmin Role = "admin"
+)
+
+// Permissions required by remote procedures
+const (
+	PermissionRead     Permission = "read"
+	PermissionTrade    Permission = "trade"
+	PermissionWithdraw Permission = "withdraw"
+	PermissionAdmin    Permission = "admin"
+)
+
+// ErrInvalidRole is returned when a role is not supported
+var ErrInvalidRole = errors.New("invalid role")
+
+var rolePermissions = map[Role][]Permission{
+	RoleReadOnly:   {PermissionRead},
+	RoleTrader:     {PermissionRead, PermissionTrade},
+	RoleWithdrawer: {PermissionRead, PermissionWithdraw},
+	RoleAdmin:      {PermissionRead, PermissionTrade, PermissionWithdraw, PermissionAdmin},
+}
+
+// ParseRole returns the role by name
+func ParseRole(r string) (Role, error) {
+	role := Role(strings.ToLower(r))
+	if _, ok := rolePermissions[role]; !ok {
+		return "", fmt.Errorf("%q %w", r, ErrInvalidRole)
+	}
+	return role, nil
+}
+
+// Allows returns whether the role grants the permission
+func (r Role) Allows(p Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+// BearerToken stores an API token sent as a bearer authorisation
+type BearerToken struct {
+	Token string
+}
+
+// GetRequestMetadata is a implementation of the GetRequestMetadata function
+func (b BearerToken) GetRequestMetadata(ctx context.Context, in ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + b.Token,
+	}, nil
+}
+
+// RequireTransportSecurity is required for bearer tokens
+func (BearerToken) RequireTransportSecurity() bool {
+	return true
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParseRole(t *testing.T) {
+	t.Parallel()
+	r, err := ParseRole("Trader")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != RoleTrader {
+		t.Errorf("received %v expected %v", r, RoleTrader)
+	}
+	_, err = ParseRole("")
+	if !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("received %v expected %v", err, ErrInvalidRole)
+	}
+}
+
+func TestRoleAllows(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		role    Role
+		allowed []Permission
+		denied  []Permission
+	}{
+		{RoleReadOnly, []Permission{PermissionRead}, []Permission{PermissionTrade, PermissionWithdraw, PermissionAdmin}},
+		{RoleTrader, []Permission{PermissionRead, PermissionTrade}, []Permission{PermissionWithdraw, PermissionAdmin}},
+		{RoleWithdrawer, []Permission{PermissionRead, PermissionWithdraw}, []Permission{PermissionTrade, PermissionAdmin}},
+		{RoleAdmin, []Permission{PermissionRead, PermissionTrade, PermissionWithdraw, PermissionAdmin}, nil},
+		{"", nil, []Permission{PermissionRead}},
+	}
+	for _, tt := range tests {
+		for _, p := range tt.allowed {
+			if !tt.role.Allows(p) {
+				t.Errorf("%q should allow %v", tt.role, p)
+			}
+		}
+		for _, p := range tt.denied {
+			if tt.role.Allows(p) {
+				t.Errorf("%q should not allow %v", tt.role, p)
+			}
+		}
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	t.Parallel()
+	md, err := BearerToken{Token: "abc"}.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if md["authorization"] != "Bearer abc" {
+		t.Errorf("received %v expected %v", md["authorization"], "Bearer abc")
+	}
+}
@@ -62,12 +62,24 @@ type GoCryptoTraderClient interface {
 	WithdrawalEventByID(ctx context.Context, in *WithdrawalEventByIDRequest, opts ...grpc.CallOption) (*WithdrawalEventByIDResponse, error)
 	WithdrawalEventsByExchange(ctx context.Context, in *WithdrawalEventsByExchangeRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error)
 	WithdrawalEventsByDate(ctx context.Context, in *WithdrawalEventsByDateRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error)
+	WithdrawalEventsByApprovalStatus(ctx context.Context, in *WithdrawalEventsByApprovalStatusRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error)
+	DepositEventsByExchange(ctx context.Context, in *DepositEventsByExchangeRequest, opts ...grpc.CallOption) (*DepositEventsByExchangeResponse, error)
+	GetRebalancePlan(ctx context.Context, in *RebalancePlanRequest, opts ...grpc.CallOption) (*RebalancePlanResponse, error)
+	ExecuteRebalancePlan(ctx context.Context, in *ExecuteRebalancePlanRequest, opts ...grpc.CallOption) (*ExecuteRebalancePlanResponse, error)
+	GetPortfolioHistory(ctx context.Context, in *GetPortfolioHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioHistoryResponse, error)
+	GetTaxReport(ctx context.Context, in *GetTaxReportRequest, opts ...grpc.CallOption) (*GetTaxReportResponse, error)
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error)
+	RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetAPITokens(ctx context.Context, in *GetAPITokensRequest, opts ...grpc.CallOption) (*GetAPITokensResponse, error)
+	ApproveWithdrawal(ctx context.Context, in *WithdrawalApprovalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	RejectWithdrawal(ctx context.Context, in *WithdrawalApprovalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
 	GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
 	SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
 	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
 	SetExchangePair(ctx context.Context, in *SetExchangePairRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error)
 	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error)
+	GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetConsolidatedOrderbookStreamClient, error)
 	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error)
 	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error)
 	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
@@ -80,6 +92,12 @@ type GoCryptoTraderClient interface {
 	GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
 	GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GCTScriptListVersions(ctx context.Context, in *GCTScriptListVersionsRequest, opts ...grpc.CallOption) (*GCTScriptListVersionsResponse, error)
+	GCTScriptDiffVersions(ctx context.Context, in *GCTScriptDiffVersionsRequest, opts ...grpc.CallOption) (*GCTScriptDiffVersionsResponse, error)
+	GCTScriptRollback(ctx context.Context, in *GCTScriptRollbackRequest, opts ...grpc.CallOption) (*GCTScriptVersion, error)
+	GCTScriptAddSchedule(ctx context.Context, in *GCTScriptSchedule, opts ...grpc.CallOption) (*GenericResponse, error)
+	GCTScriptRemoveSchedule(ctx context.Context, in *GCTScriptRemoveScheduleRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GCTScriptListSchedules(ctx context.Context, in *GCTScriptListSchedulesRequest, opts ...grpc.CallOption) (*GCTScriptListSchedulesResponse, error)
 	GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
 	SetExchangeAsset(ctx context.Context, in *SetExchangeAssetRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	SetAllExchangePairs(ctx context.Context, in *SetExchangeAllPairsRequest, opts ...grpc.CallOption) (*GenericResponse, error)
@@ -97,6 +115,12 @@ type GoCryptoTraderClient interface {
 	FindMissingSavedCandleIntervals(ctx context.Context, in *FindMissingCandlePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
 	FindMissingSavedTradeIntervals(ctx context.Context, in *FindMissingTradePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
 	SetExchangeTradeProcessing(ctx context.Context, in *SetExchangeTradeProcessingRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	UpsertDataHistoryJob(ctx context.Context, in *UpsertDataHistoryJobRequest, opts ...grpc.CallOption) (*UpsertDataHistoryJobResponse, error)
+	GetDataHistoryJobDetails(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*DataHistoryJob, error)
+	GetActiveDataHistoryJobs(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error)
+	GetDataHistoryJobsBetween(ctx context.Context, in *GetDataHistoryJobsBetweenRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error)
+	DeleteDataHistoryJob(ctx context.Context, in *DeleteDataHistoryJobRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetOrderbookAnalytics(ctx context.Context, in *GetOrderbookAnalyticsRequest, opts ...grpc.CallOption) (*GetOrderbookAnalyticsResponse, error)
 }
 
 type goCryptoTraderClient struct {
@@ -526,6 +550,105 @@ func (c *goCryptoTraderClient) WithdrawalEventsByDate(ctx context.Context, in *W
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) WithdrawalEventsByApprovalStatus(ctx context.Context, in *WithdrawalEventsByApprovalStatusRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error) {
+	out := new(WithdrawalEventsByExchangeResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawalEventsByApprovalStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DepositEventsByExchange(ctx context.Context, in *DepositEventsByExchangeRequest, opts ...grpc.CallOption) (*DepositEventsByExchangeResponse, error) {
+	out := new(DepositEventsByExchangeResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DepositEventsByExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetRebalancePlan(ctx context.Context, in *RebalancePlanRequest, opts ...grpc.CallOption) (*RebalancePlanResponse, error) {
+	out := new(RebalancePlanResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRebalancePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ExecuteRebalancePlan(ctx context.Context, in *ExecuteRebalancePlanRequest, opts ...grpc.CallOption) (*ExecuteRebalancePlanResponse, error) {
+	out := new(ExecuteRebalancePlanResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ExecuteRebalancePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolioHistory(ctx context.Context, in *GetPortfolioHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioHistoryResponse, error) {
+	out := new(GetPortfolioHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTaxReport(ctx context.Context, in *GetTaxReportRequest, opts ...grpc.CallOption) (*GetTaxReportResponse, error) {
+	out := new(GetTaxReportResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTaxReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error) {
+	out := new(CreateAPITokenResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CreateAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RevokeAPIToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAPITokens(ctx context.Context, in *GetAPITokensRequest, opts ...grpc.CallOption) (*GetAPITokensResponse, error) {
+	out := new(GetAPITokensResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAPITokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ApproveWithdrawal(ctx context.Context, in *WithdrawalApprovalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ApproveWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RejectWithdrawal(ctx context.Context, in *WithdrawalApprovalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RejectWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
 	out := new(GetLoggerDetailsResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLoggerDetails", in, out, opts...)
@@ -626,8 +749,40 @@ func (x *goCryptoTraderGetExchangeOrderbookStreamClient) Recv() (*OrderbookRespo
 	return m, nil
 }
 
+func (c *goCryptoTraderClient) GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetConsolidatedOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetConsolidatedOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetConsolidatedOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetConsolidatedOrderbookStreamClient interface {
+	Recv() (*ConsolidatedOrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetConsolidatedOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetConsolidatedOrderbookStreamClient) Recv() (*ConsolidatedOrderbookResponse, error) {
+	m := new(ConsolidatedOrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *goCryptoTraderClient) GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -659,7 +814,7 @@ func (x *goCryptoTraderGetTickerStreamClient) Recv() (*TickerResponse, error) {
 }
 
 func (c *goCryptoTraderClient) GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[5], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -780,6 +935,60 @@ func (c *goCryptoTraderClient) GCTScriptAutoLoadToggle(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) GCTScriptListVersions(ctx context.Context, in *GCTScriptListVersionsRequest, opts ...grpc.CallOption) (*GCTScriptListVersionsResponse, error) {
+	out := new(GCTScriptListVersionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptListVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptDiffVersions(ctx context.Context, in *GCTScriptDiffVersionsRequest, opts ...grpc.CallOption) (*GCTScriptDiffVersionsResponse, error) {
+	out := new(GCTScriptDiffVersionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptDiffVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptRollback(ctx context.Context, in *GCTScriptRollbackRequest, opts ...grpc.CallOption) (*GCTScriptVersion, error) {
+	out := new(GCTScriptVersion)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptRollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptAddSchedule(ctx context.Context, in *GCTScriptSchedule, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptAddSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptRemoveSchedule(ctx context.Context, in *GCTScriptRemoveScheduleRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptRemoveSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptListSchedules(ctx context.Context, in *GCTScriptListSchedulesRequest, opts ...grpc.CallOption) (*GCTScriptListSchedulesResponse, error) {
+	out := new(GCTScriptListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error) {
 	out := new(GetHistoricCandlesResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetHistoricCandles", in, out, opts...)
@@ -880,7 +1089,7 @@ func (c *goCryptoTraderClient) GetRecentTrades(ctx context.Context, in *GetSaved
 }
 
 func (c *goCryptoTraderClient) GetHistoricTrades(ctx context.Context, in *GetSavedTradesRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetHistoricTradesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[5], "/gctrpc.GoCryptoTrader/GetHistoricTrades", opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[6], "/gctrpc.GoCryptoTrader/GetHistoricTrades", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -956,6 +1165,60 @@ func (c *goCryptoTraderClient) SetExchangeTradeProcessing(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) UpsertDataHistoryJob(ctx context.Context, in *UpsertDataHistoryJobRequest, opts ...grpc.CallOption) (*UpsertDataHistoryJobResponse, error) {
+	out := new(UpsertDataHistoryJobResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/UpsertDataHistoryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDataHistoryJobDetails(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*DataHistoryJob, error) {
+	out := new(DataHistoryJob)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataHistoryJobDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetActiveDataHistoryJobs(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error) {
+	out := new(DataHistoryJobs)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetActiveDataHistoryJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDataHistoryJobsBetween(ctx context.Context, in *GetDataHistoryJobsBetweenRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error) {
+	out := new(DataHistoryJobs)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataHistoryJobsBetween", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DeleteDataHistoryJob(ctx context.Context, in *DeleteDataHistoryJobRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DeleteDataHistoryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbookAnalytics(ctx context.Context, in *GetOrderbookAnalyticsRequest, opts ...grpc.CallOption) (*GetOrderbookAnalyticsResponse, error) {
+	out := new(GetOrderbookAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbookAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServer is the server API for GoCryptoTrader service.
 // All implementations must embed UnimplementedGoCryptoTraderServer
 // for forward compatibility
@@ -1004,12 +1267,24 @@ type GoCryptoTraderServer interface {
 	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
 	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
 	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
+	WithdrawalEventsByApprovalStatus(context.Context, *WithdrawalEventsByApprovalStatusRequest) (*WithdrawalEventsByExchangeResponse, error)
+	DepositEventsByExchange(context.Context, *DepositEventsByExchangeRequest) (*DepositEventsByExchangeResponse, error)
+	GetRebalancePlan(context.Context, *RebalancePlanRequest) (*RebalancePlanResponse, error)
+	ExecuteRebalancePlan(context.Context, *ExecuteRebalancePlanRequest) (*ExecuteRebalancePlanResponse, error)
+	GetPortfolioHistory(context.Context, *GetPortfolioHistoryRequest) (*GetPortfolioHistoryResponse, error)
+	GetTaxReport(context.Context, *GetTaxReportRequest) (*GetTaxReportResponse, error)
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error)
+	RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*GenericResponse, error)
+	GetAPITokens(context.Context, *GetAPITokensRequest) (*GetAPITokensResponse, error)
+	ApproveWithdrawal(context.Context, *WithdrawalApprovalRequest) (*WithdrawResponse, error)
+	RejectWithdrawal(context.Context, *WithdrawalApprovalRequest) (*WithdrawResponse, error)
 	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
 	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
 	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
 	SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error)
 	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
 	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
+	GetConsolidatedOrderbookStream(*GetConsolidatedOrderbookStreamRequest, GoCryptoTrader_GetConsolidatedOrderbookStreamServer) error
 	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
 	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
 	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
@@ -1022,6 +1297,12 @@ type GoCryptoTraderServer interface {
 	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error)
 	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
 	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error)
+	GCTScriptListVersions(context.Context, *GCTScriptListVersionsRequest) (*GCTScriptListVersionsResponse, error)
+	GCTScriptDiffVersions(context.Context, *GCTScriptDiffVersionsRequest) (*GCTScriptDiffVersionsResponse, error)
+	GCTScriptRollback(context.Context, *GCTScriptRollbackRequest) (*GCTScriptVersion, error)
+	GCTScriptAddSchedule(context.Context, *GCTScriptSchedule) (*GenericResponse, error)
+	GCTScriptRemoveSchedule(context.Context, *GCTScriptRemoveScheduleRequest) (*GenericResponse, error)
+	GCTScriptListSchedules(context.Context, *GCTScriptListSchedulesRequest) (*GCTScriptListSchedulesResponse, error)
 	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
 	SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error)
 	SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error)
@@ -1039,6 +1320,12 @@ type GoCryptoTraderServer interface {
 	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
 	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
 	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
+	UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error)
+	GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
+	GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error)
+	GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error)
+	DeleteDataHistoryJob(context.Context, *DeleteDataHistoryJobRequest) (*GenericResponse, error)
+	GetOrderbookAnalytics(context.Context, *GetOrderbookAnalyticsRequest) (*GetOrderbookAnalyticsResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServer()
 }
 
@@ -1178,6 +1465,39 @@ func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByExchange(context.Cont
 func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByDate not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByApprovalStatus(context.Context, *WithdrawalEventsByApprovalStatusRequest) (*WithdrawalEventsByExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByApprovalStatus not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) DepositEventsByExchange(context.Context, *DepositEventsByExchangeRequest) (*DepositEventsByExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositEventsByExchange not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetRebalancePlan(context.Context, *RebalancePlanRequest) (*RebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRebalancePlan not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ExecuteRebalancePlan(context.Context, *ExecuteRebalancePlanRequest) (*ExecuteRebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRebalancePlan not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPortfolioHistory(context.Context, *GetPortfolioHistoryRequest) (*GetPortfolioHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTaxReport(context.Context, *GetTaxReportRequest) (*GetTaxReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxReport not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIToken not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetAPITokens(context.Context, *GetAPITokensRequest) (*GetAPITokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPITokens not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ApproveWithdrawal(context.Context, *WithdrawalApprovalRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RejectWithdrawal(context.Context, *WithdrawalApprovalRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectWithdrawal not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
 }
@@ -1196,6 +1516,9 @@ func (UnimplementedGoCryptoTraderServer) GetOrderbookStream(*GetOrderbookStreamR
 func (UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) GetConsolidatedOrderbookStream(*GetConsolidatedOrderbookStreamRequest, GoCryptoTrader_GetConsolidatedOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbookStream not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
 }
@@ -1232,6 +1555,24 @@ func (UnimplementedGoCryptoTraderServer) GCTScriptListAll(context.Context, *GCTS
 func (UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) GCTScriptListVersions(context.Context, *GCTScriptListVersionsRequest) (*GCTScriptListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListVersions not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptDiffVersions(context.Context, *GCTScriptDiffVersionsRequest) (*GCTScriptDiffVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptDiffVersions not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptRollback(context.Context, *GCTScriptRollbackRequest) (*GCTScriptVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptRollback not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptAddSchedule(context.Context, *GCTScriptSchedule) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAddSchedule not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptRemoveSchedule(context.Context, *GCTScriptRemoveScheduleRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptRemoveSchedule not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptListSchedules(context.Context, *GCTScriptListSchedulesRequest) (*GCTScriptListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListSchedules not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
 }
@@ -1283,6 +1624,24 @@ func (UnimplementedGoCryptoTraderServer) FindMissingSavedTradeIntervals(context.
 func (UnimplementedGoCryptoTraderServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDataHistoryJob not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveDataHistoryJobs not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobsBetween not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) DeleteDataHistoryJob(context.Context, *DeleteDataHistoryJobRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDataHistoryJob not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbookAnalytics(context.Context, *GetOrderbookAnalyticsRequest) (*GetOrderbookAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookAnalytics not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) mustEmbedUnimplementedGoCryptoTraderServer() {}
 
 // UnsafeGoCryptoTraderServer may be embedded to opt out of forward compatibility for this service.
@@ -2091,96 +2450,294 @@ func _GoCryptoTrader_WithdrawalEventsByDate_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoggerDetailsRequest)
+func _GoCryptoTrader_WithdrawalEventsByApprovalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventsByApprovalStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByApprovalStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByApprovalStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByApprovalStatus(ctx, req.(*WithdrawalEventsByApprovalStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLoggerDetailsRequest)
+func _GoCryptoTrader_DepositEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositEventsByExchangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).DepositEventsByExchange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/DepositEventsByExchange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).DepositEventsByExchange(ctx, req.(*DepositEventsByExchangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangePairsRequest)
+func _GoCryptoTrader_GetRebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalancePlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServer).GetRebalancePlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRebalancePlan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
+		return srv.(GoCryptoTraderServer).GetRebalancePlan(ctx, req.(*RebalancePlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangePairRequest)
+func _GoCryptoTrader_ExecuteRebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteRebalancePlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).ExecuteRebalancePlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/ExecuteRebalancePlan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
+		return srv.(GoCryptoTraderServer).ExecuteRebalancePlan(ctx, req.(*ExecuteRebalancePlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoCryptoTrader_GetPortfolioHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioHistory(ctx, req.(*GetPortfolioHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type GoCryptoTrader_GetOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
+func _GoCryptoTrader_GetTaxReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTaxReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTaxReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTaxReport(ctx, req.(*GetTaxReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type goCryptoTraderGetOrderbookStreamServer struct {
-	grpc.ServerStream
+func _GoCryptoTrader_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CreateAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
+func _GoCryptoTrader_RevokeAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RevokeAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RevokeAPIToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RevokeAPIToken(ctx, req.(*RevokeAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAPITokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetAPITokens(ctx, req.(*GetAPITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_ApproveWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).ApproveWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/ApproveWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).ApproveWithdrawal(ctx, req.(*WithdrawalApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RejectWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RejectWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RejectWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RejectWithdrawal(ctx, req.(*WithdrawalApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoggerDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoggerDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangePairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangePairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangePair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -2205,6 +2762,27 @@ func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookRespon
 	return x.ServerStream.SendMsg(m)
 }
 
+func _GoCryptoTrader_GetConsolidatedOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConsolidatedOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetConsolidatedOrderbookStream(m, &goCryptoTraderGetConsolidatedOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetConsolidatedOrderbookStreamServer interface {
+	Send(*ConsolidatedOrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetConsolidatedOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetConsolidatedOrderbookStreamServer) Send(m *ConsolidatedOrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetTickerStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2427,6 +3005,114 @@ func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_GCTScriptListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GCTScriptListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GCTScriptListVersions(ctx, req.(*GCTScriptListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GCTScriptDiffVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptDiffVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GCTScriptDiffVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptDiffVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GCTScriptDiffVersions(ctx, req.(*GCTScriptDiffVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GCTScriptRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GCTScriptRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptRollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GCTScriptRollback(ctx, req.(*GCTScriptRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GCTScriptAddSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GCTScriptAddSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAddSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GCTScriptAddSchedule(ctx, req.(*GCTScriptSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GCTScriptRemoveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptRemoveScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GCTScriptRemoveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptRemoveSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GCTScriptRemoveSchedule(ctx, req.(*GCTScriptRemoveScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GCTScriptListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GCTScriptListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GCTScriptListSchedules(ctx, req.(*GCTScriptListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetHistoricCandlesRequest)
 	if err := dec(in); err != nil {
@@ -2736,6 +3422,114 @@ func _GoCryptoTrader_SetExchangeTradeProcessing_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_UpsertDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDataHistoryJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).UpsertDataHistoryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/UpsertDataHistoryJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).UpsertDataHistoryJob(ctx, req.(*UpsertDataHistoryJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetDataHistoryJobDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobDetails(ctx, req.(*GetDataHistoryJobDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetActiveDataHistoryJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetActiveDataHistoryJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetActiveDataHistoryJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetActiveDataHistoryJobs(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetDataHistoryJobsBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobsBetweenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobsBetween(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobsBetween",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobsBetween(ctx, req.(*GetDataHistoryJobsBetweenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DeleteDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDataHistoryJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DeleteDataHistoryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DeleteDataHistoryJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DeleteDataHistoryJob(ctx, req.(*DeleteDataHistoryJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbookAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbookAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbookAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbookAnalytics(ctx, req.(*GetOrderbookAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoCryptoTrader_ServiceDesc is the grpc.ServiceDesc for GoCryptoTrader service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2915,6 +3709,50 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawalEventsByDate",
 			Handler:    _GoCryptoTrader_WithdrawalEventsByDate_Handler,
 		},
+		{
+			MethodName: "WithdrawalEventsByApprovalStatus",
+			Handler:    _GoCryptoTrader_WithdrawalEventsByApprovalStatus_Handler,
+		},
+		{
+			MethodName: "DepositEventsByExchange",
+			Handler:    _GoCryptoTrader_DepositEventsByExchange_Handler,
+		},
+		{
+			MethodName: "GetRebalancePlan",
+			Handler:    _GoCryptoTrader_GetRebalancePlan_Handler,
+		},
+		{
+			MethodName: "ExecuteRebalancePlan",
+			Handler:    _GoCryptoTrader_ExecuteRebalancePlan_Handler,
+		},
+		{
+			MethodName: "GetPortfolioHistory",
+			Handler:    _GoCryptoTrader_GetPortfolioHistory_Handler,
+		},
+		{
+			MethodName: "GetTaxReport",
+			Handler:    _GoCryptoTrader_GetTaxReport_Handler,
+		},
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _GoCryptoTrader_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "RevokeAPIToken",
+			Handler:    _GoCryptoTrader_RevokeAPIToken_Handler,
+		},
+		{
+			MethodName: "GetAPITokens",
+			Handler:    _GoCryptoTrader_GetAPITokens_Handler,
+		},
+		{
+			MethodName: "ApproveWithdrawal",
+			Handler:    _GoCryptoTrader_ApproveWithdrawal_Handler,
+		},
+		{
+			MethodName: "RejectWithdrawal",
+			Handler:    _GoCryptoTrader_RejectWithdrawal_Handler,
+		},
 		{
 			MethodName: "GetLoggerDetails",
 			Handler:    _GoCryptoTrader_GetLoggerDetails_Handler,
@@ -2971,6 +3809,30 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GCTScriptAutoLoadToggle",
 			Handler:    _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler,
 		},
+		{
+			MethodName: "GCTScriptListVersions",
+			Handler:    _GoCryptoTrader_GCTScriptListVersions_Handler,
+		},
+		{
+			MethodName: "GCTScriptDiffVersions",
+			Handler:    _GoCryptoTrader_GCTScriptDiffVersions_Handler,
+		},
+		{
+			MethodName: "GCTScriptRollback",
+			Handler:    _GoCryptoTrader_GCTScriptRollback_Handler,
+		},
+		{
+			MethodName: "GCTScriptAddSchedule",
+			Handler:    _GoCryptoTrader_GCTScriptAddSchedule_Handler,
+		},
+		{
+			MethodName: "GCTScriptRemoveSchedule",
+			Handler:    _GoCryptoTrader_GCTScriptRemoveSchedule_Handler,
+		},
+		{
+			MethodName: "GCTScriptListSchedules",
+			Handler:    _GoCryptoTrader_GCTScriptListSchedules_Handler,
+		},
 		{
 			MethodName: "GetHistoricCandles",
 			Handler:    _GoCryptoTrader_GetHistoricCandles_Handler,
@@ -3035,6 +3897,30 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetExchangeTradeProcessing",
 			Handler:    _GoCryptoTrader_SetExchangeTradeProcessing_Handler,
 		},
+		{
+			MethodName: "UpsertDataHistoryJob",
+			Handler:    _GoCryptoTrader_UpsertDataHistoryJob_Handler,
+		},
+		{
+			MethodName: "GetDataHistoryJobDetails",
+			Handler:    _GoCryptoTrader_GetDataHistoryJobDetails_Handler,
+		},
+		{
+			MethodName: "GetActiveDataHistoryJobs",
+			Handler:    _GoCryptoTrader_GetActiveDataHistoryJobs_Handler,
+		},
+		{
+			MethodName: "GetDataHistoryJobsBetween",
+			Handler:    _GoCryptoTrader_GetDataHistoryJobsBetween_Handler,
+		},
+		{
+			MethodName: "DeleteDataHistoryJob",
+			Handler:    _GoCryptoTrader_DeleteDataHistoryJob_Handler,
+		},
+		{
+			MethodName: "GetOrderbookAnalytics",
+			Handler:    _GoCryptoTrader_GetOrderbookAnalytics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3052,6 +3938,11 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetExchangeOrderbookStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetConsolidatedOrderbookStream",
+			Handler:       _GoCryptoTrader_GetConsolidatedOrderbookStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetTickerStream",
 			Handler:       _GoCryptoTrader_GetTickerStream_Handler,
@@ -0,0 +1,109 @@
+package gct
+
+import (
+	"fmt"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+func (m *Module) commsModule() map[string]objects.Object {
+	return map[string]objects.Object{
+		"send":         &objects.UserFunction{Name: "send", Value: m.CommsSend},
+		"sendtemplate": &objects.UserFunction{Name: "sendtemplate", Value: m.CommsSendTemplate},
+		"mediums":      &objects.UserFunction{Name: "mediums", Value: m.CommsMediums},
+	}
+}
+
+// CommsSend sends a message through the enabled communication relayers or
+// only the named relayers when given
+func (m *Module) CommsSend(args ...objects.Object) (objects.Object, error) {
+	if len(args) < 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := m.scriptName()
+	if err != nil {
+		return nil, err
+	}
+	message, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, message)
+	}
+	if message == "" {
+		return nil, fmt.Errorf(ErrEmptyParameter, "message")
+	}
+	mediums, err := parseMediums(args[2:])
+	if err != nil {
+		return nil, err
+	}
+	err = m.wrapper().Notify(script, &modules.Notification{
+		Message: message,
+		Mediums: mediums,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects.TrueValue, nil
+}
+
+// CommsSendTemplate sends a message rendered from a configured template with
+// the data map through the enabled or named communication relayers
+func (m *Module) CommsSendTemplate(args ...objects.Object) (objects.Object, error) {
+	if len(args) < 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := m.scriptName()
+	if err != nil {
+		return nil, err
+	}
+	template, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, template)
+	}
+	if template == "" {
+		return nil, fmt.Errorf(ErrEmptyParameter, "template")
+	}
+	data, ok := objects.ToInterface(args[2]).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[2])
+	}
+	mediums, err := parseMediums(args[3:])
+	if err != nil {
+		return nil, err
+	}
+	err = m.wrapper().Notify(script, &modules.Notification{
+		Template: template,
+		Data:     data,
+		Mediums:  mediums,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects.TrueValue, nil
+}
+
+// CommsMediums returns the names of the enabled and connected communication
+// relayers
+func (m *Module) CommsMediums(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 0 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	mediums := m.wrapper().CommsMediums()
+	r := objects.Array{}
+	for x := range mediums {
+		r.Value = append(r.Value, &objects.String{Value: mediums[x]})
+	}
+	return &r, nil
+}
+
+func parseMediums(args []objects.Object) ([]string, error) {
+	var mediums []string
+	for x := range args {
+		medium, ok := objects.ToString(args[x])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, medium)
+		}
+		mediums = append(mediums, medium)
+	}
+	return mediums, nil
+}
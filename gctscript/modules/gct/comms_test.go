@@ -0,0 +1,76 @@
+package gct
+
+import (
+	"errors"
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+)
+
+func TestCommsSend(t *testing.T) {
+	t.Parallel()
+	message := &objects.String{Value: "BTC-USD RSI oversold"}
+	_, err := scriptModule.CommsSend(scriptCtx)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+	_, err = Default.CommsSend(scriptCtx, message)
+	if !errors.Is(err, errScriptUnbound) {
+		t.Errorf("received %v expected %v", err, errScriptUnbound)
+	}
+	_, err = scriptModule.CommsSend(scriptCtx, blank)
+	if err == nil {
+		t.Error("expected error when message is unset")
+	}
+	_, err = scriptModule.CommsSend(scriptCtx, message, objects.UndefinedValue)
+	if err == nil {
+		t.Error("expected error when medium is not a string")
+	}
+	r, err := scriptModule.CommsSend(scriptCtx, message, &objects.String{Value: "slack"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != objects.TrueValue {
+		t.Errorf("received %v expected %v", r, objects.TrueValue)
+	}
+}
+
+func TestCommsSendTemplate(t *testing.T) {
+	t.Parallel()
+	name := &objects.String{Value: "signal"}
+	data := &objects.Map{Value: map[string]objects.Object{
+		"pair": &objects.String{Value: "BTC-USD"},
+		"rsi":  &objects.Float{Value: 28.5},
+	}}
+	_, err := scriptModule.CommsSendTemplate(scriptCtx, name)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+	_, err = scriptModule.CommsSendTemplate(scriptCtx, blank, data)
+	if err == nil {
+		t.Error("expected error when template is unset")
+	}
+	_, err = scriptModule.CommsSendTemplate(scriptCtx, name, &objects.Int{Value: 1})
+	if err == nil {
+		t.Error("expected error when template data is not a map")
+	}
+	_, err = scriptModule.CommsSendTemplate(scriptCtx, name, data)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCommsMediums(t *testing.T) {
+	t.Parallel()
+	_, err := Default.CommsMediums(scriptCtx)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+	r, err := Default.CommsMediums()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := r.(*objects.Array); !ok || len(a.Value) != 1 {
+		t.Errorf("unexpected mediums %v", r)
+	}
+}
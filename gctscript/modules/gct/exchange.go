@@ -2,6 +2,7 @@ package gct
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	objects "github.com/d5/tengo/v2"
@@ -10,28 +11,41 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta/indicators"
-	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
-var exchangeModule = map[string]objects.Object{
-	"orderbook":      &objects.UserFunction{Name: "orderbook", Value: ExchangeOrderbook},
-	"ticker":         &objects.UserFunction{Name: "ticker", Value: ExchangeTicker},
-	"exchanges":      &objects.UserFunction{Name: "exchanges", Value: ExchangeExchanges},
-	"pairs":          &objects.UserFunction{Name: "pairs", Value: ExchangePairs},
-	"accountinfo":    &objects.UserFunction{Name: "accountinfo", Value: ExchangeAccountInfo},
-	"depositaddress": &objects.UserFunction{Name: "depositaddress", Value: ExchangeDepositAddress},
-	"orderquery":     &objects.UserFunction{Name: "orderquery", Value: ExchangeOrderQuery},
-	"ordercancel":    &objects.UserFunction{Name: "ordercancel", Value: ExchangeOrderCancel},
-	"ordersubmit":    &objects.UserFunction{Name: "ordersubmit", Value: ExchangeOrderSubmit},
-	"withdrawcrypto": &objects.UserFunction{Name: "withdrawcrypto", Value: ExchangeWithdrawCrypto},
-	"withdrawfiat":   &objects.UserFunction{Name: "withdrawfiat", Value: ExchangeWithdrawFiat},
-	"ohlcv":          &objects.UserFunction{Name: "ohlcv", Value: exchangeOHLCV},
+func (m *Module) exchangeModule() map[string]objects.Object {
+	return map[string]objects.Object{
+		"orderbook":      &objects.UserFunction{Name: "orderbook", Value: m.ExchangeOrderbook},
+		"obanalytics":    &objects.UserFunction{Name: "obanalytics", Value: m.ExchangeOrderbookAnalytics},
+		"ticker":         &objects.UserFunction{Name: "ticker", Value: m.ExchangeTicker},
+		"exchanges":      &objects.UserFunction{Name: "exchanges", Value: m.ExchangeExchanges},
+		"pairs":          &objects.UserFunction{Name: "pairs", Value: m.ExchangePairs},
+		"accountinfo":    &objects.UserFunction{Name: "accountinfo", Value: m.ExchangeAccountInfo},
+		"depositaddress": &objects.UserFunction{Name: "depositaddress", Value: m.ExchangeDepositAddress},
+		"orderquery":     &objects.UserFunction{Name: "orderquery", Value: m.ExchangeOrderQuery},
+		"ordercancel":    &objects.UserFunction{Name: "ordercancel", Value: m.ExchangeOrderCancel},
+		"ordersubmit":    &objects.UserFunction{Name: "ordersubmit", Value: m.ExchangeOrderSubmit},
+		"withdrawcrypto": &objects.UserFunction{Name: "withdrawcrypto", Value: m.ExchangeWithdrawCrypto},
+		"withdrawfiat":   &objects.UserFunction{Name: "withdrawfiat", Value: m.ExchangeWithdrawFiat},
+		"ohlcv":          &objects.UserFunction{Name: "ohlcv", Value: m.exchangeOHLCV},
+		"ordermodify":    &objects.UserFunction{Name: "ordermodify", Value: m.ExchangeOrderModify},
+		"cancelbatch":    &objects.UserFunction{Name: "cancelbatch", Value: m.ExchangeCancelBatch},
+		"activeorders":   &objects.UserFunction{Name: "activeorders", Value: m.ExchangeActiveOrders},
+		"orderhistory":   &objects.UserFunction{Name: "orderhistory", Value: m.ExchangeOrderHistory},
+		"recenttrades":   &objects.UserFunction{Name: "recenttrades", Value: m.ExchangeRecentTrades},
+		"historictrades": &objects.UserFunction{Name: "historictrades", Value: m.ExchangeHistoricTrades},
+		"fee":            &objects.UserFunction{Name: "fee", Value: m.ExchangeFee},
+		"limits":         &objects.UserFunction{Name: "limits", Value: m.ExchangeExecutionLimits},
+	}
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
-func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 4 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -63,7 +77,7 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	ob, err := wrappers.GetWrapper().Orderbook(exchangeName, pair, assetType)
+	ob, err := m.wrapper().Orderbook(exchangeName, pair, assetType)
 	if err != nil {
 		return nil, err
 	}
@@ -96,8 +110,149 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 	}, nil
 }
 
+// ExchangeOrderbookAnalytics returns mid price, spread, liquidity, imbalance,
+// impact cost curves and cumulative depth price levels for requested exchange
+// & currencypair without copying the orderbook
+func (m *Module) ExchangeOrderbookAnalytics(args ...objects.Object) (objects.Object, error) {
+	if len(args) < 4 || len(args) > 8 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+	}
+
+	var req orderbook.AnalyticsRequest
+	if len(args) > 4 {
+		req.LiquidityPercentage, ok = objects.ToFloat64(args[4])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, "liquidity percentage")
+		}
+	}
+	if len(args) > 5 {
+		req.ImbalanceLevels, ok = objects.ToInt(args[5])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, "imbalance levels")
+		}
+	}
+	var err error
+	if len(args) > 6 {
+		req.ImpactAmounts, err = toFloatSlice(args[6], "impact amounts")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(args) > 7 {
+		req.DepthAmounts, err = toFloatSlice(args[7], "depth amounts")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := m.wrapper().OrderbookAnalytics(exchangeName, pair, assetType, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidity := make(map[string]objects.Object, 4)
+	liquidity["bidamount"] = &objects.Float{Value: a.Liquidity.BidAmount}
+	liquidity["bidvalue"] = &objects.Float{Value: a.Liquidity.BidValue}
+	liquidity["askamount"] = &objects.Float{Value: a.Liquidity.AskAmount}
+	liquidity["askvalue"] = &objects.Float{Value: a.Liquidity.AskValue}
+
+	data := make(map[string]objects.Object, 15)
+	data["exchange"] = &objects.String{Value: exchangeName}
+	data["pair"] = &objects.String{Value: pair.String()}
+	data["asset"] = &objects.String{Value: assetType.String()}
+	data["bestbid"] = &objects.Float{Value: a.BestBid}
+	data["bestask"] = &objects.Float{Value: a.BestAsk}
+	data["midprice"] = &objects.Float{Value: a.MidPrice}
+	data["microprice"] = &objects.Float{Value: a.MicroPrice}
+	data["spreadbps"] = &objects.Float{Value: a.SpreadBasisPoints}
+	data["imbalance"] = &objects.Float{Value: a.Imbalance}
+	data["liquidity"] = &objects.Map{Value: liquidity}
+	data["buyimpact"] = fillResultsToObject(a.BuyImpact)
+	data["sellimpact"] = fillResultsToObject(a.SellImpact)
+	data["biddepth"] = depthLevelsToObject(a.BidDepth)
+	data["askdepth"] = depthLevelsToObject(a.AskDepth)
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
+
+// toFloatSlice converts a script array to a slice of floats
+func toFloatSlice(obj objects.Object, name string) ([]float64, error) {
+	arr, ok := obj.(*objects.Array)
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, name)
+	}
+	resp := make([]float64, len(arr.Value))
+	for x := range arr.Value {
+		resp[x], ok = objects.ToFloat64(arr.Value[x])
+		if !ok {
+			return nil, fmt.Errorf(modules.ErrParameterWithPositionConvertFailed, name, x)
+		}
+	}
+	return resp, nil
+}
+
+func fillResultsToObject(results []orderbook.FillResult) *objects.Array {
+	var resp objects.Array
+	for x := range results {
+		temp := make(map[string]objects.Object, 7)
+		temp["amount"] = &objects.Float{Value: results[x].Amount}
+		temp["filledamount"] = &objects.Float{Value: results[x].FilledAmount}
+		temp["cost"] = &objects.Float{Value: results[x].Cost}
+		temp["averageprice"] = &objects.Float{Value: results[x].AveragePrice}
+		temp["worstprice"] = &objects.Float{Value: results[x].WorstPrice}
+		temp["impactbps"] = &objects.Float{Value: results[x].ImpactBasisPoints}
+		if results[x].FullyFilled {
+			temp["fullyfilled"] = objects.TrueValue
+		} else {
+			temp["fullyfilled"] = objects.FalseValue
+		}
+		resp.Value = append(resp.Value, &objects.Map{Value: temp})
+	}
+	return &resp
+}
+
+func depthLevelsToObject(levels []orderbook.DepthLevel) *objects.Array {
+	var resp objects.Array
+	for x := range levels {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: levels[x].Amount}
+		temp["price"] = &objects.Float{Value: levels[x].Price}
+		resp.Value = append(resp.Value, &objects.Map{Value: temp})
+	}
+	return &resp
+}
+
 // ExchangeTicker returns ticker data for requested exchange and currency pair
-func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 4 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -129,7 +284,7 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	tx, err := wrappers.GetWrapper().Ticker(exchangeName, pair, assetType)
+	tx, err := m.wrapper().Ticker(exchangeName, pair, assetType)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +311,7 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 }
 
 // ExchangeExchanges returns list of exchanges either enabled or all
-func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 1 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -165,7 +320,7 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, enabledOnly)
 	}
-	rtnValue := wrappers.GetWrapper().Exchanges(enabledOnly)
+	rtnValue := m.wrapper().Exchanges(enabledOnly)
 
 	r := objects.Array{}
 	for x := range rtnValue {
@@ -176,7 +331,7 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 }
 
 // ExchangePairs returns currency pairs for requested exchange
-func ExchangePairs(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangePairs(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -198,7 +353,7 @@ func ExchangePairs(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	rtnValue, err := wrappers.GetWrapper().Pairs(exchangeName, enabledOnly, assetType)
+	rtnValue, err := m.wrapper().Pairs(exchangeName, enabledOnly, assetType)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +367,7 @@ func ExchangePairs(args ...objects.Object) (objects.Object, error) {
 }
 
 // ExchangeAccountInfo returns account information for requested exchange
-func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 2 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -229,7 +384,7 @@ func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
 	if err != nil {
 		return nil, err
 	}
-	rtnValue, err := wrappers.GetWrapper().AccountInformation(exchangeName, assetType)
+	rtnValue, err := m.wrapper().AccountInformation(exchangeName, assetType)
 	if err != nil {
 		return nil, err
 	}
@@ -255,7 +410,7 @@ func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
 }
 
 // ExchangeOrderQuery query order on exchange
-func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 	if len(args) < 2 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -297,11 +452,16 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	orderDetails, err := wrappers.GetWrapper().QueryOrder(exchangeName, orderID, pair, assetType)
+	orderDetails, err := m.wrapper().QueryOrder(exchangeName, orderID, pair, assetType)
 	if err != nil {
 		return nil, err
 	}
 
+	return orderDetailToObject(orderDetails), nil
+}
+
+// orderDetailToObject converts order details to a script map
+func orderDetailToObject(orderDetails *order.Detail) *objects.Map {
 	var tradeHistory objects.Array
 	for x := range orderDetails.Trades {
 		temp := make(map[string]objects.Object, 7)
@@ -333,11 +493,11 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 
 	return &objects.Map{
 		Value: data,
-	}, nil
+	}
 }
 
 // ExchangeOrderCancel cancels order on requested exchange
-func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 	if len(args) < 2 || len(args) > 4 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -384,7 +544,7 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 	}
 
 	var isCancelled bool
-	isCancelled, err = wrappers.GetWrapper().CancelOrder(exchangeName, orderID, cp, a)
+	isCancelled, err = m.wrapper().CancelOrder(exchangeName, orderID, cp, a)
 	if err != nil {
 		return nil, err
 	}
@@ -396,7 +556,7 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 }
 
 // ExchangeOrderSubmit submit order on exchange
-func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 9 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -457,7 +617,7 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 		Exchange:  exchangeName,
 	}
 
-	rtn, err := wrappers.GetWrapper().SubmitOrder(tempSubmit)
+	rtn, err := m.wrapper().SubmitOrder(tempSubmit)
 	if err != nil {
 		return nil, err
 	}
@@ -476,7 +636,7 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 }
 
 // ExchangeDepositAddress returns deposit address (if supported by exchange)
-func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 2 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -492,7 +652,7 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 
 	currCode := currency.NewCode(currencyCode)
 
-	rtn, err := wrappers.GetWrapper().DepositAddress(exchangeName, currCode)
+	rtn, err := m.wrapper().DepositAddress(exchangeName, currCode)
 	if err != nil {
 		return nil, err
 	}
@@ -501,7 +661,7 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 }
 
 // ExchangeWithdrawCrypto submit request to withdraw crypto assets
-func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 7 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -547,7 +707,7 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 		Amount:      amount,
 	}
 
-	rtn, err := wrappers.GetWrapper().WithdrawalCryptoFunds(withdrawRequest)
+	rtn, err := m.wrapper().WithdrawalCryptoFunds(withdrawRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -556,7 +716,7 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 }
 
 // ExchangeWithdrawFiat submit request to withdraw fiat assets
-func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
+func (m *Module) ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 5 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -589,7 +749,7 @@ func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
 		Amount:      amount,
 	}
 
-	rtn, err := wrappers.GetWrapper().WithdrawalFiatFunds(bankAccountID, withdrawRequest)
+	rtn, err := m.wrapper().WithdrawalFiatFunds(bankAccountID, withdrawRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -607,7 +767,7 @@ func (o *OHLCV) TypeName() string {
 	return indicators.OHLCV
 }
 
-func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
+func (m *Module) exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 7 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -656,11 +816,313 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	ret, err := wrappers.GetWrapper().OHLCV(exchangeName, pair, assetType, startTime, endTime, kline.Interval(interval))
+	ret, err := m.wrapper().OHLCV(exchangeName, pair, assetType, startTime, endTime, kline.Interval(interval))
 	if err != nil {
 		return nil, err
 	}
 
+	return ohlcvToObject(&ret), nil
+}
+
+// ExchangeOrderModify modifies the price and amount of an existing order
+func (m *Module) ExchangeOrderModify(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	orderID, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderID)
+	}
+	exchangeName, pair, assetType, err := parseMarket(args[0], args[2], args[3], args[4])
+	if err != nil {
+		return nil, err
+	}
+	price, ok := objects.ToFloat64(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, price)
+	}
+	amount, ok := objects.ToFloat64(args[6])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, amount)
+	}
+
+	id, err := m.wrapper().ModifyOrder(&order.Modify{
+		Exchange:  exchangeName,
+		ID:        orderID,
+		Pair:      pair,
+		AssetType: assetType,
+		Price:     price,
+		Amount:    amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &objects.String{Value: id}, nil
+}
+
+// ExchangeCancelBatch cancels multiple orders in a single request returning
+// the cancellation status of each order ID
+func (m *Module) ExchangeCancelBatch(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, pair, assetType, err := parseMarket(args[0], args[1], args[2], args[3])
+	if err != nil {
+		return nil, err
+	}
+	ids, ok := objects.ToInterface(args[4]).([]interface{})
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, "order IDs")
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf(ErrEmptyParameter, "order IDs")
+	}
+	cancels := make([]order.Cancel, len(ids))
+	for x := range ids {
+		id, isString := ids[x].(string)
+		if !isString {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, ids[x])
+		}
+		cancels[x] = order.Cancel{
+			Exchange:  exchangeName,
+			ID:        id,
+			Pair:      pair,
+			AssetType: assetType,
+		}
+	}
+
+	resp, err := m.wrapper().CancelBatchOrders(exchangeName, cancels)
+	if err != nil {
+		return nil, err
+	}
+	r := make(map[string]objects.Object, len(resp.Status))
+	for id, status := range resp.Status {
+		r[id] = &objects.String{Value: status}
+	}
+	return &objects.Map{Value: r}, nil
+}
+
+// ExchangeActiveOrders returns open orders, an empty currency pair returns
+// open orders for all pairs where supported by the exchange
+func (m *Module) ExchangeActiveOrders(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, req, err := parseOrdersRequest(args[0], args[1], args[2], args[3])
+	if err != nil {
+		return nil, err
+	}
+	orders, err := m.wrapper().GetActiveOrders(exchangeName, req)
+	if err != nil {
+		return nil, err
+	}
+	return ordersToObject(orders), nil
+}
+
+// ExchangeOrderHistory returns historic orders between start and end, an
+// empty currency pair returns orders for all pairs where supported by the
+// exchange
+func (m *Module) ExchangeOrderHistory(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, req, err := parseOrdersRequest(args[0], args[1], args[2], args[3])
+	if err != nil {
+		return nil, err
+	}
+	var ok bool
+	req.StartTime, ok = objects.ToTime(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, "start time")
+	}
+	req.EndTime, ok = objects.ToTime(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, "end time")
+	}
+	orders, err := m.wrapper().GetOrderHistory(exchangeName, req)
+	if err != nil {
+		return nil, err
+	}
+	return ordersToObject(orders), nil
+}
+
+// ExchangeRecentTrades returns the most recent trades from the exchange
+func (m *Module) ExchangeRecentTrades(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, pair, assetType, err := parseMarket(args[0], args[1], args[2], args[3])
+	if err != nil {
+		return nil, err
+	}
+	trades, err := m.wrapper().GetRecentTrades(exchangeName, pair, assetType)
+	if err != nil {
+		return nil, err
+	}
+	return tradesToObject(trades), nil
+}
+
+// ExchangeHistoricTrades returns trades between start and end from the
+// exchange
+func (m *Module) ExchangeHistoricTrades(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, pair, assetType, err := parseMarket(args[0], args[1], args[2], args[3])
+	if err != nil {
+		return nil, err
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, startTime)
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, endTime)
+	}
+	trades, err := m.wrapper().GetHistoricTrades(exchangeName, pair, assetType, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return tradesToObject(trades), nil
+}
+
+// ExchangeFee returns the estimated fee for the requested fee type
+func (m *Module) ExchangeFee(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	feeType, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, feeType)
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	price, ok := objects.ToFloat64(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, price)
+	}
+	amount, ok := objects.ToFloat64(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, amount)
+	}
+	isMaker, ok := objects.ToBool(args[6])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, isMaker)
+	}
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := m.wrapper().GetFeeByType(exchangeName, &modules.FeeRequest{
+		Type:          strings.ToLower(feeType),
+		Pair:          pair,
+		IsMaker:       isMaker,
+		PurchasePrice: price,
+		Amount:        amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &objects.Float{Value: fee}, nil
+}
+
+// ExchangeExecutionLimits returns the order execution limits loaded for the
+// currency pair
+func (m *Module) ExchangeExecutionLimits(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, pair, assetType, err := parseMarket(args[0], args[1], args[2], args[3])
+	if err != nil {
+		return nil, err
+	}
+	l, err := m.wrapper().GetOrderExecutionLimits(exchangeName, pair, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 17)
+	data["pair"] = &objects.String{Value: l.Pair.String()}
+	data["asset"] = &objects.String{Value: l.Asset.String()}
+	data["minprice"] = &objects.Float{Value: l.MinPrice}
+	data["maxprice"] = &objects.Float{Value: l.MaxPrice}
+	data["stepprice"] = &objects.Float{Value: l.StepPrice}
+	data["minamount"] = &objects.Float{Value: l.MinAmount}
+	data["maxamount"] = &objects.Float{Value: l.MaxAmount}
+	data["stepamount"] = &objects.Float{Value: l.StepAmount}
+	data["minnotional"] = &objects.Float{Value: l.MinNotional}
+	data["multiplierup"] = &objects.Float{Value: l.MultiplierUp}
+	data["multiplierdown"] = &objects.Float{Value: l.MultiplierDown}
+	data["marketminamount"] = &objects.Float{Value: l.MarketMinQty}
+	data["marketmaxamount"] = &objects.Float{Value: l.MarketMaxQty}
+	data["marketstepamount"] = &objects.Float{Value: l.MarketStepSize}
+	data["maxicebergparts"] = &objects.Int{Value: l.MaxIcebergParts}
+	data["maxtotalorders"] = &objects.Int{Value: l.MaxTotalOrders}
+	data["maxalgoorders"] = &objects.Int{Value: l.MaxAlgoOrders}
+	return &objects.Map{Value: data}, nil
+}
+
+// parseOrdersRequest converts the exchange, pair, delimiter and asset
+// arguments to an orders request, allowing an empty currency pair
+func parseOrdersRequest(exchArg, pairArg, delimArg, assetArg objects.Object) (string, *order.GetOrdersRequest, error) {
+	exchangeName, ok := objects.ToString(exchArg)
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(pairArg)
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(delimArg)
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	assetTypeParam, ok := objects.ToString(assetArg)
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return "", nil, err
+	}
+	req := &order.GetOrdersRequest{
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+		AssetType: assetType,
+	}
+	if currencyPair != "" {
+		pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+		if err != nil {
+			return "", nil, err
+		}
+		req.Pairs = currency.Pairs{pair}
+	}
+	return exchangeName, req, nil
+}
+
+// ordersToObject converts orders to a script array
+func ordersToObject(orders []order.Detail) *objects.Array {
+	r := objects.Array{}
+	for x := range orders {
+		r.Value = append(r.Value, orderDetailToObject(&orders[x]))
+	}
+	return &r
+}
+
+// ohlcvToObject converts a kline item to a script OHLCV object
+func ohlcvToObject(ret *kline.Item) *OHLCV {
 	var candles objects.Array
 	for x := range ret.Candles {
 		candle := &objects.Array{}
@@ -684,7 +1146,56 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 
 	c := new(OHLCV)
 	c.Value = retValue
-	return c, nil
+	return c
+}
+
+// parseMarket converts the exchange, pair, delimiter and asset arguments
+func parseMarket(exchArg, pairArg, delimArg, assetArg objects.Object) (string, currency.Pair, asset.Item, error) {
+	exchangeName, ok := objects.ToString(exchArg)
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(pairArg)
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(delimArg)
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	assetTypeParam, ok := objects.ToString(assetArg)
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+	}
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return "", currency.Pair{}, "", err
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return "", currency.Pair{}, "", err
+	}
+	return exchangeName, pair, assetType, nil
+}
+
+// tradesToObject converts trades to a script array
+func tradesToObject(trades []trade.Data) *objects.Array {
+	r := objects.Array{}
+	for x := range trades {
+		r.Value = append(r.Value, &objects.Map{
+			Value: map[string]objects.Object{
+				"id":        &objects.String{Value: trades[x].TID},
+				"exchange":  &objects.String{Value: trades[x].Exchange},
+				"pair":      &objects.String{Value: trades[x].CurrencyPair.String()},
+				"asset":     &objects.String{Value: trades[x].AssetType.String()},
+				"side":      &objects.String{Value: trades[x].Side.String()},
+				"price":     &objects.Float{Value: trades[x].Price},
+				"amount":    &objects.Float{Value: trades[x].Amount},
+				"timestamp": &objects.Time{Value: trades[x].Timestamp},
+			},
+		})
+	}
+	return &r
 }
 
 // parseInterval will parse the interval param of indictors that have them and convert to time.Duration
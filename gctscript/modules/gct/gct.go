@@ -1,5 +1,41 @@
 package gct
 
+import (
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+// NewModule returns module functions which call the wrapper returned by fn
+func NewModule(fn func() modules.GCT) *Module {
+	return &Module{wrapper: fn}
+}
+
+// NewScriptModule returns module functions which call the wrapper returned by
+// fn on behalf of the named script
+func NewScriptModule(fn func() modules.GCT, script string) *Module {
+	return &Module{wrapper: fn, script: script}
+}
+
+// scriptName returns the name of the script the module is bound to, which
+// namespaces its state and rate limits its notifications so scripts cannot
+// read or overwrite each other's state or evade their limits
+func (m *Module) scriptName() (string, error) {
+	if m.script == "" {
+		return "", errScriptUnbound
+	}
+	return m.script, nil
+}
+
+// Modules returns a map of all loadable modules
+func (m *Module) Modules() map[string]map[string]tengo.Object {
+	return map[string]map[string]tengo.Object{
+		"exchange": m.exchangeModule(),
+		"common":   commonModule,
+		"store":    m.storeModule(),
+		"comms":    m.commsModule(),
+	}
+}
+
 // AllModuleNames returns a list of all default module names.
 func AllModuleNames() []string {
 	var names []string
@@ -48,17 +48,56 @@ func TestMain(m *testing.M) {
 
 func TestExchangeOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := ExchangeOrderbook(exch, currencyPair, delimiter, assetType)
+	_, err := Default.ExchangeOrderbook(exch, currencyPair, delimiter, assetType)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeOrderbook(exchError, currencyPair, delimiter, assetType)
+	_, err = Default.ExchangeOrderbook(exchError, currencyPair, delimiter, assetType)
 	if err != nil && errors.Is(err, errTestFailed) {
 		t.Error(err)
 	}
 
-	_, err = ExchangeOrderbook()
+	_, err = Default.ExchangeOrderbook()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeOrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	_, err := Default.ExchangeOrderbookAnalytics(exch, currencyPair, delimiter, assetType)
+	if err != nil {
+		t.Error(err)
+	}
+
+	amounts := &objects.Array{Value: []objects.Object{&objects.Float{Value: 1}, &objects.Int{Value: 2}}}
+	ret, err := Default.ExchangeOrderbookAnalytics(exch, currencyPair, delimiter, assetType,
+		&objects.Float{Value: 1}, &objects.Int{Value: 10}, amounts, amounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, ok := ret.(*objects.Map)
+	if !ok {
+		t.Fatal("expected map return")
+	}
+	impact, ok := data.Value["buyimpact"].(*objects.Array)
+	if !ok || len(impact.Value) != 2 {
+		t.Errorf("unexpected buy impact %v", data.Value["buyimpact"])
+	}
+
+	_, err = Default.ExchangeOrderbookAnalytics(exch, currencyPair, delimiter, assetType,
+		&objects.Float{Value: 1}, &objects.Int{Value: 10}, &objects.Array{Value: []objects.Object{blank}})
+	if err == nil {
+		t.Error("expected error converting impact amounts")
+	}
+
+	_, err = Default.ExchangeOrderbookAnalytics(exchError, currencyPair, delimiter, assetType)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+
+	_, err = Default.ExchangeOrderbookAnalytics()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -66,17 +105,17 @@ func TestExchangeOrderbook(t *testing.T) {
 
 func TestExchangeTicker(t *testing.T) {
 	t.Parallel()
-	_, err := ExchangeTicker(exch, currencyPair, delimiter, assetType)
+	_, err := Default.ExchangeTicker(exch, currencyPair, delimiter, assetType)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeTicker(exchError, currencyPair, delimiter, assetType)
+	_, err = Default.ExchangeTicker(exchError, currencyPair, delimiter, assetType)
 	if err != nil && errors.Is(err, errTestFailed) {
 		t.Error(err)
 	}
 
-	_, err = ExchangeTicker()
+	_, err = Default.ExchangeTicker()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -85,22 +124,22 @@ func TestExchangeTicker(t *testing.T) {
 func TestExchangeExchanges(t *testing.T) {
 	t.Parallel()
 
-	_, err := ExchangeExchanges(tv)
+	_, err := Default.ExchangeExchanges(tv)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeExchanges(exch)
+	_, err = Default.ExchangeExchanges(exch)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeExchanges(fv)
+	_, err = Default.ExchangeExchanges(fv)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeExchanges()
+	_, err = Default.ExchangeExchanges()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -109,17 +148,17 @@ func TestExchangeExchanges(t *testing.T) {
 func TestExchangePairs(t *testing.T) {
 	t.Parallel()
 
-	_, err := ExchangePairs(exch, tv, assetType)
+	_, err := Default.ExchangePairs(exch, tv, assetType)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangePairs(exchError, tv, assetType)
+	_, err = Default.ExchangePairs(exchError, tv, assetType)
 	if err != nil && errors.Is(err, errTestFailed) {
 		t.Error(err)
 	}
 
-	_, err = ExchangePairs()
+	_, err = Default.ExchangePairs()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -128,17 +167,17 @@ func TestExchangePairs(t *testing.T) {
 func TestAccountInfo(t *testing.T) {
 	t.Parallel()
 
-	_, err := ExchangeAccountInfo()
+	_, err := Default.ExchangeAccountInfo()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
 
-	_, err = ExchangeAccountInfo(exch, assetType)
+	_, err = Default.ExchangeAccountInfo(exch, assetType)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeAccountInfo(exchError, assetType)
+	_, err = Default.ExchangeAccountInfo(exchError, assetType)
 	if err != nil && !errors.Is(err, errTestFailed) {
 		t.Error(err)
 	}
@@ -147,17 +186,17 @@ func TestAccountInfo(t *testing.T) {
 func TestExchangeOrderQuery(t *testing.T) {
 	t.Parallel()
 
-	_, err := ExchangeOrderQuery()
+	_, err := Default.ExchangeOrderQuery()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
 
-	_, err = ExchangeOrderQuery(exch, orderID)
+	_, err = Default.ExchangeOrderQuery(exch, orderID)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeOrderQuery(exchError, orderID)
+	_, err = Default.ExchangeOrderQuery(exchError, orderID)
 	if err != nil && !errors.Is(err, errTestFailed) {
 		t.Error(err)
 	}
@@ -165,32 +204,32 @@ func TestExchangeOrderQuery(t *testing.T) {
 
 func TestExchangeOrderCancel(t *testing.T) {
 	t.Parallel()
-	_, err := ExchangeOrderCancel()
+	_, err := Default.ExchangeOrderCancel()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
 
-	_, err = ExchangeOrderCancel(blank, orderID, currencyPair, assetType)
+	_, err = Default.ExchangeOrderCancel(blank, orderID, currencyPair, assetType)
 	if err == nil {
 		t.Error("expecting error")
 	}
 
-	_, err = ExchangeOrderCancel(exch, blank, currencyPair, assetType)
+	_, err = Default.ExchangeOrderCancel(exch, blank, currencyPair, assetType)
 	if err == nil {
 		t.Error("expecting error")
 	}
 
-	_, err = ExchangeOrderCancel(exch, orderID)
+	_, err = Default.ExchangeOrderCancel(exch, orderID)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeOrderCancel(exch, orderID, currencyPair)
+	_, err = Default.ExchangeOrderCancel(exch, orderID, currencyPair)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeOrderCancel(exch, orderID, currencyPair, assetType)
+	_, err = Default.ExchangeOrderCancel(exch, orderID, currencyPair, assetType)
 	if err != nil {
 		t.Error(err)
 	}
@@ -198,7 +237,7 @@ func TestExchangeOrderCancel(t *testing.T) {
 
 func TestExchangeOrderSubmit(t *testing.T) {
 	t.Parallel()
-	_, err := ExchangeOrderSubmit()
+	_, err := Default.ExchangeOrderSubmit()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -209,19 +248,19 @@ func TestExchangeOrderSubmit(t *testing.T) {
 	orderAmount := &objects.Float{Value: 1}
 	orderAsset := &objects.String{Value: asset.Spot.String()}
 
-	_, err = ExchangeOrderSubmit(exch, currencyPair, delimiter,
+	_, err = Default.ExchangeOrderSubmit(exch, currencyPair, delimiter,
 		orderType, orderSide, orderPrice, orderAmount, orderID, orderAsset)
 	if err != nil && !errors.Is(err, errTestFailed) {
 		t.Error(err)
 	}
 
-	_, err = ExchangeOrderSubmit(exch, currencyPair, delimiter,
+	_, err = Default.ExchangeOrderSubmit(exch, currencyPair, delimiter,
 		orderType, orderSide, orderPrice, orderAmount, orderID, orderAsset)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeOrderSubmit(objects.TrueValue, currencyPair, delimiter,
+	_, err = Default.ExchangeOrderSubmit(objects.TrueValue, currencyPair, delimiter,
 		orderType, orderSide, orderPrice, orderAmount, orderID, orderAsset)
 	if err != nil {
 		t.Error(err)
@@ -239,18 +278,18 @@ func TestAllModuleNames(t *testing.T) {
 
 func TestExchangeDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := ExchangeDepositAddress()
+	_, err := Default.ExchangeDepositAddress()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
 
 	currCode := &objects.String{Value: "BTC"}
-	_, err = ExchangeDepositAddress(exch, currCode)
+	_, err = Default.ExchangeDepositAddress(exch, currCode)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = ExchangeDepositAddress(exchError, currCode)
+	_, err = Default.ExchangeDepositAddress(exchError, currCode)
 	if err != nil && !errors.Is(err, errTestFailed) {
 		t.Error(err)
 	}
@@ -258,7 +297,7 @@ func TestExchangeDepositAddress(t *testing.T) {
 
 func TestExchangeWithdrawCrypto(t *testing.T) {
 	t.Parallel()
-	_, err := ExchangeWithdrawCrypto()
+	_, err := Default.ExchangeWithdrawCrypto()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -268,7 +307,7 @@ func TestExchangeWithdrawCrypto(t *testing.T) {
 	address := &objects.String{Value: "0xTHISISALEGITBTCADDRESSS"}
 	amount := &objects.Float{Value: 1.0}
 
-	_, err = ExchangeWithdrawCrypto(exch, currCode, address, address, amount, amount, desc)
+	_, err = Default.ExchangeWithdrawCrypto(exch, currCode, address, address, amount, amount, desc)
 	if err != nil {
 		t.Error(err)
 	}
@@ -276,7 +315,7 @@ func TestExchangeWithdrawCrypto(t *testing.T) {
 
 func TestExchangeWithdrawFiat(t *testing.T) {
 	t.Parallel()
-	_, err := ExchangeWithdrawFiat()
+	_, err := Default.ExchangeWithdrawFiat()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -285,7 +324,7 @@ func TestExchangeWithdrawFiat(t *testing.T) {
 	desc := &objects.String{Value: "Hello"}
 	amount := &objects.Float{Value: 1.0}
 	bankID := &objects.String{Value: "test-bank-01"}
-	_, err = ExchangeWithdrawFiat(exch, currCode, desc, amount, bankID)
+	_, err = Default.ExchangeWithdrawFiat(exch, currCode, desc, amount, bankID)
 	if err != nil {
 		t.Error(err)
 	}
@@ -332,3 +371,161 @@ func TestParseInterval(t *testing.T) {
 		}
 	}
 }
+
+func TestExchangeOrderModify(t *testing.T) {
+	t.Parallel()
+	_, err := Default.ExchangeOrderModify()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	price := &objects.Float{Value: 1}
+	v, err := Default.ExchangeOrderModify(exch, orderID, currencyPair, delimiter, assetType, price, price)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, ok := objects.ToString(v); !ok || id != orderID.Value {
+		t.Errorf("received %v expected %v", v, orderID.Value)
+	}
+	_, err = Default.ExchangeOrderModify(exch, orderID, currencyPair, delimiter, assetType, blank, price)
+	if err == nil {
+		t.Error("expected error converting price")
+	}
+}
+
+func TestExchangeCancelBatch(t *testing.T) {
+	t.Parallel()
+	_, err := Default.ExchangeCancelBatch()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	ids := &objects.Array{Value: []objects.Object{orderID, &objects.String{Value: "1236"}}}
+	v, err := Default.ExchangeCancelBatch(exch, currencyPair, delimiter, assetType, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, ok := v.(*objects.Map); !ok || len(m.Value) != 2 {
+		t.Errorf("unexpected response %v", v)
+	}
+	_, err = Default.ExchangeCancelBatch(exch, currencyPair, delimiter, assetType, &objects.Array{})
+	if err == nil {
+		t.Error("expected error with no order IDs")
+	}
+	_, err = Default.ExchangeCancelBatch(exch, currencyPair, delimiter, assetType, &objects.Array{Value: []objects.Object{&objects.Int{Value: 1}}})
+	if err == nil {
+		t.Error("expected error converting order ID")
+	}
+}
+
+func TestExchangeActiveOrders(t *testing.T) {
+	t.Parallel()
+	_, err := Default.ExchangeActiveOrders()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	v, err := Default.ExchangeActiveOrders(exch, currencyPair, delimiter, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := v.(*objects.Array); !ok || len(a.Value) != 1 {
+		t.Errorf("unexpected response %v", v)
+	}
+	_, err = Default.ExchangeActiveOrders(exch, blank, delimiter, assetType)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = Default.ExchangeActiveOrders(exch, currencyPair, delimiter, &objects.String{Value: "fake"})
+	if err == nil {
+		t.Error("expected error with invalid asset")
+	}
+}
+
+func TestExchangeOrderHistory(t *testing.T) {
+	t.Parallel()
+	_, err := Default.ExchangeOrderHistory()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+	v, err := Default.ExchangeOrderHistory(exch, currencyPair, delimiter, assetType, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := v.(*objects.Array); !ok || len(a.Value) != 1 {
+		t.Errorf("unexpected response %v", v)
+	}
+	_, err = Default.ExchangeOrderHistory(exch, currencyPair, delimiter, assetType, start, blank)
+	if err == nil {
+		t.Error("expected error converting end time")
+	}
+}
+
+func TestExchangeTrades(t *testing.T) {
+	t.Parallel()
+	_, err := Default.ExchangeRecentTrades()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	v, err := Default.ExchangeRecentTrades(exch, currencyPair, delimiter, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := v.(*objects.Array); !ok || len(a.Value) != 1 {
+		t.Errorf("unexpected response %v", v)
+	}
+
+	_, err = Default.ExchangeHistoricTrades()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+	v, err = Default.ExchangeHistoricTrades(exch, currencyPair, delimiter, assetType, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := v.(*objects.Array); !ok || len(a.Value) != 1 {
+		t.Errorf("unexpected response %v", v)
+	}
+}
+
+func TestExchangeFee(t *testing.T) {
+	t.Parallel()
+	_, err := Default.ExchangeFee()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	feeType := &objects.String{Value: modules.TradeFee}
+	price := &objects.Float{Value: 1000}
+	amount := &objects.Float{Value: 1}
+	v, err := Default.ExchangeFee(exch, feeType, currencyPair, delimiter, price, amount, tv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, ok := objects.ToFloat64(v); !ok || f != 1 {
+		t.Errorf("received %v expected %v", v, 1)
+	}
+	_, err = Default.ExchangeFee(exch, feeType, currencyPair, delimiter, blank, amount, tv)
+	if err == nil {
+		t.Error("expected error converting price")
+	}
+}
+
+func TestExchangeExecutionLimits(t *testing.T) {
+	t.Parallel()
+	_, err := Default.ExchangeExecutionLimits()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	v, err := Default.ExchangeExecutionLimits(exch, currencyPair, delimiter, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := v.(*objects.Map)
+	if !ok {
+		t.Fatalf("unexpected response %v", v)
+	}
+	if f, _ := objects.ToFloat64(m.Value["minamount"]); f != 0.001 {
+		t.Errorf("received %v expected %v", f, 0.001)
+	}
+}
@@ -3,7 +3,8 @@ package gct
 import (
 	"errors"
 
-	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 )
 
 const (
@@ -13,11 +14,24 @@ const (
 	ErrEmptyParameter = "received empty parameter for %v"
 )
 
-var errInvalidInterval = errors.New("invalid interval")
+var (
+	errInvalidInterval = errors.New("invalid interval")
+	errScriptUnbound   = errors.New("module is not bound to a script")
+)
 var supportedDurations = []string{"1m", "3m", "5m", "15m", "30m", "1h", "2h", "4h", "6h", "12h", "24h", "1d", "3d", "1w"}
 
-// Modules map of all loadable modules
-var Modules = map[string]map[string]tengo.Object{
-	"exchange": exchangeModule,
-	"common":   commonModule,
+var (
+	// Default module functions calling the global wrapper
+	Default = NewModule(wrappers.GetWrapper)
+	// Modules map of all loadable modules calling the global wrapper
+	Modules = Default.Modules()
+)
+
+// Module holds the module functions bound to the wrapper they call, allowing
+// each script to be given its own wrapper
+type Module struct {
+	wrapper func() modules.GCT
+	// script is the name of the script the module is bound to, which
+	// namespaces its persistent state
+	script string
 }
@@ -0,0 +1,249 @@
+package gct
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+func (m *Module) storeModule() map[string]objects.Object {
+	return map[string]objects.Object{
+		"set":      &objects.UserFunction{Name: "set", Value: m.StoreSet},
+		"get":      &objects.UserFunction{Name: "get", Value: m.StoreGet},
+		"delete":   &objects.UserFunction{Name: "delete", Value: m.StoreDelete},
+		"keys":     &objects.UserFunction{Name: "keys", Value: m.StoreKeys},
+		"candles":  &objects.UserFunction{Name: "candles", Value: m.StoreCandles},
+		"trades":   &objects.UserFunction{Name: "trades", Value: m.StoreTrades},
+		"auditlog": &objects.UserFunction{Name: "auditlog", Value: m.StoreAuditLog},
+	}
+}
+
+// StoreSet persists a value against a key for the script, values are stored
+// as JSON so any value that can be represented as such is supported
+func (m *Module) StoreSet(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, key, err := m.parseStoreKey(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	value, err := json.Marshal(objects.ToInterface(args[2]))
+	if err != nil {
+		return nil, err
+	}
+	err = m.wrapper().SetState(script, key, string(value))
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// StoreGet returns the value stored against a key for the script or
+// undefined if no value has been stored
+func (m *Module) StoreGet(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, key, err := m.parseStoreKey(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	value, err := m.wrapper().GetState(script, key)
+	if err != nil {
+		if errors.Is(err, modules.ErrStateNotFound) {
+			return objects.UndefinedValue, nil
+		}
+		return nil, err
+	}
+
+	d := json.NewDecoder(bytes.NewBufferString(value))
+	// numbers are decoded as json.Number so integers are restored as such
+	d.UseNumber()
+	var v interface{}
+	err = d.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return objects.FromInterface(restoreNumbers(v))
+}
+
+// StoreDelete removes a key stored for the script
+func (m *Module) StoreDelete(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, key, err := m.parseStoreKey(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	err = m.wrapper().DeleteState(script, key)
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// StoreKeys returns all keys stored for the script
+func (m *Module) StoreKeys(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := m.scriptName()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := m.wrapper().StateKeys(script)
+	if err != nil {
+		return nil, err
+	}
+	r := objects.Array{}
+	for x := range keys {
+		r.Value = append(r.Value, &objects.String{Value: keys[x]})
+	}
+	return &r, nil
+}
+
+// StoreCandles returns candles stored in the database in the same format as
+// exchange.ohlcv so they can be used with the ta modules
+func (m *Module) StoreCandles(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, pair, assetType, err := parseMarket(args[0], args[1], args[2], args[3])
+	if err != nil {
+		return nil, err
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, startTime)
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, endTime)
+	}
+	intervalStr, ok := objects.ToString(args[6])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, intervalStr)
+	}
+	interval, err := parseInterval(intervalStr)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := m.wrapper().StoredCandles(exchangeName, pair, assetType, startTime, endTime, kline.Interval(interval))
+	if err != nil {
+		return nil, err
+	}
+	return ohlcvToObject(&ret), nil
+}
+
+// StoreTrades returns trades stored in the database
+func (m *Module) StoreTrades(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	exchangeName, pair, assetType, err := parseMarket(args[0], args[1], args[2], args[3])
+	if err != nil {
+		return nil, err
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, startTime)
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, endTime)
+	}
+
+	trades, err := m.wrapper().StoredTrades(exchangeName, pair, assetType, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return tradesToObject(trades), nil
+}
+
+// StoreAuditLog returns audit log entries stored in the database
+func (m *Module) StoreAuditLog(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	startTime, ok := objects.ToTime(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, startTime)
+	}
+	endTime, ok := objects.ToTime(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, endTime)
+	}
+	order, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, order)
+	}
+	limit, ok := objects.ToInt(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, limit)
+	}
+
+	events, err := m.wrapper().AuditEvents(startTime, endTime, order, limit)
+	if err != nil {
+		return nil, err
+	}
+	r := objects.Array{}
+	for x := range events {
+		r.Value = append(r.Value, &objects.Map{
+			Value: map[string]objects.Object{
+				"type":       &objects.String{Value: events[x].Type},
+				"identifier": &objects.String{Value: events[x].Identifier},
+				"message":    &objects.String{Value: events[x].Message},
+				"timestamp":  &objects.Time{Value: events[x].Timestamp},
+			},
+		})
+	}
+	return &r, nil
+}
+
+// parseStoreKey returns the state namespace and converts the key argument.
+// The script context argument is accepted for compatibility but not trusted,
+// state is always stored against the script the module is bound to
+func (m *Module) parseStoreKey(_, keyArg objects.Object) (script, key string, err error) {
+	script, err = m.scriptName()
+	if err != nil {
+		return "", "", err
+	}
+	key, ok := objects.ToString(keyArg)
+	if !ok {
+		return "", "", fmt.Errorf(ErrParameterConvertFailed, key)
+	}
+	if key == "" {
+		return "", "", fmt.Errorf(ErrEmptyParameter, "key")
+	}
+	return script, key, nil
+}
+
+// restoreNumbers converts decoded json numbers to int64 where possible and
+// float64 otherwise
+func restoreNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case []interface{}:
+		for i := range t {
+			t[i] = restoreNumbers(t[i])
+		}
+	case map[string]interface{}:
+		for k := range t {
+			t[k] = restoreNumbers(t[k])
+		}
+	}
+	return v
+}
@@ -0,0 +1,149 @@
+package gct
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+)
+
+var (
+	scriptCtx    = &objects.String{Value: "test.gct-3a5b8e3e-5f7c-4d5e-9a5e-2b7d1e6c9f10"}
+	scriptModule = NewScriptModule(wrappers.GetWrapper, "test.gct")
+)
+
+func TestStoreState(t *testing.T) {
+	t.Parallel()
+	key := &objects.String{Value: "position"}
+	_, err := scriptModule.StoreSet(scriptCtx, key)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+	_, err = Default.StoreSet(scriptCtx, key, &objects.Int{Value: 1})
+	if !errors.Is(err, errScriptUnbound) {
+		t.Errorf("received %v expected %v", err, errScriptUnbound)
+	}
+	_, err = scriptModule.StoreSet(scriptCtx, blank, &objects.Int{Value: 1})
+	if err == nil {
+		t.Error("expected error when key is unset")
+	}
+	_, err = scriptModule.StoreSet(scriptCtx, key, &objects.Map{Value: map[string]objects.Object{
+		"amount": &objects.Float{Value: 1.5},
+	}})
+	if err != nil {
+		t.Error(err)
+	}
+
+	v, err := scriptModule.StoreGet(scriptCtx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i, ok := v.(*objects.Int); !ok || i.Value != 1 {
+		t.Errorf("expected stored integer to be restored as int received %v", v)
+	}
+	_, err = scriptModule.StoreGet(scriptCtx)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+
+	_, err = scriptModule.StoreDelete(scriptCtx, key)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = scriptModule.StoreDelete(scriptCtx)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+
+	keys, err := scriptModule.StoreKeys(scriptCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := keys.(*objects.Array); !ok || len(a.Value) != 1 {
+		t.Errorf("unexpected keys %v", keys)
+	}
+	_, err = scriptModule.StoreKeys()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+}
+
+func TestStoreCandles(t *testing.T) {
+	t.Parallel()
+	start := &objects.Time{Value: time.Now().Add(-time.Hour * 24)}
+	end := &objects.Time{Value: time.Now()}
+	interval := &objects.String{Value: "1h"}
+	v, err := Default.StoreCandles(exch, currencyPair, delimiter, assetType, start, end, interval)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := v.(*OHLCV); !ok {
+		t.Errorf("received %v expected OHLCV", v.TypeName())
+	}
+	_, err = Default.StoreCandles(exchError, currencyPair, delimiter, assetType, start, end, interval)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+	_, err = Default.StoreCandles(exch, currencyPair, delimiter, assetType, start, end, &objects.String{Value: "6m"})
+	if !errors.Is(err, errInvalidInterval) {
+		t.Errorf("received %v expected %v", err, errInvalidInterval)
+	}
+	_, err = Default.StoreCandles()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+}
+
+func TestStoreTrades(t *testing.T) {
+	t.Parallel()
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+	v, err := Default.StoreTrades(exch, currencyPair, delimiter, assetType, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := v.(*objects.Array); !ok || len(a.Value) != 1 {
+		t.Errorf("unexpected trades %v", v)
+	}
+	_, err = Default.StoreTrades(exchError, currencyPair, delimiter, assetType, start, end)
+	if err != nil && errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+	_, err = Default.StoreTrades()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+}
+
+func TestStoreAuditLog(t *testing.T) {
+	t.Parallel()
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+	v, err := Default.StoreAuditLog(start, end, &objects.String{Value: "asc"}, &objects.Int{Value: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := v.(*objects.Array); !ok || len(a.Value) != 1 {
+		t.Errorf("unexpected audit events %v", v)
+	}
+	_, err = Default.StoreAuditLog(start, end, &objects.String{Value: "asc"}, blank)
+	if err == nil {
+		t.Error("expected error converting limit")
+	}
+	_, err = Default.StoreAuditLog()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, objects.ErrWrongNumArguments)
+	}
+}
+
+func TestScriptName(t *testing.T) {
+	t.Parallel()
+	if name, err := scriptModule.scriptName(); err != nil || name != "test.gct" {
+		t.Errorf("received %v %v expected %v", name, err, "test.gct")
+	}
+	if _, err := Default.scriptName(); !errors.Is(err, errScriptUnbound) {
+		t.Errorf("received %v expected %v", err, errScriptUnbound)
+	}
+}
@@ -3,6 +3,7 @@ package loader
 import (
 	"github.com/d5/tengo/v2"
 	"github.com/d5/tengo/v2/stdlib"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta"
 )
@@ -10,11 +11,21 @@ import (
 // GetModuleMap returns the module map that includes all modules
 // for the given module names.
 func GetModuleMap() *tengo.ModuleMap {
+	return getModuleMap(gct.Modules)
+}
+
+// GetModuleMapWithWrapper returns the module map that includes all modules
+// with the gct modules calling the wrapper returned by fn on behalf of the
+// named script
+func GetModuleMapWithWrapper(fn func() modules.GCT, script string) *tengo.ModuleMap {
+	return getModuleMap(gct.NewScriptModule(fn, script).Modules())
+}
+
+func getModuleMap(gctModules map[string]map[string]tengo.Object) *tengo.ModuleMap {
 	modules := tengo.NewModuleMap()
 
-	gctModuleList := gct.AllModuleNames()
-	for _, name := range gctModuleList {
-		if mod := gct.Modules[name]; mod != nil {
+	for name, mod := range gctModules {
+		if mod != nil {
 			modules.AddBuiltinModule(name, mod)
 		}
 	}
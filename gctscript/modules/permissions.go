@@ -0,0 +1,126 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Access levels granted to a script, each level includes the levels before it
+const (
+	AccessRead     = "read"
+	AccessTrade    = "trade"
+	AccessWithdraw = "withdraw"
+)
+
+var (
+	// ErrPermissionDenied is returned when a script calls the wrapper outside
+	// of its permissions
+	ErrPermissionDenied = errors.New("permission denied")
+
+	errInvalidAccess = errors.New("invalid access level")
+	accessLevels     = []string{AccessRead, AccessTrade, AccessWithdraw}
+)
+
+// Permissions restricts the exchanges, pairs and actions available to a
+// script. Empty exchange and pair lists allow all, a zero max order notional
+// allows orders of any size
+type Permissions struct {
+	Exchanges        []string `json:"exchanges,omitempty"`
+	Pairs            []string `json:"pairs,omitempty"`
+	Access           string   `json:"access"`
+	MaxOrderNotional float64  `json:"max_order_notional,omitempty"`
+}
+
+// Validate checks the access level, pairs and max order notional
+func (p *Permissions) Validate() error {
+	if p.level(p.Access) < 0 {
+		return fmt.Errorf("%w %q, supported levels: %s",
+			errInvalidAccess, p.Access, strings.Join(accessLevels, ", "))
+	}
+	for x := range p.Pairs {
+		if _, err := currency.NewPairFromString(p.Pairs[x]); err != nil {
+			return err
+		}
+	}
+	if p.MaxOrderNotional < 0 {
+		return errors.New("max order notional cannot be negative")
+	}
+	return nil
+}
+
+// CheckAccess returns an error if the permissions do not include the access
+// level
+func (p *Permissions) CheckAccess(access string) error {
+	if p.level(p.Access) < p.level(access) {
+		return fmt.Errorf("%w: %s access required", ErrPermissionDenied, access)
+	}
+	return nil
+}
+
+// CheckExchange returns an error if the exchange is not permitted
+func (p *Permissions) CheckExchange(exch string) error {
+	if !p.AllowsExchange(exch) {
+		return fmt.Errorf("%w: exchange %s", ErrPermissionDenied, exch)
+	}
+	return nil
+}
+
+// CheckPair returns an error if the exchange or pair is not permitted
+func (p *Permissions) CheckPair(exch string, pair currency.Pair) error {
+	if err := p.CheckExchange(exch); err != nil {
+		return err
+	}
+	if !p.AllowsPair(pair) {
+		return fmt.Errorf("%w: pair %s", ErrPermissionDenied, pair)
+	}
+	return nil
+}
+
+// CheckNotional returns an error if the order notional exceeds the max order
+// notional
+func (p *Permissions) CheckNotional(notional float64) error {
+	if p.MaxOrderNotional > 0 && notional > p.MaxOrderNotional {
+		return fmt.Errorf("%w: order notional %v exceeds max %v",
+			ErrPermissionDenied, notional, p.MaxOrderNotional)
+	}
+	return nil
+}
+
+// AllowsExchange returns true if the exchange is permitted
+func (p *Permissions) AllowsExchange(exch string) bool {
+	if len(p.Exchanges) == 0 {
+		return true
+	}
+	for x := range p.Exchanges {
+		if strings.EqualFold(p.Exchanges[x], exch) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsPair returns true if the pair is permitted
+func (p *Permissions) AllowsPair(pair currency.Pair) bool {
+	if len(p.Pairs) == 0 {
+		return true
+	}
+	for x := range p.Pairs {
+		allowed, err := currency.NewPairFromString(p.Pairs[x])
+		if err == nil && allowed.Equal(pair) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Permissions) level(access string) int {
+	for x := range accessLevels {
+		if strings.EqualFold(accessLevels[x], access) {
+			return x
+		}
+	}
+	return -1
+}
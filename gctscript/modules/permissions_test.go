@@ -0,0 +1,75 @@
+package modules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func TestPermissionsValidate(t *testing.T) {
+	t.Parallel()
+	p := Permissions{Access: "Trade", Pairs: []string{"BTC-USD"}}
+	if err := p.Validate(); err != nil {
+		t.Error(err)
+	}
+	p.Access = ""
+	if err := p.Validate(); !errors.Is(err, errInvalidAccess) {
+		t.Errorf("received %v expected %v", err, errInvalidAccess)
+	}
+	p.Access = AccessRead
+	p.Pairs = []string{"B"}
+	if err := p.Validate(); err == nil {
+		t.Error("expected invalid pair error")
+	}
+	p.Pairs = nil
+	p.MaxOrderNotional = -1
+	if err := p.Validate(); err == nil {
+		t.Error("expected negative max order notional error")
+	}
+}
+
+func TestPermissionsChecks(t *testing.T) {
+	t.Parallel()
+	p := Permissions{
+		Exchanges:        []string{"Bitstamp"},
+		Pairs:            []string{"BTC-USD"},
+		Access:           AccessTrade,
+		MaxOrderNotional: 10,
+	}
+	if err := p.CheckAccess(AccessRead); err != nil {
+		t.Error(err)
+	}
+	if err := p.CheckAccess(AccessTrade); err != nil {
+		t.Error(err)
+	}
+	if err := p.CheckAccess(AccessWithdraw); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, ErrPermissionDenied)
+	}
+	if err := p.CheckExchange("bitstamp"); err != nil {
+		t.Error(err)
+	}
+	if err := p.CheckExchange("Binance"); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, ErrPermissionDenied)
+	}
+	if err := p.CheckPair("Bitstamp", currency.NewPair(currency.BTC, currency.USD)); err != nil {
+		t.Error(err)
+	}
+	if err := p.CheckPair("Bitstamp", currency.NewPair(currency.USD, currency.BTC)); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, ErrPermissionDenied)
+	}
+	if err := p.CheckNotional(10); err != nil {
+		t.Error(err)
+	}
+	if err := p.CheckNotional(10.1); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, ErrPermissionDenied)
+	}
+
+	var all Permissions
+	if !all.AllowsExchange("Binance") || !all.AllowsPair(currency.NewPair(currency.ETH, currency.BTC)) {
+		t.Error("expected empty permissions to allow all exchanges and pairs")
+	}
+	if err := all.CheckNotional(1e9); err != nil {
+		t.Error(err)
+	}
+}
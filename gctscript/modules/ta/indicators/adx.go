@@ -0,0 +1,110 @@
+package indicators
+
+import (
+	"math"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// ADXModule average directional index indicator commands
+var ADXModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: adx},
+}
+
+// AverageDirectionalIndex is the string constant
+const AverageDirectionalIndex = "Average Directional Index"
+
+// ADX defines a custom Average Directional Index indicator tengo object
+type ADX struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *ADX) TypeName() string {
+	return AverageDirectionalIndex
+}
+
+func adx(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(ADX)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = p[0]
+	ret, plusDI, minusDI := calcADX(s.High, s.Low, s.Close, r.Period)
+	appendSeries(&r.Array, ret, plusDI, minusDI)
+	return r, nil
+}
+
+// calcADX returns the average directional index alongside the positive and
+// negative directional indicators (DMI) using Wilder smoothing
+func calcADX(high, low, closePrices []float64, period int) (out, plusDI, minusDI []float64) {
+	out = make([]float64, len(closePrices))
+	plusDI = make([]float64, len(closePrices))
+	minusDI = make([]float64, len(closePrices))
+	if len(closePrices) <= period {
+		return out, plusDI, minusDI
+	}
+
+	tr := trueRange(high, low, closePrices)
+	var smoothTR, smoothPlus, smoothMinus, sumDX float64
+	dx := make([]float64, len(closePrices))
+	p := float64(period)
+	for i := 1; i < len(closePrices); i++ {
+		up := high[i] - high[i-1]
+		down := low[i-1] - low[i]
+		var plusDM, minusDM float64
+		if up > down && up > 0 {
+			plusDM = up
+		}
+		if down > up && down > 0 {
+			minusDM = down
+		}
+
+		if i <= period {
+			smoothTR += tr[i]
+			smoothPlus += plusDM
+			smoothMinus += minusDM
+			if i < period {
+				continue
+			}
+		} else {
+			smoothTR = smoothTR - smoothTR/p + tr[i]
+			smoothPlus = smoothPlus - smoothPlus/p + plusDM
+			smoothMinus = smoothMinus - smoothMinus/p + minusDM
+		}
+
+		if smoothTR != 0 {
+			plusDI[i] = 100 * smoothPlus / smoothTR
+			minusDI[i] = 100 * smoothMinus / smoothTR
+		}
+		if sum := plusDI[i] + minusDI[i]; sum != 0 {
+			dx[i] = 100 * math.Abs(plusDI[i]-minusDI[i]) / sum
+		}
+
+		switch {
+		case i < 2*period-1:
+			sumDX += dx[i]
+		case i == 2*period-1:
+			out[i] = (sumDX + dx[i]) / p
+		default:
+			out[i] = (out[i-1]*(p-1) + dx[i]) / p
+		}
+	}
+	return out, plusDI, minusDI
+}
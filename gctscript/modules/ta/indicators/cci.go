@@ -0,0 +1,73 @@
+package indicators
+
+import (
+	"math"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// CCIModule commodity channel index indicator commands
+var CCIModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: cci},
+}
+
+// CommodityChannelIndex is the string constant
+const CommodityChannelIndex = "Commodity Channel Index"
+
+// CCI defines a custom Commodity Channel Index indicator tengo object
+type CCI struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *CCI) TypeName() string {
+	return CommodityChannelIndex
+}
+
+func cci(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(CCI)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = p[0]
+	appendSeries(&r.Array, calcCCI(s.High, s.Low, s.Close, r.Period))
+	return r, nil
+}
+
+// calcCCI returns the deviation of the typical price from its average relative
+// to the mean absolute deviation
+func calcCCI(high, low, closePrices []float64, period int) []float64 {
+	out := make([]float64, len(closePrices))
+	tp := make([]float64, len(closePrices))
+	for i := range closePrices {
+		tp[i] = (high[i] + low[i] + closePrices[i]) / 3
+	}
+	avg := smaFrom(tp, 0, period)
+	for i := period - 1; i < len(closePrices); i++ {
+		var meanDev float64
+		for j := i - period + 1; j <= i; j++ {
+			meanDev += math.Abs(tp[j] - avg[i])
+		}
+		meanDev /= float64(period)
+		if meanDev != 0 {
+			out[i] = (tp[i] - avg[i]) / (0.015 * meanDev)
+		}
+	}
+	return out
+}
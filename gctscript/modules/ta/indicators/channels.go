@@ -0,0 +1,140 @@
+package indicators
+
+import (
+	"fmt"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// KeltnerModule keltner channels indicator commands
+var KeltnerModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: keltner},
+}
+
+// DonchianModule donchian channels indicator commands
+var DonchianModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: donchian},
+}
+
+const (
+	// KeltnerChannels is the string constant
+	KeltnerChannels = "Keltner Channels"
+	// DonchianChannels is the string constant
+	DonchianChannels = "Donchian Channels"
+)
+
+// Keltner defines a custom Keltner Channels indicator tengo object
+type Keltner struct {
+	objects.Array
+	EMAPeriod, ATRPeriod int
+	Multiplier           float64
+}
+
+// TypeName returns the name of the custom type.
+func (o *Keltner) TypeName() string {
+	return KeltnerChannels
+}
+
+// Donchian defines a custom Donchian Channels indicator tengo object
+type Donchian struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *Donchian) TypeName() string {
+	return DonchianChannels
+}
+
+func keltner(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Keltner)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+	multiplier, ok := objects.ToFloat64(args[3])
+	if !ok {
+		return nil, fmt.Errorf(modules.ErrParameterConvertFailed, multiplier)
+	}
+
+	r.EMAPeriod, r.ATRPeriod, r.Multiplier = p[0], p[1], multiplier
+	middle, upper, lower := calcKeltner(s.High, s.Low, s.Close, r.EMAPeriod, r.ATRPeriod, multiplier)
+	appendSeries(&r.Array, middle, upper, lower)
+	return r, nil
+}
+
+func donchian(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Donchian)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = p[0]
+	middle, upper, lower := calcDonchian(s.High, s.Low, r.Period)
+	appendSeries(&r.Array, middle, upper, lower)
+	return r, nil
+}
+
+// calcKeltner returns an exponential moving average of the close as the
+// middle line with bands the average true range multiplied above and below
+func calcKeltner(high, low, closePrices []float64, emaPeriod, atrPeriod int, multiplier float64) (middle, upper, lower []float64) {
+	upper = make([]float64, len(closePrices))
+	lower = make([]float64, len(closePrices))
+	middle = make([]float64, len(closePrices))
+	if len(closePrices) <= atrPeriod {
+		// average true range is only available from the period after atrPeriod
+		return middle, upper, lower
+	}
+	ema := indicators.EMA(closePrices, emaPeriod)
+	atr := indicators.ATR(high, low, closePrices, atrPeriod)
+	start := emaPeriod - 1
+	if atrPeriod > start {
+		start = atrPeriod
+	}
+	for i := start; i < len(closePrices); i++ {
+		middle[i] = ema[i]
+		upper[i] = ema[i] + multiplier*atr[i]
+		lower[i] = ema[i] - multiplier*atr[i]
+	}
+	return middle, upper, lower
+}
+
+// calcDonchian returns the highest high and lowest low of the period and the
+// midpoint between them
+func calcDonchian(high, low []float64, period int) (middle, upper, lower []float64) {
+	upper = highest(high, period)
+	lower = lowest(low, period)
+	middle = make([]float64, len(high))
+	for i := period - 1; i < len(high); i++ {
+		middle[i] = (upper[i] + lower[i]) / 2
+	}
+	return middle, upper, lower
+}
@@ -0,0 +1,78 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// IchimokuModule ichimoku cloud indicator commands
+var IchimokuModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: ichimoku},
+}
+
+// IchimokuCloud is the string constant
+const IchimokuCloud = "Ichimoku Cloud"
+
+// Ichimoku defines a custom Ichimoku Cloud indicator tengo object
+type Ichimoku struct {
+	objects.Array
+	ConversionPeriod, BasePeriod, SpanBPeriod int
+}
+
+// TypeName returns the name of the custom type.
+func (o *Ichimoku) TypeName() string {
+	return IchimokuCloud
+}
+
+func ichimoku(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Ichimoku)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.ConversionPeriod, r.BasePeriod, r.SpanBPeriod = p[0], p[1], p[2]
+	conversion, base, spanA, spanB := calcIchimoku(s.High, s.Low, r.ConversionPeriod, r.BasePeriod, r.SpanBPeriod)
+	appendSeries(&r.Array, conversion, base, spanA, spanB)
+	return r, nil
+}
+
+// calcIchimoku returns the conversion (tenkan-sen), base (kijun-sen) and
+// leading span A and B (senkou span) lines. Values are aligned to the period
+// they are calculated from, the leading spans are conventionally plotted
+// ahead by the base period and the lagging span is the close plotted behind
+func calcIchimoku(high, low []float64, conversionPeriod, basePeriod, spanBPeriod int) (conversion, base, spanA, spanB []float64) {
+	midpoint := func(period int) []float64 {
+		out := make([]float64, len(high))
+		hh := highest(high, period)
+		ll := lowest(low, period)
+		for i := period - 1; i < len(high); i++ {
+			out[i] = (hh[i] + ll[i]) / 2
+		}
+		return out
+	}
+	conversion = midpoint(conversionPeriod)
+	base = midpoint(basePeriod)
+	spanB = midpoint(spanBPeriod)
+	spanA = make([]float64, len(high))
+	start := conversionPeriod
+	if basePeriod > start {
+		start = basePeriod
+	}
+	for i := start - 1; i < len(high); i++ {
+		spanA[i] = (conversion[i] + base[i]) / 2
+	}
+	return conversion, base, spanA, spanB
+}
@@ -2,6 +2,7 @@ package indicators
 
 import (
 	"errors"
+	"math"
 	"math/rand"
 	"os"
 	"reflect"
@@ -561,3 +562,278 @@ func TestParseMAType(t *testing.T) {
 		})
 	}
 }
+
+var (
+	refHigh   = []float64{10, 11, 12, 11.5, 13, 14, 13.5, 15, 16, 15.5, 17, 16, 15, 14, 15, 16, 17, 18, 17.5, 19}
+	refLow    = []float64{9, 9.5, 10.5, 10, 11, 12.5, 12, 13, 14.5, 14, 15, 14.5, 13, 12.5, 13.5, 14.5, 15.5, 16.5, 16, 17}
+	refClose  = []float64{9.5, 10.5, 11.5, 11, 12.5, 13.5, 13, 14.5, 15.5, 15, 16.5, 15, 13.5, 13, 14.5, 15.5, 16.5, 17.5, 16.5, 18.5}
+	refVolume = []float64{100, 120, 130, 90, 150, 160, 110, 170, 180, 140, 190, 150, 130, 120, 140, 160, 170, 180, 150, 200}
+)
+
+func refCandles() *objects.Array {
+	candles := &objects.Array{}
+	for x := range refClose {
+		candles.Value = append(candles.Value, &objects.Array{Value: []objects.Object{
+			&objects.Int{Value: int64(x)},
+			&objects.Float{Value: refClose[x]},
+			&objects.Float{Value: refHigh[x]},
+			&objects.Float{Value: refLow[x]},
+			&objects.Float{Value: refClose[x]},
+			&objects.Float{Value: refVolume[x]},
+		}})
+	}
+	return candles
+}
+
+func checkReference(t *testing.T, name string, received, expected float64) {
+	t.Helper()
+	if math.Abs(received-expected) > 1e-6 {
+		t.Errorf("%s received %v expected %v", name, received, expected)
+	}
+}
+
+func TestParseSeries(t *testing.T) {
+	t.Parallel()
+	s, err := parseSeries(refCandles())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Close) != len(refClose) || s.High[19] != 19 || s.Volume[19] != 200 {
+		t.Error("unexpected candle conversion")
+	}
+
+	ohlcvObject := &objects.Map{Value: map[string]objects.Object{"candles": refCandles()}}
+	s, err = parseSeries(ohlcvObject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Low[0] != 9 {
+		t.Error("unexpected OHLCV object conversion")
+	}
+
+	prices := &objects.Array{Value: []objects.Object{&objects.Int{Value: 1}, &objects.Float{Value: 2.5}}}
+	s, err = parseSeries(prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.High[1] != 2.5 || s.Low[1] != 2.5 || s.Close[0] != 1 || s.Volume[0] != 0 {
+		t.Error("unexpected price conversion")
+	}
+
+	_, err = parseSeries(&objects.String{Value: testString})
+	if err == nil {
+		t.Error("expected conversion failed error")
+	}
+	_, err = parseSeries(&objects.Map{Value: map[string]objects.Object{}})
+	if err == nil {
+		t.Error("expected conversion failed error")
+	}
+	_, err = parseSeries(ohlcvDataInvalid)
+	if err == nil {
+		t.Error("expected conversion failed error")
+	}
+	_, err = parseSeries(&objects.Array{Value: []objects.Object{&objects.Array{}}})
+	if err == nil {
+		t.Error("expected conversion failed error")
+	}
+}
+
+func TestParsePeriods(t *testing.T) {
+	t.Parallel()
+	p, err := parsePeriods(&objects.Int{Value: 1}, &objects.Float{Value: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p[0] != 1 || p[1] != 2 {
+		t.Errorf("unexpected periods %v", p)
+	}
+	_, err = parsePeriods(&objects.Int{Value: 0})
+	if err == nil {
+		t.Error("expected invalid period error")
+	}
+	_, err = parsePeriods(&objects.String{Value: testString})
+	if err == nil {
+		t.Error("expected conversion failed error")
+	}
+}
+
+func TestReferenceValues(t *testing.T) {
+	t.Parallel()
+	k, d := calcStochastic(refHigh, refLow, refClose, 5, 3, 3)
+	checkReference(t, "stochastic k", k[19], 82.154882)
+	checkReference(t, "stochastic d", d[19], 84.271284)
+	checkReference(t, "stochastic before first k", k[5], 0)
+	checkReference(t, "stochastic k", k[8], 85)
+
+	k, d = calcStochRSI(refClose, 5, 5, 3, 3)
+	checkReference(t, "stochastic rsi k", k[19], 69.533197)
+	checkReference(t, "stochastic rsi d", d[19], 81.518866)
+
+	adxValues, plusDI, minusDI := calcADX(refHigh, refLow, refClose, 5)
+	checkReference(t, "adx", adxValues[19], 46.619591)
+	checkReference(t, "adx first", adxValues[9], 69.396824)
+	checkReference(t, "adx before first", adxValues[8], 0)
+	checkReference(t, "+di", plusDI[19], 44.312371)
+	checkReference(t, "-di", minusDI[19], 11.505026)
+
+	cciValues := calcCCI(refHigh, refLow, refClose, 5)
+	checkReference(t, "cci", cciValues[19], 118.644068)
+	checkReference(t, "cci", cciValues[12], -140.151515)
+
+	willR := calcWillR(refHigh, refLow, refClose, 5)
+	checkReference(t, "williams %r", willR[19], -11.111111)
+	checkReference(t, "williams %r", willR[12], -87.5)
+
+	conversion, base, spanA, spanB := calcIchimoku(refHigh, refLow, 3, 5, 7)
+	checkReference(t, "ichimoku conversion", conversion[19], 17.5)
+	checkReference(t, "ichimoku base", base[19], 16.75)
+	checkReference(t, "ichimoku span a", spanA[19], 17.125)
+	checkReference(t, "ichimoku span b", spanB[19], 15.75)
+
+	expectedPSAR := []float64{11.851104, 12.57195, 17, 16.92, 16.7432, 16.573472, 12.5, 12.59, 12.8064, 13.014144}
+	sar := calcPSAR(refHigh, refLow, refClose, 0.02, 0.2)
+	for x := range expectedPSAR {
+		checkReference(t, "parabolic sar", sar[10+x], expectedPSAR[x])
+	}
+
+	middle, upper, lower := calcKeltner(refHigh, refLow, refClose, 5, 5, 2)
+	checkReference(t, "keltner middle", middle[19], 16.941077)
+	checkReference(t, "keltner upper", upper[19], 20.531494)
+	checkReference(t, "keltner lower", lower[19], 13.35066)
+
+	middle, upper, lower = calcDonchian(refHigh, refLow, 5)
+	checkReference(t, "donchian middle", middle[19], 16.75)
+	checkReference(t, "donchian upper", upper[19], 19)
+	checkReference(t, "donchian lower", lower[19], 14.5)
+
+	checkReference(t, "vwap", calcVWAP(refHigh, refLow, refClose, refVolume, 0)[19], 14.445011)
+	checkReference(t, "rolling vwap", calcVWAP(refHigh, refLow, refClose, refVolume, 5)[19], 16.841085)
+
+	value, direction := calcSuperTrend(refHigh, refLow, refClose, 5, 2)
+	checkReference(t, "supertrend", value[5], 16.45)
+	checkReference(t, "supertrend direction", direction[9], -1)
+	checkReference(t, "supertrend", value[10], 12.632064)
+	checkReference(t, "supertrend direction", direction[10], 1)
+	checkReference(t, "supertrend", value[19], 14.409583)
+
+	checkReference(t, "wma", wmaFrom(refClose, 0, 5)[19], 17.3)
+	checkReference(t, "wma", wmaFrom(refClose, 0, 5)[4], 11.433333)
+	checkReference(t, "hma", calcHMA(refClose, 9)[19], 18.12037)
+	checkReference(t, "hma", calcHMA(refClose, 9)[10], 16.287037)
+	checkReference(t, "kama", calcKAMA(refClose, 5, 2, 30)[19], 16.231422)
+	checkReference(t, "kama", calcKAMA(refClose, 5, 2, 30)[5], 12.798374)
+
+	expectedPivots := map[string][]float64{
+		"classic":   {16.666667, 17.333333, 18.166667, 18.833333, 15.833333, 15.166667, 14.333333},
+		"fibonacci": {16.666667, 17.239667, 17.593667, 18.166667, 16.093667, 15.739667, 15.166667},
+		"camarilla": {16.666667, 16.6375, 16.775, 16.9125, 16.3625, 16.225, 16.0875},
+		"woodie":    {16.625, 17.25, 18.125, 18.75, 15.75, 15.125, 14.25},
+	}
+	for pivotType, expected := range expectedPivots {
+		levels, err := calcPivots(refHigh, refLow, refClose, pivotType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for x := range expected {
+			checkReference(t, pivotType+" pivots", levels[x][19], expected[x])
+			checkReference(t, pivotType+" pivots first period", levels[x][0], 0)
+		}
+	}
+	if _, err := calcPivots(refHigh, refLow, refClose, "fake"); !errors.Is(err, errInvalidPivotType) {
+		t.Errorf("received %v expected %v", err, errInvalidPivotType)
+	}
+}
+
+func TestShortSeries(t *testing.T) {
+	t.Parallel()
+	short := []float64{1, 2}
+	if _, d := calcStochastic(short, short, short, 5, 3, 3); len(d) != 2 {
+		t.Error("unexpected stochastic length")
+	}
+	if v, _, _ := calcADX(short, short, short, 5); len(v) != 2 {
+		t.Error("unexpected adx length")
+	}
+	if v, _, _ := calcKeltner(short, short, short, 5, 5, 2); len(v) != 2 {
+		t.Error("unexpected keltner length")
+	}
+	if v, _ := calcSuperTrend(short, short, short, 5, 2); len(v) != 2 {
+		t.Error("unexpected supertrend length")
+	}
+	if v := calcKAMA(short, 5, 2, 30); len(v) != 2 {
+		t.Error("unexpected kama length")
+	}
+	if v := calcPSAR(short[:1], short[:1], short[:1], 0.02, 0.2); len(v) != 1 {
+		t.Error("unexpected parabolic sar length")
+	}
+}
+
+func TestExtendedIndicatorModules(t *testing.T) {
+	candles := refCandles()
+	period := &objects.Int{Value: 5}
+	smooth := &objects.Int{Value: 3}
+	multiplier := &objects.Float{Value: 2}
+	invalid := &objects.String{Value: testString}
+	testCases := []struct {
+		name     string
+		fn       objects.CallableFunc
+		args     []objects.Object
+		typeName string
+	}{
+		{"stoch", stochastic, []objects.Object{candles, period, smooth, smooth}, StochasticOscillator},
+		{"stochrsi", stochRSI, []objects.Object{candles, period, period, smooth, smooth}, StochasticRelativeStrengthIndex},
+		{"adx", adx, []objects.Object{candles, period}, AverageDirectionalIndex},
+		{"cci", cci, []objects.Object{candles, period}, CommodityChannelIndex},
+		{"willr", willR, []objects.Object{candles, period}, WilliamsPercentRange},
+		{"ichimoku", ichimoku, []objects.Object{candles, smooth, period, &objects.Int{Value: 7}}, IchimokuCloud},
+		{"psar", psar, []objects.Object{candles, &objects.Float{Value: 0.02}, &objects.Float{Value: 0.2}}, ParabolicSAR},
+		{"keltner", keltner, []objects.Object{candles, period, period, multiplier}, KeltnerChannels},
+		{"donchian", donchian, []objects.Object{candles, period}, DonchianChannels},
+		{"vwap", vwap, []objects.Object{candles}, VolumeWeightedAveragePrice},
+		{"rolling vwap", vwap, []objects.Object{candles, period}, VolumeWeightedAveragePrice},
+		{"supertrend", superTrend, []objects.Object{candles, period, multiplier}, SuperTrendIndicator},
+		{"wma", wma, []objects.Object{candles, period}, WeightedMovingAverage},
+		{"hma", hma, []objects.Object{candles, period}, HullMovingAverage},
+		{"kama", kama, []objects.Object{candles, period}, KaufmanAdaptiveMovingAverage},
+		{"kama periods", kama, []objects.Object{candles, period, smooth, &objects.Int{Value: 30}}, KaufmanAdaptiveMovingAverage},
+		{"pivots", pivots, []objects.Object{candles, &objects.String{Value: "Classic"}}, PivotPoints},
+	}
+	for x := range testCases {
+		tc := testCases[x]
+		ret, err := tc.fn(tc.args...)
+		if err != nil {
+			t.Fatalf("%s %v", tc.name, err)
+		}
+		if ret.TypeName() != tc.typeName {
+			t.Errorf("%s received %v expected %v", tc.name, ret.TypeName(), tc.typeName)
+		}
+		if a, ok := objects.ToInterface(ret).([]interface{}); ok && len(a) != len(refClose) {
+			t.Errorf("%s received %v values expected %v", tc.name, len(a), len(refClose))
+		}
+
+		if _, err = tc.fn(); !errors.Is(err, objects.ErrWrongNumArguments) {
+			t.Errorf("%s received %v expected %v", tc.name, err, objects.ErrWrongNumArguments)
+		}
+		args := append([]objects.Object{ohlcvDataInvalid}, tc.args[1:]...)
+		if _, err = tc.fn(args...); err == nil {
+			t.Errorf("%s expected conversion failed error", tc.name)
+		}
+		if len(tc.args) > 1 {
+			args = append([]objects.Object{candles, invalid}, tc.args[2:]...)
+			if _, err = tc.fn(args...); err == nil {
+				t.Errorf("%s expected conversion failed error", tc.name)
+			}
+		}
+	}
+
+	validator.IsTestExecution.Store(true)
+	defer validator.IsTestExecution.Store(false)
+	for x := range testCases {
+		ret, err := testCases[x].fn(testCases[x].args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a, ok := objects.ToInterface(ret).([]interface{}); ok && len(a) != 0 {
+			t.Errorf("%s expected empty array on test execution", testCases[x].name)
+		}
+	}
+}
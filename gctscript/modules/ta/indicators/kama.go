@@ -0,0 +1,83 @@
+package indicators
+
+import (
+	"math"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// KAMAModule kaufman adaptive moving average indicator commands
+var KAMAModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: kama},
+}
+
+// KaufmanAdaptiveMovingAverage is the string constant
+const KaufmanAdaptiveMovingAverage = "Kaufman Adaptive Moving Average"
+
+// KAMA defines a custom Kaufman Adaptive Moving Average indicator tengo object
+type KAMA struct {
+	objects.Array
+	Period, FastPeriod, SlowPeriod int
+}
+
+// TypeName returns the name of the custom type.
+func (o *KAMA) TypeName() string {
+	return KaufmanAdaptiveMovingAverage
+}
+
+func kama(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 && len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(KAMA)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period, r.FastPeriod, r.SlowPeriod = p[0], 2, 30
+	if len(p) == 3 {
+		r.FastPeriod, r.SlowPeriod = p[1], p[2]
+	}
+	appendSeries(&r.Array, calcKAMA(s.Close, r.Period, r.FastPeriod, r.SlowPeriod))
+	return r, nil
+}
+
+// calcKAMA returns a moving average which follows price closely when the
+// efficiency ratio of price movement to volatility is high and flattens when
+// price is noisy. It is seeded with the close at period-1 and returned from
+// period
+func calcKAMA(in []float64, period, fastPeriod, slowPeriod int) []float64 {
+	out := make([]float64, len(in))
+	if len(in) <= period {
+		return out
+	}
+	fastSC := 2 / (float64(fastPeriod) + 1)
+	slowSC := 2 / (float64(slowPeriod) + 1)
+	prev := in[period-1]
+	for i := period; i < len(in); i++ {
+		change := math.Abs(in[i] - in[i-period])
+		var volatility float64
+		for j := i - period + 1; j <= i; j++ {
+			volatility += math.Abs(in[j] - in[j-1])
+		}
+		var er float64
+		if volatility != 0 {
+			er = change / volatility
+		}
+		sc := math.Pow(er*(fastSC-slowSC)+slowSC, 2)
+		prev += sc * (in[i] - prev)
+		out[i] = prev
+	}
+	return out
+}
@@ -0,0 +1,101 @@
+package indicators
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// PivotsModule pivot points indicator commands
+var PivotsModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: pivots},
+}
+
+// PivotPoints is the string constant
+const PivotPoints = "Pivot Points"
+
+var errInvalidPivotType = errors.New("invalid pivot type, expected classic, fibonacci, camarilla or woodie")
+
+// Pivots defines a custom Pivot Points indicator tengo object
+type Pivots struct {
+	objects.Array
+	Type string
+}
+
+// TypeName returns the name of the custom type.
+func (o *Pivots) TypeName() string {
+	return PivotPoints
+}
+
+func pivots(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Pivots)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	pivotType, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(modules.ErrParameterConvertFailed, pivotType)
+	}
+
+	r.Type = strings.ToLower(pivotType)
+	levels, err := calcPivots(s.High, s.Low, s.Close, r.Type)
+	if err != nil {
+		return nil, err
+	}
+	appendSeries(&r.Array, levels...)
+	return r, nil
+}
+
+// calcPivots returns the pivot, resistance 1-3 and support 1-3 levels of each
+// period derived from the previous period's high, low and close
+func calcPivots(high, low, closePrices []float64, pivotType string) ([][]float64, error) {
+	switch pivotType {
+	case "classic", "fibonacci", "camarilla", "woodie":
+	default:
+		return nil, errInvalidPivotType
+	}
+	levels := make([][]float64, 7)
+	for x := range levels {
+		levels[x] = make([]float64, len(closePrices))
+	}
+	for i := 1; i < len(closePrices); i++ {
+		h, l, c := high[i-1], low[i-1], closePrices[i-1]
+		r := h - l
+		pp := (h + l + c) / 3
+		var r1, r2, r3, s1, s2, s3 float64
+		switch pivotType {
+		case "classic", "woodie":
+			if pivotType == "woodie" {
+				pp = (h + l + 2*c) / 4
+			}
+			r1, s1 = 2*pp-l, 2*pp-h
+			r2, s2 = pp+r, pp-r
+			r3, s3 = h+2*(pp-l), l-2*(h-pp)
+		case "fibonacci":
+			r1, s1 = pp+0.382*r, pp-0.382*r
+			r2, s2 = pp+0.618*r, pp-0.618*r
+			r3, s3 = pp+r, pp-r
+		case "camarilla":
+			r1, s1 = c+r*1.1/12, c-r*1.1/12
+			r2, s2 = c+r*1.1/6, c-r*1.1/6
+			r3, s3 = c+r*1.1/4, c-r*1.1/4
+		}
+		for x, v := range []float64{pp, r1, r2, r3, s1, s2, s3} {
+			levels[x][i] = v
+		}
+	}
+	return levels, nil
+}
@@ -0,0 +1,102 @@
+package indicators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// PSARModule parabolic stop and reverse indicator commands
+var PSARModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: psar},
+}
+
+// ParabolicSAR is the string constant
+const ParabolicSAR = "Parabolic Stop and Reverse"
+
+// PSAR defines a custom Parabolic SAR indicator tengo object
+type PSAR struct {
+	objects.Array
+	Step, Max float64
+}
+
+// TypeName returns the name of the custom type.
+func (o *PSAR) TypeName() string {
+	return ParabolicSAR
+}
+
+func psar(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(PSAR)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var allErrors []string
+	step, ok := objects.ToFloat64(args[1])
+	if !ok {
+		allErrors = append(allErrors, fmt.Sprintf(modules.ErrParameterConvertFailed, step))
+	}
+	maxStep, ok := objects.ToFloat64(args[2])
+	if !ok {
+		allErrors = append(allErrors, fmt.Sprintf(modules.ErrParameterConvertFailed, maxStep))
+	}
+	if len(allErrors) > 0 {
+		return nil, errors.New(strings.Join(allErrors, ", "))
+	}
+
+	r.Step, r.Max = step, maxStep
+	appendSeries(&r.Array, calcPSAR(s.High, s.Low, s.Close, step, maxStep))
+	return r, nil
+}
+
+// calcPSAR returns the parabolic stop and reverse, the acceleration factor
+// increases by step each time a new extreme is made up to max. The initial
+// trend is taken from the direction of the first two closes
+func calcPSAR(high, low, closePrices []float64, step, maxStep float64) []float64 {
+	out := make([]float64, len(closePrices))
+	if len(closePrices) < 2 {
+		return out
+	}
+
+	long := closePrices[1] >= closePrices[0]
+	sar, ep := high[0], low[1]
+	if long {
+		sar, ep = low[0], high[1]
+	}
+	af := step
+	out[1] = sar
+	for i := 2; i < len(closePrices); i++ {
+		sar += af * (ep - sar)
+		if long {
+			sar = math.Min(sar, math.Min(low[i-1], low[i-2]))
+			if low[i] < sar {
+				long, sar, ep, af = false, ep, low[i], step
+			} else if high[i] > ep {
+				ep, af = high[i], math.Min(af+step, maxStep)
+			}
+		} else {
+			sar = math.Max(sar, math.Max(high[i-1], high[i-2]))
+			if high[i] > sar {
+				long, sar, ep, af = true, ep, high[i], step
+			} else if low[i] < ep {
+				ep, af = low[i], math.Min(af+step, maxStep)
+			}
+		}
+		out[i] = sar
+	}
+	return out
+}
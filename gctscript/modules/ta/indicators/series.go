@@ -0,0 +1,188 @@
+package indicators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+var errInvalidPeriod = errors.New("period must be greater than zero")
+
+// series holds OHLCV input data split into columns
+type series struct {
+	Open, High, Low, Close, Volume []float64
+}
+
+// parseSeries converts the OHLCV object returned by exchange.ohlcv, an array
+// of [time, open, high, low, close, volume] candles or an array of prices into
+// columns. Prices are used as the open, high, low and close of each period with
+// zero volume
+func parseSeries(in objects.Object) (*series, error) {
+	data, ok := objects.ToInterface(in).([]interface{})
+	if !ok {
+		// exchange.ohlcv returns a map holding the candles
+		candles, err := in.IndexGet(&objects.String{Value: "candles"})
+		if err != nil || candles == nil {
+			return nil, fmt.Errorf(modules.ErrParameterConvertFailed, OHLCV)
+		}
+		data, ok = objects.ToInterface(candles).([]interface{})
+		if !ok {
+			return nil, fmt.Errorf(modules.ErrParameterConvertFailed, OHLCV)
+		}
+	}
+
+	s := &series{
+		Open:   make([]float64, len(data)),
+		High:   make([]float64, len(data)),
+		Low:    make([]float64, len(data)),
+		Close:  make([]float64, len(data)),
+		Volume: make([]float64, len(data)),
+	}
+	var allErrors []string
+	for x := range data {
+		candle, isCandle := data[x].([]interface{})
+		if !isCandle {
+			value, err := toFloat64(data[x])
+			if err != nil {
+				allErrors = append(allErrors, err.Error())
+			}
+			s.Open[x], s.High[x], s.Low[x], s.Close[x] = value, value, value, value
+			continue
+		}
+		if len(candle) < 6 {
+			return nil, fmt.Errorf(modules.ErrParameterConvertFailed, OHLCV)
+		}
+		for i, column := range [][]float64{s.Open, s.High, s.Low, s.Close, s.Volume} {
+			value, err := toFloat64(candle[i+1])
+			if err != nil {
+				allErrors = append(allErrors, err.Error())
+			}
+			column[x] = value
+		}
+	}
+	if len(allErrors) > 0 {
+		return nil, errors.New(strings.Join(allErrors, ", "))
+	}
+	return s, nil
+}
+
+// parsePeriods converts period arguments which must be greater than zero
+func parsePeriods(args ...objects.Object) ([]int, error) {
+	periods := make([]int, len(args))
+	var allErrors []string
+	for x := range args {
+		v, ok := objects.ToInt(args[x])
+		if !ok {
+			allErrors = append(allErrors, fmt.Sprintf(modules.ErrParameterConvertFailed, v))
+			continue
+		}
+		if v < 1 {
+			allErrors = append(allErrors, errInvalidPeriod.Error())
+		}
+		periods[x] = v
+	}
+	if len(allErrors) > 0 {
+		return nil, errors.New(strings.Join(allErrors, ", "))
+	}
+	return periods, nil
+}
+
+// appendSeries appends a single series as floats or multiple series as an
+// array of values per period
+func appendSeries(r *objects.Array, values ...[]float64) {
+	if len(values) == 0 {
+		return
+	}
+	for x := range values[0] {
+		if len(values) == 1 {
+			r.Value = append(r.Value, &objects.Float{Value: values[0][x]})
+			continue
+		}
+		temp := &objects.Array{}
+		for i := range values {
+			temp.Value = append(temp.Value, &objects.Float{Value: values[i][x]})
+		}
+		r.Value = append(r.Value, temp)
+	}
+}
+
+// highest returns the highest value of each period, values before the first
+// full period are zero
+func highest(in []float64, period int) []float64 {
+	out := make([]float64, len(in))
+	for i := period - 1; i < len(in); i++ {
+		v := in[i-period+1]
+		for j := i - period + 2; j <= i; j++ {
+			v = math.Max(v, in[j])
+		}
+		out[i] = v
+	}
+	return out
+}
+
+// lowest returns the lowest value of each period, values before the first
+// full period are zero
+func lowest(in []float64, period int) []float64 {
+	out := make([]float64, len(in))
+	for i := period - 1; i < len(in); i++ {
+		v := in[i-period+1]
+		for j := i - period + 2; j <= i; j++ {
+			v = math.Min(v, in[j])
+		}
+		out[i] = v
+	}
+	return out
+}
+
+// smaFrom returns the simple moving average of values from the start index,
+// ignoring warm up values of a previous calculation
+func smaFrom(in []float64, start, period int) []float64 {
+	out := make([]float64, len(in))
+	if start < 0 {
+		start = 0
+	}
+	var sum float64
+	for i := start; i < len(in); i++ {
+		sum += in[i]
+		if i-start >= period {
+			sum -= in[i-period]
+		}
+		if i-start >= period-1 {
+			out[i] = sum / float64(period)
+		}
+	}
+	return out
+}
+
+// wmaFrom returns the weighted moving average of values from the start index,
+// ignoring warm up values of a previous calculation
+func wmaFrom(in []float64, start, period int) []float64 {
+	out := make([]float64, len(in))
+	if start < 0 {
+		start = 0
+	}
+	weights := float64(period*(period+1)) / 2
+	for i := start + period - 1; i < len(in); i++ {
+		var sum float64
+		for j := 0; j < period; j++ {
+			sum += in[i-period+1+j] * float64(j+1)
+		}
+		out[i] = sum / weights
+	}
+	return out
+}
+
+// trueRange returns the greatest of the current range and the distance from
+// the previous close, the first value is zero
+func trueRange(high, low, closePrices []float64) []float64 {
+	out := make([]float64, len(closePrices))
+	for i := 1; i < len(closePrices); i++ {
+		out[i] = math.Max(high[i]-low[i],
+			math.Max(math.Abs(high[i]-closePrices[i-1]), math.Abs(low[i]-closePrices[i-1])))
+	}
+	return out
+}
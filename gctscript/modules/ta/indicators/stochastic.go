@@ -0,0 +1,139 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// StochasticModule stochastic oscillator indicator commands
+var StochasticModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: stochastic},
+}
+
+// StochRSIModule stochastic relative strength index indicator commands
+var StochRSIModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: stochRSI},
+}
+
+const (
+	// StochasticOscillator is the string constant
+	StochasticOscillator = "Stochastic Oscillator"
+	// StochasticRelativeStrengthIndex is the string constant
+	StochasticRelativeStrengthIndex = "Stochastic Relative Strength Index"
+)
+
+// Stochastic defines a custom Stochastic Oscillator indicator tengo object
+type Stochastic struct {
+	objects.Array
+	KPeriod, KSmooth, DPeriod int
+}
+
+// TypeName returns the name of the custom type.
+func (o *Stochastic) TypeName() string {
+	return StochasticOscillator
+}
+
+// StochRSI defines a custom Stochastic Relative Strength Index indicator tengo
+// object
+type StochRSI struct {
+	objects.Array
+	RSIPeriod, StochPeriod, KSmooth, DPeriod int
+}
+
+// TypeName returns the name of the custom type.
+func (o *StochRSI) TypeName() string {
+	return StochasticRelativeStrengthIndex
+}
+
+func stochastic(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(Stochastic)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.KPeriod, r.KSmooth, r.DPeriod = p[0], p[1], p[2]
+	k, d := calcStochastic(s.High, s.Low, s.Close, r.KPeriod, r.KSmooth, r.DPeriod)
+	appendSeries(&r.Array, k, d)
+	return r, nil
+}
+
+func stochRSI(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(StochRSI)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1:]...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.RSIPeriod, r.StochPeriod, r.KSmooth, r.DPeriod = p[0], p[1], p[2], p[3]
+	k, d := calcStochRSI(s.Close, r.RSIPeriod, r.StochPeriod, r.KSmooth, r.DPeriod)
+	appendSeries(&r.Array, k, d)
+	return r, nil
+}
+
+// calcStochastic returns the slow %K and %D lines, %K is the position of the
+// close within the high low range of the period smoothed over kSmooth periods
+// and %D the average of %K
+func calcStochastic(high, low, closePrices []float64, kPeriod, kSmooth, dPeriod int) (k, d []float64) {
+	hh := highest(high, kPeriod)
+	ll := lowest(low, kPeriod)
+	fastK := make([]float64, len(closePrices))
+	for i := kPeriod - 1; i < len(closePrices); i++ {
+		if hh[i] != ll[i] {
+			fastK[i] = 100 * (closePrices[i] - ll[i]) / (hh[i] - ll[i])
+		}
+	}
+	k = smaFrom(fastK, kPeriod-1, kSmooth)
+	d = smaFrom(k, kPeriod+kSmooth-2, dPeriod)
+	return k, d
+}
+
+// calcStochRSI applies the stochastic oscillator to the relative strength
+// index of closing prices
+func calcStochRSI(closePrices []float64, rsiPeriod, stochPeriod, kSmooth, dPeriod int) (k, d []float64) {
+	rsi := indicators.RSI(closePrices, rsiPeriod)
+	start := rsiPeriod + stochPeriod - 1
+	raw := make([]float64, len(closePrices))
+	for i := start; i < len(closePrices); i++ {
+		hi, lo := rsi[i], rsi[i]
+		for j := i - stochPeriod + 1; j < i; j++ {
+			if rsi[j] > hi {
+				hi = rsi[j]
+			}
+			if rsi[j] < lo {
+				lo = rsi[j]
+			}
+		}
+		if hi != lo {
+			raw[i] = 100 * (rsi[i] - lo) / (hi - lo)
+		}
+	}
+	k = smaFrom(raw, start, kSmooth)
+	d = smaFrom(k, start+kSmooth-1, dPeriod)
+	return k, d
+}
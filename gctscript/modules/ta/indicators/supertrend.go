@@ -0,0 +1,103 @@
+package indicators
+
+import (
+	"fmt"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// SuperTrendModule supertrend indicator commands
+var SuperTrendModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: superTrend},
+}
+
+// SuperTrendIndicator is the string constant
+const SuperTrendIndicator = "SuperTrend"
+
+// SuperTrend defines a custom SuperTrend indicator tengo object
+type SuperTrend struct {
+	objects.Array
+	Period     int
+	Multiplier float64
+}
+
+// TypeName returns the name of the custom type.
+func (o *SuperTrend) TypeName() string {
+	return SuperTrendIndicator
+}
+
+func superTrend(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(SuperTrend)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+	multiplier, ok := objects.ToFloat64(args[2])
+	if !ok {
+		return nil, fmt.Errorf(modules.ErrParameterConvertFailed, multiplier)
+	}
+
+	r.Period, r.Multiplier = p[0], multiplier
+	value, direction := calcSuperTrend(s.High, s.Low, s.Close, r.Period, multiplier)
+	appendSeries(&r.Array, value, direction)
+	return r, nil
+}
+
+// calcSuperTrend returns the supertrend line and its direction, 1 when the
+// line trails below price in an uptrend and -1 when above in a downtrend
+func calcSuperTrend(high, low, closePrices []float64, period int, multiplier float64) (value, direction []float64) {
+	value = make([]float64, len(closePrices))
+	direction = make([]float64, len(closePrices))
+	if len(closePrices) <= period {
+		// average true range is only available from the period after period
+		return value, direction
+	}
+
+	atr := indicators.ATR(high, low, closePrices, period)
+	var finalUpper, finalLower float64
+	for i := period; i < len(closePrices); i++ {
+		hl2 := (high[i] + low[i]) / 2
+		basicUpper := hl2 + multiplier*atr[i]
+		basicLower := hl2 - multiplier*atr[i]
+		if i == period {
+			finalUpper, finalLower = basicUpper, basicLower
+			direction[i] = -1
+			if closePrices[i] > finalUpper {
+				direction[i] = 1
+			}
+		} else {
+			if basicUpper < finalUpper || closePrices[i-1] > finalUpper {
+				finalUpper = basicUpper
+			}
+			if basicLower > finalLower || closePrices[i-1] < finalLower {
+				finalLower = basicLower
+			}
+			direction[i] = direction[i-1]
+			if direction[i] < 0 && closePrices[i] > finalUpper {
+				direction[i] = 1
+			} else if direction[i] > 0 && closePrices[i] < finalLower {
+				direction[i] = -1
+			}
+		}
+		value[i] = finalUpper
+		if direction[i] > 0 {
+			value[i] = finalLower
+		}
+	}
+	return value, direction
+}
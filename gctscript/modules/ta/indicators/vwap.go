@@ -0,0 +1,76 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// VWAPModule volume weighted average price indicator commands
+var VWAPModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: vwap},
+}
+
+// VolumeWeightedAveragePrice is the string constant
+const VolumeWeightedAveragePrice = "Volume Weighted Average Price"
+
+// VWAP defines a custom Volume Weighted Average Price indicator tengo object
+type VWAP struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *VWAP) TypeName() string {
+	return VolumeWeightedAveragePrice
+}
+
+func vwap(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(VWAP)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 2 {
+		p, err := parsePeriods(args[1])
+		if err != nil {
+			return nil, err
+		}
+		r.Period = p[0]
+	}
+
+	appendSeries(&r.Array, calcVWAP(s.High, s.Low, s.Close, s.Volume, r.Period))
+	return r, nil
+}
+
+// calcVWAP returns the volume weighted typical price, cumulative from the
+// first period when period is zero otherwise over a rolling period
+func calcVWAP(high, low, closePrices, volume []float64, period int) []float64 {
+	out := make([]float64, len(closePrices))
+	var sumPV, sumV float64
+	for i := range closePrices {
+		sumPV += (high[i] + low[i] + closePrices[i]) / 3 * volume[i]
+		sumV += volume[i]
+		if period > 0 {
+			if i >= period {
+				j := i - period
+				sumPV -= (high[j] + low[j] + closePrices[j]) / 3 * volume[j]
+				sumV -= volume[j]
+			}
+			if i < period-1 {
+				continue
+			}
+		}
+		if sumV != 0 {
+			out[i] = sumPV / sumV
+		}
+	}
+	return out
+}
@@ -0,0 +1,63 @@
+package indicators
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// WillRModule williams percent range indicator commands
+var WillRModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: willR},
+}
+
+// WilliamsPercentRange is the string constant
+const WilliamsPercentRange = "Williams Percent Range"
+
+// WillR defines a custom Williams %R indicator tengo object
+type WillR struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *WillR) TypeName() string {
+	return WilliamsPercentRange
+}
+
+func willR(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(WillR)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = p[0]
+	appendSeries(&r.Array, calcWillR(s.High, s.Low, s.Close, r.Period))
+	return r, nil
+}
+
+// calcWillR returns the position of the close relative to the highest high
+// of the period, ranging from -100 at the low to 0 at the high
+func calcWillR(high, low, closePrices []float64, period int) []float64 {
+	out := make([]float64, len(closePrices))
+	hh := highest(high, period)
+	ll := lowest(low, period)
+	for i := period - 1; i < len(closePrices); i++ {
+		if hh[i] != ll[i] {
+			out[i] = -100 * (hh[i] - closePrices[i]) / (hh[i] - ll[i])
+		}
+	}
+	return out
+}
@@ -0,0 +1,112 @@
+package indicators
+
+import (
+	"math"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// WMAModule weighted moving average indicator commands
+var WMAModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: wma},
+}
+
+// HMAModule hull moving average indicator commands
+var HMAModule = map[string]objects.Object{
+	"calculate": &objects.UserFunction{Name: "calculate", Value: hma},
+}
+
+const (
+	// WeightedMovingAverage is the string constant
+	WeightedMovingAverage = "Weighted Moving Average"
+	// HullMovingAverage is the string constant
+	HullMovingAverage = "Hull Moving Average"
+)
+
+// WMA defines a custom Weighted Moving Average indicator tengo object
+type WMA struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *WMA) TypeName() string {
+	return WeightedMovingAverage
+}
+
+// HMA defines a custom Hull Moving Average indicator tengo object
+type HMA struct {
+	objects.Array
+	Period int
+}
+
+// TypeName returns the name of the custom type.
+func (o *HMA) TypeName() string {
+	return HullMovingAverage
+}
+
+func wma(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(WMA)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = p[0]
+	appendSeries(&r.Array, wmaFrom(s.Close, 0, r.Period))
+	return r, nil
+}
+
+func hma(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	r := new(HMA)
+	if validator.IsTestExecution.Load() == true {
+		return r, nil
+	}
+
+	s, err := parseSeries(args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePeriods(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	r.Period = p[0]
+	appendSeries(&r.Array, calcHMA(s.Close, r.Period))
+	return r, nil
+}
+
+// calcHMA returns the weighted moving average over the square root of period
+// of twice the half period weighted average less the full period average
+func calcHMA(in []float64, period int) []float64 {
+	half := period / 2
+	if half < 1 {
+		half = 1
+	}
+	sqrtPeriod := int(math.Sqrt(float64(period)))
+	halfWMA := wmaFrom(in, 0, half)
+	fullWMA := wmaFrom(in, 0, period)
+	raw := make([]float64, len(in))
+	for i := period - 1; i < len(in); i++ {
+		raw[i] = 2*halfWMA[i] - fullWMA[i]
+	}
+	return wmaFrom(raw, period-1, sqrtPeriod)
+}
@@ -16,4 +16,19 @@ var Modules = map[string]map[string]tengo.Object{
 	"indicator/mfi":                    indicators.MfiModule,
 	"indicator/atr":                    indicators.AtrModule,
 	"indicator/correlationcoefficient": indicators.CorrelationCoefficientModule,
+	"indicator/stoch":                  indicators.StochasticModule,
+	"indicator/stochrsi":               indicators.StochRSIModule,
+	"indicator/adx":                    indicators.ADXModule,
+	"indicator/cci":                    indicators.CCIModule,
+	"indicator/willr":                  indicators.WillRModule,
+	"indicator/ichimoku":               indicators.IchimokuModule,
+	"indicator/psar":                   indicators.PSARModule,
+	"indicator/keltner":                indicators.KeltnerModule,
+	"indicator/donchian":               indicators.DonchianModule,
+	"indicator/vwap":                   indicators.VWAPModule,
+	"indicator/supertrend":             indicators.SuperTrendModule,
+	"indicator/wma":                    indicators.WMAModule,
+	"indicator/hma":                    indicators.HMAModule,
+	"indicator/kama":                   indicators.KAMAModule,
+	"indicator/pivots":                 indicators.PivotsModule,
 }
@@ -1,6 +1,7 @@
 package modules
 
 import (
+	"errors"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -10,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -20,12 +22,30 @@ const (
 	ErrParameterWithPositionConvertFailed = "%v at position %v failed conversion"
 )
 
+// Fee types supported by FeeRequest
+const (
+	TradeFee            = "trade"
+	OfflineTradeFee     = "offlinetrade"
+	CryptoDepositFee    = "cryptodeposit"
+	CryptoWithdrawalFee = "cryptowithdrawal"
+)
+
+var (
+	// ErrStateNotFound is returned by a Store when a script key has no value
+	ErrStateNotFound = errors.New("script state not found")
+	// ErrRateLimited is returned by Comms when a script exceeds its
+	// notification rate limit
+	ErrRateLimited = errors.New("notification rate limit exceeded")
+)
+
 // Wrapper instance of GCT to use for modules
 var Wrapper GCT
 
 // GCT interface requirements
 type GCT interface {
 	Exchange
+	Store
+	Comms
 }
 
 // Exchange interface requirements
@@ -33,6 +53,7 @@ type Exchange interface {
 	Exchanges(enabledOnly bool) []string
 	IsEnabled(exch string) bool
 	Orderbook(exch string, pair currency.Pair, item asset.Item) (*orderbook.Base, error)
+	OrderbookAnalytics(exch string, pair currency.Pair, item asset.Item, req *orderbook.AnalyticsRequest) (*orderbook.Analytics, error)
 	Ticker(exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error)
 	Pairs(exch string, enabledOnly bool, item asset.Item) (*currency.Pairs, error)
 	QueryOrder(exch, orderid string, pair currency.Pair, assetType asset.Item) (*order.Detail, error)
@@ -43,6 +64,61 @@ type Exchange interface {
 	WithdrawalFiatFunds(bankAccountID string, request *withdraw.Request) (out string, err error)
 	WithdrawalCryptoFunds(request *withdraw.Request) (out string, err error)
 	OHLCV(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error)
+	ModifyOrder(mod *order.Modify) (string, error)
+	CancelBatchOrders(exch string, cancels []order.Cancel) (order.CancelBatchResponse, error)
+	GetActiveOrders(exch string, req *order.GetOrdersRequest) ([]order.Detail, error)
+	GetOrderHistory(exch string, req *order.GetOrdersRequest) ([]order.Detail, error)
+	GetRecentTrades(exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error)
+	GetHistoricTrades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error)
+	GetFeeByType(exch string, req *FeeRequest) (float64, error)
+	GetOrderExecutionLimits(exch string, pair currency.Pair, item asset.Item) (order.MinMaxLevel, error)
+}
+
+// FeeRequest defines the parameters of a fee estimate, for deposits and
+// withdrawals the fee applies to the pair base currency
+type FeeRequest struct {
+	Type          string
+	Pair          currency.Pair
+	IsMaker       bool
+	PurchasePrice float64
+	Amount        float64
+}
+
+// Store interface requirements for persistent script state and read only
+// access to stored data
+type Store interface {
+	GetState(script, key string) (string, error)
+	SetState(script, key, value string) error
+	DeleteState(script, key string) error
+	StateKeys(script string) ([]string, error)
+	StoredCandles(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error)
+	StoredTrades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error)
+	AuditEvents(start, end time.Time, order string, limit int) ([]AuditEvent, error)
+}
+
+// Comms interface requirements for sending script notifications through the
+// enabled communication relayers
+type Comms interface {
+	CommsMediums() []string
+	Notify(script string, n *Notification) error
+}
+
+// Notification is a message sent by a script, either the Message as is or
+// rendered from the named Template with Data. Empty Mediums sends to all
+// enabled relayers
+type Notification struct {
+	Message  string
+	Template string
+	Data     map[string]interface{}
+	Mediums  []string
+}
+
+// AuditEvent is a stored audit log entry
+type AuditEvent struct {
+	Type       string
+	Identifier string
+	Message    string
+	Timestamp  time.Time
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
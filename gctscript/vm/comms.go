@@ -0,0 +1,67 @@
+package vm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"text/template"
+	"time"
+)
+
+const (
+	// DefaultCommsRateLimit is the number of notifications a script can send
+	// per rate interval when unset
+	DefaultCommsRateLimit = 10
+	// DefaultCommsRateInterval is the notification rate interval when unset
+	DefaultCommsRateInterval = time.Minute
+)
+
+var (
+	errTemplateNotFound = errors.New("comms template not found")
+	errInvalidRateLimit = errors.New("comms rate limit and interval cannot be negative")
+)
+
+// ValidateComms checks the notification rate limit and parses each template
+func (c *Config) ValidateComms() error {
+	if c.Comms.RateLimit < 0 || c.Comms.RateInterval < 0 {
+		return errInvalidRateLimit
+	}
+	for name, text := range c.Comms.Templates {
+		if _, err := template.New(name).Parse(text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Limit returns the number of notifications a script can send per interval,
+// applying the defaults to unset values
+func (c *CommsConfig) Limit() (limit int, interval time.Duration) {
+	limit, interval = c.RateLimit, c.RateInterval
+	if limit == 0 {
+		limit = DefaultCommsRateLimit
+	}
+	if interval == 0 {
+		interval = DefaultCommsRateInterval
+	}
+	return limit, interval
+}
+
+// Render executes the named template with data, missing data keys are
+// returned as an error rather than rendered as empty values
+func (c *CommsConfig) Render(name string, data interface{}) (string, error) {
+	text, ok := c.Templates[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", errTemplateNotFound, name)
+	}
+	t, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	err = t.Execute(&buf, data)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,46 @@
+package vm
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCommsConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{Comms: CommsConfig{
+		Templates: map[string]string{
+			"signal": "{{.pair}} RSI {{printf \"%.1f\" .rsi}}",
+		},
+	}}
+	if err := c.ValidateComms(); err != nil {
+		t.Fatal(err)
+	}
+	limit, interval := c.Comms.Limit()
+	if limit != DefaultCommsRateLimit || interval != DefaultCommsRateInterval {
+		t.Errorf("received %v %v expected defaults", limit, interval)
+	}
+
+	msg, err := c.Comms.Render("signal", map[string]interface{}{"pair": "BTC-USD", "rsi": 28.54})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg != "BTC-USD RSI 28.5" {
+		t.Errorf("received %q expected %q", msg, "BTC-USD RSI 28.5")
+	}
+	if _, err = c.Comms.Render("signal", map[string]interface{}{"pair": "BTC-USD"}); err == nil {
+		t.Error("expected missing key error")
+	}
+	if _, err = c.Comms.Render("missing", nil); !errors.Is(err, errTemplateNotFound) {
+		t.Errorf("received %v expected %v", err, errTemplateNotFound)
+	}
+
+	c.Comms.Templates["broken"] = "{{.pair"
+	if err = c.ValidateComms(); err == nil {
+		t.Error("expected template parse error")
+	}
+	c.Comms = CommsConfig{RateLimit: 5, RateInterval: -time.Second}
+	if err = c.ValidateComms(); !errors.Is(err, errInvalidRateLimit) {
+		t.Errorf("received %v expected %v", err, errInvalidRateLimit)
+	}
+}
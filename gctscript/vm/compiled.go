@@ -0,0 +1,214 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/parser"
+)
+
+// maxCallArgs is the maximum number of arguments a script function can be
+// called with from Go
+const maxCallArgs = 4
+
+var errTooManyCallArgs = fmt.Errorf("script functions can be called with at most %d arguments", maxCallArgs)
+
+// Compiled holds the byte code and globals of a compiled script. Unlike
+// tengo.Compiled it allows script functions to be called against the script
+// globals after the main program has run, so event callbacks share state with
+// the rest of the script
+type Compiled struct {
+	m             sync.Mutex
+	bytecode      *tengo.Bytecode
+	globals       []tengo.Object
+	globalIndexes map[string]int
+	// callIndex is the first of the global slots reserved to pass a function
+	// and its arguments to the call trampoline
+	callIndex    int
+	limits       limits
+	instructions int64
+}
+
+// compileOptions configures how script source is compiled
+type compileOptions struct {
+	// name is the source file name reported in error positions
+	name         string
+	allowImports bool
+	limits       limits
+	// coverage records executed statements when set
+	coverage *Coverage
+}
+
+// compile parses and compiles the script source mirroring tengo.Script,
+// reserving global slots for calls made through Call
+func compile(input []byte, variables map[string]interface{}, modules *tengo.ModuleMap, opts compileOptions) (*Compiled, error) {
+	compiled := &Compiled{limits: opts.limits}
+	symbolTable := tengo.NewSymbolTable()
+	for idx, fn := range tengo.GetAllBuiltinFunctions() {
+		symbolTable.DefineBuiltin(idx, fn.Name)
+	}
+
+	globals := make([]tengo.Object, tengo.GlobalsSize)
+	for name, value := range variables {
+		obj, err := tengo.FromInterface(value)
+		if err != nil {
+			return nil, err
+		}
+		globals[symbolTable.Define(name).Index] = obj
+	}
+
+	name := opts.name
+	if name == "" {
+		name = "(main)"
+	}
+	fileSet := parser.NewFileSet()
+	srcFile := fileSet.AddFile(name, -1, len(input))
+	file, err := parser.NewParser(srcFile, input, nil).ParseFile()
+	if err != nil {
+		return nil, err
+	}
+
+	// hooks are inserted before each statement, the function names they call
+	// cannot be referenced by script source
+	var hooks []stmtHook
+	var in instrumenter
+	if opts.coverage != nil {
+		globals[symbolTable.Define(coverFunction).Index] = opts.coverage.cover()
+		globals[symbolTable.Define(enterFunction).Index] = opts.coverage.enter()
+		globals[symbolTable.Define(leaveFunction).Index] = opts.coverage.leave()
+		hooks = append(hooks, opts.coverage.hook(srcFile))
+		in.enter, in.leave = enterFunction, leaveFunction
+	}
+	if opts.limits.maxInstructions > 0 {
+		globals[symbolTable.Define(stepFunction).Index] = compiled.step()
+		hooks = append(hooks, func(s parser.Stmt) []parser.Stmt {
+			return []parser.Stmt{callStmt(stepFunction, s.Pos())}
+		})
+		in.loop = stepFunction
+	}
+	if len(hooks) > 0 {
+		in.hook = func(s parser.Stmt) []parser.Stmt {
+			var stmts []parser.Stmt
+			for x := range hooks {
+				stmts = append(stmts, hooks[x](s)...)
+			}
+			return stmts
+		}
+		file.Stmts = in.stmts(file.Stmts)
+	}
+
+	c := tengo.NewCompiler(srcFile, symbolTable, nil, modules, nil)
+	c.EnableFileImport(opts.allowImports)
+	if err = c.Compile(file); err != nil {
+		return nil, err
+	}
+
+	callIndex := symbolTable.MaxSymbols() + 1
+	if callIndex+maxCallArgs+1 > tengo.GlobalsSize {
+		return nil, errors.New("exceeding global variables limit")
+	}
+	globals = globals[:callIndex+maxCallArgs+1]
+
+	globalIndexes := make(map[string]int)
+	for _, name := range symbolTable.Names() {
+		symbol, _, _ := symbolTable.Resolve(name, false)
+		if symbol.Scope == tengo.ScopeGlobal {
+			globalIndexes[name] = symbol.Index
+		}
+	}
+
+	bytecode := c.Bytecode()
+	bytecode.RemoveDuplicates()
+	compiled.bytecode = bytecode
+	compiled.globals = globals
+	compiled.globalIndexes = globalIndexes
+	compiled.callIndex = callIndex
+	return compiled, nil
+}
+
+// Run executes the main program
+func (c *Compiled) Run() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.instructions = 0
+	return tengo.NewVM(c.bytecode, c.globals, c.limits.allocs()).Run()
+}
+
+// RunContext executes the main program aborting when the context is done
+func (c *Compiled) RunContext(ctx context.Context) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.instructions = 0
+	return runContext(ctx, tengo.NewVM(c.bytecode, c.globals, c.limits.allocs()))
+}
+
+// Call invokes a callable script object with the supplied arguments against
+// the script globals, aborting when the context is done. Calls are serialised
+// with runs of the main program
+func (c *Compiled) Call(ctx context.Context, fn tengo.Object, args ...tengo.Object) error {
+	if !fn.CanCall() {
+		return fmt.Errorf("not callable: %s", fn.TypeName())
+	}
+	if len(args) > maxCallArgs {
+		return errTooManyCallArgs
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.instructions = 0
+
+	// the trampoline loads the function and its arguments from the reserved
+	// global slots, calls it and discards the result
+	var insts []byte
+	c.globals[c.callIndex] = fn
+	insts = append(insts, tengo.MakeInstruction(parser.OpGetGlobal, c.callIndex)...)
+	for i := range args {
+		c.globals[c.callIndex+1+i] = args[i]
+		insts = append(insts, tengo.MakeInstruction(parser.OpGetGlobal, c.callIndex+1+i)...)
+	}
+	insts = append(insts, tengo.MakeInstruction(parser.OpCall, len(args), 0)...)
+	insts = append(insts, tengo.MakeInstruction(parser.OpPop)...)
+	insts = append(insts, tengo.MakeInstruction(parser.OpSuspend)...)
+	defer func() {
+		for i := c.callIndex; i < len(c.globals); i++ {
+			c.globals[i] = nil
+		}
+	}()
+
+	trampoline := &tengo.Bytecode{
+		FileSet:      c.bytecode.FileSet,
+		MainFunction: &tengo.CompiledFunction{Instructions: insts},
+		Constants:    c.bytecode.Constants,
+	}
+	return runContext(ctx, tengo.NewVM(trampoline, c.globals, c.limits.allocs()))
+}
+
+// Get returns a global variable identified by name
+func (c *Compiled) Get(name string) *tengo.Variable {
+	c.m.Lock()
+	defer c.m.Unlock()
+	var value tengo.Object = tengo.UndefinedValue
+	if idx, ok := c.globalIndexes[name]; ok && c.globals[idx] != nil {
+		value = c.globals[idx]
+	}
+	// objects are passed through by tengo.FromInterface so cannot error
+	v, _ := tengo.NewVariable(name, value)
+	return v
+}
+
+func runContext(ctx context.Context, v *tengo.VM) (err error) {
+	ch := make(chan error, 1)
+	go func() {
+		ch <- v.Run()
+	}()
+	select {
+	case <-ctx.Done():
+		v.Abort()
+		<-ch
+		err = ctx.Err()
+	case err = <-ch:
+	}
+	return
+}
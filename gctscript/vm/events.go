@@ -0,0 +1,481 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/d5/tengo/v2"
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// eventsModule returns the events module bound to the VM, allowing a script
+// to register callbacks which are invoked as data is dispatched
+func (vm *VM) eventsModule() map[string]tengo.Object {
+	return map[string]tengo.Object{
+		"ticker":      &tengo.UserFunction{Name: "ticker", Value: vm.subscribeTicker},
+		"orderbook":   &tengo.UserFunction{Name: "orderbook", Value: vm.subscribeOrderbook},
+		"trades":      &tengo.UserFunction{Name: "trades", Value: vm.subscribeTrades},
+		"orders":      &tengo.UserFunction{Name: "orders", Value: vm.subscribeOrders},
+		"unsubscribe": &tengo.UserFunction{Name: "unsubscribe", Value: vm.unsubscribeEvent},
+	}
+}
+
+// subscribeTicker registers a callback for ticker updates of an exchange pair
+func (vm *VM) subscribeTicker(args ...tengo.Object) (tengo.Object, error) {
+	exch, p, a, callback, err := parseEventArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = vm.checkEventPair(exch, p); err != nil {
+		return nil, err
+	}
+	return vm.subscribe(eventKey("ticker", exch, p, a), callback,
+		func() (dispatch.Pipe, error) {
+			return ticker.SubscribeTicker(exch, p, a)
+		},
+		func(data interface{}) (tengo.Object, bool) {
+			t, ok := data.(ticker.Price)
+			if !ok {
+				return nil, false
+			}
+			return tickerToObject(&t), true
+		})
+}
+
+// subscribeOrderbook registers a callback for orderbook depth updates of an
+// exchange pair
+func (vm *VM) subscribeOrderbook(args ...tengo.Object) (tengo.Object, error) {
+	exch, p, a, callback, err := parseEventArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = vm.checkEventPair(exch, p); err != nil {
+		return nil, err
+	}
+	return vm.subscribe(eventKey("orderbook", exch, p, a), callback,
+		func() (dispatch.Pipe, error) {
+			return orderbook.SubscribeToExchangeOrderbooks(exch)
+		},
+		func(data interface{}) (tengo.Object, bool) {
+			ob, ok := data.(orderbook.Base)
+			if !ok || ob.Asset != a || !ob.Pair.Equal(p) {
+				return nil, false
+			}
+			return orderbookToObject(&ob), true
+		})
+}
+
+// subscribeTrades registers a callback for trade prints of an exchange pair,
+// the callback receives each batch of trades as an array
+func (vm *VM) subscribeTrades(args ...tengo.Object) (tengo.Object, error) {
+	exch, p, a, callback, err := parseEventArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+	if err = vm.checkEventPair(exch, p); err != nil {
+		return nil, err
+	}
+	return vm.subscribe(eventKey("trades", exch, p, a), callback,
+		trade.SubscribeToFeed,
+		func(data interface{}) (tengo.Object, bool) {
+			trades, ok := data.([]trade.Data)
+			if !ok {
+				return nil, false
+			}
+			var r tengo.Array
+			for i := range trades {
+				if !strings.EqualFold(trades[i].Exchange, exch) ||
+					trades[i].AssetType != a ||
+					!trades[i].CurrencyPair.Equal(p) {
+					continue
+				}
+				r.Value = append(r.Value, tradeToObject(&trades[i]))
+			}
+			return &r, len(r.Value) > 0
+		})
+}
+
+// subscribeOrders registers a callback for tracked order additions and status
+// changes on an exchange
+func (vm *VM) subscribeOrders(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 2 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	exch, ok := tengo.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(gct.ErrParameterConvertFailed, exch)
+	}
+	perms := vm.permissions()
+	if perms != nil {
+		if err := perms.CheckExchange(exch); err != nil {
+			vm.violation(StatusPermissionDenied, err)
+			return nil, err
+		}
+	}
+	return vm.subscribe("orders/"+strings.ToLower(exch), args[1],
+		order.SubscribeToUpdates,
+		func(data interface{}) (tengo.Object, bool) {
+			d, ok := data.(order.Detail)
+			if !ok || !strings.EqualFold(d.Exchange, exch) {
+				return nil, false
+			}
+			// order updates are only delivered for pairs the script may trade
+			if perms != nil && !perms.AllowsPair(d.Pair) {
+				return nil, false
+			}
+			return orderToObject(&d), true
+		})
+}
+
+// checkEventPair returns an error if the script's permissions do not include
+// the exchange and pair, recording a violation
+func (vm *VM) checkEventPair(exch string, p currency.Pair) error {
+	perms := vm.permissions()
+	if perms == nil {
+		return nil
+	}
+	if err := perms.CheckPair(exch, p); err != nil {
+		vm.violation(StatusPermissionDenied, err)
+		return err
+	}
+	return nil
+}
+
+// unsubscribeEvent removes a subscription by the ID returned when it was
+// registered, returns false if no subscription is found
+func (vm *VM) unsubscribeEvent(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	id, ok := tengo.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(gct.ErrParameterConvertFailed, id)
+	}
+	if vm.unsubscribe(id) {
+		return tengo.TrueValue, nil
+	}
+	return tengo.FalseValue, nil
+}
+
+// subscribe registers the callback against the event key, registering the
+// same key again replaces the callback so scripts run on a timer do not
+// duplicate subscriptions. Returns the subscription ID
+func (vm *VM) subscribe(key string, callback tengo.Object, subscribeFn func() (dispatch.Pipe, error), convert eventConverter) (tengo.Object, error) {
+	return vm.subscribeQueued(key, callback, 0, subscribeFn, convert)
+}
+
+// subscribeQueued registers the callback as subscribe does, buffering up to
+// queue events while the callback is busy when queue is greater than zero
+func (vm *VM) subscribeQueued(key string, callback tengo.Object, queue int, subscribeFn func() (dispatch.Pipe, error), convert eventConverter) (tengo.Object, error) {
+	if !callback.CanCall() {
+		return nil, fmt.Errorf("%w: %s", errCallbackNotCallable, callback.TypeName())
+	}
+	vm.subM.Lock()
+	defer vm.subM.Unlock()
+	for _, s := range vm.subscriptions {
+		if s.key == key {
+			s.callback = callback
+			return &tengo.String{Value: s.id}, nil
+		}
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	s := &subscription{
+		id:       id.String(),
+		key:      key,
+		callback: callback,
+		convert:  convert,
+		queue:    queue,
+	}
+	// scripts under validation or test register callbacks without
+	// subscribing to live data
+	if validator.IsTestExecution.Load() != true && vm.test == nil {
+		s.pipe, err = subscribeFn()
+		if err != nil {
+			return nil, err
+		}
+		s.shutdown = make(chan struct{})
+		vm.wg.Add(1)
+		go vm.listen(s)
+	}
+	if vm.subscriptions == nil {
+		vm.subscriptions = make(map[string]*subscription)
+	}
+	vm.subscriptions[s.id] = s
+	if vm.config.Verbose {
+		log.Debugf(log.GCTScriptMgr, "Script: %s ID: %v subscribed to %s", vm.ShortName(), vm.ID, key)
+	}
+	return &tengo.String{Value: s.id}, nil
+}
+
+// unsubscribe stops the subscription listener without waiting for it to
+// return so it can be called from within a callback
+func (vm *VM) unsubscribe(id string) bool {
+	vm.subM.Lock()
+	defer vm.subM.Unlock()
+	s, ok := vm.subscriptions[id]
+	if !ok {
+		return false
+	}
+	if s.shutdown != nil {
+		close(s.shutdown)
+	}
+	delete(vm.subscriptions, id)
+	return true
+}
+
+// unsubscribeAll stops all subscriptions and waits for in flight callbacks to
+// complete
+func (vm *VM) unsubscribeAll() {
+	vm.subM.Lock()
+	for id, s := range vm.subscriptions {
+		if s.shutdown != nil {
+			close(s.shutdown)
+		}
+		delete(vm.subscriptions, id)
+	}
+	vm.subM.Unlock()
+	vm.wg.Wait()
+}
+
+// hasSubscriptions returns true if the script has registered any callbacks
+func (vm *VM) hasSubscriptions() bool {
+	vm.subM.Lock()
+	defer vm.subM.Unlock()
+	return len(vm.subscriptions) > 0
+}
+
+// listen invokes the subscription callback for each relevant event until the
+// subscription is stopped
+func (vm *VM) listen(s *subscription) {
+	events := s.pipe.C
+	if s.queue > 0 {
+		q := make(chan interface{}, s.queue)
+		vm.wg.Add(1)
+		go vm.enqueue(s, q)
+		events = q
+	}
+	defer func() {
+		if s.queue == 0 {
+			releasePipe(s)
+		}
+		vm.wg.Done()
+	}()
+	for {
+		select {
+		case <-s.shutdown:
+			return
+		case data, ok := <-events:
+			if !ok {
+				return
+			}
+			event, ok := s.convert(*data.(*interface{}))
+			if !ok {
+				continue
+			}
+			select {
+			case <-s.shutdown:
+				return
+			default:
+			}
+			vm.subM.Lock()
+			callback := s.callback
+			vm.subM.Unlock()
+			err := vm.invoke(callback, event)
+			if err != nil {
+				log.Errorln(log.GCTScriptMgr, err)
+			}
+		}
+	}
+}
+
+// enqueue reads events from the subscription pipe into the bounded queue so
+// the dispatcher is not held up by a busy callback, dropping the oldest queued
+// event when the queue is full
+func (vm *VM) enqueue(s *subscription, q chan interface{}) {
+	defer func() {
+		releasePipe(s)
+		close(q)
+		vm.wg.Done()
+	}()
+	for {
+		select {
+		case <-s.shutdown:
+			return
+		case data, ok := <-s.pipe.C:
+			if !ok {
+				return
+			}
+			select {
+			case q <- data:
+				continue
+			default:
+			}
+			select {
+			case <-q:
+				s.dropped++
+				if vm.config.Verbose {
+					log.Warnf(log.GCTScriptMgr, "Script: %s ID: %v %s queue full, dropped %d events",
+						vm.ShortName(), vm.ID, s.key, s.dropped)
+				}
+			default:
+			}
+			// enqueue is the only sender so there is now room in the queue
+			q <- data
+		}
+	}
+}
+
+func releasePipe(s *subscription) {
+	err := s.pipe.Release()
+	if err != nil {
+		log.Errorln(log.GCTScriptMgr, err)
+	}
+}
+
+// invoke calls a script callback with the event enforcing the script timeout
+// for each invocation
+func (vm *VM) invoke(callback, event tengo.Object) error {
+	ct, cancel := context.WithTimeout(context.Background(), vm.config.ScriptTimeout)
+	defer cancel()
+	err := vm.Compiled.Call(ct, callback, event)
+	if err != nil {
+		vm.limitViolation(err)
+		vm.event(StatusFailure, TypeExecute)
+		return Error{
+			Action: "Callback",
+			Script: vm.File,
+			Cause:  err,
+		}
+	}
+	return nil
+}
+
+// parseEventArgs parses the exchange, pair, delimiter, asset and callback
+// arguments shared by market data subscriptions
+func parseEventArgs(args ...tengo.Object) (exch string, p currency.Pair, a asset.Item, callback tengo.Object, err error) {
+	if len(args) != 5 {
+		err = tengo.ErrWrongNumArguments
+		return
+	}
+	exch, ok := tengo.ToString(args[0])
+	if !ok {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, exch)
+		return
+	}
+	currencyPair, ok := tengo.ToString(args[1])
+	if !ok {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, currencyPair)
+		return
+	}
+	delimiter, ok := tengo.ToString(args[2])
+	if !ok {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, delimiter)
+		return
+	}
+	assetTypeParam, ok := tengo.ToString(args[3])
+	if !ok {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, assetTypeParam)
+		return
+	}
+	p, err = currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return
+	}
+	a, err = asset.New(assetTypeParam)
+	if err != nil {
+		return
+	}
+	return exch, p, a, args[4], nil
+}
+
+func eventKey(event, exch string, p currency.Pair, a asset.Item) string {
+	return strings.ToLower(event + "/" + exch + "/" + p.String() + "/" + a.String())
+}
+
+func tickerToObject(t *ticker.Price) tengo.Object {
+	data := make(map[string]tengo.Object, 14)
+	data["exchange"] = &tengo.String{Value: t.ExchangeName}
+	data["last"] = &tengo.Float{Value: t.Last}
+	data["high"] = &tengo.Float{Value: t.High}
+	data["low"] = &tengo.Float{Value: t.Low}
+	data["bid"] = &tengo.Float{Value: t.Bid}
+	data["ask"] = &tengo.Float{Value: t.Ask}
+	data["volume"] = &tengo.Float{Value: t.Volume}
+	data["quotevolume"] = &tengo.Float{Value: t.QuoteVolume}
+	data["priceath"] = &tengo.Float{Value: t.PriceATH}
+	data["open"] = &tengo.Float{Value: t.Open}
+	data["close"] = &tengo.Float{Value: t.Close}
+	data["pair"] = &tengo.String{Value: t.Pair.String()}
+	data["asset"] = &tengo.String{Value: t.AssetType.String()}
+	data["updated"] = &tengo.Time{Value: t.LastUpdated}
+	return &tengo.Map{Value: data}
+}
+
+func orderbookToObject(ob *orderbook.Base) tengo.Object {
+	var asks, bids tengo.Array
+	for x := range ob.Asks {
+		temp := make(map[string]tengo.Object, 2)
+		temp["amount"] = &tengo.Float{Value: ob.Asks[x].Amount}
+		temp["price"] = &tengo.Float{Value: ob.Asks[x].Price}
+		asks.Value = append(asks.Value, &tengo.Map{Value: temp})
+	}
+	for x := range ob.Bids {
+		temp := make(map[string]tengo.Object, 2)
+		temp["amount"] = &tengo.Float{Value: ob.Bids[x].Amount}
+		temp["price"] = &tengo.Float{Value: ob.Bids[x].Price}
+		bids.Value = append(bids.Value, &tengo.Map{Value: temp})
+	}
+	data := make(map[string]tengo.Object, 6)
+	data["exchange"] = &tengo.String{Value: ob.Exchange}
+	data["pair"] = &tengo.String{Value: ob.Pair.String()}
+	data["asks"] = &asks
+	data["bids"] = &bids
+	data["asset"] = &tengo.String{Value: ob.Asset.String()}
+	data["updated"] = &tengo.Time{Value: ob.LastUpdated}
+	return &tengo.Map{Value: data}
+}
+
+func tradeToObject(t *trade.Data) tengo.Object {
+	data := make(map[string]tengo.Object, 8)
+	data["exchange"] = &tengo.String{Value: t.Exchange}
+	data["pair"] = &tengo.String{Value: t.CurrencyPair.String()}
+	data["asset"] = &tengo.String{Value: t.AssetType.String()}
+	data["tid"] = &tengo.String{Value: t.TID}
+	data["side"] = &tengo.String{Value: t.Side.String()}
+	data["price"] = &tengo.Float{Value: t.Price}
+	data["amount"] = &tengo.Float{Value: t.Amount}
+	data["timestamp"] = &tengo.Time{Value: t.Timestamp}
+	return &tengo.Map{Value: data}
+}
+
+func orderToObject(d *order.Detail) tengo.Object {
+	data := make(map[string]tengo.Object, 13)
+	data["exchange"] = &tengo.String{Value: d.Exchange}
+	data["id"] = &tengo.String{Value: d.ID}
+	data["internalid"] = &tengo.String{Value: d.InternalOrderID}
+	data["pair"] = &tengo.String{Value: d.Pair.String()}
+	data["asset"] = &tengo.String{Value: d.AssetType.String()}
+	data["side"] = &tengo.String{Value: d.Side.String()}
+	data["type"] = &tengo.String{Value: d.Type.String()}
+	data["status"] = &tengo.String{Value: d.Status.String()}
+	data["price"] = &tengo.Float{Value: d.Price}
+	data["amount"] = &tengo.Float{Value: d.Amount}
+	data["executed"] = &tengo.Float{Value: d.ExecutedAmount}
+	data["remaining"] = &tengo.Float{Value: d.RemainingAmount}
+	data["updated"] = &tengo.Time{Value: d.LastUpdated}
+	return &tengo.Map{Value: data}
+}
@@ -0,0 +1,239 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+var testScriptEvents = filepath.Join("..", "..", "testdata", "gctscript", "events.gct")
+
+func loadEventsVM(t *testing.T) *VM {
+	t.Helper()
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM := manager.New()
+	if testVM == nil {
+		t.Fatal("Failed to allocate new VM exiting")
+	}
+	err := testVM.Load(testScriptEvents)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testVM
+}
+
+func TestEventSubscriptions(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	testVM := loadEventsVM(t)
+	err := testVM.RunCtx()
+	if err == nil {
+		t.Fatal("expected error subscribing to a ticker which does not exist")
+	}
+
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: "eventtest",
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Last:         1337,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.RunCtx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !testVM.hasSubscriptions() {
+		t.Fatal("expected script to be subscribed")
+	}
+	id := testVM.Compiled.Get("sub").String()
+
+	// re-running the script replaces the callback of the existing subscription
+	err = testVM.RunCtx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(testVM.subscriptions) != 1 || testVM.Compiled.Get("sub").String() != id {
+		t.Errorf("expected a single subscription with ID %v", id)
+	}
+
+	err = testVM.invoke(testVM.Compiled.Get("on_ticker").Object(), tickerToObject(&ticker.Price{Last: 1338}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testVM.Compiled.Get("count").Int() != 1 || testVM.Compiled.Get("last").Float() != 1338 {
+		t.Errorf("unexpected script state count %v last %v",
+			testVM.Compiled.Get("count"),
+			testVM.Compiled.Get("last"))
+	}
+
+	testVM.config.ScriptTimeout = time.Millisecond
+	err = testVM.invoke(testVM.Compiled.Get("spin").Object(), tengo.UndefinedValue)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("received %v expected %v", err, context.DeadlineExceeded)
+	}
+
+	if testVM.unsubscribe("not a subscription") {
+		t.Error("expected unknown subscription to be ignored")
+	}
+	if !testVM.unsubscribe(id) {
+		t.Error("expected subscription to be removed")
+	}
+	err = testVM.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testVM.hasSubscriptions() {
+		t.Error("expected subscriptions to be released on shutdown")
+	}
+}
+
+func TestEventSubscriptionsValidation(t *testing.T) {
+	validator.IsTestExecution.Store(true)
+	defer validator.IsTestExecution.Store(false)
+	testVM := loadEventsVM(t)
+	err := testVM.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	testVM.subM.Lock()
+	for _, s := range testVM.subscriptions {
+		if s.shutdown != nil {
+			t.Error("expected no live subscription during validation")
+		}
+	}
+	testVM.subM.Unlock()
+
+	_, err = testVM.subscribeOrders(&tengo.String{Value: "eventtest"}, &tengo.Int{Value: 1})
+	if !errors.Is(err, errCallbackNotCallable) {
+		t.Errorf("received %v expected %v", err, errCallbackNotCallable)
+	}
+	_, err = testVM.subscribeTrades(&tengo.String{Value: "eventtest"})
+	if !errors.Is(err, tengo.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, tengo.ErrWrongNumArguments)
+	}
+	r, err := testVM.unsubscribeEvent(testVM.Compiled.Get("sub").Object())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != tengo.TrueValue {
+		t.Error("expected subscription to be removed")
+	}
+	err = testVM.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEventSubscriptionPermissions(t *testing.T) {
+	validator.IsTestExecution.Store(true)
+	defer validator.IsTestExecution.Store(false)
+	testVM := loadEventsVM(t)
+	testVM.config.Permissions = map[string]modules.Permissions{
+		testVM.ShortName(): {
+			Exchanges: []string{"eventtest"},
+			Pairs:     []string{"BTC-USD"},
+			Access:    modules.AccessRead,
+		},
+	}
+	err := testVM.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	callback := testVM.Compiled.Get("on_ticker").Object()
+
+	_, err = testVM.subscribeTicker(&tengo.String{Value: "other"}, &tengo.String{Value: "BTC-USD"},
+		&tengo.String{Value: "-"}, &tengo.String{Value: "spot"}, callback)
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	_, err = testVM.subscribeOrderbook(&tengo.String{Value: "eventtest"}, &tengo.String{Value: "ETH-USD"},
+		&tengo.String{Value: "-"}, &tengo.String{Value: "spot"}, callback)
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	_, err = testVM.subscribeTrades(&tengo.String{Value: "eventtest"}, &tengo.String{Value: "ETH-USD"},
+		&tengo.String{Value: "-"}, &tengo.String{Value: "spot"}, callback)
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	_, err = testVM.subscribeOrders(&tengo.String{Value: "other"}, callback)
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+
+	id, err := testVM.subscribeOrders(&tengo.String{Value: "eventtest"}, callback)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := testVM.subscriptions[id.(*tengo.String).Value]
+	if _, ok := s.convert(order.Detail{Exchange: "eventtest", Pair: currency.NewPair(currency.ETH, currency.USD)}); ok {
+		t.Error("expected order update for a pair outside the permissions to be dropped")
+	}
+	if _, ok := s.convert(order.Detail{Exchange: "eventtest", Pair: currency.NewPair(currency.BTC, currency.USD)}); !ok {
+		t.Error("expected order update for a permitted pair to be delivered")
+	}
+	err = testVM.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompiledCall(t *testing.T) {
+	t.Parallel()
+	c, err := compile([]byte(`x := 1
+add := func(a, b) { x += a + b }`), nil, nil, compileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Call(context.Background(), c.Get("add").Object(), &tengo.Int{Value: 2}, &tengo.Int{Value: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Get("x").Int() != 6 {
+		t.Errorf("received %v expected %v", c.Get("x").Int(), 6)
+	}
+	err = c.Call(context.Background(), c.Get("x").Object())
+	if err == nil {
+		t.Error("expected error calling a non callable object")
+	}
+	args := make([]tengo.Object, maxCallArgs+1)
+	err = c.Call(context.Background(), c.Get("add").Object(), args...)
+	if !errors.Is(err, errTooManyCallArgs) {
+		t.Errorf("received %v expected %v", err, errTooManyCallArgs)
+	}
+	err = c.Call(context.Background(), c.Get("add").Object(), &tengo.Int{Value: 2})
+	if err == nil {
+		t.Error("expected error calling with the wrong number of arguments")
+	}
+	if c.Get("missing").Object() != tengo.UndefinedValue {
+		t.Error("expected undefined value")
+	}
+}
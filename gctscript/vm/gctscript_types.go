@@ -3,6 +3,8 @@ package vm
 import (
 	"errors"
 	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 const (
@@ -11,14 +13,38 @@ const (
 	ErrScriptFailedValidation = "validation failed"
 )
 
+// AllScripts is the permissions key applied to scripts without their own
+// permissions
+const AllScripts = "*"
+
 // Config user configurable options for gctscript
 type Config struct {
-	Enabled            bool          `json:"enabled"`
-	ScriptTimeout      time.Duration `json:"timeout"`
-	MaxVirtualMachines uint8         `json:"max_virtual_machines"`
-	AllowImports       bool          `json:"allow_imports"`
-	AutoLoad           []string      `json:"auto_load"`
-	Verbose            bool          `json:"verbose"`
+	Enabled            bool                           `json:"enabled"`
+	ScriptTimeout      time.Duration                  `json:"timeout"`
+	MaxVirtualMachines uint8                          `json:"max_virtual_machines"`
+	AllowImports       bool                           `json:"allow_imports"`
+	AutoLoad           []string                       `json:"auto_load"`
+	Verbose            bool                           `json:"verbose"`
+	MaxAllocations     int64                          `json:"max_allocations"`
+	MaxInstructions    int64                          `json:"max_instructions"`
+	Permissions        map[string]modules.Permissions `json:"permissions,omitempty"`
+	// RequireSignedScripts only allows uploads signed by one of the hex
+	// encoded ed25519 PublicKeys and scripts matching a signed version to run
+	RequireSignedScripts bool     `json:"require_signed_scripts"`
+	PublicKeys           []string `json:"public_keys,omitempty"`
+	// MaintenanceWindows are skip windows keyed by exchange name applied to
+	// schedules running scripts for that exchange
+	MaintenanceWindows map[string][]Window `json:"maintenance_windows,omitempty"`
+	Comms              CommsConfig         `json:"comms"`
+}
+
+// CommsConfig limits the notifications each script can send through the
+// communication relayers to RateLimit per RateInterval and holds the
+// text/template message templates scripts can render by name
+type CommsConfig struct {
+	RateLimit    int               `json:"rate_limit"`
+	RateInterval time.Duration     `json:"rate_interval"`
+	Templates    map[string]string `json:"templates,omitempty"`
 }
 
 // Error interface to meet error requirements
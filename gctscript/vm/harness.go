@@ -0,0 +1,410 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/parser"
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+// Globals called by scripts under the test harness to record coverage and the
+// call stack, the names cannot be referenced by script source
+const (
+	coverFunction = "$cover"
+	enterFunction = "$enter"
+	leaveFunction = "$leave"
+)
+
+// ErrAssertionFailed is returned when an assertion fails outside of the test
+// harness
+var ErrAssertionFailed = errors.New("assertion failed")
+
+// Coverage records the statements of a script executed by the test harness
+type Coverage struct {
+	lines []int
+	hits  []int
+	// frames holds the statement executing in each function on the call
+	// stack, -1 before the first statement of a function
+	frames []int
+}
+
+// TestFailure is a failed assertion and the line it was made on
+type TestFailure struct {
+	Line    int
+	Message string
+}
+
+// TestResult is the outcome of running a script with the test harness
+type TestResult struct {
+	Script     string
+	Err        error
+	Failures   []TestFailure
+	Statements int
+	Covered    int
+	Uncovered  []int
+	Duration   time.Duration
+}
+
+// testRun holds the state of a script run by the test harness
+type testRun struct {
+	wrapper  validator.Wrapper
+	coverage *Coverage
+	failures []TestFailure
+}
+
+// TestScript compiles and runs a script against the validator wrapper,
+// returning market data from the fixtures where available. Assertion failures
+// are recorded rather than stopping the script and statement coverage is
+// reported. Ticker and orderbook callbacks registered by the script are
+// invoked with matching fixtures after the script has run, timers are ignored
+func TestScript(file string, fixtures *validator.Fixtures) *TestResult {
+	start := time.Now()
+	result := &TestResult{Script: file}
+	id, err := uuid.NewV4()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	vm := &VM{
+		ID: id,
+		config: &Config{
+			Enabled:       true,
+			ScriptTimeout: DefaultTimeoutValue,
+			AllowImports:  true,
+		},
+		unregister: func() error { return nil },
+		test: &testRun{
+			wrapper:  validator.Wrapper{Fixtures: fixtures},
+			coverage: &Coverage{frames: []int{-1}},
+		},
+	}
+	result.Err = vm.runTest(file)
+	vm.unsubscribeAll()
+
+	result.Failures = vm.test.failures
+	result.Statements, result.Covered = vm.test.coverage.Statements()
+	result.Uncovered = vm.test.coverage.Uncovered()
+	result.Duration = time.Since(start)
+	return result
+}
+
+func (vm *VM) runTest(file string) error {
+	err := vm.Load(file)
+	if err != nil {
+		return err
+	}
+	err = vm.Compile()
+	if err != nil {
+		return Error{
+			Action: "Compile",
+			Script: vm.File,
+			Cause:  err,
+		}
+	}
+	err = vm.RunCtx()
+	if err != nil {
+		return err
+	}
+	return vm.invokeFixtures()
+}
+
+// invokeFixtures calls registered ticker and orderbook callbacks with
+// matching fixtures
+func (vm *VM) invokeFixtures() error {
+	f := vm.test.wrapper.Fixtures
+	if f == nil {
+		return nil
+	}
+	vm.subM.Lock()
+	subs := make([]*subscription, 0, len(vm.subscriptions))
+	for _, s := range vm.subscriptions {
+		subs = append(subs, s)
+	}
+	vm.subM.Unlock()
+	sort.Slice(subs, func(i, j int) bool { return subs[i].key < subs[j].key })
+
+	var events []interface{}
+	for x := range f.Tickers {
+		events = append(events, f.Tickers[x])
+	}
+	for x := range f.Orderbooks {
+		events = append(events, f.Orderbooks[x])
+	}
+	for x := range subs {
+		for i := range events {
+			if subs[x].key != fixtureKey(events[i]) {
+				continue
+			}
+			obj, ok := subs[x].convert(events[i])
+			if !ok {
+				continue
+			}
+			err := vm.invoke(subs[x].callback, obj)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func fixtureKey(data interface{}) string {
+	switch d := data.(type) {
+	case ticker.Price:
+		return eventKey("ticker", d.ExchangeName, d.Pair, d.AssetType)
+	case orderbook.Base:
+		return eventKey("orderbook", d.Exchange, d.Pair, d.Asset)
+	}
+	return ""
+}
+
+// Statements returns the number of statements in the script and the number
+// executed
+func (c *Coverage) Statements() (total, covered int) {
+	for x := range c.hits {
+		if c.hits[x] > 0 {
+			covered++
+		}
+	}
+	return len(c.hits), covered
+}
+
+// Percent returns the percentage of statements executed
+func (c *Coverage) Percent() float64 {
+	total, covered := c.Statements()
+	if total == 0 {
+		return 0
+	}
+	return float64(covered) / float64(total) * 100
+}
+
+// Uncovered returns the lines with statements which were not executed
+func (c *Coverage) Uncovered() []int {
+	seen := make(map[int]bool)
+	var lines []int
+	for x := range c.hits {
+		if c.hits[x] == 0 && !seen[c.lines[x]] {
+			seen[c.lines[x]] = true
+			lines = append(lines, c.lines[x])
+		}
+	}
+	sort.Ints(lines)
+	return lines
+}
+
+// Line returns the line of the statement executing in the innermost function
+// call
+func (c *Coverage) Line() int {
+	if len(c.frames) == 0 || c.frames[len(c.frames)-1] < 0 {
+		return 0
+	}
+	return c.lines[c.frames[len(c.frames)-1]]
+}
+
+// hook returns a statement hook recording the line of each statement and
+// inserting a call to the cover function
+func (c *Coverage) hook(srcFile *parser.SourceFile) stmtHook {
+	return func(s parser.Stmt) []parser.Stmt {
+		id := len(c.lines)
+		c.lines = append(c.lines, srcFile.Position(s.Pos()).Line)
+		c.hits = append(c.hits, 0)
+		return []parser.Stmt{callStmt(coverFunction, s.Pos(), &parser.IntLit{Value: int64(id), ValuePos: s.Pos()})}
+	}
+}
+
+// cover returns the function recording executed statements
+func (c *Coverage) cover() *tengo.UserFunction {
+	return &tengo.UserFunction{
+		Name: coverFunction,
+		Value: func(args ...tengo.Object) (tengo.Object, error) {
+			if len(args) != 1 {
+				return nil, tengo.ErrWrongNumArguments
+			}
+			id, ok := args[0].(*tengo.Int)
+			if !ok || id.Value < 0 || int(id.Value) >= len(c.hits) {
+				return nil, errors.New("invalid statement")
+			}
+			if len(c.frames) == 0 {
+				c.frames = append(c.frames, -1)
+			}
+			c.frames[len(c.frames)-1] = int(id.Value)
+			c.hits[id.Value]++
+			return nil, nil
+		},
+	}
+}
+
+// enter returns the function pushing a frame on entry to a script function
+func (c *Coverage) enter() *tengo.UserFunction {
+	return &tengo.UserFunction{
+		Name: enterFunction,
+		Value: func(...tengo.Object) (tengo.Object, error) {
+			c.frames = append(c.frames, -1)
+			return nil, nil
+		},
+	}
+}
+
+// leave returns the function popping a frame on return from a script
+// function, passing through the returned value
+func (c *Coverage) leave() *tengo.UserFunction {
+	return &tengo.UserFunction{
+		Name: leaveFunction,
+		Value: func(args ...tengo.Object) (tengo.Object, error) {
+			if len(c.frames) > 0 {
+				c.frames = c.frames[:len(c.frames)-1]
+			}
+			if len(args) > 0 {
+				return args[0], nil
+			}
+			return tengo.UndefinedValue, nil
+		},
+	}
+}
+
+// assertModule returns the assert module bound to the VM. Under the test
+// harness failures are recorded and the assertion returns false, otherwise a
+// failure stops the script
+func (vm *VM) assertModule() map[string]tengo.Object {
+	return map[string]tengo.Object{
+		"equal":    &tengo.UserFunction{Name: "equal", Value: vm.assertEqual},
+		"notequal": &tengo.UserFunction{Name: "notequal", Value: vm.assertNotEqual},
+		"istrue":   &tengo.UserFunction{Name: "istrue", Value: vm.assertTrue},
+		"isfalse":  &tengo.UserFunction{Name: "isfalse", Value: vm.assertFalse},
+		"near":     &tengo.UserFunction{Name: "near", Value: vm.assertNear},
+		"iserror":  &tengo.UserFunction{Name: "iserror", Value: vm.assertIsError},
+		"fail":     &tengo.UserFunction{Name: "fail", Value: vm.assertFail},
+	}
+}
+
+// assertResult returns true if the assertion passed, recording or returning
+// the failure otherwise. A message argument following the required
+// arguments prefixes the failure
+func (vm *VM) assertResult(passed bool, args []tengo.Object, required int, format string, a ...interface{}) (tengo.Object, error) {
+	if passed {
+		return tengo.TrueValue, nil
+	}
+	msg := fmt.Sprintf(format, a...)
+	if len(args) > required {
+		if s, ok := tengo.ToString(args[required]); ok && s != "" {
+			msg = s + ": " + msg
+		}
+	}
+	if vm.test == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAssertionFailed, msg)
+	}
+	vm.test.failures = append(vm.test.failures, TestFailure{
+		Line:    vm.test.coverage.Line(),
+		Message: msg,
+	})
+	return tengo.FalseValue, nil
+}
+
+// objectsEqual compares numbers by value regardless of type and other
+// objects with their own equality
+func objectsEqual(a, b tengo.Object) bool {
+	x, aNumber := toNumber(a)
+	y, bNumber := toNumber(b)
+	if aNumber && bNumber {
+		return x == y
+	}
+	return a.Equals(b)
+}
+
+func toNumber(o tengo.Object) (float64, bool) {
+	switch o.(type) {
+	case *tengo.Int, *tengo.Float:
+		return tengo.ToFloat64(o)
+	}
+	return 0, false
+}
+
+func checkArgs(args []tengo.Object, required int) error {
+	if len(args) < required || len(args) > required+1 {
+		return tengo.ErrWrongNumArguments
+	}
+	return nil
+}
+
+func (vm *VM) assertEqual(args ...tengo.Object) (tengo.Object, error) {
+	if err := checkArgs(args, 2); err != nil {
+		return nil, err
+	}
+	return vm.assertResult(objectsEqual(args[0], args[1]), args, 2,
+		"expected %s received %s", args[1], args[0])
+}
+
+func (vm *VM) assertNotEqual(args ...tengo.Object) (tengo.Object, error) {
+	if err := checkArgs(args, 2); err != nil {
+		return nil, err
+	}
+	return vm.assertResult(!objectsEqual(args[0], args[1]), args, 2,
+		"expected value other than %s", args[1])
+}
+
+func (vm *VM) assertTrue(args ...tengo.Object) (tengo.Object, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return nil, err
+	}
+	return vm.assertResult(!args[0].IsFalsy(), args, 1,
+		"expected true received %s", args[0])
+}
+
+func (vm *VM) assertFalse(args ...tengo.Object) (tengo.Object, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return nil, err
+	}
+	return vm.assertResult(args[0].IsFalsy(), args, 1,
+		"expected false received %s", args[0])
+}
+
+func (vm *VM) assertNear(args ...tengo.Object) (tengo.Object, error) {
+	if err := checkArgs(args, 3); err != nil {
+		return nil, err
+	}
+	actual, ok := toNumber(args[0])
+	if !ok {
+		return nil, fmt.Errorf(gct.ErrParameterConvertFailed, args[0])
+	}
+	expected, ok := toNumber(args[1])
+	if !ok {
+		return nil, fmt.Errorf(gct.ErrParameterConvertFailed, args[1])
+	}
+	tolerance, ok := toNumber(args[2])
+	if !ok {
+		return nil, fmt.Errorf(gct.ErrParameterConvertFailed, args[2])
+	}
+	return vm.assertResult(math.Abs(actual-expected) <= tolerance, args, 3,
+		"expected %v ±%v received %v", expected, tolerance, actual)
+}
+
+func (vm *VM) assertIsError(args ...tengo.Object) (tengo.Object, error) {
+	if err := checkArgs(args, 1); err != nil {
+		return nil, err
+	}
+	_, isError := args[0].(*tengo.Error)
+	return vm.assertResult(isError, args, 1,
+		"expected error received %s", args[0])
+}
+
+func (vm *VM) assertFail(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) > 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	return vm.assertResult(false, args, 0, "failed")
+}
+
+// testWrapper returns the wrapper used by scripts under the test harness
+func (t *testRun) testWrapper() modules.GCT {
+	return t.wrapper
+}
@@ -0,0 +1,143 @@
+package vm
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+var testFixtures = filepath.Join("..", "..", "testdata", "gctscript", "fixtures.json")
+
+func writeTestScript(t *testing.T, name, script string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, name)
+	err = ioutil.WriteFile(path, []byte(script), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestTestScriptAssertions(t *testing.T) {
+	t.Parallel()
+	script := writeTestScript(t, "assert.gct", `assert := import("assert")
+assert.equal(1, 1.0)
+assert.equal("a", "b", "strings")
+assert.near(1.05, 1, 0.1)
+assert.near(2, 1, 0.1)
+if false {
+	assert.fail("unreachable")
+}
+assert.iserror(error("bad"))
+assert.istrue(0)
+double := func(n) {
+	if n > 0 {
+		return n * 2
+	}
+}
+assert.equal(double(2), 5)
+assert.equal(double(-1), undefined)`)
+	result := TestScript(script, nil)
+	if result.Err != nil {
+		t.Fatal(result.Err)
+	}
+	expected := []TestFailure{
+		{Line: 3, Message: `strings: expected "b" received "a"`},
+		{Line: 5, Message: "expected 1 ±0.1 received 2"},
+		{Line: 10, Message: "expected true received 0"},
+		{Line: 16, Message: "expected 5 received 4"},
+	}
+	if !reflect.DeepEqual(result.Failures, expected) {
+		t.Errorf("received %+v expected %+v", result.Failures, expected)
+	}
+	if result.Statements != 14 || result.Covered != 13 {
+		t.Errorf("received %d/%d statements covered expected 13/14", result.Covered, result.Statements)
+	}
+	if !reflect.DeepEqual(result.Uncovered, []int{7}) {
+		t.Errorf("received %v uncovered lines expected [7]", result.Uncovered)
+	}
+}
+
+func TestTestScriptFixtures(t *testing.T) {
+	t.Parallel()
+	fixtures, err := validator.LoadFixtures(testFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := writeTestScript(t, "fixtures.gct", `exch := import("exchange")
+events := import("events")
+assert := import("assert")
+
+t := exch.ticker("binance", "BTC-USDT", "-", "spot")
+assert.equal(t.last, 10000)
+ob := exch.orderbook("binance", "BTC-USDT", "-", "spot")
+assert.equal(ob.bids[0].price, 9995)
+
+calls := 0
+events.ticker("binance", "BTC-USDT", "-", "spot", func(t) {
+	calls++
+	assert.equal(t.ask, 10005)
+})
+events.ticker("binance", "ETH-USDT", "-", "spot", func(t) {
+	assert.fail("no fixture")
+})`)
+	result := TestScript(script, fixtures)
+	if result.Err != nil {
+		t.Fatal(result.Err)
+	}
+	if len(result.Failures) != 0 {
+		t.Errorf("unexpected failures %+v", result.Failures)
+	}
+	if !reflect.DeepEqual(result.Uncovered, []int{16}) {
+		t.Errorf("received %v uncovered lines expected [16]", result.Uncovered)
+	}
+}
+
+func TestTestScriptErrors(t *testing.T) {
+	t.Parallel()
+	script := writeTestScript(t, "compile.gct", "a := 1\nb := c")
+	result := TestScript(script, nil)
+	if result.Err == nil || !strings.Contains(result.Err.Error(), "compile.gct:2:6") {
+		t.Errorf("expected compile error with script line received %v", result.Err)
+	}
+
+	script = writeTestScript(t, "runtime.gct", "a := 1\nb := a.x.y")
+	result = TestScript(script, nil)
+	if result.Err == nil || !strings.Contains(result.Err.Error(), "runtime.gct:2:") {
+		t.Errorf("expected runtime error with script line received %v", result.Err)
+	}
+}
+
+func TestAssertOutsideHarness(t *testing.T) {
+	t.Parallel()
+	script := writeTestScript(t, "assert.gct", `assert := import("assert")
+assert.equal(1, 2)`)
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM := manager.New()
+	err := testVM.Load(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Run()
+	if !errors.Is(err, ErrAssertionFailed) {
+		t.Errorf("received %v expected %v", err, ErrAssertionFailed)
+	}
+}
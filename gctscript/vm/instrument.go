@@ -0,0 +1,163 @@
+package vm
+
+import (
+	"github.com/d5/tengo/v2/parser"
+)
+
+// stmtHook returns the statements to insert before a script statement
+type stmtHook func(parser.Stmt) []parser.Stmt
+
+// instrumenter inserts hook statements before every statement of a parsed
+// script, including statements within function literals, without modifying
+// the tengo compiler or VM
+type instrumenter struct {
+	hook stmtHook
+	// enter and leave, when set, name the global functions called on entry to
+	// each function literal and on its return, leave is passed the returned
+	// value and must return it
+	enter, leave string
+	// loop, when set, names the global function called at the start of every
+	// loop iteration so loops without statements, such as for {}, are counted
+	loop  string
+	depth int
+}
+
+// callStmt returns a statement calling the named global function, the call is
+// positioned at the statement it precedes so runtime errors raised by the
+// function report the line of that statement
+func callStmt(name string, pos parser.Pos, args ...parser.Expr) parser.Stmt {
+	return &parser.ExprStmt{Expr: callExpr(name, pos, args...)}
+}
+
+func callExpr(name string, pos parser.Pos, args ...parser.Expr) parser.Expr {
+	return &parser.CallExpr{
+		Func:   &parser.Ident{Name: name, NamePos: pos},
+		LParen: pos,
+		Args:   args,
+		RParen: pos,
+	}
+}
+
+func (in *instrumenter) stmts(stmts []parser.Stmt) []parser.Stmt {
+	out := make([]parser.Stmt, 0, len(stmts)*2)
+	for x := range stmts {
+		out = append(out, in.hook(stmts[x])...)
+		in.stmt(stmts[x])
+		out = append(out, stmts[x])
+	}
+	return out
+}
+
+func (in *instrumenter) block(b *parser.BlockStmt) {
+	if b != nil {
+		b.Stmts = in.stmts(b.Stmts)
+	}
+}
+
+func (in *instrumenter) stmt(s parser.Stmt) {
+	switch t := s.(type) {
+	case *parser.AssignStmt:
+		in.exprs(t.LHS...)
+		in.exprs(t.RHS...)
+	case *parser.BlockStmt:
+		in.block(t)
+	case *parser.ExportStmt:
+		in.exprs(t.Result)
+	case *parser.ExprStmt:
+		in.exprs(t.Expr)
+	case *parser.ForInStmt:
+		in.exprs(t.Iterable)
+		in.loopBody(t.Body)
+	case *parser.ForStmt:
+		if t.Init != nil {
+			in.stmt(t.Init)
+		}
+		in.exprs(t.Cond)
+		if t.Post != nil {
+			in.stmt(t.Post)
+		}
+		in.loopBody(t.Body)
+	case *parser.IfStmt:
+		if t.Init != nil {
+			in.stmt(t.Init)
+		}
+		in.exprs(t.Cond)
+		in.block(t.Body)
+		if t.Else != nil {
+			in.stmt(t.Else)
+		}
+	case *parser.IncDecStmt:
+		in.exprs(t.Expr)
+	case *parser.ReturnStmt:
+		in.exprs(t.Result)
+		if in.leave != "" && in.depth > 0 {
+			var args []parser.Expr
+			if t.Result != nil {
+				args = append(args, t.Result)
+			}
+			t.Result = callExpr(in.leave, t.ReturnPos, args...)
+		}
+	}
+}
+
+// loopBody instruments a loop body, prepending the loop call when set
+func (in *instrumenter) loopBody(b *parser.BlockStmt) {
+	if b == nil {
+		return
+	}
+	in.block(b)
+	if in.loop != "" {
+		b.Stmts = append([]parser.Stmt{callStmt(in.loop, b.LBrace)}, b.Stmts...)
+	}
+}
+
+// funcBody instruments a function literal body, wrapping it with the enter
+// and leave calls when set
+func (in *instrumenter) funcBody(b *parser.BlockStmt) {
+	in.depth++
+	in.block(b)
+	in.depth--
+	if in.enter != "" {
+		b.Stmts = append([]parser.Stmt{callStmt(in.enter, b.LBrace)}, b.Stmts...)
+	}
+	if in.leave != "" {
+		b.Stmts = append(b.Stmts, callStmt(in.leave, b.RBrace))
+	}
+}
+
+// exprs instruments the bodies of function literals within the expressions
+func (in *instrumenter) exprs(exprs ...parser.Expr) {
+	for x := range exprs {
+		switch t := exprs[x].(type) {
+		case *parser.FuncLit:
+			in.funcBody(t.Body)
+		case *parser.ArrayLit:
+			in.exprs(t.Elements...)
+		case *parser.BinaryExpr:
+			in.exprs(t.LHS, t.RHS)
+		case *parser.CallExpr:
+			in.exprs(t.Func)
+			in.exprs(t.Args...)
+		case *parser.CondExpr:
+			in.exprs(t.Cond, t.True, t.False)
+		case *parser.ErrorExpr:
+			in.exprs(t.Expr)
+		case *parser.ImmutableExpr:
+			in.exprs(t.Expr)
+		case *parser.IndexExpr:
+			in.exprs(t.Expr, t.Index)
+		case *parser.MapLit:
+			for i := range t.Elements {
+				in.exprs(t.Elements[i].Value)
+			}
+		case *parser.ParenExpr:
+			in.exprs(t.Expr)
+		case *parser.SelectorExpr:
+			in.exprs(t.Expr, t.Sel)
+		case *parser.SliceExpr:
+			in.exprs(t.Expr, t.Low, t.High)
+		case *parser.UnaryExpr:
+			in.exprs(t.Expr)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package vm
+
+import (
+	"errors"
+
+	"github.com/d5/tengo/v2"
+)
+
+// stepFunction is the global called before each statement when an
+// instruction limit is set, the name cannot be referenced by script source
+const stepFunction = "$step"
+
+var (
+	// ErrInstructionLimit is returned when a script exceeds its instruction
+	// limit
+	ErrInstructionLimit = errors.New("instruction limit exceeded")
+)
+
+// limits holds the VM resource limits of a compiled script, zero values are
+// unlimited
+type limits struct {
+	maxAllocs       int64
+	maxInstructions int64
+}
+
+// allocs returns the allocation limit in the form used by tengo.NewVM
+func (l limits) allocs() int64 {
+	if l.maxAllocs <= 0 {
+		return -1
+	}
+	return l.maxAllocs
+}
+
+// step returns the function counting executed statements against the
+// instruction limit, the count is reset before each run
+func (c *Compiled) step() *tengo.UserFunction {
+	return &tengo.UserFunction{
+		Name: stepFunction,
+		Value: func(...tengo.Object) (tengo.Object, error) {
+			c.instructions++
+			if c.instructions > c.limits.maxInstructions {
+				return nil, ErrInstructionLimit
+			}
+			return nil, nil
+		},
+	}
+}
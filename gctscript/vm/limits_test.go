@@ -0,0 +1,152 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+const loopScript = `x := 0
+sum := func(n) {
+	for i := 0; i < n; i++ {
+		if i % 2 == 0 {
+			x += i
+		} else {
+			x -= 1
+		}
+	}
+}
+sum(100)`
+
+func TestInstructionLimit(t *testing.T) {
+	t.Parallel()
+	c, err := compile([]byte(loopScript), nil, nil, compileOptions{limits: limits{maxInstructions: 100}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Run()
+	if !errors.Is(err, ErrInstructionLimit) {
+		t.Errorf("received %v expected %v", err, ErrInstructionLimit)
+	}
+
+	c, err = compile([]byte(loopScript), nil, nil, compileOptions{limits: limits{maxInstructions: 1000}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Get("x").Int() != 2400 {
+		t.Errorf("received %v expected %v", c.Get("x").Int(), 2400)
+	}
+	// the limit applies to each call rather than the life of the script
+	for i := 0; i < 2; i++ {
+		err = c.Call(context.Background(), c.Get("sum").Object(), &tengo.Int{Value: 100})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = c.Call(context.Background(), c.Get("sum").Object(), &tengo.Int{Value: 1000})
+	if !errors.Is(err, ErrInstructionLimit) {
+		t.Errorf("received %v expected %v", err, ErrInstructionLimit)
+	}
+
+	_, err = compile([]byte(`$step := 1`), nil, nil, compileOptions{limits: limits{maxInstructions: 1000}})
+	if err == nil {
+		t.Error("expected step function to be inaccessible from script source")
+	}
+}
+
+func TestInstructionLimitEmptyLoops(t *testing.T) {
+	t.Parallel()
+	for _, script := range []string{
+		`for {}`,
+		`for i := 0; ; i++ {}`,
+		`f := func() { for true {} }
+f()`,
+		`for x in [1, 2, 3] {}
+for {}`,
+	} {
+		c, err := compile([]byte(script), nil, nil, compileOptions{limits: limits{maxInstructions: 1000}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = c.Run()
+		if !errors.Is(err, ErrInstructionLimit) {
+			t.Errorf("%s: received %v expected %v", script, err, ErrInstructionLimit)
+		}
+	}
+}
+
+func TestAllocationLimit(t *testing.T) {
+	t.Parallel()
+	script := []byte(`a := []
+for i := 0; i < 100; i++ {
+	a = append(a, [i])
+}`)
+	c, err := compile(script, nil, nil, compileOptions{limits: limits{maxAllocs: 50}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Run()
+	if !errors.Is(err, tengo.ErrObjectAllocLimit) {
+		t.Errorf("received %v expected %v", err, tengo.ErrObjectAllocLimit)
+	}
+
+	c, err = compile(script, nil, nil, compileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Run()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVMPermissions(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := filepath.Join(dir, "withdraw.gct")
+	err = ioutil.WriteFile(script, []byte(`exch := import("exchange")
+exch.withdrawcrypto("Bitstamp", "BTC", "address", "", 1, 0, "test")`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := configHelper(true, false, maxTestVirtualMachines)
+	c.Permissions = map[string]modules.Permissions{
+		AllScripts:     {Access: modules.AccessWithdraw},
+		"withdraw.gct": {Access: modules.AccessTrade},
+	}
+	manager := GctScriptManager{config: c, started: 1}
+	testVM := manager.New()
+	err = testVM.Load(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Run()
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+
+	c.Permissions["withdraw.gct"] = modules.Permissions{Access: "admin"}
+	err = manager.New().Load(script)
+	if err == nil {
+		t.Error("expected invalid permissions error")
+	}
+}
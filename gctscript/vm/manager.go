@@ -17,6 +17,11 @@ type GctScriptManager struct {
 	config   *Config
 	started  int32
 	shutdown chan struct{}
+	// schedules are keyed by name, scheduleWake signals the scheduler to
+	// recalculate the next run after a change
+	scheduleM    sync.Mutex
+	schedules    map[string]*Schedule
+	scheduleWake chan struct{}
 	// Optional values to override stored config ('nil' if not overridden)
 	MaxVirtualMachines *uint8
 }
@@ -49,6 +54,7 @@ func (g *GctScriptManager) Start(wg *sync.WaitGroup) (err error) {
 	log.Debugln(log.Global, gctscriptManagerName, subsystem.MsgSubSystemStarting)
 
 	g.shutdown = make(chan struct{})
+	g.scheduleWake = make(chan struct{}, 1)
 	wg.Add(1)
 	go g.run(wg)
 	return nil
@@ -77,12 +83,13 @@ func (g *GctScriptManager) run(wg *sync.WaitGroup) {
 
 	SetDefaultScriptOutput()
 	g.autoLoad()
+	g.loadSchedules()
 	defer func() {
 		wg.Done()
 		log.Debugln(log.GCTScriptMgr, gctscriptManagerName, subsystem.MsgSubSystemShutdown)
 	}()
 
-	<-g.shutdown
+	g.runScheduler()
 }
 
 // GetMaxVirtualMachines returns the max number of VMs to create
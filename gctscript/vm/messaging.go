@@ -0,0 +1,225 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+const (
+	// DefaultMessageQueue is the number of messages buffered for a topic
+	// subscriber when the script does not set a queue size
+	DefaultMessageQueue = 100
+	// MaxMessageQueue is the largest queue a topic subscriber can request
+	MaxMessageQueue = 10000
+)
+
+var (
+	errTopicUnset          = errors.New("message topic must be set")
+	errUnsupportedPayload  = errors.New("unsupported message payload type")
+	errInvalidMessageQueue = fmt.Errorf("message queue size must be between 1 and %d", MaxMessageQueue)
+
+	// topics routes messages published by scripts to the subscribers of each
+	// topic
+	topics = topicRouter{
+		ids: make(map[string]uuid.UUID),
+		mux: dispatch.GetNewMux(),
+	}
+)
+
+// topicRouter assigns each topic a dispatch ID on first use
+type topicRouter struct {
+	sync.Mutex
+	ids map[string]uuid.UUID
+	mux *dispatch.Mux
+}
+
+// message is published to the subscribers of a topic, the payload is a copy
+// of the published object so scripts never share mutable values
+type message struct {
+	Topic   string
+	Sender  string
+	Payload interface{}
+	Time    time.Time
+}
+
+func (r *topicRouter) id(topic string) (uuid.UUID, error) {
+	r.Lock()
+	defer r.Unlock()
+	id, ok := r.ids[topic]
+	if ok {
+		return id, nil
+	}
+	id, err := r.mux.GetID()
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	r.ids[topic] = id
+	return id, nil
+}
+
+func (r *topicRouter) publish(m *message) error {
+	id, err := r.id(m.Topic)
+	if err != nil {
+		return err
+	}
+	return r.mux.Publish([]uuid.UUID{id}, m)
+}
+
+func (r *topicRouter) subscribe(topic string) (dispatch.Pipe, error) {
+	id, err := r.id(topic)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return r.mux.Subscribe(id)
+}
+
+// messagingModule returns the messaging module bound to the VM, allowing
+// scripts to publish to and subscribe to named topics
+func (vm *VM) messagingModule() map[string]tengo.Object {
+	return map[string]tengo.Object{
+		"publish":     &tengo.UserFunction{Name: "publish", Value: vm.publishMessage},
+		"subscribe":   &tengo.UserFunction{Name: "subscribe", Value: vm.subscribeTopic},
+		"unsubscribe": &tengo.UserFunction{Name: "unsubscribe", Value: vm.unsubscribeEvent},
+	}
+}
+
+// publishMessage publishes a payload of strings, numbers, bools, times, maps
+// and arrays to a topic
+func (vm *VM) publishMessage(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 2 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	topic, ok := tengo.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(gct.ErrParameterConvertFailed, topic)
+	}
+	if topic == "" {
+		return nil, errTopicUnset
+	}
+	payload, err := toPayload(args[1])
+	if err != nil {
+		return nil, err
+	}
+	// scripts under validation or test do not publish to live subscribers
+	if validator.IsTestExecution.Load() == true || vm.test != nil {
+		return tengo.TrueValue, nil
+	}
+	err = topics.publish(&message{
+		Topic:   topic,
+		Sender:  vm.ShortName(),
+		Payload: payload,
+		Time:    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tengo.TrueValue, nil
+}
+
+// subscribeTopic registers a callback for messages published to a topic with
+// an optional queue size, returning the subscription ID
+func (vm *VM) subscribeTopic(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	topic, ok := tengo.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(gct.ErrParameterConvertFailed, topic)
+	}
+	if topic == "" {
+		return nil, errTopicUnset
+	}
+	queue := DefaultMessageQueue
+	if len(args) == 3 {
+		queue, ok = tengo.ToInt(args[2])
+		if !ok {
+			return nil, fmt.Errorf(gct.ErrParameterConvertFailed, queue)
+		}
+		if queue < 1 || queue > MaxMessageQueue {
+			return nil, errInvalidMessageQueue
+		}
+	}
+	return vm.subscribeQueued("messaging/"+topic, args[1], queue,
+		func() (dispatch.Pipe, error) {
+			return topics.subscribe(topic)
+		},
+		func(data interface{}) (tengo.Object, bool) {
+			m, ok := data.(message)
+			if !ok || m.Topic != topic {
+				return nil, false
+			}
+			payload, err := tengo.FromInterface(m.Payload)
+			if err != nil {
+				return nil, false
+			}
+			return &tengo.Map{Value: map[string]tengo.Object{
+				"topic":   &tengo.String{Value: m.Topic},
+				"sender":  &tengo.String{Value: m.Sender},
+				"payload": payload,
+				"time":    &tengo.Time{Value: m.Time},
+			}}, true
+		})
+}
+
+// toPayload deep copies a script object to a message payload
+func toPayload(o tengo.Object) (interface{}, error) {
+	switch t := o.(type) {
+	case *tengo.Undefined:
+		return nil, nil
+	case *tengo.String:
+		return t.Value, nil
+	case *tengo.Int:
+		return t.Value, nil
+	case *tengo.Float:
+		return t.Value, nil
+	case *tengo.Bool:
+		return !t.IsFalsy(), nil
+	case *tengo.Char:
+		return string(t.Value), nil
+	case *tengo.Time:
+		return t.Value, nil
+	case *tengo.Bytes:
+		return append([]byte(nil), t.Value...), nil
+	case *tengo.Array:
+		return toPayloadArray(t.Value)
+	case *tengo.ImmutableArray:
+		return toPayloadArray(t.Value)
+	case *tengo.Map:
+		return toPayloadMap(t.Value)
+	case *tengo.ImmutableMap:
+		return toPayloadMap(t.Value)
+	}
+	return nil, fmt.Errorf("%w: %s", errUnsupportedPayload, o.TypeName())
+}
+
+func toPayloadArray(values []tengo.Object) (interface{}, error) {
+	r := make([]interface{}, len(values))
+	for x := range values {
+		v, err := toPayload(values[x])
+		if err != nil {
+			return nil, err
+		}
+		r[x] = v
+	}
+	return r, nil
+}
+
+func toPayloadMap(values map[string]tengo.Object) (interface{}, error) {
+	r := make(map[string]interface{}, len(values))
+	for k, o := range values {
+		v, err := toPayload(o)
+		if err != nil {
+			return nil, err
+		}
+		r[k] = v
+	}
+	return r, nil
+}
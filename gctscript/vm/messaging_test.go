@@ -0,0 +1,165 @@
+package vm
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+)
+
+const (
+	subscriberScript = `messaging := import("messaging")
+count := 0
+last := undefined
+on_signal := func(msg) {
+	count += 1
+	last = msg
+}
+sub := messaging.subscribe("signals", on_signal, 10)`
+	publisherScript = `messaging := import("messaging")
+signal := {pair: "BTC-USD", rsi: 28.5, levels: [1, 2], buy: true}
+messaging.publish("signals", signal)
+signal.rsi = 99`
+)
+
+func loadMessagingVM(t *testing.T, manager *GctScriptManager, dir, name, source string) *VM {
+	t.Helper()
+	file := filepath.Join(dir, name)
+	err := ioutil.WriteFile(file, []byte(source), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testVM := manager.New()
+	if testVM == nil {
+		t.Fatal("Failed to allocate new VM exiting")
+	}
+	err = testVM.Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testVM
+}
+
+func TestMessaging(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	subscriber := loadMessagingVM(t, &manager, dir, "subscriber.gct", subscriberScript)
+	publisher := loadMessagingVM(t, &manager, dir, "publisher.gct", publisherScript)
+	if err = subscriber.RunCtx(); err != nil {
+		t.Fatal(err)
+	}
+
+	// dispatch drops messages while the subscriber is not ready to receive so
+	// publish until one is received
+	deadline := time.Now().Add(5 * time.Second)
+	for subscriber.Compiled.Get("count").Int() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("message not received")
+		}
+		if err = publisher.RunCtx(); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	m := subscriber.Compiled.Get("last").Map()
+	if m["topic"] != "signals" || m["sender"] != "publisher.gct" {
+		t.Errorf("unexpected message %v", m)
+	}
+	payload, ok := m["payload"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("unexpected payload %v", m["payload"])
+	}
+	// changes made by the publisher after publishing are not shared
+	if payload["rsi"] != 28.5 || payload["buy"] != true || len(payload["levels"].([]interface{})) != 2 {
+		t.Errorf("unexpected payload %v", payload)
+	}
+
+	if err = publisher.Shutdown(); err != nil {
+		t.Error(err)
+	}
+	if err = subscriber.Shutdown(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMessagingArgs(t *testing.T) {
+	t.Parallel()
+	testVM := &VM{config: configHelper(true, true, maxTestVirtualMachines)}
+	callback := &tengo.UserFunction{Value: func(...tengo.Object) (tengo.Object, error) { return nil, nil }}
+	_, err := testVM.publishMessage(&tengo.String{})
+	if !errors.Is(err, tengo.ErrWrongNumArguments) {
+		t.Errorf("received %v expected %v", err, tengo.ErrWrongNumArguments)
+	}
+	_, err = testVM.publishMessage(&tengo.String{}, &tengo.Int{Value: 1})
+	if !errors.Is(err, errTopicUnset) {
+		t.Errorf("received %v expected %v", err, errTopicUnset)
+	}
+	_, err = testVM.publishMessage(&tengo.String{Value: "signals"}, callback)
+	if !errors.Is(err, errUnsupportedPayload) {
+		t.Errorf("received %v expected %v", err, errUnsupportedPayload)
+	}
+	_, err = testVM.subscribeTopic(&tengo.String{Value: "signals"}, callback, &tengo.Int{Value: MaxMessageQueue + 1})
+	if !errors.Is(err, errInvalidMessageQueue) {
+		t.Errorf("received %v expected %v", err, errInvalidMessageQueue)
+	}
+	_, err = testVM.subscribeTopic(&tengo.String{Value: "signals"}, &tengo.Int{})
+	if !errors.Is(err, errCallbackNotCallable) {
+		t.Errorf("received %v expected %v", err, errCallbackNotCallable)
+	}
+
+	payload, err := toPayload(&tengo.Map{Value: map[string]tengo.Object{
+		"nested": &tengo.ImmutableArray{Value: []tengo.Object{&tengo.Char{Value: 'a'}, tengo.UndefinedValue}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested := payload.(map[string]interface{})["nested"].([]interface{})
+	if nested[0] != "a" || nested[1] != nil {
+		t.Errorf("unexpected payload %v", payload)
+	}
+}
+
+func TestSubscriptionQueue(t *testing.T) {
+	t.Parallel()
+	testVM := &VM{config: configHelper(true, true, maxTestVirtualMachines)}
+	pipe := make(chan interface{})
+	s := &subscription{key: "messaging/test", pipe: dispatch.Pipe{C: pipe}, queue: 2}
+	q := make(chan interface{}, s.queue)
+	testVM.wg.Add(1)
+	go testVM.enqueue(s, q)
+	for i := 1; i <= 3; i++ {
+		pipe <- i
+	}
+	close(pipe)
+	testVM.wg.Wait()
+	var received []interface{}
+	for data := range q {
+		received = append(received, data)
+	}
+	// the oldest event is dropped when the callback falls behind
+	if len(received) != 2 || received[0] != 2 || received[1] != 3 || s.dropped != 1 {
+		t.Errorf("received %v dropped %v expected [2 3] dropped 1", received, s.dropped)
+	}
+}
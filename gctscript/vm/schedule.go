@@ -0,0 +1,346 @@
+package vm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/cron"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptschedule"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// ValidateWindows checks the configured exchange maintenance windows
+func (c *Config) ValidateWindows() error {
+	for exch, windows := range c.MaintenanceWindows {
+		for x := range windows {
+			if err := windows[x].validate(); err != nil {
+				return fmt.Errorf("%s maintenance window %d: %w", exch, x, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (w *Window) validate() error {
+	if w.Cron != "" {
+		if w.Duration <= 0 || !w.Start.IsZero() || !w.End.IsZero() {
+			return errInvalidWindow
+		}
+		_, err := cron.Parse(w.Cron)
+		return err
+	}
+	if w.Start.IsZero() || !w.End.After(w.Start) {
+		return errInvalidWindow
+	}
+	return nil
+}
+
+// active returns whether t falls within the window
+func (w *Window) active(t time.Time) bool {
+	if w.Cron == "" {
+		return !t.Before(w.Start) && t.Before(w.End)
+	}
+	c, err := cron.Parse(w.Cron)
+	if err != nil {
+		return false
+	}
+	// the window is active when it started within its duration before t,
+	// cron expressions are evaluated in UTC like schedules
+	t = t.UTC()
+	start := c.Next(t.Add(-w.Duration))
+	return !start.IsZero() && !start.After(t)
+}
+
+// AddSchedule validates and stores a schedule, replacing any schedule with
+// the same name. Schedules are persisted when database support is enabled
+func (g *GctScriptManager) AddSchedule(s *Schedule) error {
+	if s.Name == "" {
+		return errScheduleNameUnset
+	}
+	if filepath.Ext(s.Script) != common.GctExt {
+		s.Script += common.GctExt
+	}
+	path, err := scriptFilePath(s.Script)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(path); err != nil {
+		return err
+	}
+	if (s.Cron == "") == s.RunAt.IsZero() {
+		return errScheduleTimeInvalid
+	}
+	if s.Cron != "" {
+		s.cron, err = cron.Parse(s.Cron)
+		if err != nil {
+			return err
+		}
+	} else if !s.RunAt.After(time.Now()) {
+		return errScheduleInPast
+	}
+	for x := range s.SkipWindows {
+		if err = s.SkipWindows[x].validate(); err != nil {
+			return fmt.Errorf("skip window %d: %w", x, err)
+		}
+	}
+	s.Enabled = true
+	s.RunAt = s.RunAt.UTC()
+	s.nextRun(time.Now())
+
+	err = g.persistSchedule(s)
+	if err != nil {
+		return err
+	}
+	g.scheduleM.Lock()
+	if g.schedules == nil {
+		g.schedules = make(map[string]*Schedule)
+	}
+	g.schedules[s.Name] = s
+	g.scheduleM.Unlock()
+	g.wakeScheduler()
+	return nil
+}
+
+// RemoveSchedule removes the named schedule
+func (g *GctScriptManager) RemoveSchedule(name string) error {
+	g.scheduleM.Lock()
+	_, ok := g.schedules[name]
+	delete(g.schedules, name)
+	g.scheduleM.Unlock()
+	if !ok {
+		return fmt.Errorf("%w %s", ErrScheduleNotFound, name)
+	}
+	err := scriptschedule.Delete(name)
+	if err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		return err
+	}
+	g.wakeScheduler()
+	return nil
+}
+
+// Schedules returns a copy of all schedules ordered by name
+func (g *GctScriptManager) Schedules() []Schedule {
+	g.scheduleM.Lock()
+	defer g.scheduleM.Unlock()
+	schedules := make([]Schedule, 0, len(g.schedules))
+	for _, s := range g.schedules {
+		schedules = append(schedules, *s)
+	}
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].Name < schedules[j].Name })
+	return schedules
+}
+
+// loadSchedules restores persisted schedules, one-off runs which were due
+// while the manager was not running are marked as missed rather than run
+func (g *GctScriptManager) loadSchedules() {
+	stored, err := scriptschedule.All()
+	if err != nil {
+		if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+			log.Errorf(log.GCTScriptMgr, "Failed to load script schedules: %v", err)
+		}
+		return
+	}
+	now := time.Now()
+	g.scheduleM.Lock()
+	defer g.scheduleM.Unlock()
+	g.schedules = make(map[string]*Schedule)
+	for x := range stored {
+		s := &Schedule{
+			Name:       stored[x].Name,
+			Script:     stored[x].ScriptName,
+			Cron:       stored[x].CronExpression,
+			RunAt:      stored[x].RunAt,
+			Exchanges:  stored[x].Exchanges,
+			Enabled:    stored[x].Enabled,
+			LastRun:    stored[x].LastRunAt,
+			LastStatus: stored[x].LastStatus,
+		}
+		if stored[x].SkipWindows != "" {
+			err = json.Unmarshal([]byte(stored[x].SkipWindows), &s.SkipWindows)
+			if err != nil {
+				log.Errorf(log.GCTScriptMgr, "Schedule %s has invalid skip windows and is disabled: %v", s.Name, err)
+				s.Enabled = false
+			}
+		}
+		if s.Cron != "" {
+			s.cron, err = cron.Parse(s.Cron)
+			if err != nil {
+				log.Errorf(log.GCTScriptMgr, "Schedule %s has an invalid cron expression and is disabled: %v", s.Name, err)
+				s.Enabled = false
+			}
+		} else if s.Enabled && !s.RunAt.After(now) {
+			log.Warnf(log.GCTScriptMgr, "Schedule %s run at %v was missed", s.Name, s.RunAt)
+			s.Enabled = false
+			s.LastStatus = ScheduleStatusMissed
+			if err = g.persistSchedule(s); err != nil {
+				log.Errorf(log.GCTScriptMgr, "Failed to store schedule %s: %v", s.Name, err)
+			}
+		}
+		s.nextRun(now)
+		g.schedules[s.Name] = s
+	}
+}
+
+// runScheduler runs due schedules until the manager is shut down
+func (g *GctScriptManager) runScheduler() {
+	for {
+		var timer *time.Timer
+		var due <-chan time.Time
+		if next := g.nextScheduledRun(); !next.IsZero() {
+			timer = time.NewTimer(time.Until(next))
+			due = timer.C
+		}
+		select {
+		case <-g.shutdown:
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-g.scheduleWake:
+			if timer != nil {
+				timer.Stop()
+			}
+		case <-due:
+			g.runDueSchedules(time.Now())
+		}
+	}
+}
+
+// wakeScheduler recalculates the next scheduled run after schedules change
+func (g *GctScriptManager) wakeScheduler() {
+	select {
+	case g.scheduleWake <- struct{}{}:
+	default:
+	}
+}
+
+func (g *GctScriptManager) nextScheduledRun() time.Time {
+	g.scheduleM.Lock()
+	defer g.scheduleM.Unlock()
+	var next time.Time
+	for _, s := range g.schedules {
+		if s.Enabled && !s.NextRun.IsZero() && (next.IsZero() || s.NextRun.Before(next)) {
+			next = s.NextRun
+		}
+	}
+	return next
+}
+
+// runDueSchedules starts the scripts of schedules due at or before now
+func (g *GctScriptManager) runDueSchedules(now time.Time) {
+	g.scheduleM.Lock()
+	defer g.scheduleM.Unlock()
+	for _, s := range g.schedules {
+		if !s.Enabled || s.NextRun.IsZero() || s.NextRun.After(now) {
+			continue
+		}
+		s.LastRun = now.UTC()
+		s.LastStatus = g.runSchedule(s, now)
+		if s.cron == nil {
+			s.Enabled = false
+		}
+		s.nextRun(now)
+		if err := g.persistSchedule(s); err != nil {
+			log.Errorf(log.GCTScriptMgr, "Failed to store schedule %s: %v", s.Name, err)
+		}
+	}
+}
+
+// runSchedule starts the schedule's script unless a skip window is active,
+// returning the status of the run
+func (g *GctScriptManager) runSchedule(s *Schedule, now time.Time) string {
+	if reason := g.skipReason(s, now); reason != "" {
+		log.Infof(log.GCTScriptMgr, "Schedule %s skipped %s: %s", s.Name, s.Script, reason)
+		return ScheduleStatusSkipped + ": " + reason
+	}
+	vm := g.New()
+	if vm == nil {
+		log.Errorf(log.GCTScriptMgr, "Schedule %s unable to create Virtual Machine for %s", s.Name, s.Script)
+		return ScheduleStatusFailed + ": unable to create virtual machine"
+	}
+	err := vm.Load(filepath.Join(ScriptPath, filepath.FromSlash(s.Script)))
+	if err != nil {
+		log.Errorf(log.GCTScriptMgr, "Schedule %s failed to load %s: %v", s.Name, s.Script, err)
+		if errUnregister := vm.unregister(); errUnregister != nil {
+			log.Errorln(log.GCTScriptMgr, errUnregister)
+		}
+		return ScheduleStatusFailed + ": " + err.Error()
+	}
+	if g.config.Verbose {
+		log.Debugf(log.GCTScriptMgr, "Schedule %s started %s ID: %v", s.Name, s.Script, vm.ID)
+	}
+	go vm.CompileAndRun()
+	return ScheduleStatusStarted
+}
+
+// skipReason returns why a run at t is skipped, or an empty string if it is
+// not within a skip or exchange maintenance window
+func (g *GctScriptManager) skipReason(s *Schedule, t time.Time) string {
+	for x := range s.SkipWindows {
+		if s.SkipWindows[x].active(t) {
+			return "skip window"
+		}
+	}
+	for exch, windows := range g.config.MaintenanceWindows {
+		if !common.StringDataCompareInsensitive(s.Exchanges, exch) {
+			continue
+		}
+		for x := range windows {
+			if windows[x].active(t) {
+				return strings.ToLower(exch) + " maintenance window"
+			}
+		}
+	}
+	return ""
+}
+
+// nextRun sets the next time the schedule is due after t
+func (s *Schedule) nextRun(t time.Time) {
+	switch {
+	case !s.Enabled:
+		s.NextRun = time.Time{}
+	case s.cron != nil:
+		s.NextRun = s.cron.Next(t.UTC())
+	default:
+		s.NextRun = s.RunAt
+	}
+}
+
+// persistSchedule stores the schedule, schedules are kept in memory only when
+// database support is disabled
+func (g *GctScriptManager) persistSchedule(s *Schedule) error {
+	var windows string
+	if len(s.SkipWindows) > 0 {
+		data, err := json.Marshal(s.SkipWindows)
+		if err != nil {
+			return err
+		}
+		windows = string(data)
+	}
+	err := scriptschedule.Upsert(&scriptschedule.Schedule{
+		Name:           s.Name,
+		ScriptName:     s.Script,
+		CronExpression: s.Cron,
+		RunAt:          s.RunAt,
+		Exchanges:      s.Exchanges,
+		SkipWindows:    windows,
+		Enabled:        s.Enabled,
+		LastRunAt:      s.LastRun,
+		LastStatus:     s.LastStatus,
+	})
+	if errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		if g.config.Verbose {
+			log.Debugf(log.GCTScriptMgr, "Schedule %s will not persist across restarts: %v", s.Name, err)
+		}
+		return nil
+	}
+	return err
+}
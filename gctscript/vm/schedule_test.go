@@ -0,0 +1,238 @@
+package vm
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptschedule"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+func TestWindowActive(t *testing.T) {
+	t.Parallel()
+	w := Window{Cron: "0 2 * * *", Duration: time.Hour}
+	if err := w.validate(); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		t      time.Time
+		active bool
+	}{
+		{time.Date(2020, 12, 17, 1, 59, 0, 0, time.UTC), false},
+		{time.Date(2020, 12, 17, 2, 0, 0, 0, time.UTC), true},
+		{time.Date(2020, 12, 17, 2, 59, 0, 0, time.UTC), true},
+		{time.Date(2020, 12, 17, 3, 0, 0, 0, time.UTC), false},
+		{time.Date(2020, 12, 17, 12, 30, 0, 0, time.FixedZone("UTC+10", 10*60*60)), true},
+		{time.Date(2020, 12, 17, 2, 30, 0, 0, time.FixedZone("UTC+10", 10*60*60)), false},
+	} {
+		if w.active(tc.t) != tc.active {
+			t.Errorf("%v received %v expected %v", tc.t, !tc.active, tc.active)
+		}
+	}
+
+	start := time.Date(2020, 12, 24, 0, 0, 0, 0, time.UTC)
+	w = Window{Start: start, End: start.Add(48 * time.Hour)}
+	if err := w.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !w.active(start) || w.active(w.End) || w.active(start.Add(-time.Second)) {
+		t.Error("unexpected one-off window result")
+	}
+
+	for _, w = range []Window{
+		{},
+		{Cron: "0 2 * * *"},
+		{Cron: "0 2 * * *", Duration: time.Hour, Start: start},
+		{Start: start, End: start},
+	} {
+		if err := w.validate(); !errors.Is(err, errInvalidWindow) {
+			t.Errorf("%+v received %v expected %v", w, err, errInvalidWindow)
+		}
+	}
+	c := Config{MaintenanceWindows: map[string][]Window{"Bitstamp": {{Cron: "bad", Duration: time.Hour}}}}
+	if err := c.ValidateWindows(); err == nil {
+		t.Error("expected invalid maintenance window error")
+	}
+}
+
+func scheduleTestDir(t *testing.T) func() {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldPath := ScriptPath
+	ScriptPath = dir
+	err = ioutil.WriteFile(filepath.Join(dir, "rebalance.gct"), []byte("a := 1\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		ScriptPath = oldPath
+		os.RemoveAll(dir)
+	}
+}
+
+func TestScheduleRuns(t *testing.T) {
+	defer scheduleTestDir(t)()
+	c := configHelper(true, false, maxTestVirtualMachines)
+	c.MaintenanceWindows = map[string][]Window{
+		"bitstamp": {{Cron: "0 4 * * *", Duration: 30 * time.Minute}},
+	}
+	manager := GctScriptManager{config: c, started: 1}
+
+	if err := manager.AddSchedule(&Schedule{Name: "rebalance", Script: "rebalance"}); !errors.Is(err, errScheduleTimeInvalid) {
+		t.Errorf("received %v expected %v", err, errScheduleTimeInvalid)
+	}
+	if err := manager.AddSchedule(&Schedule{Name: "rebalance", Script: "missing", Cron: "@hourly"}); err == nil {
+		t.Error("expected missing script error")
+	}
+	err := manager.AddSchedule(&Schedule{Name: "rebalance", Script: "rebalance", RunAt: time.Now().Add(-time.Minute)})
+	if !errors.Is(err, errScheduleInPast) {
+		t.Errorf("received %v expected %v", err, errScheduleInPast)
+	}
+
+	s := &Schedule{
+		Name:      "rebalance",
+		Script:    "rebalance",
+		Cron:      "0 */4 * * *",
+		Exchanges: []string{"Bitstamp"},
+	}
+	if err = manager.AddSchedule(s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Script != "rebalance.gct" || !s.Enabled || s.NextRun.IsZero() {
+		t.Errorf("unexpected schedule %+v", s)
+	}
+
+	midnight := time.Date(2020, 12, 17, 0, 0, 0, 0, time.UTC)
+	s.nextRun(midnight.Add(-time.Minute))
+	manager.runDueSchedules(midnight.Add(-time.Minute))
+	if !s.LastRun.IsZero() {
+		t.Error("expected schedule not to run before it is due")
+	}
+	manager.runDueSchedules(midnight)
+	if s.LastStatus != ScheduleStatusStarted || !s.LastRun.Equal(midnight) {
+		t.Errorf("received %v expected %v", s.LastStatus, ScheduleStatusStarted)
+	}
+	if !s.NextRun.Equal(midnight.Add(4 * time.Hour)) {
+		t.Errorf("received %v expected %v", s.NextRun, midnight.Add(4*time.Hour))
+	}
+	manager.runDueSchedules(s.NextRun)
+	if !strings.HasPrefix(s.LastStatus, ScheduleStatusSkipped) {
+		t.Errorf("received %v expected %v", s.LastStatus, ScheduleStatusSkipped)
+	}
+
+	s.SkipWindows = []Window{{Cron: "0 8 * * *", Duration: time.Minute}}
+	manager.runDueSchedules(s.NextRun)
+	if !strings.HasPrefix(s.LastStatus, ScheduleStatusSkipped) {
+		t.Errorf("received %v expected %v", s.LastStatus, ScheduleStatusSkipped)
+	}
+
+	once := &Schedule{Name: "once", Script: "rebalance.gct", RunAt: time.Now().Add(time.Hour)}
+	if err = manager.AddSchedule(once); err != nil {
+		t.Fatal(err)
+	}
+	manager.runDueSchedules(once.RunAt)
+	if once.LastStatus != ScheduleStatusStarted || once.Enabled || !once.NextRun.IsZero() {
+		t.Errorf("unexpected one-off schedule %+v", once)
+	}
+
+	if len(manager.Schedules()) != 2 {
+		t.Errorf("received %v expected %v schedules", len(manager.Schedules()), 2)
+	}
+	if err = manager.RemoveSchedule("once"); err != nil {
+		t.Error(err)
+	}
+	if err = manager.RemoveSchedule("once"); !errors.Is(err, ErrScheduleNotFound) {
+		t.Errorf("received %v expected %v", err, ErrScheduleNotFound)
+	}
+	waitForScripts(t)
+}
+
+// waitForScripts waits for the scripts started from ScriptPath to finish
+func waitForScripts(t *testing.T) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		running := false
+		AllVMSync.Range(func(_, v interface{}) bool {
+			running = strings.HasPrefix(v.(*VM).File, ScriptPath)
+			return !running
+		})
+		if !running {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("scheduled scripts did not finish")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestLoadSchedules(t *testing.T) {
+	defer scheduleTestDir(t)()
+	testhelpers.TempDir = ScriptPath
+	testhelpers.MigrationDir = filepath.Join("..", "..", "database", "migrations")
+	dbConn, err := testhelpers.ConnectToDatabase(&database.Config{
+		Driver:            database.DBSQLite3,
+		ConnectionDetails: drivers.ConnectionDetails{Database: "schedules.db"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = testhelpers.CloseDatabase(dbConn); err != nil {
+			t.Error(err)
+		}
+		database.DB.SQL = nil
+	}()
+
+	manager := GctScriptManager{config: configHelper(true, false, maxTestVirtualMachines), started: 1}
+	err = manager.AddSchedule(&Schedule{
+		Name:        "rebalance",
+		Script:      "rebalance.gct",
+		Cron:        "@daily",
+		SkipWindows: []Window{{Cron: "0 0 * * 0", Duration: time.Hour}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a one-off run which was due while the manager was stopped
+	err = scriptschedule.Upsert(&scriptschedule.Schedule{
+		Name:       "missed",
+		ScriptName: "rebalance.gct",
+		RunAt:      time.Now().Add(-time.Hour),
+		Enabled:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := GctScriptManager{config: manager.config, started: 1}
+	loaded.loadSchedules()
+	schedules := loaded.Schedules()
+	if len(schedules) != 2 {
+		t.Fatalf("received %v expected %v schedules", len(schedules), 2)
+	}
+	if schedules[0].LastStatus != ScheduleStatusMissed || schedules[0].Enabled {
+		t.Errorf("unexpected missed schedule %+v", schedules[0])
+	}
+	if !schedules[1].Enabled || schedules[1].NextRun.IsZero() || len(schedules[1].SkipWindows) != 1 {
+		t.Errorf("unexpected schedule %+v", schedules[1])
+	}
+	stored, err := scriptschedule.All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored[0].LastStatus != ScheduleStatusMissed || stored[0].Enabled {
+		t.Errorf("expected missed status to be stored %+v", stored[0])
+	}
+}
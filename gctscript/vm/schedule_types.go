@@ -0,0 +1,60 @@
+package vm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/cron"
+)
+
+const (
+	// ScheduleStatusStarted is the status of a scheduled run which started the
+	// script
+	ScheduleStatusStarted = "started"
+	// ScheduleStatusSkipped is the status of a scheduled run within a skip
+	// window
+	ScheduleStatusSkipped = "skipped"
+	// ScheduleStatusFailed is the status of a scheduled run where the script
+	// could not be started
+	ScheduleStatusFailed = "failed"
+	// ScheduleStatusMissed is the status of a one-off run which was due while
+	// the script manager was not running
+	ScheduleStatusMissed = "missed"
+)
+
+var (
+	// ErrScheduleNotFound is returned when no schedule has the name
+	ErrScheduleNotFound = errors.New("schedule not found")
+
+	errScheduleNameUnset   = errors.New("schedule name must be set")
+	errScheduleTimeInvalid = errors.New("schedule requires either a cron expression or a run time")
+	errScheduleInPast      = errors.New("schedule run time must be in the future")
+	errInvalidWindow       = errors.New("skip window requires a cron expression and duration or a start and end time")
+)
+
+// Window is a period in which scheduled runs are skipped, either recurring
+// for Duration from each time matching Cron or once between Start and End
+type Window struct {
+	Cron     string        `json:"cron,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+	Start    time.Time     `json:"start,omitempty"`
+	End      time.Time     `json:"end,omitempty"`
+}
+
+// Schedule runs a script on each time matching a cron expression or once at
+// RunAt, skipping runs within its skip windows or the maintenance windows of
+// its exchanges. Times are evaluated in UTC
+type Schedule struct {
+	Name        string
+	Script      string
+	Cron        string
+	RunAt       time.Time
+	Exchanges   []string
+	SkipWindows []Window
+	Enabled     bool
+	LastRun     time.Time
+	LastStatus  string
+	NextRun     time.Time
+
+	cron *cron.Schedule
+}
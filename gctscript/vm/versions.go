@@ -0,0 +1,198 @@
+package vm
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptversion"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+var (
+	// ErrScriptNotSigned is returned when signed scripts are required and a
+	// script has no valid signature from a configured public key
+	ErrScriptNotSigned = errors.New("script is not signed by a configured public key")
+
+	errInvalidPublicKey = errors.New("invalid public key")
+	errNoPublicKeys     = errors.New("no public keys configured")
+	errInvalidSignature = errors.New("signature does not match a configured public key")
+	errInvalidPath      = errors.New("invalid script path")
+)
+
+// ValidateSigning checks the configured public keys are hex encoded ed25519
+// keys and that keys are configured when signed scripts are required
+func (c *Config) ValidateSigning() error {
+	if c.RequireSignedScripts && len(c.PublicKeys) == 0 {
+		return errNoPublicKeys
+	}
+	for x := range c.PublicKeys {
+		key, err := hex.DecodeString(c.PublicKeys[x])
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return fmt.Errorf("%w %s", errInvalidPublicKey, c.PublicKeys[x])
+		}
+	}
+	return nil
+}
+
+// Hash returns the hex encoded SHA256 hash of script data
+func Hash(data []byte) string {
+	return hex.EncodeToString(crypto.GetSHA256(data))
+}
+
+// VerifySignature returns the configured public key which produced the ed25519
+// signature of data. Unsigned data returns no signer unless signed scripts are
+// required
+func (g *GctScriptManager) VerifySignature(data, signature []byte) (string, error) {
+	if len(signature) == 0 {
+		if g.config.RequireSignedScripts {
+			return "", ErrScriptNotSigned
+		}
+		return "", nil
+	}
+	if len(g.config.PublicKeys) == 0 {
+		return "", errNoPublicKeys
+	}
+	for x := range g.config.PublicKeys {
+		key, err := hex.DecodeString(g.config.PublicKeys[x])
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return "", fmt.Errorf("%w %s", errInvalidPublicKey, g.config.PublicKeys[x])
+		}
+		if ed25519.Verify(key, data, signature) {
+			return g.config.PublicKeys[x], nil
+		}
+	}
+	return "", errInvalidSignature
+}
+
+// RecordVersion stores the script data as the next version of the script
+func (g *GctScriptManager) RecordVersion(name string, data []byte, author string, signature []byte, signer string) (*scriptversion.Version, error) {
+	v := &scriptversion.Version{
+		ScriptName: name,
+		Hash:       Hash(data),
+		Author:     author,
+		Data:       data,
+		Signature:  hex.EncodeToString(signature),
+		Signer:     signer,
+	}
+	err := scriptversion.Insert(v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Versions returns the stored versions of a script, newest first
+func (g *GctScriptManager) Versions(name string) ([]scriptversion.Version, error) {
+	return scriptversion.List(name)
+}
+
+// Diff returns a unified diff of two versions of a script, version zero is the
+// latest version
+func (g *GctScriptManager) Diff(name string, from, to int64) (string, error) {
+	a, err := scriptversion.Get(name, from)
+	if err != nil {
+		return "", fmt.Errorf("version %d: %w", from, err)
+	}
+	b, err := scriptversion.Get(name, to)
+	if err != nil {
+		return "", fmt.Errorf("version %d: %w", to, err)
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(a.Data)),
+		B:        difflib.SplitLines(string(b.Data)),
+		FromFile: name + "@" + strconv.FormatInt(a.Version, 10),
+		ToFile:   name + "@" + strconv.FormatInt(b.Version, 10),
+		Context:  3,
+	})
+}
+
+// Rollback restores a previous version of a script to the script path,
+// recording it as a new version by the author. Running virtual machines are
+// not reloaded
+func (g *GctScriptManager) Rollback(name string, version int64, author string) (*scriptversion.Version, error) {
+	path, err := scriptFilePath(name)
+	if err != nil {
+		return nil, err
+	}
+	v, err := scriptversion.Get(name, version)
+	if err != nil {
+		return nil, err
+	}
+	if g.config.RequireSignedScripts && !g.config.trustedSigner(v.Signer) {
+		return nil, ErrScriptNotSigned
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0770)
+	if err != nil {
+		return nil, err
+	}
+	err = ioutil.WriteFile(path, v.Data, 0600)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := hex.DecodeString(v.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return g.RecordVersion(name, v.Data, author, signature, v.Signer)
+}
+
+// trustedSigner returns whether the signer is a configured public key
+func (c *Config) trustedSigner(signer string) bool {
+	if signer == "" {
+		return false
+	}
+	for x := range c.PublicKeys {
+		if c.PublicKeys[x] == signer {
+			return true
+		}
+	}
+	return false
+}
+
+// ScriptName returns the name versions of the script file are stored under,
+// its path relative to the script path
+func ScriptName(file string) string {
+	rel, err := filepath.Rel(ScriptPath, file)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return filepath.Base(file)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// scriptFilePath returns the path of a named script within the script path
+func scriptFilePath(name string) (string, error) {
+	path := filepath.Join(ScriptPath, filepath.FromSlash(name))
+	if !strings.HasPrefix(path, filepath.Clean(ScriptPath)+string(os.PathSeparator)) {
+		return "", fmt.Errorf("%w %s", errInvalidPath, name)
+	}
+	return path, nil
+}
+
+// checkSigned returns an error when signed scripts are required and the
+// script does not match the latest version signed by a configured public key.
+// Validation and test runs are not checked
+func (vm *VM) checkSigned(data []byte) error {
+	if !vm.config.RequireSignedScripts || vm.test != nil || validator.IsTestExecution.Load() == true {
+		return nil
+	}
+	v, err := scriptversion.Get(ScriptName(vm.File), 0)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrScriptNotSigned, err)
+	}
+	if v.Hash != Hash(data) {
+		return fmt.Errorf("%w: script does not match version %d", ErrScriptNotSigned, v.Version)
+	}
+	if !vm.config.trustedSigner(v.Signer) {
+		return ErrScriptNotSigned
+	}
+	return nil
+}
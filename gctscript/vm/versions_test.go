@@ -0,0 +1,185 @@
+package vm
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+func TestValidateSigning(t *testing.T) {
+	t.Parallel()
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Config{RequireSignedScripts: true}
+	if err = c.ValidateSigning(); !errors.Is(err, errNoPublicKeys) {
+		t.Errorf("received %v expected %v", err, errNoPublicKeys)
+	}
+	c.PublicKeys = []string{"abcd"}
+	if err = c.ValidateSigning(); !errors.Is(err, errInvalidPublicKey) {
+		t.Errorf("received %v expected %v", err, errInvalidPublicKey)
+	}
+	c.PublicKeys = []string{hex.EncodeToString(public)}
+	if err = c.ValidateSigning(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, other, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("a := 1")
+	g := GctScriptManager{config: &Config{}}
+	signer, err := g.VerifySignature(data, nil)
+	if err != nil || signer != "" {
+		t.Errorf("received %v %v expected unsigned script to be accepted", signer, err)
+	}
+	if _, err = g.VerifySignature(data, ed25519.Sign(private, data)); !errors.Is(err, errNoPublicKeys) {
+		t.Errorf("received %v expected %v", err, errNoPublicKeys)
+	}
+
+	g.config.RequireSignedScripts = true
+	g.config.PublicKeys = []string{hex.EncodeToString(public)}
+	if _, err = g.VerifySignature(data, nil); !errors.Is(err, ErrScriptNotSigned) {
+		t.Errorf("received %v expected %v", err, ErrScriptNotSigned)
+	}
+	if _, err = g.VerifySignature(data, ed25519.Sign(other, data)); !errors.Is(err, errInvalidSignature) {
+		t.Errorf("received %v expected %v", err, errInvalidSignature)
+	}
+	signer, err = g.VerifySignature(data, ed25519.Sign(private, data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer != g.config.PublicKeys[0] {
+		t.Errorf("received %v expected %v", signer, g.config.PublicKeys[0])
+	}
+}
+
+func TestScriptVersions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	oldPath := ScriptPath
+	ScriptPath = dir
+	defer func() { ScriptPath = oldPath }()
+
+	testhelpers.TempDir = dir
+	testhelpers.MigrationDir = filepath.Join("..", "..", "database", "migrations")
+	dbConn, err := testhelpers.ConnectToDatabase(&database.Config{
+		Driver:            database.DBSQLite3,
+		ConnectionDetails: drivers.ConnectionDetails{Database: "versions.db"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = testhelpers.CloseDatabase(dbConn); err != nil {
+			t.Error(err)
+		}
+		database.DB.SQL = nil
+	}()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := configHelper(true, true, maxTestVirtualMachines)
+	c.RequireSignedScripts = true
+	c.PublicKeys = []string{hex.EncodeToString(public)}
+	manager := GctScriptManager{config: c, started: 1}
+
+	script := filepath.Join(dir, "signed.gct")
+	load := func() error {
+		return manager.New().Load(script)
+	}
+	write := func(data string) {
+		if err = ioutil.WriteFile(script, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("a := 1\n")
+	if err = load(); !errors.Is(err, ErrScriptNotSigned) {
+		t.Errorf("received %v expected %v", err, ErrScriptNotSigned)
+	}
+	v1 := []byte("a := 1\n")
+	signer, err := manager.VerifySignature(v1, ed25519.Sign(private, v1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := manager.RecordVersion(ScriptName(script), v1, "author", ed25519.Sign(private, v1), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Version != 1 || v.ScriptName != "signed.gct" || v.Hash != Hash(v1) {
+		t.Errorf("unexpected version %+v", v)
+	}
+	if err = load(); err != nil {
+		t.Error(err)
+	}
+
+	write("a := 2\n")
+	if err = load(); !errors.Is(err, ErrScriptNotSigned) {
+		t.Errorf("received %v expected %v", err, ErrScriptNotSigned)
+	}
+	_, err = manager.RecordVersion("signed.gct", []byte("a := 2\n"), "author", nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = load(); !errors.Is(err, ErrScriptNotSigned) {
+		t.Errorf("received %v expected %v", err, ErrScriptNotSigned)
+	}
+
+	diff, err := manager.Diff("signed.gct", 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(diff, "-a := 1") || !strings.Contains(diff, "+a := 2") {
+		t.Errorf("unexpected diff %s", diff)
+	}
+
+	if _, err = manager.Rollback("signed.gct", 2, "reviewer"); !errors.Is(err, ErrScriptNotSigned) {
+		t.Errorf("received %v expected %v", err, ErrScriptNotSigned)
+	}
+	v, err = manager.Rollback("signed.gct", 1, "reviewer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Version != 3 || v.Author != "reviewer" || v.Signer != signer {
+		t.Errorf("unexpected version %+v", v)
+	}
+	if err = load(); err != nil {
+		t.Error(err)
+	}
+	versions, err := manager.Versions("signed.gct")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 3 {
+		t.Errorf("received %v expected %v versions", len(versions), 3)
+	}
+
+	if _, err = manager.Rollback("../signed.gct", 1, "reviewer"); !errors.Is(err, errInvalidPath) {
+		t.Errorf("received %v expected %v", err, errInvalidPath)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"io/ioutil"
 	"path/filepath"
 	"sync/atomic"
@@ -15,7 +16,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	scriptevent "github.com/thrasher-corp/gocryptotrader/database/repository/script"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/loader"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/volatiletech/null"
@@ -45,7 +48,6 @@ func (g *GctScriptManager) NewVM() (vm *VM) {
 
 	vm = &VM{
 		ID:         newUUID,
-		Script:     pool.Get().(*tengo.Script),
 		config:     g.config,
 		unregister: func() error { return g.RemoveVM(newUUID) },
 	}
@@ -82,21 +84,33 @@ func (vm *VM) Load(file string) error {
 
 	vm.File = file
 	vm.Path = filepath.Dir(file)
-	vm.Script = tengo.NewScript(code)
-	scriptctx := vm.ShortName() + "-" + vm.ID.String()
-	err = vm.Script.Add("ctx", scriptctx)
+	err = vm.checkSigned(code)
 	if err != nil {
-		return err
+		return &Error{
+			Action: "Load: Signature",
+			Script: file,
+			Cause:  err,
+		}
 	}
-
-	vm.Script.SetImports(loader.GetModuleMap())
+	vm.source = code
+	vm.variables = map[string]interface{}{
+		"ctx": vm.ShortName() + "-" + vm.ID.String(),
+	}
+	vm.modules, err = vm.moduleMap()
+	if err != nil {
+		return &Error{
+			Action: "Load: Permissions",
+			Script: file,
+			Cause:  err,
+		}
+	}
+	vm.modules.AddBuiltinModule("events", vm.eventsModule())
+	vm.modules.AddBuiltinModule("messaging", vm.messagingModule())
+	vm.modules.AddBuiltinModule("assert", vm.assertModule())
 	vm.Hash = vm.getHash()
 
-	if vm.config.AllowImports {
-		if vm.config.Verbose {
-			log.Debugf(log.GCTScriptMgr, "File imports enabled for vm: %v", vm.ID)
-		}
-		vm.Script.EnableFileImport(true)
+	if vm.config.AllowImports && vm.config.Verbose {
+		log.Debugf(log.GCTScriptMgr, "File imports enabled for vm: %v", vm.ID)
 	}
 	vm.event(StatusSuccess, TypeLoad)
 	return nil
@@ -104,8 +118,21 @@ func (vm *VM) Load(file string) error {
 
 // Compile compiles to byte code loaded copy of vm script
 func (vm *VM) Compile() (err error) {
-	vm.Compiled = new(tengo.Compiled)
-	vm.Compiled, err = vm.Script.Compile()
+	if vm.source == nil {
+		return ErrNoVMLoaded
+	}
+	opts := compileOptions{
+		name:         vm.ShortName(),
+		allowImports: vm.config.AllowImports,
+		limits: limits{
+			maxAllocs:       vm.config.MaxAllocations,
+			maxInstructions: vm.config.MaxInstructions,
+		},
+	}
+	if vm.test != nil {
+		opts.coverage = vm.test.coverage
+	}
+	vm.Compiled, err = compile(vm.source, vm.variables, vm.modules, opts)
 	return
 }
 
@@ -117,6 +144,7 @@ func (vm *VM) Run() (err error) {
 
 	err = vm.Compiled.Run()
 	if err != nil {
+		vm.limitViolation(err)
 		vm.event(StatusFailure, TypeExecute)
 		return Error{
 			Action: "Run",
@@ -142,6 +170,7 @@ func (vm *VM) RunCtx() (err error) {
 
 	err = vm.Compiled.RunContext(ct)
 	if err != nil {
+		vm.limitViolation(err)
 		vm.event(StatusFailure, TypeExecute)
 		return Error{
 			Action: "RunCtx",
@@ -170,6 +199,7 @@ func (vm *VM) CompileAndRun() {
 	err = vm.RunCtx()
 	if err != nil {
 		log.Error(log.GCTScriptMgr, err)
+		vm.unsubscribeAll()
 		err = vm.unregister()
 		if err != nil {
 			log.Error(log.GCTScriptMgr, err)
@@ -195,6 +225,11 @@ func (vm *VM) CompileAndRun() {
 			return
 		}
 		vm.runner()
+	} else if vm.hasSubscriptions() {
+		// scripts with event callbacks remain loaded until stopped
+		if vm.config.Verbose {
+			log.Debugf(log.GCTScriptMgr, "Script: %s ID: %v waiting for events", vm.ShortName(), vm.ID)
+		}
 	} else {
 		err = vm.Shutdown()
 		if err != nil {
@@ -215,8 +250,7 @@ func (vm *VM) Shutdown() error {
 	if vm.config.Verbose {
 		log.Debugf(log.GCTScriptMgr, "Shutting down script: %s ID: %v", vm.ShortName(), vm.ID)
 	}
-	vm.Script = nil
-	pool.Put(vm.Script)
+	vm.unsubscribeAll()
 	vm.event(StatusSuccess, TypeStop)
 	return vm.unregister()
 }
@@ -240,8 +274,58 @@ func (vm *VM) ShortName() string {
 	return filepath.Base(vm.File)
 }
 
+// permissions returns the permissions configured for the script, or nil if
+// the script is unrestricted
+func (vm *VM) permissions() *modules.Permissions {
+	p, ok := vm.config.Permissions[vm.ShortName()]
+	if !ok {
+		p, ok = vm.config.Permissions[AllScripts]
+	}
+	if !ok {
+		return nil
+	}
+	return &p
+}
+
+// moduleMap returns the modules available to the script, scripts with
+// permissions configured are given a wrapper restricted to them
+func (vm *VM) moduleMap() (*tengo.ModuleMap, error) {
+	wrapper := wrappers.GetWrapper
+	if vm.test != nil {
+		wrapper = vm.test.testWrapper
+	}
+	p := vm.permissions()
+	if p == nil {
+		return loader.GetModuleMapWithWrapper(wrapper, vm.ShortName()), nil
+	}
+	restricted, err := wrappers.NewRestricted(p, wrapper, func(err error) {
+		vm.violation(StatusPermissionDenied, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loader.GetModuleMapWithWrapper(func() modules.GCT { return restricted }, vm.ShortName()), nil
+}
+
+// limitViolation records a violation if the error was caused by the script
+// exceeding a VM limit
+func (vm *VM) limitViolation(err error) {
+	switch {
+	case errors.Is(err, tengo.ErrObjectAllocLimit):
+		vm.violation(StatusAllocationLimit, err)
+	case errors.Is(err, ErrInstructionLimit):
+		vm.violation(StatusInstructionLimit, err)
+	}
+}
+
+// violation logs and records a script breaking its permissions or VM limits
+func (vm *VM) violation(status string, err error) {
+	log.Warnf(log.GCTScriptMgr, "Script: %s ID: %v %s: %v", vm.ShortName(), vm.ID, status, err)
+	vm.event(status, TypeViolation)
+}
+
 func (vm *VM) event(status, executionType string) {
-	if validator.IsTestExecution.Load() == true {
+	if validator.IsTestExecution.Load() == true || vm.test != nil {
 		return
 	}
 
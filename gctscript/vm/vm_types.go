@@ -2,11 +2,13 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
 	"github.com/d5/tengo/v2"
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 )
 
 const (
@@ -25,39 +27,76 @@ const (
 	TypeStop = "stop"
 	// TypeRead text to display in script_event table when a script contents is read
 	TypeRead = "read"
+	// TypeViolation text to display in script_event table when a script breaks
+	// its permissions or VM limits
+	TypeViolation = "violation"
 
 	// StatusSuccess text to display in script_event table on successful execution
 	StatusSuccess = "success"
 	// StatusFailure text to display in script_event table when script execution fails
 	StatusFailure = "failure"
+	// StatusPermissionDenied text to display in script_event table when a script
+	// calls the wrapper outside of its permissions
+	StatusPermissionDenied = "permission denied"
+	// StatusAllocationLimit text to display in script_event table when a script
+	// exceeds the allocation limit
+	StatusAllocationLimit = "allocation limit exceeded"
+	// StatusInstructionLimit text to display in script_event table when a script
+	// exceeds the instruction limit
+	StatusInstructionLimit = "instruction limit exceeded"
 )
 
 type vmscount int32
 
 var (
-	pool = &sync.Pool{
-		New: func() interface{} {
-			return new(tengo.Script)
-		},
-	}
 	// AllVMSync stores all current Virtual Machine instances
 	AllVMSync = &sync.Map{}
 	// VMSCount running total count of Virtual Machines
 	VMSCount vmscount
+
+	errCallbackNotCallable = errors.New("event callback is not callable")
 )
 
-// VM contains a pointer to "script" (precompiled source) and "compiled" (compiled byte code) instances
+// VM contains the script source, its compiled byte code and the event
+// subscriptions registered by the script
 type VM struct {
 	ID         uuid.UUID
 	Hash       string
 	File       string
 	Path       string
-	Script     *tengo.Script
-	Compiled   *tengo.Compiled
+	Compiled   *Compiled
 	ctx        context.Context
 	T          time.Duration
 	NextRun    time.Time
 	S          chan struct{}
 	config     *Config
 	unregister func() error
+
+	source    []byte
+	variables map[string]interface{}
+	modules   *tengo.ModuleMap
+
+	subM          sync.Mutex
+	subscriptions map[string]*subscription
+	wg            sync.WaitGroup
+	// test is set when the script is run by the test harness
+	test *testRun
+}
+
+// eventConverter converts dispatched data to the object passed to a script
+// callback, returning false if the data is not relevant to the subscription
+type eventConverter func(data interface{}) (tengo.Object, bool)
+
+// subscription is a script callback registered against a dispatch pipe
+type subscription struct {
+	id       string
+	key      string
+	callback tengo.Object
+	pipe     dispatch.Pipe
+	convert  eventConverter
+	shutdown chan struct{}
+	// queue is the number of events buffered for the callback, zero events
+	// are delivered directly from the pipe
+	queue   int
+	dropped int64
 }
@@ -0,0 +1,105 @@
+package comms
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"golang.org/x/time/rate"
+)
+
+var errEmptyMessage = errors.New("notification message is empty")
+
+// Comms implements script notifications through the engine communications
+// manager for Wrapper
+type Comms struct {
+	m        sync.Mutex
+	limiters map[string]*limiter
+}
+
+// limiter is a script's notification rate limiter along with the limit it
+// was created with so configuration changes take effect
+type limiter struct {
+	*rate.Limiter
+	limit    int
+	interval time.Duration
+	last     time.Time
+}
+
+// CommsMediums returns the names of the enabled and connected communication
+// relayers
+func (c *Comms) CommsMediums() []string {
+	status, err := engine.Bot.CommsManager.GetStatus()
+	if err != nil {
+		return nil
+	}
+	var mediums []string
+	for name, s := range status {
+		if s.Enabled && s.Connected {
+			mediums = append(mediums, name)
+		}
+	}
+	sort.Strings(mediums)
+	return mediums
+}
+
+// Notify renders the notification and pushes it to the communications
+// manager if the script is within its rate limit
+func (c *Comms) Notify(script string, n *modules.Notification) error {
+	cfg := &engine.Bot.Config.GCTScript.Comms
+	message := n.Message
+	if n.Template != "" {
+		var err error
+		message, err = cfg.Render(n.Template, n.Data)
+		if err != nil {
+			return err
+		}
+	}
+	if message == "" {
+		return errEmptyMessage
+	}
+	if !c.allow(script, cfg) {
+		return fmt.Errorf("%s %w", script, modules.ErrRateLimited)
+	}
+	return engine.Bot.CommsManager.Notify(base.Event{
+		Type:    "GCTScript " + script,
+		Message: message,
+	}, n.Mediums...)
+}
+
+// allow returns true if the script can send a notification now. Scripts are
+// identified by name, which is bound to the script's modules rather than
+// supplied by the script
+func (c *Comms) allow(script string, cfg *vm.CommsConfig) bool {
+	limit, interval := cfg.Limit()
+	now := time.Now()
+	c.m.Lock()
+	defer c.m.Unlock()
+	// a limiter unused for its interval has recovered its full burst, so it
+	// is removed rather than kept for scripts which may never run again
+	for name, l := range c.limiters {
+		if now.Sub(l.last) >= l.interval {
+			delete(c.limiters, name)
+		}
+	}
+	l, ok := c.limiters[script]
+	if !ok || l.limit != limit || l.interval != interval {
+		if c.limiters == nil {
+			c.limiters = make(map[string]*limiter)
+		}
+		l = &limiter{
+			Limiter:  rate.NewLimiter(rate.Every(interval/time.Duration(limit)), limit),
+			limit:    limit,
+			interval: interval,
+		}
+		c.limiters[script] = l
+	}
+	l.last = now
+	return l.AllowN(now, 1)
+}
@@ -0,0 +1,73 @@
+package comms
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+)
+
+func TestAllow(t *testing.T) {
+	t.Parallel()
+	var c Comms
+	cfg := &vm.CommsConfig{RateLimit: 2, RateInterval: time.Hour}
+	for i := 0; i < 2; i++ {
+		if !c.allow("signal.gct", cfg) {
+			t.Fatalf("notification %d should be allowed", i)
+		}
+	}
+	if c.allow("signal.gct", cfg) {
+		t.Error("expected notification to be rate limited")
+	}
+	if !c.allow("trader.gct", cfg) {
+		t.Error("expected rate limits to apply per script")
+	}
+	// a changed limit replaces the script's limiter
+	cfg.RateLimit = 3
+	if !c.allow("signal.gct", cfg) {
+		t.Error("expected changed rate limit to apply")
+	}
+	// limiters which have recovered are removed
+	c.limiters["trader.gct"].last = time.Now().Add(-time.Hour)
+	c.allow("signal.gct", cfg)
+	if _, ok := c.limiters["trader.gct"]; ok || len(c.limiters) != 1 {
+		t.Errorf("expected recovered limiter to be removed, have %d limiters", len(c.limiters))
+	}
+}
+
+func TestNotify(t *testing.T) {
+	engine.Bot = &engine.Engine{Config: &config.Config{}}
+	engine.Bot.Config.GCTScript.Comms = vm.CommsConfig{
+		RateLimit:    1,
+		RateInterval: time.Hour,
+		Templates:    map[string]string{"signal": "{{.pair}} RSI {{.rsi}}"},
+	}
+	var c Comms
+	err := c.Notify("signal.gct", &modules.Notification{})
+	if !errors.Is(err, errEmptyMessage) {
+		t.Errorf("received %v expected %v", err, errEmptyMessage)
+	}
+	err = c.Notify("signal.gct", &modules.Notification{Template: "signal"})
+	if err == nil {
+		t.Error("expected template error for missing data")
+	}
+	// the communications manager is not started
+	err = c.Notify("signal.gct", &modules.Notification{
+		Template: "signal",
+		Data:     map[string]interface{}{"pair": "BTC-USD", "rsi": 28.5},
+	})
+	if err == nil || errors.Is(err, modules.ErrRateLimited) {
+		t.Errorf("received %v expected communications manager error", err)
+	}
+	err = c.Notify("signal.gct", &modules.Notification{Message: "hello"})
+	if !errors.Is(err, modules.ErrRateLimited) {
+		t.Errorf("received %v expected %v", err, modules.ErrRateLimited)
+	}
+	if len(c.CommsMediums()) != 0 {
+		t.Error("expected no mediums when the communications manager is not started")
+	}
+}
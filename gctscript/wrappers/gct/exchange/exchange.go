@@ -16,10 +16,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
+var errUnsupportedFeeType = errors.New("unsupported fee type")
+
 // Exchange implements all required methods for Wrapper
 type Exchange struct{}
 
@@ -53,6 +57,16 @@ func (e Exchange) Orderbook(exch string, pair currency.Pair, item asset.Item) (*
 	return engine.Bot.GetSpecificOrderbook(pair, exch, item)
 }
 
+// OrderbookAnalytics returns analytics calculated directly against the stored
+// orderbook depth for the requested exchange, pair and asset
+func (e Exchange) OrderbookAnalytics(exch string, pair currency.Pair, item asset.Item, req *orderbook.AnalyticsRequest) (*orderbook.Analytics, error) {
+	depth, err := orderbook.GetDepth(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	return depth.GetAnalytics(req)
+}
+
 // Ticker returns ticker for provided currency pair & asset type
 func (e Exchange) Ticker(exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
 	ex, err := e.GetExchange(exch)
@@ -218,7 +232,7 @@ func (e Exchange) OHLCV(exch string, pair currency.Pair, item asset.Item, start,
 	if err != nil {
 		return kline.Item{}, err
 	}
-	ret, err := ex.GetHistoricCandlesExtended(pair, item, start, end, interval)
+	ret, err := exchange.GetHistoricCandlesWithResample(ex, pair, item, start, end, interval, true)
 	if err != nil {
 		return kline.Item{}, err
 	}
@@ -231,3 +245,106 @@ func (e Exchange) OHLCV(exch string, pair currency.Pair, item asset.Item, start,
 
 	return ret, nil
 }
+
+// ModifyOrder modifies an existing order on the exchange returning the order
+// ID which may change depending on the exchange
+func (e Exchange) ModifyOrder(mod *order.Modify) (string, error) {
+	ex, err := e.GetExchange(mod.Exchange)
+	if err != nil {
+		return "", err
+	}
+	return ex.ModifyOrder(mod)
+}
+
+// CancelBatchOrders cancels multiple orders on the exchange in one request
+func (e Exchange) CancelBatchOrders(exch string, cancels []order.Cancel) (order.CancelBatchResponse, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return order.CancelBatchResponse{}, err
+	}
+	for x := range cancels {
+		cancels[x].Exchange = ex.GetName()
+	}
+	return ex.CancelBatchOrders(cancels)
+}
+
+// GetActiveOrders returns open orders on the exchange
+func (e Exchange) GetActiveOrders(exch string, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetActiveOrders(req)
+}
+
+// GetOrderHistory returns historic orders on the exchange
+func (e Exchange) GetOrderHistory(exch string, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetOrderHistory(req)
+}
+
+// GetRecentTrades returns the most recent trades for the currency pair
+func (e Exchange) GetRecentTrades(exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetRecentTrades(pair, item)
+}
+
+// GetHistoricTrades returns trades for the currency pair between start and end
+func (e Exchange) GetHistoricTrades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetHistoricTrades(pair, item, start, end)
+}
+
+// GetFeeByType returns the fee for the requested fee type
+func (e Exchange) GetFeeByType(exch string, req *modules.FeeRequest) (float64, error) {
+	var feeType exchange.FeeType
+	switch req.Type {
+	case modules.TradeFee:
+		feeType = exchange.CryptocurrencyTradeFee
+	case modules.OfflineTradeFee:
+		feeType = exchange.OfflineTradeFee
+	case modules.CryptoDepositFee:
+		feeType = exchange.CyptocurrencyDepositFee
+	case modules.CryptoWithdrawalFee:
+		feeType = exchange.CryptocurrencyWithdrawalFee
+	default:
+		return 0, fmt.Errorf("%w %s", errUnsupportedFeeType, req.Type)
+	}
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return 0, err
+	}
+	return ex.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       feeType,
+		Pair:          req.Pair,
+		IsMaker:       req.IsMaker,
+		PurchasePrice: req.PurchasePrice,
+		Amount:        req.Amount,
+	})
+}
+
+// GetOrderExecutionLimits returns the loaded order execution limits for the
+// currency pair
+func (e Exchange) GetOrderExecutionLimits(exch string, pair currency.Pair, item asset.Item) (order.MinMaxLevel, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return order.MinMaxLevel{}, err
+	}
+	limits, err := ex.GetOrderExecutionLimits(item, pair)
+	if err != nil {
+		return order.MinMaxLevel{}, err
+	}
+	levels := limits.Levels()
+	levels.Pair = pair
+	levels.Asset = item
+	return levels, nil
+}
@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 // change these if you wish to test another exchange and/or currency pair
@@ -113,6 +115,18 @@ func TestExchange_Orderbook(t *testing.T) {
 	}
 }
 
+func TestExchange_OrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	c, err := currency.NewPairDelimiter(pairs, delimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = exchangeTest.OrderbookAnalytics("fake", c, assetType, nil)
+	if err == nil {
+		t.Fatal("expected OrderbookAnalytics to return error for an orderbook which is not loaded")
+	}
+}
+
 func TestExchange_Pairs(t *testing.T) {
 	t.Parallel()
 	_, err := exchangeTest.Pairs(exchName, false, assetType)
@@ -200,6 +214,60 @@ func TestOHLCV(t *testing.T) {
 	}
 }
 
+func TestExchange_GetRecentTrades(t *testing.T) {
+	t.Parallel()
+	_, err := exchangeTest.GetRecentTrades("hello world", currency.NewPair(currency.BTC, currency.AUD), assetType)
+	if err == nil {
+		t.Error("expected error with unknown exchange")
+	}
+}
+
+func TestExchange_GetActiveOrders(t *testing.T) {
+	if !configureExchangeKeys() {
+		t.Skip("no exchange configured test skipped")
+	}
+	t.Parallel()
+	_, err := exchangeTest.GetActiveOrders(exchName, &order.GetOrdersRequest{
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+		AssetType: assetType,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExchange_GetFeeByType(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	_, err := exchangeTest.GetFeeByType(exchName, &modules.FeeRequest{Type: "hello world", Pair: cp})
+	if !errors.Is(err, errUnsupportedFeeType) {
+		t.Errorf("received %v expected %v", err, errUnsupportedFeeType)
+	}
+	_, err = exchangeTest.GetFeeByType(exchName, &modules.FeeRequest{
+		Type:          modules.OfflineTradeFee,
+		Pair:          cp,
+		PurchasePrice: 1000,
+		Amount:        1,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExchange_GetOrderExecutionLimits(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	_, err := exchangeTest.GetOrderExecutionLimits("hello world", cp, assetType)
+	if err == nil {
+		t.Error("expected error with unknown exchange")
+	}
+	_, err = exchangeTest.GetOrderExecutionLimits(exchName, cp, assetType)
+	if !errors.Is(err, order.ErrExchangeLimitNotLoaded) {
+		t.Errorf("received %v expected %v", err, order.ErrExchangeLimitNotLoaded)
+	}
+}
+
 func setupEngine() (err error) {
 	engine.Bot, err = engine.NewFromSettings(&settings, nil)
 	if err != nil {
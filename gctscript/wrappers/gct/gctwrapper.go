@@ -1,10 +1,16 @@
 package gct
 
-import "github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/comms"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/store"
+)
 
 // Setup returns a Wrapper
 func Setup() *Wrapper {
 	return &Wrapper{
 		&exchange.Exchange{},
+		&store.Store{},
+		&comms.Comms{},
 	}
 }
@@ -77,17 +77,17 @@ var (
 
 func TestExchangeOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangeOrderbook(exch, currencyPair, delimiter, assetType)
+	_, err := gct.Default.ExchangeOrderbook(exch, currencyPair, delimiter, assetType)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangeOrderbook(exchError, currencyPair, delimiter, assetType)
+	_, err = gct.Default.ExchangeOrderbook(exchError, currencyPair, delimiter, assetType)
 	if err != nil && errors.Is(err, errTestFailed) {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangeOrderbook()
+	_, err = gct.Default.ExchangeOrderbook()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -95,17 +95,17 @@ func TestExchangeOrderbook(t *testing.T) {
 
 func TestExchangeTicker(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangeTicker(exch, currencyPair, delimiter, assetType)
+	_, err := gct.Default.ExchangeTicker(exch, currencyPair, delimiter, assetType)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangeTicker(exchError, currencyPair, delimiter, assetType)
+	_, err = gct.Default.ExchangeTicker(exchError, currencyPair, delimiter, assetType)
 	if err != nil && errors.Is(err, errTestFailed) {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangeTicker()
+	_, err = gct.Default.ExchangeTicker()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -113,22 +113,22 @@ func TestExchangeTicker(t *testing.T) {
 
 func TestExchangeExchanges(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangeExchanges(tv)
+	_, err := gct.Default.ExchangeExchanges(tv)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangeExchanges(exch)
+	_, err = gct.Default.ExchangeExchanges(exch)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangeExchanges(fv)
+	_, err = gct.Default.ExchangeExchanges(fv)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangeExchanges()
+	_, err = gct.Default.ExchangeExchanges()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -136,17 +136,17 @@ func TestExchangeExchanges(t *testing.T) {
 
 func TestExchangePairs(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangePairs(exch, tv, assetType)
+	_, err := gct.Default.ExchangePairs(exch, tv, assetType)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangePairs(exchError, tv, assetType)
+	_, err = gct.Default.ExchangePairs(exchError, tv, assetType)
 	if err != nil && errors.Is(err, errTestFailed) {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangePairs()
+	_, err = gct.Default.ExchangePairs()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Error(err)
 	}
@@ -154,11 +154,11 @@ func TestExchangePairs(t *testing.T) {
 
 func TestAccountInfo(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangeAccountInfo()
+	_, err := gct.Default.ExchangeAccountInfo()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Fatal(err)
 	}
-	_, err = gct.ExchangeAccountInfo(exch, assetType)
+	_, err = gct.Default.ExchangeAccountInfo(exch, assetType)
 	if err != nil &&
 		!strings.Contains(err.Error(), "unset/default API keys") {
 		t.Error(err)
@@ -168,12 +168,12 @@ func TestAccountInfo(t *testing.T) {
 func TestExchangeOrderQuery(t *testing.T) {
 	t.Parallel()
 
-	_, err := gct.ExchangeOrderQuery()
+	_, err := gct.Default.ExchangeOrderQuery()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Fatal(err)
 	}
 
-	_, err = gct.ExchangeOrderQuery(exch, orderID)
+	_, err = gct.Default.ExchangeOrderQuery(exch, orderID)
 	if err != nil && err != common.ErrNotYetImplemented {
 		t.Error(err)
 	}
@@ -181,11 +181,11 @@ func TestExchangeOrderQuery(t *testing.T) {
 
 func TestExchangeOrderCancel(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangeOrderCancel()
+	_, err := gct.Default.ExchangeOrderCancel()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Fatal(err)
 	}
-	_, err = gct.ExchangeOrderCancel(exch, orderID, currencyPair, assetType)
+	_, err = gct.Default.ExchangeOrderCancel(exch, orderID, currencyPair, assetType)
 	if err != nil && err != common.ErrNotYetImplemented {
 		t.Error(err)
 	}
@@ -193,7 +193,7 @@ func TestExchangeOrderCancel(t *testing.T) {
 
 func TestExchangeOrderSubmit(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangeOrderSubmit()
+	_, err := gct.Default.ExchangeOrderSubmit()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Fatal(err)
 	}
@@ -204,7 +204,7 @@ func TestExchangeOrderSubmit(t *testing.T) {
 	orderAmount := &objects.Float{Value: 1}
 	orderAsset := &objects.String{Value: asset.Spot.String()}
 
-	_, err = gct.ExchangeOrderSubmit(exch, currencyPair, delimiter,
+	_, err = gct.Default.ExchangeOrderSubmit(exch, currencyPair, delimiter,
 		orderType, orderSide, orderPrice, orderAmount, orderID, orderAsset)
 	if err != nil &&
 		!strings.Contains(err.Error(), "unset/default API keys") {
@@ -223,13 +223,13 @@ func TestAllModuleNames(t *testing.T) {
 
 func TestExchangeDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangeDepositAddress()
+	_, err := gct.Default.ExchangeDepositAddress()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Fatal(err)
 	}
 
 	currCode := &objects.String{Value: "BTC"}
-	_, err = gct.ExchangeDepositAddress(exch, currCode)
+	_, err = gct.Default.ExchangeDepositAddress(exch, currCode)
 	if err != nil && err.Error() != "deposit address store is nil" {
 		t.Error(err)
 	}
@@ -237,7 +237,7 @@ func TestExchangeDepositAddress(t *testing.T) {
 
 func TestExchangeWithdrawCrypto(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangeWithdrawCrypto()
+	_, err := gct.Default.ExchangeWithdrawCrypto()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Fatal(err)
 	}
@@ -247,7 +247,7 @@ func TestExchangeWithdrawCrypto(t *testing.T) {
 	address := &objects.String{Value: "0xTHISISALEGITBTCADDRESSS"}
 	amount := &objects.Float{Value: 1.0}
 
-	_, err = gct.ExchangeWithdrawCrypto(exch, currCode, address, address, amount, amount, desc)
+	_, err = gct.Default.ExchangeWithdrawCrypto(exch, currCode, address, address, amount, amount, desc)
 	if err != nil {
 		t.Error(err)
 	}
@@ -255,7 +255,7 @@ func TestExchangeWithdrawCrypto(t *testing.T) {
 
 func TestExchangeWithdrawFiat(t *testing.T) {
 	t.Parallel()
-	_, err := gct.ExchangeWithdrawFiat()
+	_, err := gct.Default.ExchangeWithdrawFiat()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
 		t.Fatal(err)
 	}
@@ -264,7 +264,7 @@ func TestExchangeWithdrawFiat(t *testing.T) {
 	amount := &objects.Float{Value: 1.0}
 	desc := &objects.String{Value: "2"}
 	bankID := &objects.String{Value: "3!"}
-	_, err = gct.ExchangeWithdrawFiat(exch, currCode, desc, amount, bankID)
+	_, err = gct.Default.ExchangeWithdrawFiat(exch, currCode, desc, amount, bankID)
 	if err != nil && err.Error() != "exchange Bitstamp bank details not found for TEST" {
 		t.Error(err)
 	}
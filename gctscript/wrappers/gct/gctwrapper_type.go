@@ -1,8 +1,14 @@
 package gct
 
-import "github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/comms"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/store"
+)
 
 // Wrapper struct
 type Wrapper struct {
 	*exchange.Exchange
+	*store.Store
+	*comms.Comms
 }
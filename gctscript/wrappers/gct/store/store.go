@@ -0,0 +1,102 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+// Store implements persistent script state and read only access to stored
+// data for Wrapper
+type Store struct{}
+
+// GetState returns the value stored against the script key
+func (s Store) GetState(script, key string) (string, error) {
+	value, err := scriptstate.Get(script, key)
+	if errors.Is(err, scriptstate.ErrStateNotFound) {
+		return "", modules.ErrStateNotFound
+	}
+	return value, err
+}
+
+// SetState stores the value against the script key
+func (s Store) SetState(script, key, value string) error {
+	return scriptstate.Set(script, key, value)
+}
+
+// DeleteState removes the script key
+func (s Store) DeleteState(script, key string) error {
+	return scriptstate.Delete(script, key)
+}
+
+// StateKeys returns all keys stored by the script
+func (s Store) StateKeys(script string) ([]string, error) {
+	return scriptstate.Keys(script)
+}
+
+// StoredCandles returns candles stored in the database for the requested
+// exchange, pair, asset and interval
+func (s Store) StoredCandles(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.LoadFromDatabase(exch, pair, item, interval, start, end)
+}
+
+// StoredTrades returns trades stored in the database for the requested
+// exchange, pair and asset
+func (s Store) StoredTrades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error) {
+	return trade.GetTradesInRange(exch,
+		item.String(),
+		pair.Base.String(),
+		pair.Quote.String(),
+		start,
+		end)
+}
+
+// AuditEvents returns audit log entries between start and end
+func (s Store) AuditEvents(start, end time.Time, order string, limit int) ([]modules.AuditEvent, error) {
+	events, err := audit.GetEvent(start, end, order, limit)
+	if err != nil {
+		return nil, err
+	}
+	var resp []modules.AuditEvent
+	switch v := events.(type) {
+	case postgres.AuditEventSlice:
+		for x := range v {
+			resp = append(resp, modules.AuditEvent{
+				Type:       v[x].Type,
+				Identifier: v[x].Identifier,
+				Message:    v[x].Message,
+				Timestamp:  v[x].CreatedAt.UTC(),
+			})
+		}
+	case sqlite3.AuditEventSlice:
+		for x := range v {
+			resp = append(resp, modules.AuditEvent{
+				Type:       v[x].Type,
+				Identifier: v[x].Identifier,
+				Message:    v[x].Message,
+				Timestamp:  parseSQLiteTime(v[x].CreatedAt),
+			})
+		}
+	}
+	return resp, nil
+}
+
+// parseSQLiteTime converts a SQLite timestamp which may be stored in either
+// the default or RFC3339 format, returning a zero time if unparsable
+func parseSQLiteTime(s string) time.Time {
+	t, err := time.Parse(common.SimpleTimeFormat, s)
+	if err != nil {
+		t, _ = time.Parse(time.RFC3339, s)
+	}
+	return t.UTC()
+}
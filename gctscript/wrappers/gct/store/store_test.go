@@ -0,0 +1,40 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+func TestStateDatabaseDisabled(t *testing.T) {
+	t.Parallel()
+	var s Store
+	if _, err := s.GetState("test.gct", "key"); !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+	if err := s.SetState("test.gct", "key", "1"); !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+	if err := s.DeleteState("test.gct", "key"); !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+	if _, err := s.StateKeys("test.gct"); !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+}
+
+func TestParseSQLiteTime(t *testing.T) {
+	t.Parallel()
+	expected := time.Date(2020, 12, 15, 12, 0, 0, 0, time.UTC)
+	if r := parseSQLiteTime("2020-12-15 12:00:00"); !r.Equal(expected) {
+		t.Errorf("received %v expected %v", r, expected)
+	}
+	if r := parseSQLiteTime("2020-12-15T12:00:00Z"); !r.Equal(expected) {
+		t.Errorf("received %v expected %v", r, expected)
+	}
+	if r := parseSQLiteTime("bad"); !r.IsZero() {
+		t.Errorf("received %v expected zero time", r)
+	}
+}
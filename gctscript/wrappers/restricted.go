@@ -0,0 +1,369 @@
+package wrappers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// Restricted checks calls against a script's permissions before passing them
+// to the wrapper, calls outside of the permissions are reported to the
+// violation handler and return modules.ErrPermissionDenied
+type Restricted struct {
+	wrapper     func() modules.GCT
+	permissions modules.Permissions
+	violation   func(error)
+}
+
+// NewRestricted returns a wrapper restricted to the permissions which calls
+// the wrapper returned by fn, onViolation is called for each denied call
+func NewRestricted(p *modules.Permissions, fn func() modules.GCT, onViolation func(error)) (*Restricted, error) {
+	if p == nil {
+		return nil, fmt.Errorf("%w: nil permissions", modules.ErrPermissionDenied)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Restricted{
+		wrapper:     fn,
+		permissions: *p,
+		violation:   onViolation,
+	}, nil
+}
+
+// check runs each permission check stopping at the first failure which is
+// reported as a violation
+func (r *Restricted) check(checks ...error) error {
+	for x := range checks {
+		if checks[x] != nil {
+			if r.violation != nil {
+				r.violation(checks[x])
+			}
+			return checks[x]
+		}
+	}
+	return nil
+}
+
+// notional returns the value of an order in its quote currency, the last
+// traded price is used for orders without a price
+func (r *Restricted) notional(exch string, pair currency.Pair, a asset.Item, amount, price float64) (float64, error) {
+	if r.permissions.MaxOrderNotional == 0 {
+		return 0, nil
+	}
+	if price == 0 {
+		tick, err := r.wrapper().Ticker(exch, pair, a)
+		if err != nil {
+			return 0, fmt.Errorf("%w: cannot determine order notional: %v",
+				modules.ErrPermissionDenied, err)
+		}
+		price = tick.Last
+	}
+	return amount * price, nil
+}
+
+// Exchanges returns the permitted exchanges
+func (r *Restricted) Exchanges(enabledOnly bool) []string {
+	all := r.wrapper().Exchanges(enabledOnly)
+	var permitted []string
+	for x := range all {
+		if r.permissions.AllowsExchange(all[x]) {
+			permitted = append(permitted, all[x])
+		}
+	}
+	return permitted
+}
+
+// IsEnabled returns false for exchanges which are not permitted
+func (r *Restricted) IsEnabled(exch string) bool {
+	return r.permissions.AllowsExchange(exch) && r.wrapper().IsEnabled(exch)
+}
+
+// Orderbook returns the orderbook for a permitted pair
+func (r *Restricted) Orderbook(exch string, pair currency.Pair, item asset.Item) (*orderbook.Base, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+	return r.wrapper().Orderbook(exch, pair, item)
+}
+
+// OrderbookAnalytics returns orderbook analytics for a permitted pair
+func (r *Restricted) OrderbookAnalytics(exch string, pair currency.Pair, item asset.Item, req *orderbook.AnalyticsRequest) (*orderbook.Analytics, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+	return r.wrapper().OrderbookAnalytics(exch, pair, item, req)
+}
+
+// Ticker returns the ticker for a permitted pair
+func (r *Restricted) Ticker(exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+	return r.wrapper().Ticker(exch, pair, item)
+}
+
+// Pairs returns the pairs for a permitted exchange
+func (r *Restricted) Pairs(exch string, enabledOnly bool, item asset.Item) (*currency.Pairs, error) {
+	if err := r.check(r.permissions.CheckExchange(exch)); err != nil {
+		return nil, err
+	}
+	return r.wrapper().Pairs(exch, enabledOnly, item)
+}
+
+// QueryOrder returns the details of an order for a permitted pair
+func (r *Restricted) QueryOrder(exch, orderid string, pair currency.Pair, assetType asset.Item) (*order.Detail, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+	return r.wrapper().QueryOrder(exch, orderid, pair, assetType)
+}
+
+// SubmitOrder submits an order if trading is permitted and the order is
+// within the max order notional
+func (r *Restricted) SubmitOrder(submit *order.Submit) (*order.SubmitResponse, error) {
+	if submit == nil {
+		return nil, order.ErrSubmissionIsNil
+	}
+	err := r.check(r.permissions.CheckAccess(modules.AccessTrade),
+		r.permissions.CheckPair(submit.Exchange, submit.Pair))
+	if err != nil {
+		return nil, err
+	}
+	notional, err := r.notional(submit.Exchange, submit.Pair, submit.AssetType, submit.Amount, submit.Price)
+	if err = r.check(err, r.permissions.CheckNotional(notional)); err != nil {
+		return nil, err
+	}
+	return r.wrapper().SubmitOrder(submit)
+}
+
+// CancelOrder cancels an order if trading is permitted
+func (r *Restricted) CancelOrder(exch, orderid string, pair currency.Pair, item asset.Item) (bool, error) {
+	err := r.check(r.permissions.CheckAccess(modules.AccessTrade),
+		r.permissions.CheckPair(exch, pair))
+	if err != nil {
+		return false, err
+	}
+	return r.wrapper().CancelOrder(exch, orderid, pair, item)
+}
+
+// AccountInformation returns account holdings for a permitted exchange
+func (r *Restricted) AccountInformation(exch string, assetType asset.Item) (account.Holdings, error) {
+	if err := r.check(r.permissions.CheckExchange(exch)); err != nil {
+		return account.Holdings{}, err
+	}
+	return r.wrapper().AccountInformation(exch, assetType)
+}
+
+// DepositAddress returns a deposit address for a permitted exchange
+func (r *Restricted) DepositAddress(exch string, currencyCode currency.Code) (string, error) {
+	if err := r.check(r.permissions.CheckExchange(exch)); err != nil {
+		return "", err
+	}
+	return r.wrapper().DepositAddress(exch, currencyCode)
+}
+
+// WithdrawalFiatFunds withdraws fiat funds if withdrawals are permitted
+func (r *Restricted) WithdrawalFiatFunds(bankAccountID string, request *withdraw.Request) (string, error) {
+	if request == nil {
+		return "", withdraw.ErrRequestCannotBeNil
+	}
+	err := r.check(r.permissions.CheckAccess(modules.AccessWithdraw),
+		r.permissions.CheckExchange(request.Exchange))
+	if err != nil {
+		return "", err
+	}
+	return r.wrapper().WithdrawalFiatFunds(bankAccountID, request)
+}
+
+// WithdrawalCryptoFunds withdraws crypto funds if withdrawals are permitted
+func (r *Restricted) WithdrawalCryptoFunds(request *withdraw.Request) (string, error) {
+	if request == nil {
+		return "", withdraw.ErrRequestCannotBeNil
+	}
+	err := r.check(r.permissions.CheckAccess(modules.AccessWithdraw),
+		r.permissions.CheckExchange(request.Exchange))
+	if err != nil {
+		return "", err
+	}
+	return r.wrapper().WithdrawalCryptoFunds(request)
+}
+
+// OHLCV returns candles for a permitted pair
+func (r *Restricted) OHLCV(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return kline.Item{}, err
+	}
+	return r.wrapper().OHLCV(exch, pair, item, start, end, interval)
+}
+
+// ModifyOrder modifies an order if trading is permitted and the modified
+// order is within the max order notional
+func (r *Restricted) ModifyOrder(mod *order.Modify) (string, error) {
+	if mod == nil {
+		return "", order.ErrModifyOrderIsNil
+	}
+	err := r.check(r.permissions.CheckAccess(modules.AccessTrade),
+		r.permissions.CheckPair(mod.Exchange, mod.Pair))
+	if err != nil {
+		return "", err
+	}
+	if mod.Amount > 0 {
+		notional, err := r.notional(mod.Exchange, mod.Pair, mod.AssetType, mod.Amount, mod.Price)
+		if err = r.check(err, r.permissions.CheckNotional(notional)); err != nil {
+			return "", err
+		}
+	}
+	return r.wrapper().ModifyOrder(mod)
+}
+
+// CancelBatchOrders cancels orders if trading is permitted
+func (r *Restricted) CancelBatchOrders(exch string, cancels []order.Cancel) (order.CancelBatchResponse, error) {
+	checks := []error{r.permissions.CheckAccess(modules.AccessTrade),
+		r.permissions.CheckExchange(exch)}
+	for x := range cancels {
+		checks = append(checks, r.permissions.CheckPair(exch, cancels[x].Pair))
+	}
+	if err := r.check(checks...); err != nil {
+		return order.CancelBatchResponse{}, err
+	}
+	return r.wrapper().CancelBatchOrders(exch, cancels)
+}
+
+// GetActiveOrders returns active orders for permitted pairs
+func (r *Restricted) GetActiveOrders(exch string, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	if err := r.checkOrdersRequest(exch, req); err != nil {
+		return nil, err
+	}
+	orders, err := r.wrapper().GetActiveOrders(exch, req)
+	return r.filterOrders(orders), err
+}
+
+// GetOrderHistory returns historic orders for permitted pairs
+func (r *Restricted) GetOrderHistory(exch string, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	if err := r.checkOrdersRequest(exch, req); err != nil {
+		return nil, err
+	}
+	orders, err := r.wrapper().GetOrderHistory(exch, req)
+	return r.filterOrders(orders), err
+}
+
+func (r *Restricted) checkOrdersRequest(exch string, req *order.GetOrdersRequest) error {
+	checks := []error{r.permissions.CheckExchange(exch)}
+	if req != nil {
+		for x := range req.Pairs {
+			checks = append(checks, r.permissions.CheckPair(exch, req.Pairs[x]))
+		}
+	}
+	return r.check(checks...)
+}
+
+// filterOrders removes orders for pairs which are not permitted, requests
+// without pairs return orders for all pairs
+func (r *Restricted) filterOrders(orders []order.Detail) []order.Detail {
+	var permitted []order.Detail
+	for x := range orders {
+		if r.permissions.AllowsPair(orders[x].Pair) {
+			permitted = append(permitted, orders[x])
+		}
+	}
+	return permitted
+}
+
+// GetRecentTrades returns recent trades for a permitted pair
+func (r *Restricted) GetRecentTrades(exch string, pair currency.Pair, item asset.Item) ([]trade.Data, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+	return r.wrapper().GetRecentTrades(exch, pair, item)
+}
+
+// GetHistoricTrades returns historic trades for a permitted pair
+func (r *Restricted) GetHistoricTrades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+	return r.wrapper().GetHistoricTrades(exch, pair, item, start, end)
+}
+
+// GetFeeByType returns a fee estimate for a permitted pair
+func (r *Restricted) GetFeeByType(exch string, req *modules.FeeRequest) (float64, error) {
+	checks := []error{r.permissions.CheckExchange(exch)}
+	if req != nil {
+		checks = append(checks, r.permissions.CheckPair(exch, req.Pair))
+	}
+	if err := r.check(checks...); err != nil {
+		return 0, err
+	}
+	return r.wrapper().GetFeeByType(exch, req)
+}
+
+// GetOrderExecutionLimits returns execution limits for a permitted pair
+func (r *Restricted) GetOrderExecutionLimits(exch string, pair currency.Pair, item asset.Item) (order.MinMaxLevel, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return order.MinMaxLevel{}, err
+	}
+	return r.wrapper().GetOrderExecutionLimits(exch, pair, item)
+}
+
+// GetState returns stored script state
+func (r *Restricted) GetState(script, key string) (string, error) {
+	return r.wrapper().GetState(script, key)
+}
+
+// SetState stores script state
+func (r *Restricted) SetState(script, key, value string) error {
+	return r.wrapper().SetState(script, key, value)
+}
+
+// DeleteState removes stored script state
+func (r *Restricted) DeleteState(script, key string) error {
+	return r.wrapper().DeleteState(script, key)
+}
+
+// StateKeys returns the keys stored for a script
+func (r *Restricted) StateKeys(script string) ([]string, error) {
+	return r.wrapper().StateKeys(script)
+}
+
+// StoredCandles returns stored candles for a permitted pair
+func (r *Restricted) StoredCandles(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return kline.Item{}, err
+	}
+	return r.wrapper().StoredCandles(exch, pair, item, start, end, interval)
+}
+
+// StoredTrades returns stored trades for a permitted pair
+func (r *Restricted) StoredTrades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error) {
+	if err := r.check(r.permissions.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+	return r.wrapper().StoredTrades(exch, pair, item, start, end)
+}
+
+// AuditEvents returns stored audit events
+func (r *Restricted) AuditEvents(start, end time.Time, orderBy string, limit int) ([]modules.AuditEvent, error) {
+	return r.wrapper().AuditEvents(start, end, orderBy, limit)
+}
+
+// CommsMediums returns the enabled communication relayers
+func (r *Restricted) CommsMediums() []string {
+	return r.wrapper().CommsMediums()
+}
+
+// Notify sends a script notification, notifications are rate limited per
+// script rather than restricted by permissions
+func (r *Restricted) Notify(script string, n *modules.Notification) error {
+	return r.wrapper().Notify(script, n)
+}
@@ -0,0 +1,201 @@
+package wrappers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const testExchange = "Bitstamp"
+
+var (
+	btcusd = currency.NewPair(currency.BTC, currency.USD)
+	ethusd = currency.NewPair(currency.ETH, currency.USD)
+)
+
+func testWrapper() modules.GCT {
+	return validator.Wrapper{}
+}
+
+func newTestRestricted(t *testing.T, p *modules.Permissions) (r *Restricted, violations *int) {
+	t.Helper()
+	violations = new(int)
+	r, err := NewRestricted(p, testWrapper, func(err error) {
+		if !errors.Is(err, modules.ErrPermissionDenied) {
+			t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+		}
+		*violations++
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, violations
+}
+
+func TestNewRestricted(t *testing.T) {
+	t.Parallel()
+	_, err := NewRestricted(nil, testWrapper, nil)
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	_, err = NewRestricted(&modules.Permissions{Access: "admin"}, testWrapper, nil)
+	if err == nil {
+		t.Error("expected invalid access level error")
+	}
+	_, err = NewRestricted(&modules.Permissions{Access: modules.AccessRead}, testWrapper, nil)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRestrictedRead(t *testing.T) {
+	t.Parallel()
+	r, violations := newTestRestricted(t, &modules.Permissions{
+		Exchanges: []string{testExchange},
+		Pairs:     []string{"BTC-USD"},
+		Access:    modules.AccessRead,
+	})
+
+	if _, err := r.Ticker(testExchange, btcusd, asset.Spot); err != nil {
+		t.Error(err)
+	}
+	if _, err := r.Ticker("Binance", btcusd, asset.Spot); !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	if _, err := r.Orderbook(testExchange, ethusd, asset.Spot); !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	if _, err := r.OHLCV("bitstamp", btcusd, asset.Spot, time.Now(), time.Now(), kline.OneHour); err != nil {
+		t.Error(err)
+	}
+	if _, err := r.AccountInformation(testExchange, asset.Spot); err != nil {
+		t.Error(err)
+	}
+	if _, err := r.StoredTrades(testExchange, ethusd, asset.Spot, time.Now(), time.Now()); !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	if exchanges := r.Exchanges(true); len(exchanges) != 0 {
+		t.Errorf("expected validator exchanges to be filtered, received %v", exchanges)
+	}
+	if r.IsEnabled("Binance") {
+		t.Error("expected exchange without permission to be disabled")
+	}
+	if *violations != 3 {
+		t.Errorf("received %v violations expected 3", *violations)
+	}
+
+	orders, err := r.GetActiveOrders(testExchange, &order.GetOrdersRequest{AssetType: asset.Spot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 0 {
+		t.Error("expected orders for pairs without permission to be filtered")
+	}
+	orders, err = r.GetOrderHistory(testExchange, &order.GetOrdersRequest{Pairs: currency.Pairs{btcusd}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 {
+		t.Error("expected permitted orders to be returned")
+	}
+	_, err = r.GetOrderHistory(testExchange, &order.GetOrdersRequest{Pairs: currency.Pairs{ethusd}})
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+
+	_, err = r.SubmitOrder(&order.Submit{Exchange: testExchange, Pair: btcusd, Amount: 1, Price: 1})
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	_, err = r.CancelOrder(testExchange, "1", btcusd, asset.Spot)
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	_, err = r.WithdrawalCryptoFunds(&withdraw.Request{Exchange: testExchange})
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+}
+
+func TestRestrictedTrade(t *testing.T) {
+	t.Parallel()
+	r, violations := newTestRestricted(t, &modules.Permissions{
+		Access:           modules.AccessTrade,
+		MaxOrderNotional: 100,
+	})
+
+	_, err := r.SubmitOrder(&order.Submit{Exchange: testExchange, Pair: btcusd, Amount: 10, Price: 10})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = r.SubmitOrder(&order.Submit{Exchange: testExchange, Pair: btcusd, Amount: 10, Price: 10.01})
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	// market orders use the validator ticker last price of 1
+	_, err = r.SubmitOrder(&order.Submit{Exchange: testExchange, Pair: btcusd, Amount: 100})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = r.SubmitOrder(&order.Submit{Exchange: testExchange, Pair: btcusd, Amount: 101})
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	_, err = r.SubmitOrder(nil)
+	if !errors.Is(err, order.ErrSubmissionIsNil) {
+		t.Errorf("received %v expected %v", err, order.ErrSubmissionIsNil)
+	}
+	_, err = r.ModifyOrder(&order.Modify{Exchange: testExchange, Pair: btcusd, Amount: 50, Price: 3})
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	_, err = r.ModifyOrder(&order.Modify{Exchange: testExchange, Pair: btcusd, Price: 3})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = r.CancelBatchOrders(testExchange, []order.Cancel{{Pair: btcusd}})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = r.WithdrawalFiatFunds("", &withdraw.Request{Exchange: testExchange})
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	if *violations != 4 {
+		t.Errorf("received %v violations expected 4", *violations)
+	}
+}
+
+func TestRestrictedWithdraw(t *testing.T) {
+	t.Parallel()
+	r, violations := newTestRestricted(t, &modules.Permissions{
+		Exchanges: []string{testExchange},
+		Access:    modules.AccessWithdraw,
+	})
+	_, err := r.WithdrawalCryptoFunds(&withdraw.Request{Exchange: testExchange})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = r.WithdrawalFiatFunds("", &withdraw.Request{Exchange: "Binance"})
+	if !errors.Is(err, modules.ErrPermissionDenied) {
+		t.Errorf("received %v expected %v", err, modules.ErrPermissionDenied)
+	}
+	_, err = r.WithdrawalCryptoFunds(nil)
+	if !errors.Is(err, withdraw.ErrRequestCannotBeNil) {
+		t.Errorf("received %v expected %v", err, withdraw.ErrRequestCannotBeNil)
+	}
+	if _, err = r.SubmitOrder(&order.Submit{Exchange: testExchange, Pair: ethusd, Amount: 1}); err != nil {
+		t.Error(err)
+	}
+	if *violations != 1 {
+		t.Errorf("received %v violations expected 1", *violations)
+	}
+}
@@ -0,0 +1,231 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// Fixtures holds market data returned by the validator wrapper in place of
+// its default values, allowing scripts to be tested against known data
+type Fixtures struct {
+	Tickers    []ticker.Price
+	Orderbooks []orderbook.Base
+	Candles    []kline.Item
+}
+
+// fixtureMarket identifies the market of a fixture
+type fixtureMarket struct {
+	Exchange string `json:"exchange"`
+	Pair     string `json:"pair"`
+	Asset    string `json:"asset"`
+}
+
+// fixtureFile is the JSON representation of fixtures, orderbook levels are
+// [price, amount] and candles are [unix time, open, high, low, close, volume]
+type fixtureFile struct {
+	Tickers []struct {
+		fixtureMarket
+		Last        float64 `json:"last"`
+		High        float64 `json:"high"`
+		Low         float64 `json:"low"`
+		Bid         float64 `json:"bid"`
+		Ask         float64 `json:"ask"`
+		Volume      float64 `json:"volume"`
+		QuoteVolume float64 `json:"quote_volume"`
+		Open        float64 `json:"open"`
+		Close       float64 `json:"close"`
+	} `json:"tickers"`
+	Orderbooks []struct {
+		fixtureMarket
+		Bids [][2]float64 `json:"bids"`
+		Asks [][2]float64 `json:"asks"`
+	} `json:"orderbooks"`
+	Candles []struct {
+		fixtureMarket
+		Interval string       `json:"interval"`
+		Candles  [][6]float64 `json:"candles"`
+	} `json:"candles"`
+}
+
+// LoadFixtures reads fixtures from a JSON file
+func LoadFixtures(path string) (*Fixtures, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file fixtureFile
+	err = json.Unmarshal(data, &file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	f := &Fixtures{}
+	for x := range file.Tickers {
+		t := &file.Tickers[x]
+		p, a, err := t.parse()
+		if err != nil {
+			return nil, fmt.Errorf("%s ticker %d: %w", path, x, err)
+		}
+		f.Tickers = append(f.Tickers, ticker.Price{
+			ExchangeName: t.Exchange,
+			Pair:         p,
+			AssetType:    a,
+			Last:         t.Last,
+			High:         t.High,
+			Low:          t.Low,
+			Bid:          t.Bid,
+			Ask:          t.Ask,
+			Volume:       t.Volume,
+			QuoteVolume:  t.QuoteVolume,
+			Open:         t.Open,
+			Close:        t.Close,
+			LastUpdated:  time.Now(),
+		})
+	}
+	for x := range file.Orderbooks {
+		ob := &file.Orderbooks[x]
+		p, a, err := ob.parse()
+		if err != nil {
+			return nil, fmt.Errorf("%s orderbook %d: %w", path, x, err)
+		}
+		f.Orderbooks = append(f.Orderbooks, orderbook.Base{
+			Exchange: ob.Exchange,
+			Pair:     p,
+			Asset:    a,
+			Bids:     fixtureLevels(ob.Bids),
+			Asks:     fixtureLevels(ob.Asks),
+		})
+	}
+	for x := range file.Candles {
+		c := &file.Candles[x]
+		p, a, err := c.parse()
+		if err != nil {
+			return nil, fmt.Errorf("%s candles %d: %w", path, x, err)
+		}
+		var interval time.Duration
+		if c.Interval != "" {
+			interval, err = parseFixtureInterval(c.Interval)
+			if err != nil {
+				return nil, fmt.Errorf("%s candles %d: %w", path, x, err)
+			}
+		}
+		item := kline.Item{
+			Exchange: c.Exchange,
+			Pair:     p,
+			Asset:    a,
+			Interval: kline.Interval(interval),
+		}
+		for i := range c.Candles {
+			item.Candles = append(item.Candles, kline.Candle{
+				Time:   time.Unix(int64(c.Candles[i][0]), 0).UTC(),
+				Open:   c.Candles[i][1],
+				High:   c.Candles[i][2],
+				Low:    c.Candles[i][3],
+				Close:  c.Candles[i][4],
+				Volume: c.Candles[i][5],
+			})
+		}
+		f.Candles = append(f.Candles, item)
+	}
+	return f, nil
+}
+
+func (m *fixtureMarket) parse() (currency.Pair, asset.Item, error) {
+	p, err := currency.NewPairFromString(m.Pair)
+	if err != nil {
+		return currency.Pair{}, "", err
+	}
+	a := asset.Spot
+	if m.Asset != "" {
+		a, err = asset.New(m.Asset)
+		if err != nil {
+			return currency.Pair{}, "", err
+		}
+	}
+	return p, a, nil
+}
+
+func fixtureLevels(levels [][2]float64) []orderbook.Item {
+	items := make([]orderbook.Item, len(levels))
+	for x := range levels {
+		items[x] = orderbook.Item{Price: levels[x][0], Amount: levels[x][1]}
+	}
+	return items
+}
+
+// parseFixtureInterval parses a duration which may use the day and week units
+// supported by scripts
+func parseFixtureInterval(in string) (time.Duration, error) {
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if strings.HasSuffix(in, suffix) {
+			n, err := strconv.Atoi(strings.TrimSuffix(in, suffix))
+			if err != nil {
+				return 0, fmt.Errorf("invalid interval %s", in)
+			}
+			return time.Duration(n) * unit, nil
+		}
+	}
+	return time.ParseDuration(in)
+}
+
+func fixtureMatch(exch, fixtureExch string, p, fixturePair currency.Pair, a, fixtureAsset asset.Item) bool {
+	return strings.EqualFold(exch, fixtureExch) && p.Equal(fixturePair) && a == fixtureAsset
+}
+
+// ticker returns the fixture ticker for the market
+func (f *Fixtures) ticker(exch string, p currency.Pair, a asset.Item) (*ticker.Price, bool) {
+	if f == nil {
+		return nil, false
+	}
+	for x := range f.Tickers {
+		if fixtureMatch(exch, f.Tickers[x].ExchangeName, p, f.Tickers[x].Pair, a, f.Tickers[x].AssetType) {
+			t := f.Tickers[x]
+			return &t, true
+		}
+	}
+	return nil, false
+}
+
+// orderbook returns the fixture orderbook for the market
+func (f *Fixtures) orderbook(exch string, p currency.Pair, a asset.Item) (*orderbook.Base, bool) {
+	if f == nil {
+		return nil, false
+	}
+	for x := range f.Orderbooks {
+		if fixtureMatch(exch, f.Orderbooks[x].Exchange, p, f.Orderbooks[x].Pair, a, f.Orderbooks[x].Asset) {
+			ob := f.Orderbooks[x]
+			return &ob, true
+		}
+	}
+	return nil, false
+}
+
+// candles returns the fixture candles for the market and interval, fixture
+// candles without an interval match any interval. Candles are returned
+// regardless of the requested time range
+func (f *Fixtures) candles(exch string, p currency.Pair, a asset.Item, i kline.Interval) (kline.Item, bool) {
+	if f == nil {
+		return kline.Item{}, false
+	}
+	for x := range f.Candles {
+		c := &f.Candles[x]
+		if fixtureMatch(exch, c.Exchange, p, c.Pair, a, c.Asset) &&
+			(c.Interval == 0 || c.Interval == i) {
+			item := *c
+			item.Interval = i
+			item.Candles = append([]kline.Candle(nil), c.Candles...)
+			return item, true
+		}
+	}
+	return kline.Item{}, false
+}
@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var testFixtures = filepath.Join("..", "..", "..", "testdata", "gctscript", "fixtures.json")
+
+func TestLoadFixtures(t *testing.T) {
+	t.Parallel()
+	f, err := LoadFixtures(testFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Tickers) != 1 || len(f.Orderbooks) != 1 || len(f.Candles) != 1 {
+		t.Fatalf("unexpected fixtures %+v", f)
+	}
+	if f.Tickers[0].AssetType != asset.Spot {
+		t.Errorf("received %v expected default asset %v", f.Tickers[0].AssetType, asset.Spot)
+	}
+	if f.Candles[0].Interval != kline.OneHour || len(f.Candles[0].Candles) != 3 {
+		t.Errorf("unexpected candles %+v", f.Candles[0])
+	}
+
+	_, err = LoadFixtures("missing.json")
+	if err == nil {
+		t.Error("expected error loading missing fixtures")
+	}
+	_, err = parseFixtureInterval("1x")
+	if err == nil {
+		t.Error("expected error parsing invalid interval")
+	}
+	d, err := parseFixtureInterval("1w")
+	if err != nil || d != 7*24*time.Hour {
+		t.Errorf("received %v %v expected %v", d, err, 7*24*time.Hour)
+	}
+}
+
+func TestWrapperFixtures(t *testing.T) {
+	t.Parallel()
+	f, err := LoadFixtures(testFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := Wrapper{Fixtures: f}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+
+	tick, err := w.Ticker("Binance", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick.Last != 10000 {
+		t.Errorf("received %v expected %v", tick.Last, 10000)
+	}
+	tick, err = w.Ticker("Binance", p, asset.Margin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick.Last != 1 {
+		t.Errorf("received %v expected default ticker", tick.Last)
+	}
+
+	ob, err := w.Orderbook("binance", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ob.Bids) != 2 || ob.Asks[0].Price != 10005 {
+		t.Errorf("unexpected orderbook %+v", ob)
+	}
+
+	start := time.Unix(1577836800, 0)
+	candles, err := w.OHLCV("binance", p, asset.Spot, start, start.Add(3*time.Hour), kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles.Candles) != 3 || candles.Candles[2].Close != 7160 {
+		t.Errorf("unexpected candles %+v", candles)
+	}
+	candles, err = w.OHLCV("binance", p, asset.Spot, start, start.Add(3*24*time.Hour), kline.OneDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles.Candles) != 200 {
+		t.Errorf("received %v expected default candles", len(candles.Candles))
+	}
+}
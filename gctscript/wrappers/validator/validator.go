@@ -11,6 +11,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -47,6 +49,9 @@ func (w Wrapper) Orderbook(exch string, pair currency.Pair, item asset.Item) (*o
 	if exch == exchError.String() {
 		return nil, errTestFailed
 	}
+	if ob, ok := w.Fixtures.orderbook(exch, pair, item); ok {
+		return ob, nil
+	}
 
 	return &orderbook.Base{
 		Exchange: exch,
@@ -67,11 +72,57 @@ func (w Wrapper) Orderbook(exch string, pair currency.Pair, item asset.Item) (*o
 	}, nil
 }
 
+// OrderbookAnalytics validator for test execution/scripts
+func (w Wrapper) OrderbookAnalytics(exch string, _ currency.Pair, _ asset.Item, req *orderbook.AnalyticsRequest) (*orderbook.Analytics, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	a := &orderbook.Analytics{
+		BestBid:           1,
+		BestAsk:           1,
+		MidPrice:          1,
+		MicroPrice:        1,
+		SpreadBasisPoints: 1,
+		Imbalance:         1,
+		Liquidity: orderbook.Liquidity{
+			BidAmount: 1,
+			BidValue:  1,
+			AskAmount: 1,
+			AskValue:  1,
+		},
+	}
+	if req == nil {
+		return a, nil
+	}
+	for x := range req.ImpactAmounts {
+		fill := orderbook.FillResult{
+			Amount:            req.ImpactAmounts[x],
+			FilledAmount:      req.ImpactAmounts[x],
+			Cost:              req.ImpactAmounts[x],
+			AveragePrice:      1,
+			WorstPrice:        1,
+			ImpactBasisPoints: 1,
+			FullyFilled:       true,
+		}
+		a.BuyImpact = append(a.BuyImpact, fill)
+		a.SellImpact = append(a.SellImpact, fill)
+	}
+	for x := range req.DepthAmounts {
+		level := orderbook.DepthLevel{Amount: req.DepthAmounts[x], Price: 1}
+		a.BidDepth = append(a.BidDepth, level)
+		a.AskDepth = append(a.AskDepth, level)
+	}
+	return a, nil
+}
+
 // Ticker validator for test execution/scripts
 func (w Wrapper) Ticker(exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
 	if exch == exchError.String() {
 		return nil, errTestFailed
 	}
+	if t, ok := w.Fixtures.ticker(exch, pair, item); ok {
+		return t, nil
+	}
 	return &ticker.Price{
 		Last:         1,
 		High:         2,
@@ -246,6 +297,9 @@ func (w Wrapper) OHLCV(exch string, p currency.Pair, a asset.Item, start, end ti
 	if exch == exchError.String() {
 		return kline.Item{}, errTestFailed
 	}
+	if item, ok := w.Fixtures.candles(exch, p, a, i); ok {
+		return item, nil
+	}
 	var candles []kline.Candle
 
 	candles = append(candles, kline.Candle{
@@ -278,3 +332,178 @@ func (w Wrapper) OHLCV(exch string, p currency.Pair, a asset.Item, start, end ti
 		Candles:  candles,
 	}, nil
 }
+
+// ModifyOrder validator for test execution/scripts
+func (w Wrapper) ModifyOrder(mod *order.Modify) (string, error) {
+	if mod.Exchange == exchError.String() {
+		return "", errTestFailed
+	}
+	return mod.ID, nil
+}
+
+// CancelBatchOrders validator for test execution/scripts
+func (w Wrapper) CancelBatchOrders(exch string, cancels []order.Cancel) (order.CancelBatchResponse, error) {
+	if exch == exchError.String() {
+		return order.CancelBatchResponse{}, errTestFailed
+	}
+	resp := order.CancelBatchResponse{Status: make(map[string]string)}
+	for x := range cancels {
+		resp.Status[cancels[x].ID] = "true"
+	}
+	return resp, nil
+}
+
+// GetActiveOrders validator for test execution/scripts
+func (w Wrapper) GetActiveOrders(exch string, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return validatorOrders(exch, req, order.Active), nil
+}
+
+// GetOrderHistory validator for test execution/scripts
+func (w Wrapper) GetOrderHistory(exch string, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return validatorOrders(exch, req, order.Filled), nil
+}
+
+func validatorOrders(exch string, req *order.GetOrdersRequest, s order.Status) []order.Detail {
+	var pair currency.Pair
+	if len(req.Pairs) > 0 {
+		pair = req.Pairs[0]
+	}
+	return []order.Detail{
+		{
+			Exchange:  exch,
+			ID:        "1",
+			Pair:      pair,
+			AssetType: req.AssetType,
+			Side:      order.Buy,
+			Type:      order.Limit,
+			Status:    s,
+			Price:     validatorClose,
+			Amount:    validatorVol,
+			Date:      time.Now(),
+		},
+	}
+}
+
+// GetRecentTrades validator for test execution/scripts
+func (w Wrapper) GetRecentTrades(exch string, p currency.Pair, a asset.Item) ([]trade.Data, error) {
+	return w.GetHistoricTrades(exch, p, a, time.Now(), time.Now())
+}
+
+// GetHistoricTrades validator for test execution/scripts
+func (w Wrapper) GetHistoricTrades(exch string, p currency.Pair, a asset.Item, start, _ time.Time) ([]trade.Data, error) {
+	return w.StoredTrades(exch, p, a, start, start)
+}
+
+// GetFeeByType validator for test execution/scripts
+func (w Wrapper) GetFeeByType(exch string, req *modules.FeeRequest) (float64, error) {
+	if exch == exchError.String() {
+		return 0, errTestFailed
+	}
+	return req.PurchasePrice * req.Amount * 0.001, nil
+}
+
+// GetOrderExecutionLimits validator for test execution/scripts
+func (w Wrapper) GetOrderExecutionLimits(exch string, p currency.Pair, a asset.Item) (order.MinMaxLevel, error) {
+	if exch == exchError.String() {
+		return order.MinMaxLevel{}, errTestFailed
+	}
+	return order.MinMaxLevel{
+		Pair:       p,
+		Asset:      a,
+		MinPrice:   validatorLow,
+		MaxPrice:   validatorHigh,
+		StepPrice:  0.01,
+		MinAmount:  0.001,
+		MaxAmount:  validatorVol,
+		StepAmount: 0.001,
+	}, nil
+}
+
+// GetState validator for test execution/scripts
+func (w Wrapper) GetState(script, _ string) (string, error) {
+	if script == exchError.String() {
+		return "", errTestFailed
+	}
+	return "1", nil
+}
+
+// SetState validator for test execution/scripts
+func (w Wrapper) SetState(script, _, _ string) error {
+	if script == exchError.String() {
+		return errTestFailed
+	}
+	return nil
+}
+
+// DeleteState validator for test execution/scripts
+func (w Wrapper) DeleteState(script, _ string) error {
+	if script == exchError.String() {
+		return errTestFailed
+	}
+	return nil
+}
+
+// StateKeys validator for test execution/scripts
+func (w Wrapper) StateKeys(script string) ([]string, error) {
+	if script == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []string{"key"}, nil
+}
+
+// StoredCandles validator for test execution/scripts
+func (w Wrapper) StoredCandles(exch string, p currency.Pair, a asset.Item, start, end time.Time, i kline.Interval) (kline.Item, error) {
+	return w.OHLCV(exch, p, a, start, end, i)
+}
+
+// StoredTrades validator for test execution/scripts
+func (w Wrapper) StoredTrades(exch string, p currency.Pair, a asset.Item, start, _ time.Time) ([]trade.Data, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []trade.Data{
+		{
+			Exchange:     exch,
+			CurrencyPair: p,
+			AssetType:    a,
+			Side:         order.Buy,
+			Price:        validatorClose,
+			Amount:       validatorVol,
+			Timestamp:    start,
+		},
+	}, nil
+}
+
+// AuditEvents validator for test execution/scripts
+func (w Wrapper) AuditEvents(start, _ time.Time, _ string, _ int) ([]modules.AuditEvent, error) {
+	return []modules.AuditEvent{
+		{
+			Type:       "test",
+			Identifier: "validator",
+			Message:    "hello world",
+			Timestamp:  start,
+		},
+	}, nil
+}
+
+// CommsMediums validator for test execution/scripts
+func (w Wrapper) CommsMediums() []string {
+	return []string{"validator"}
+}
+
+// Notify validator for test execution/scripts, notifications are not sent
+func (w Wrapper) Notify(script string, n *modules.Notification) error {
+	if script == exchError.String() {
+		return errTestFailed
+	}
+	if n.Message == "" && n.Template == "" {
+		return errTestFailed
+	}
+	return nil
+}
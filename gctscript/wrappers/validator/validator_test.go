@@ -8,6 +8,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -132,6 +134,29 @@ func TestWrapper_Orderbook(t *testing.T) {
 	}
 }
 
+func TestWrapper_OrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	c, err := currency.NewPairDelimiter(pairs, delimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := testWrapper.OrderbookAnalytics(exchName, c, assetType, &orderbook.AnalyticsRequest{
+		ImpactAmounts: []float64{1, 2},
+		DepthAmounts:  []float64{1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.BuyImpact) != 2 || len(a.AskDepth) != 1 {
+		t.Errorf("unexpected analytics %+v", a)
+	}
+
+	_, err = testWrapper.OrderbookAnalytics(exchError.String(), currencyPair, asset.Spot, nil)
+	if err == nil {
+		t.Fatal("expected OrderbookAnalytics to return error with invalid name")
+	}
+}
+
 func TestWrapper_Pairs(t *testing.T) {
 	t.Parallel()
 	_, err := testWrapper.Pairs(exchName, false, assetType)
@@ -247,3 +272,157 @@ func TestWrapper_OHLCV(t *testing.T) {
 		t.Fatal("expected OHLCV to return error with invalid name")
 	}
 }
+
+func TestWrapper_State(t *testing.T) {
+	t.Parallel()
+	v, err := testWrapper.GetState("test.gct", "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "1" {
+		t.Errorf("expected 1 received %v", v)
+	}
+	if err = testWrapper.SetState("test.gct", "key", "1"); err != nil {
+		t.Error(err)
+	}
+	if err = testWrapper.DeleteState("test.gct", "key"); err != nil {
+		t.Error(err)
+	}
+	keys, err := testWrapper.StateKeys("test.gct")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 {
+		t.Errorf("expected 1 received %v", len(keys))
+	}
+	if _, err = testWrapper.GetState(exchError.String(), "key"); err == nil {
+		t.Error("expected GetState to return error with invalid name")
+	}
+	if err = testWrapper.SetState(exchError.String(), "key", "1"); err == nil {
+		t.Error("expected SetState to return error with invalid name")
+	}
+}
+
+func TestWrapper_StoredData(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.StoredCandles(exchName, currencyPair, assetType, time.Now().Add(-24*time.Hour), time.Now(), kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trades, err := testWrapper.StoredTrades(exchName, currencyPair, assetType, time.Now().Add(-time.Hour), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 {
+		t.Errorf("expected 1 received %v", len(trades))
+	}
+	_, err = testWrapper.StoredTrades(exchError.String(), currencyPair, assetType, time.Now().Add(-time.Hour), time.Now())
+	if err == nil {
+		t.Error("expected StoredTrades to return error with invalid name")
+	}
+	events, err := testWrapper.AuditEvents(time.Now().Add(-time.Hour), time.Now(), "asc", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 {
+		t.Errorf("expected 1 received %v", len(events))
+	}
+}
+
+func TestWrapper_ModifyOrder(t *testing.T) {
+	t.Parallel()
+	id, err := testWrapper.ModifyOrder(&order.Modify{Exchange: exchName, ID: orderID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != orderID {
+		t.Errorf("expected %v received %v", orderID, id)
+	}
+	_, err = testWrapper.ModifyOrder(&order.Modify{Exchange: exchError.String()})
+	if err == nil {
+		t.Error("expected ModifyOrder to return error with invalid name")
+	}
+}
+
+func TestWrapper_CancelBatchOrders(t *testing.T) {
+	t.Parallel()
+	resp, err := testWrapper.CancelBatchOrders(exchName, []order.Cancel{{ID: "1"}, {ID: "2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Status) != 2 {
+		t.Errorf("expected 2 received %v", len(resp.Status))
+	}
+	_, err = testWrapper.CancelBatchOrders(exchError.String(), nil)
+	if err == nil {
+		t.Error("expected CancelBatchOrders to return error with invalid name")
+	}
+}
+
+func TestWrapper_GetOrders(t *testing.T) {
+	t.Parallel()
+	req := &order.GetOrdersRequest{Pairs: currency.Pairs{currencyPair}, AssetType: assetType}
+	orders, err := testWrapper.GetActiveOrders(exchName, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || orders[0].Status != order.Active {
+		t.Errorf("unexpected active orders %v", orders)
+	}
+	orders, err = testWrapper.GetOrderHistory(exchName, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || orders[0].Status != order.Filled {
+		t.Errorf("unexpected order history %v", orders)
+	}
+	_, err = testWrapper.GetActiveOrders(exchError.String(), req)
+	if err == nil {
+		t.Error("expected GetActiveOrders to return error with invalid name")
+	}
+}
+
+func TestWrapper_GetTrades(t *testing.T) {
+	t.Parallel()
+	trades, err := testWrapper.GetRecentTrades(exchName, currencyPair, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 {
+		t.Errorf("expected 1 received %v", len(trades))
+	}
+	_, err = testWrapper.GetHistoricTrades(exchError.String(), currencyPair, assetType, time.Now().Add(-time.Hour), time.Now())
+	if err == nil {
+		t.Error("expected GetHistoricTrades to return error with invalid name")
+	}
+}
+
+func TestWrapper_GetFeeByType(t *testing.T) {
+	t.Parallel()
+	fee, err := testWrapper.GetFeeByType(exchName, &modules.FeeRequest{PurchasePrice: 1000, Amount: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee != 1 {
+		t.Errorf("expected 1 received %v", fee)
+	}
+	_, err = testWrapper.GetFeeByType(exchError.String(), &modules.FeeRequest{})
+	if err == nil {
+		t.Error("expected GetFeeByType to return error with invalid name")
+	}
+}
+
+func TestWrapper_GetOrderExecutionLimits(t *testing.T) {
+	t.Parallel()
+	l, err := testWrapper.GetOrderExecutionLimits(exchName, currencyPair, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l.Pair.Equal(currencyPair) || l.Asset != assetType {
+		t.Errorf("unexpected limits %+v", l)
+	}
+	_, err = testWrapper.GetOrderExecutionLimits(exchError.String(), currencyPair, assetType)
+	if err == nil {
+		t.Error("expected GetOrderExecutionLimits to return error with invalid name")
+	}
+}
@@ -17,5 +17,8 @@ var (
 	errTestFailed = errors.New("test failed")
 )
 
-// Wrapper for validator interface
-type Wrapper struct{}
+// Wrapper for validator interface, fixtures replace the default market data
+// returned for matching markets
+type Wrapper struct {
+	Fixtures *Fixtures
+}
@@ -21,6 +21,7 @@ require (
 	github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e // indirect
 	github.com/pelletier/go-toml v1.8.0 // indirect
 	github.com/pkg/errors v0.9.1
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/pquerna/otp v1.3.0
 	github.com/shopspring/decimal v1.2.0
 	github.com/spf13/afero v1.3.4 // indirect
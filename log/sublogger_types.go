@@ -20,6 +20,8 @@ var (
 	WebsocketMgr     *subLogger
 	EventMgr         *subLogger
 	DispatchMgr      *subLogger
+	CandleMgr        *subLogger
+	DataHistory      *subLogger
 
 	RequestSys  *subLogger
 	ExchangeSys *subLogger
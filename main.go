@@ -54,6 +54,12 @@ func main() {
 	flag.DurationVar(&settings.EventManagerDelay, "eventmanagerdelay", time.Duration(0), "sets the event managers sleep delay between event checking")
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
+	flag.BoolVar(&settings.EnableCandleManager, "candlemanager", false, "enables the candle manager to build live candles from websocket trades and klines")
+	flag.BoolVar(&settings.EnableDataHistoryManager, "datahistorymanager", false, "enables the data history manager to fill gaps in stored candle and trade data")
+	flag.BoolVar(&settings.EnableWithdrawalTracker, "withdrawaltracker", false, "enables the withdrawal tracker to poll exchanges until submitted withdrawals complete")
+	flag.BoolVar(&settings.EnableDepositTracker, "deposittracker", false, "enables the deposit address manager to poll exchanges for credited deposits to known deposit addresses")
+	flag.BoolVar(&settings.EnableRebalancer, "rebalancer", false, "enables the rebalancer to propose transfers restoring target allocations across exchanges")
+	flag.BoolVar(&settings.EnablePortfolioHistory, "portfoliohistory", false, "enables the portfolio history manager to periodically store the fiat value and profit and loss of the portfolio")
 	flag.IntVar(&settings.DispatchMaxWorkerAmount, "dispatchworkers", dispatch.DefaultMaxWorkers, "sets the dispatch package max worker generation limit")
 	flag.IntVar(&settings.DispatchJobsLimit, "dispatchjobslimit", dispatch.DefaultJobsLimit, "sets the dispatch package max jobs limit")
 
@@ -219,60 +219,33 @@ func (p *Base) RemoveAddress(address, description string, coinType currency.Code
 	return errors.New("portfolio item does not exist")
 }
 
-// UpdatePortfolio adds to the portfolio addresses by coin type
+// UpdatePortfolio adds to the portfolio addresses by coin type, fetching
+// balances from the balance provider registered for the coin type. Extended
+// public keys are expanded into their derived addresses
 func (p *Base) UpdatePortfolio(addresses []string, coinType currency.Code) error {
 	if strings.Contains(strings.Join(addresses, ","), PortfolioAddressExchange) ||
 		strings.Contains(strings.Join(addresses, ","), PortfolioAddressPersonal) {
 		return nil
 	}
 
-	switch coinType {
-	case currency.ETH:
-		for x := range addresses {
-			result, err := GetEthereumBalance(addresses[x])
-			if err != nil {
-				return err
-			}
-
-			if result.Error.Message != "" {
-				return errors.New(result.Error.Message)
-			}
-
-			err = p.AddAddress(addresses[x],
-				PortfolioAddressPersonal,
-				coinType,
-				result.ETH.Balance)
-			if err != nil {
-				return err
-			}
+	provider := GetProvider(coinType)
+	for x := range addresses {
+		var balance float64
+		var err error
+		if IsExtendedPublicKey(addresses[x]) {
+			balance, err = GetExtendedPublicKeyBalance(provider, addresses[x], coinType)
+		} else {
+			balance, err = provider.GetBalance(addresses[x], coinType)
 		}
-	case currency.XRP:
-		for x := range addresses {
-			result, err := GetRippleBalance(addresses[x])
-			if err != nil {
-				return err
-			}
-			err = p.AddAddress(addresses[x],
-				PortfolioAddressPersonal,
-				coinType,
-				result)
-			if err != nil {
-				return err
-			}
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", provider.Name(), addresses[x], err)
 		}
-	default:
-		for x := range addresses {
-			result, err := GetCryptoIDAddress(addresses[x], coinType)
-			if err != nil {
-				return err
-			}
-			err = p.AddAddress(addresses[x],
-				PortfolioAddressPersonal,
-				coinType,
-				result)
-			if err != nil {
-				return err
-			}
+		err = p.AddAddress(addresses[x],
+			PortfolioAddressPersonal,
+			coinType,
+			balance)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -517,3 +490,16 @@ func IsWhiteListed(address string) (ret bool) {
 	}
 	return
 }
+
+// IsWhiteListedFor checks if address is whitelisted for withdraw transfers of
+// the supplied currency
+func IsWhiteListedFor(address string, coinType currency.Code) bool {
+	for x := range Portfolio.Addresses {
+		if Portfolio.Addresses[x].Address != address ||
+			!Portfolio.Addresses[x].CoinType.Match(coinType) {
+			continue
+		}
+		return Portfolio.Addresses[x].WhiteListed
+	}
+	return false
+}
@@ -521,6 +521,19 @@ func TestIsWhiteListed(t *testing.T) {
 	}
 }
 
+func TestIsWhiteListedFor(t *testing.T) {
+	seedPortFolioForTest(t)
+	if !IsWhiteListedFor(core.BitcoinDonationAddress, currency.BTC) {
+		t.Fatal("expected IsWhiteListedFor() to return true")
+	}
+	if IsWhiteListedFor(core.BitcoinDonationAddress, currency.LTC) {
+		t.Fatal("expected IsWhiteListedFor() to return false for a different currency")
+	}
+	if IsWhiteListedFor(testBTCAddress, currency.BTC) {
+		t.Fatal("expected IsWhiteListedFor() to return false")
+	}
+}
+
 func TestStartPortfolioWatcher(t *testing.T) {
 	newBase := Base{}
 	newBase.AddAddress("LX2LMYXtuv5tiYEMztSSoEZcafFPYJFRK1",
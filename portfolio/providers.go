@@ -0,0 +1,302 @@
+package portfolio
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// erc20BalanceOf is the ERC-20 balanceOf(address) function selector
+const erc20BalanceOf = "0x70a08231"
+
+var (
+	registry = providerRegistry{
+		providers: make(map[*currency.Item]BalanceProvider),
+	}
+	ethereumAddressRegexp = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+)
+
+// RegisterProvider sets the balance provider used for the coin type, replacing
+// any provider previously registered for it
+func RegisterProvider(coinType currency.Code, p BalanceProvider) error {
+	if coinType.IsEmpty() {
+		return errors.New("coin type is empty")
+	}
+	if p == nil {
+		return errNilProvider
+	}
+	registry.m.Lock()
+	registry.providers[coinType.Item] = p
+	registry.m.Unlock()
+	return nil
+}
+
+// UnregisterProvider restores the default balance provider for the coin type
+func UnregisterProvider(coinType currency.Code) {
+	registry.m.Lock()
+	delete(registry.providers, coinType.Item)
+	registry.m.Unlock()
+}
+
+// GetProvider returns the balance provider registered for the coin type,
+// falling back to Ethplorer for ETH, XRPScan for XRP and CryptoID for
+// everything else
+func GetProvider(coinType currency.Code) BalanceProvider {
+	registry.m.RLock()
+	p, ok := registry.providers[coinType.Item]
+	registry.m.RUnlock()
+	if ok {
+		return p
+	}
+	switch {
+	case coinType.Match(currency.ETH):
+		return &EthplorerProvider{}
+	case coinType.Match(currency.XRP):
+		return &XRPScanProvider{}
+	default:
+		return &CryptoIDProvider{}
+	}
+}
+
+// NewProvider returns the balance provider defined by the config
+func NewProvider(cfg *ProviderConfig) (BalanceProvider, error) {
+	if cfg.TokenDecimals < 0 {
+		return nil, errTokenDecimalsInvalid
+	}
+	if cfg.TokenContract != "" && !ethereumAddressRegexp.MatchString(cfg.TokenContract) {
+		return nil, fmt.Errorf("%s %w", cfg.TokenContract, errInvalidTokenContract)
+	}
+	provider := strings.ToLower(cfg.Provider)
+	switch provider {
+	case ProviderEthplorer:
+		return &EthplorerProvider{
+			TokenContract: cfg.TokenContract,
+			TokenDecimals: cfg.TokenDecimals,
+		}, nil
+	case ProviderGeth:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("%s %w", provider, errProviderURLUnset)
+		}
+		return &GethProvider{
+			URL:           cfg.URL,
+			TokenContract: cfg.TokenContract,
+			TokenDecimals: cfg.TokenDecimals,
+		}, nil
+	}
+	if cfg.TokenContract != "" {
+		return nil, fmt.Errorf("%s %w", provider, errTokenNotSupported)
+	}
+	switch provider {
+	case ProviderCryptoID:
+		return &CryptoIDProvider{}, nil
+	case ProviderXRPScan:
+		return &XRPScanProvider{}, nil
+	case ProviderBitcoind:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("%s %w", provider, errProviderURLUnset)
+		}
+		return &BitcoindProvider{
+			URL:      cfg.URL,
+			Username: cfg.Username,
+			Password: cfg.Password,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%q %w", cfg.Provider, errUnknownProvider)
+	}
+}
+
+// SetupProviders registers the balance providers defined by the configs
+func SetupProviders(cfgs []ProviderConfig) error {
+	var errs common.Errors
+	for i := range cfgs {
+		p, err := NewProvider(&cfgs[i])
+		if err == nil {
+			err = RegisterProvider(currency.NewCode(cfgs[i].CoinType), p)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("portfolio provider %s: %w", cfgs[i].CoinType, err))
+			continue
+		}
+		log.Debugf(log.PortfolioMgr, "Portfolio using %s balance provider for %s.\n",
+			p.Name(), strings.ToUpper(cfgs[i].CoinType))
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Name returns the provider name
+func (c *CryptoIDProvider) Name() string {
+	return ProviderCryptoID
+}
+
+// GetBalance returns the address balance
+func (c *CryptoIDProvider) GetBalance(address string, coinType currency.Code) (float64, error) {
+	return GetCryptoIDAddress(address, coinType)
+}
+
+// Name returns the provider name
+func (e *EthplorerProvider) Name() string {
+	return ProviderEthplorer
+}
+
+// GetBalance returns the address's Ether balance, or its token balance when a
+// token contract is set
+func (e *EthplorerProvider) GetBalance(address string, _ currency.Code) (float64, error) {
+	if e.TokenContract == "" {
+		result, err := GetEthereumBalance(address)
+		if err != nil {
+			return 0, err
+		}
+		if result.Error.Message != "" {
+			return 0, errors.New(result.Error.Message)
+		}
+		return result.ETH.Balance, nil
+	}
+	if !ethereumAddressRegexp.MatchString(address) {
+		return 0, errInvalidEthereumAddr
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s?apiKey=freekey&token=%s",
+		ethplorerAPIURL, ethplorerAddressInfo, address, e.TokenContract)
+	var result EthplorerTokenResponse
+	err := common.SendHTTPGetRequest(urlPath, true, Verbose, &result)
+	if err != nil {
+		return 0, err
+	}
+	if result.Error.Message != "" {
+		return 0, errors.New(result.Error.Message)
+	}
+	for i := range result.Tokens {
+		if strings.EqualFold(result.Tokens[i].TokenInfo.Address, e.TokenContract) {
+			return result.Tokens[i].Balance / math.Pow10(e.TokenDecimals), nil
+		}
+	}
+	return 0, nil
+}
+
+// Name returns the provider name
+func (x *XRPScanProvider) Name() string {
+	return ProviderXRPScan
+}
+
+// GetBalance returns the address balance
+func (x *XRPScanProvider) GetBalance(address string, _ currency.Code) (float64, error) {
+	return GetRippleBalance(address)
+}
+
+// Name returns the provider name
+func (b *BitcoindProvider) Name() string {
+	return ProviderBitcoind
+}
+
+// GetBalance returns the total value of the unspent outputs paying the address
+func (b *BitcoindProvider) GetBalance(address string, _ currency.Code) (float64, error) {
+	var result ScanTxOutSetResponse
+	err := sendJSONRPC(b.URL, b.Username, b.Password, "scantxoutset",
+		[]interface{}{"start", []string{"addr(" + address + ")"}}, &result)
+	if err != nil {
+		return 0, err
+	}
+	if !result.Success {
+		return 0, errScanUnsuccessful
+	}
+	return result.TotalAmount, nil
+}
+
+// Name returns the provider name
+func (g *GethProvider) Name() string {
+	return ProviderGeth
+}
+
+// GetBalance returns the address's Ether balance, or its token balance when a
+// token contract is set
+func (g *GethProvider) GetBalance(address string, _ currency.Code) (float64, error) {
+	if !ethereumAddressRegexp.MatchString(address) {
+		return 0, errInvalidEthereumAddr
+	}
+	var result string
+	if g.TokenContract == "" {
+		err := sendJSONRPC(g.URL, "", "", "eth_getBalance",
+			[]interface{}{address, "latest"}, &result)
+		if err != nil {
+			return 0, err
+		}
+		return parseHexAmount(result, 18)
+	}
+	err := sendJSONRPC(g.URL, "", "", "eth_call", []interface{}{
+		ethCallParams{
+			To:   g.TokenContract,
+			Data: erc20BalanceOf + fmt.Sprintf("%064s", strings.ToLower(address[2:])),
+		},
+		"latest",
+	}, &result)
+	if err != nil {
+		return 0, err
+	}
+	return parseHexAmount(result, g.TokenDecimals)
+}
+
+// parseHexAmount converts a hex encoded integer amount in the currency's
+// smallest unit to a decimal amount
+func parseHexAmount(amount string, decimals int) (float64, error) {
+	amount = strings.TrimPrefix(amount, "0x")
+	if amount == "" {
+		return 0, nil
+	}
+	i, ok := new(big.Int).SetString(amount, 16)
+	if !ok {
+		return 0, fmt.Errorf("invalid hex amount %q", amount)
+	}
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(i),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))).Float64()
+	return f, nil
+}
+
+// sendJSONRPC calls the method on a self-hosted node and decodes its result
+func sendJSONRPC(url, username, password, method string, params []interface{}, result interface{}) error {
+	payload, err := json.Marshal(jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+	headers := map[string]string{"Content-Type": "application/json"}
+	if username != "" || password != "" {
+		headers["Authorization"] = "Basic " +
+			base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	}
+	if Verbose {
+		log.Debugf(log.PortfolioMgr, "JSON-RPC request %s: %s\n", method, payload)
+	}
+	contents, err := common.SendHTTPRequest(http.MethodPost, url, headers, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	if Verbose {
+		log.Debugf(log.PortfolioMgr, "JSON-RPC response %s: %s\n", method, contents)
+	}
+	var resp jsonRPCResponse
+	err = json.Unmarshal([]byte(contents), &resp)
+	if err != nil {
+		return fmt.Errorf("%s unable to decode response: %w", method, err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s error %d: %s", method, resp.Error.Code, resp.Error.Message)
+	}
+	return json.Unmarshal(resp.Result, result)
+}
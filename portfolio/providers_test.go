@@ -0,0 +1,201 @@
+package portfolio
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+const (
+	testEthAddress    = "0xb794f5ea0ba39494ce839613fffba74279579268"
+	testTokenContract = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+)
+
+// newTestNode returns a JSON-RPC server answering each method with the
+// supplied result
+func newTestNode(t *testing.T, results map[string]interface{}) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		if user, pass, ok := r.BasicAuth(); ok && (user != "user" || pass != "pass") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		resp := map[string]interface{}{"id": 1}
+		if result, ok := results[req.Method]; ok {
+			resp["result"] = result
+		} else {
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "Method not found"}
+		}
+		if req.Method == "eth_call" {
+			var call ethCallParams
+			if err := json.Unmarshal(req.Params[0], &call); err != nil {
+				t.Error(err)
+			}
+			if call.To != testTokenContract ||
+				call.Data != erc20BalanceOf+"000000000000000000000000"+testEthAddress[2:] {
+				t.Errorf("unexpected eth_call %+v", call)
+			}
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		cfg      ProviderConfig
+		expected string
+		err      error
+	}{
+		{cfg: ProviderConfig{Provider: "CryptoID"}, expected: ProviderCryptoID},
+		{cfg: ProviderConfig{Provider: ProviderEthplorer, TokenContract: testTokenContract, TokenDecimals: 6}, expected: ProviderEthplorer},
+		{cfg: ProviderConfig{Provider: ProviderXRPScan}, expected: ProviderXRPScan},
+		{cfg: ProviderConfig{Provider: ProviderBitcoind, URL: "http://localhost"}, expected: ProviderBitcoind},
+		{cfg: ProviderConfig{Provider: ProviderGeth, URL: "http://localhost"}, expected: ProviderGeth},
+		{cfg: ProviderConfig{Provider: ProviderBitcoind}, err: errProviderURLUnset},
+		{cfg: ProviderConfig{Provider: ProviderGeth}, err: errProviderURLUnset},
+		{cfg: ProviderConfig{Provider: ProviderBitcoind, URL: "http://localhost", TokenContract: testTokenContract}, err: errTokenNotSupported},
+		{cfg: ProviderConfig{Provider: ProviderGeth, URL: "http://localhost", TokenContract: "0x1"}, err: errInvalidTokenContract},
+		{cfg: ProviderConfig{Provider: ProviderGeth, URL: "http://localhost", TokenDecimals: -1}, err: errTokenDecimalsInvalid},
+		{cfg: ProviderConfig{Provider: "blockchair"}, err: errUnknownProvider},
+	} {
+		p, err := NewProvider(&tt.cfg)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%+v received %v expected %v", tt.cfg, err, tt.err)
+			continue
+		}
+		if err == nil && p.Name() != tt.expected {
+			t.Errorf("%+v received %v expected %v", tt.cfg, p.Name(), tt.expected)
+		}
+	}
+}
+
+func TestGetProvider(t *testing.T) {
+	t.Parallel()
+	if p := GetProvider(currency.ETH); p.Name() != ProviderEthplorer {
+		t.Errorf("received %v expected %v", p.Name(), ProviderEthplorer)
+	}
+	if p := GetProvider(currency.XRP); p.Name() != ProviderXRPScan {
+		t.Errorf("received %v expected %v", p.Name(), ProviderXRPScan)
+	}
+
+	code := currency.NewCode("PROVIDERTEST")
+	if p := GetProvider(code); p.Name() != ProviderCryptoID {
+		t.Errorf("received %v expected %v", p.Name(), ProviderCryptoID)
+	}
+	if err := RegisterProvider(code, nil); !errors.Is(err, errNilProvider) {
+		t.Errorf("received %v expected %v", err, errNilProvider)
+	}
+	err := SetupProviders([]ProviderConfig{
+		{CoinType: "providertest", Provider: ProviderGeth, URL: "http://localhost"},
+		{CoinType: "providertest2", Provider: "blockchair"},
+	})
+	var errs common.Errors
+	if !errors.As(err, &errs) || len(errs) != 1 || !errors.Is(errs[0], errUnknownProvider) {
+		t.Errorf("received %v expected %v", err, errUnknownProvider)
+	}
+	if p := GetProvider(code); p.Name() != ProviderGeth {
+		t.Errorf("received %v expected %v", p.Name(), ProviderGeth)
+	}
+	UnregisterProvider(code)
+	if p := GetProvider(code); p.Name() != ProviderCryptoID {
+		t.Errorf("received %v expected %v", p.Name(), ProviderCryptoID)
+	}
+}
+
+func TestBitcoindProvider(t *testing.T) {
+	t.Parallel()
+	node := newTestNode(t, map[string]interface{}{
+		"scantxoutset": ScanTxOutSetResponse{Success: true, TotalAmount: 1.5},
+	})
+	p := BitcoindProvider{URL: node.URL, Username: "user", Password: "pass"}
+	balance, err := p.GetBalance("1JCe8z4jJVNXSjohjM4i9Hh813dLCNx2Sy", currency.BTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance != 1.5 {
+		t.Errorf("received %v expected %v", balance, 1.5)
+	}
+
+	p.Password = "wrong"
+	if _, err = p.GetBalance("1JCe8z4jJVNXSjohjM4i9Hh813dLCNx2Sy", currency.BTC); err == nil {
+		t.Error("expected error with invalid credentials")
+	}
+}
+
+func TestGethProvider(t *testing.T) {
+	t.Parallel()
+	node := newTestNode(t, map[string]interface{}{
+		"eth_getBalance": "0x1bc16d674ec80000",
+		"eth_call":       "0x00000000000000000000000000000000000000000000000000000000001e8480",
+	})
+	p := GethProvider{URL: node.URL}
+	balance, err := p.GetBalance(testEthAddress, currency.ETH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance != 2 {
+		t.Errorf("received %v expected %v", balance, 2)
+	}
+
+	p.TokenContract = testTokenContract
+	p.TokenDecimals = 6
+	balance, err = p.GetBalance(testEthAddress, currency.USDT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance != 2 {
+		t.Errorf("received %v expected %v", balance, 2)
+	}
+
+	if _, err = p.GetBalance("1JCe8z4jJVNXSjohjM4i9Hh813dLCNx2Sy", currency.USDT); !errors.Is(err, errInvalidEthereumAddr) {
+		t.Errorf("received %v expected %v", err, errInvalidEthereumAddr)
+	}
+
+	node = newTestNode(t, nil)
+	p = GethProvider{URL: node.URL}
+	if _, err = p.GetBalance(testEthAddress, currency.ETH); err == nil {
+		t.Error("expected error from node")
+	}
+}
+
+func TestUpdatePortfolioProvider(t *testing.T) {
+	t.Parallel()
+	code := currency.NewCode("UPDATEPROVIDERTEST")
+	err := RegisterProvider(code, &testBalanceProvider{balances: map[string]float64{"addr": 5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer UnregisterProvider(code)
+
+	var b Base
+	err = b.UpdatePortfolio([]string{"addr"}, code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance, ok := b.GetAddressBalance("addr", PortfolioAddressPersonal, code); !ok || balance != 5 {
+		t.Errorf("received %v %v expected 5", balance, ok)
+	}
+
+	// Addresses cannot be derived for the coin type
+	err = b.UpdatePortfolio([]string{testMasterXPub}, code)
+	if !errors.Is(err, errNoAddressVersion) {
+		t.Errorf("received %v expected %v", err, errNoAddressVersion)
+	}
+}
@@ -0,0 +1,151 @@
+package portfolio
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Balance provider names accepted by NewProvider
+const (
+	ProviderCryptoID  = "cryptoid"
+	ProviderEthplorer = "ethplorer"
+	ProviderXRPScan   = "xrpscan"
+	ProviderBitcoind  = "bitcoind"
+	ProviderGeth      = "geth"
+)
+
+var (
+	errNilProvider            = errors.New("balance provider is nil")
+	errUnknownProvider        = errors.New("unknown balance provider")
+	errProviderURLUnset       = errors.New("balance provider url unset")
+	errInvalidTokenContract   = errors.New("invalid token contract address")
+	errTokenDecimalsInvalid   = errors.New("token decimals must not be negative")
+	errTokenNotSupported      = errors.New("balance provider does not support tokens")
+	errInvalidEthereumAddr    = errors.New("not an Ethereum address")
+	errScanUnsuccessful       = errors.New("utxo set scan unsuccessful")
+	errInvalidExtendedKey     = errors.New("invalid extended public key")
+	errUnsupportedExtendedKey = errors.New("unsupported extended public key version")
+)
+
+// BalanceProvider returns the on-chain balance of an address
+type BalanceProvider interface {
+	Name() string
+	GetBalance(address string, coinType currency.Code) (float64, error)
+}
+
+// ProviderConfig defines a balance provider used for a coin type in place of
+// the default block explorer
+type ProviderConfig struct {
+	CoinType string `json:"coinType"`
+	// Provider is one of cryptoid, ethplorer, xrpscan, bitcoind or geth
+	Provider string `json:"provider"`
+	// URL, Username and Password are the JSON-RPC endpoint and credentials of
+	// a self-hosted bitcoind or geth node
+	URL      string `json:"url,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// TokenContract and TokenDecimals define an ERC-20 token whose balance is
+	// returned instead of the chain's native balance
+	TokenContract string `json:"tokenContract,omitempty"`
+	TokenDecimals int    `json:"tokenDecimals,omitempty"`
+}
+
+// providerRegistry holds the balance providers registered per coin type
+type providerRegistry struct {
+	m         sync.RWMutex
+	providers map[*currency.Item]BalanceProvider
+}
+
+// CryptoIDProvider fetches balances from the CryptoID block explorer
+type CryptoIDProvider struct{}
+
+// EthplorerProvider fetches Ethereum and ERC-20 token balances from the
+// Ethplorer block explorer
+type EthplorerProvider struct {
+	TokenContract string
+	TokenDecimals int
+}
+
+// XRPScanProvider fetches Ripple balances from the XRPScan block explorer
+type XRPScanProvider struct{}
+
+// BitcoindProvider fetches balances from a bitcoind compatible node by
+// scanning its UTXO set, which does not require the address to be in the
+// node's wallet
+type BitcoindProvider struct {
+	URL      string
+	Username string
+	Password string
+}
+
+// GethProvider fetches Ether and ERC-20 token balances from a geth
+// compatible node
+type GethProvider struct {
+	URL           string
+	TokenContract string
+	TokenDecimals int
+}
+
+// EthplorerToken is a token balance held by an Ethplorer address. Balances
+// are in the token's smallest unit
+type EthplorerToken struct {
+	TokenInfo struct {
+		Address string `json:"address"`
+		Symbol  string `json:"symbol"`
+	} `json:"tokenInfo"`
+	Balance float64 `json:"balance"`
+}
+
+// EthplorerTokenResponse holds the token balances of an Ethplorer address
+type EthplorerTokenResponse struct {
+	Address string           `json:"address"`
+	Tokens  []EthplorerToken `json:"tokens"`
+	Error   struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// jsonRPCRequest is a JSON-RPC request sent to a self-hosted node
+type jsonRPCRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// jsonRPCResponse is a JSON-RPC response returned by a self-hosted node
+type jsonRPCResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// ScanTxOutSetResponse holds the result of a bitcoind scantxoutset call
+type ScanTxOutSetResponse struct {
+	Success     bool    `json:"success"`
+	TotalAmount float64 `json:"total_amount"`
+}
+
+// ethCallParams are the parameters of a geth eth_call
+type ethCallParams struct {
+	To   string `json:"to"`
+	Data string `json:"data"`
+}
+
+// ExtendedPublicKey is a BIP32 extended public key from which child addresses
+// are derived
+type ExtendedPublicKey struct {
+	Version     [4]byte
+	Depth       byte
+	Fingerprint [4]byte
+	ChildNumber uint32
+	ChainCode   []byte
+	// Key is the compressed public key
+	Key []byte
+}
@@ -0,0 +1,261 @@
+package taxlot
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ParseMethod returns the lot matching method by name
+func ParseMethod(m string) (Method, error) {
+	switch method := Method(strings.ToLower(m)); method {
+	case FIFO, LIFO, HIFO, AverageCost:
+		return method, nil
+	case "":
+		return FIFO, nil
+	default:
+		return "", fmt.Errorf("%q %w", m, errUnsupportedMethod)
+	}
+}
+
+// Calculate applies the events in time order, matching disposals against
+// acquired lots using the method. Lots are pooled per currency across
+// exchanges. A withdrawal linked to a deposit on another tracked exchange is
+// a transfer and keeps its lots, with any amount lost in transit removed at
+// no gain. Other withdrawals remove lots without realising a gain, and
+// deposits not linked to a tracked withdrawal are acquired as new lots
+func Calculate(events []Event, method Method) (*Report, error) {
+	if _, err := ParseMethod(string(method)); err != nil {
+		return nil, err
+	}
+	sorted := make([]Event, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	// Transfers are deposits and withdrawals sharing a transfer ID, deposits
+	// without a matching withdrawal came from outside the tracked exchanges
+	withdrawn := make(map[string]bool)
+	received := make(map[string]float64)
+	for i := range sorted {
+		if err := validateEvent(&sorted[i]); err != nil {
+			return nil, err
+		}
+		if sorted[i].TransferID == "" {
+			continue
+		}
+		switch sorted[i].Type {
+		case Withdrawal:
+			withdrawn[transferKey(&sorted[i])] = true
+		case Deposit:
+			received[transferKey(&sorted[i])] += sorted[i].Amount
+		}
+	}
+	transfers := make(map[string]float64)
+	for key, amount := range received {
+		if withdrawn[key] {
+			transfers[key] = amount
+		}
+	}
+
+	c := calculator{method: method, lots: make(map[string][]Lot)}
+	for i := range sorted {
+		e := &sorted[i]
+		switch e.Type {
+		case Buy:
+			c.acquire(e, e.Amount*e.Price+e.Fee)
+		case Deposit:
+			if _, ok := transfers[transferKey(e)]; ok && e.TransferID != "" {
+				// Credited by a tracked withdrawal
+				continue
+			}
+			c.acquire(e, e.Amount*e.Price+e.Fee)
+		case Sell:
+			c.dispose(e)
+		case Withdrawal:
+			if received, ok := transfers[transferKey(e)]; ok && e.TransferID != "" {
+				c.remove(e, e.Amount-received)
+				continue
+			}
+			c.remove(e, e.Amount)
+		}
+	}
+
+	r := &Report{Method: method, Disposals: c.disposals}
+	for _, lots := range c.lots {
+		r.OpenLots = append(r.OpenLots, lots...)
+	}
+	sort.Slice(r.OpenLots, func(i, j int) bool {
+		if r.OpenLots[i].Currency.String() != r.OpenLots[j].Currency.String() {
+			return r.OpenLots[i].Currency.String() < r.OpenLots[j].Currency.String()
+		}
+		return r.OpenLots[i].Acquired.Before(r.OpenLots[j].Acquired)
+	})
+	return r, nil
+}
+
+// TaxYear returns the start and end of the tax year beginning in the year on
+// the month and day in the location. The end is exclusive
+func TaxYear(year int, month time.Month, day int, loc *time.Location) (start, end time.Time, err error) {
+	if month < time.January || month > time.December || day < 1 || day > 31 {
+		return start, end, fmt.Errorf("%w: %d-%d", errInvalidTaxYear, month, day)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	start = time.Date(year, month, day, 0, 0, 0, 0, loc)
+	if start.Month() != month {
+		return time.Time{}, end, fmt.Errorf("%w: %d-%d", errInvalidTaxYear, month, day)
+	}
+	return start, start.AddDate(1, 0, 0), nil
+}
+
+// DisposalsBetween returns the disposals made from the start time up to but
+// excluding the end time with their totals
+func (r *Report) DisposalsBetween(start, end time.Time) ([]Disposal, Summary) {
+	var disposals []Disposal
+	var s Summary
+	for i := range r.Disposals {
+		if r.Disposals[i].Disposed.Before(start) || !r.Disposals[i].Disposed.Before(end) {
+			continue
+		}
+		disposals = append(disposals, r.Disposals[i])
+		s.Proceeds += r.Disposals[i].Proceeds
+		s.Cost += r.Disposals[i].Cost
+		s.Gain += r.Disposals[i].Gain
+	}
+	return disposals, s
+}
+
+func validateEvent(e *Event) error {
+	if e.Currency.IsEmpty() {
+		return fmt.Errorf("%s %s %w", e.Exchange, e.ID, errCurrencyUnset)
+	}
+	if e.Amount <= 0 {
+		return fmt.Errorf("%s %s %w", e.Exchange, e.ID, errInvalidAmount)
+	}
+	switch e.Type {
+	case Buy, Sell, Deposit, Withdrawal:
+		return nil
+	default:
+		return fmt.Errorf("%s %s %q %w", e.Exchange, e.ID, e.Type, errInvalidEventType)
+	}
+}
+
+func transferKey(e *Event) string {
+	return e.Currency.Upper().String() + e.TransferID
+}
+
+// calculator holds the open lots of each currency while events are applied
+type calculator struct {
+	method    Method
+	lots      map[string][]Lot
+	disposals []Disposal
+}
+
+func (c *calculator) acquire(e *Event, cost float64) {
+	code := e.Currency.Upper().String()
+	lots := c.lots[code]
+	if c.method == AverageCost && len(lots) > 0 {
+		lots[0].Amount += e.Amount
+		lots[0].Cost += cost
+		return
+	}
+	c.lots[code] = append(lots, Lot{
+		Currency: e.Currency.Upper(),
+		Exchange: e.Exchange,
+		Amount:   e.Amount,
+		Cost:     cost,
+		Acquired: e.Timestamp,
+	})
+}
+
+// dispose matches the sale against open lots and records the realised gain
+// of each part. Any amount beyond the open lots is recorded at zero cost
+func (c *calculator) dispose(e *Event) {
+	proceedsPerUnit := (e.Amount*e.Price - e.Fee) / e.Amount
+	parts, unmatched := c.take(e.Currency.Upper().String(), e.Amount)
+	for i := range parts {
+		proceeds := parts[i].Amount * proceedsPerUnit
+		c.disposals = append(c.disposals, Disposal{
+			Currency: e.Currency.Upper(),
+			Exchange: e.Exchange,
+			Amount:   parts[i].Amount,
+			Acquired: parts[i].Acquired,
+			Disposed: e.Timestamp,
+			Proceeds: proceeds,
+			Cost:     parts[i].Cost,
+			Gain:     proceeds - parts[i].Cost,
+		})
+	}
+	if unmatched > dust {
+		proceeds := unmatched * proceedsPerUnit
+		c.disposals = append(c.disposals, Disposal{
+			Currency: e.Currency.Upper(),
+			Exchange: e.Exchange,
+			Amount:   unmatched,
+			Disposed: e.Timestamp,
+			Proceeds: proceeds,
+			Gain:     proceeds,
+		})
+	}
+}
+
+// remove takes the amount from open lots without realising a gain
+func (c *calculator) remove(e *Event, amount float64) {
+	if amount <= dust {
+		return
+	}
+	c.take(e.Currency.Upper().String(), amount)
+}
+
+// take removes the amount from the currency's open lots in the order of the
+// matching method, returning the parts taken and any amount not covered
+func (c *calculator) take(code string, amount float64) (parts []Lot, unmatched float64) {
+	lots := c.lots[code]
+	for amount > dust && len(lots) > 0 {
+		i := c.next(lots)
+		taken := math.Min(amount, lots[i].Amount)
+		cost := lots[i].Cost * taken / lots[i].Amount
+		parts = append(parts, Lot{
+			Currency: lots[i].Currency,
+			Exchange: lots[i].Exchange,
+			Amount:   taken,
+			Cost:     cost,
+			Acquired: lots[i].Acquired,
+		})
+		lots[i].Amount -= taken
+		lots[i].Cost -= cost
+		amount -= taken
+		if lots[i].Amount <= dust {
+			lots = append(lots[:i], lots[i+1:]...)
+		}
+	}
+	c.lots[code] = lots
+	if len(lots) == 0 {
+		delete(c.lots, code)
+	}
+	return parts, math.Max(amount, 0)
+}
+
+// next returns the index of the lot to dispose of first
+func (c *calculator) next(lots []Lot) int {
+	switch c.method {
+	case LIFO:
+		return len(lots) - 1
+	case HIFO:
+		highest := 0
+		for i := 1; i < len(lots); i++ {
+			if lots[i].Cost/lots[i].Amount > lots[highest].Cost/lots[highest].Amount {
+				highest = i
+			}
+		}
+		return highest
+	default:
+		// FIFO and the single pooled average cost lot
+		return 0
+	}
+}
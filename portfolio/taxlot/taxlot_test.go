@@ -0,0 +1,224 @@
+package taxlot
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func day(d int) time.Time {
+	return testStart.AddDate(0, 0, d)
+}
+
+func closeTo(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// testEvents buys 1 BTC at 100, 1 BTC at 300 and 1 BTC at 200 then sells
+// 1.5 BTC at 400
+func testEvents() []Event {
+	return []Event{
+		{Exchange: "a", ID: "4", Currency: currency.BTC, Type: Sell, Amount: 1.5, Price: 400, Timestamp: day(3)},
+		{Exchange: "a", ID: "1", Currency: currency.BTC, Type: Buy, Amount: 1, Price: 100, Timestamp: day(0)},
+		{Exchange: "a", ID: "2", Currency: currency.BTC, Type: Buy, Amount: 1, Price: 300, Timestamp: day(1)},
+		{Exchange: "a", ID: "3", Currency: currency.BTC, Type: Buy, Amount: 1, Price: 200, Timestamp: day(2)},
+	}
+}
+
+func TestParseMethod(t *testing.T) {
+	t.Parallel()
+	m, err := ParseMethod("HIFO")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != HIFO {
+		t.Errorf("received %v expected %v", m, HIFO)
+	}
+	m, err = ParseMethod("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != FIFO {
+		t.Errorf("received %v expected %v", m, FIFO)
+	}
+	_, err = ParseMethod("random")
+	if !errors.Is(err, errUnsupportedMethod) {
+		t.Errorf("received %v expected %v", err, errUnsupportedMethod)
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		method   Method
+		cost     float64
+		openCost float64
+	}{
+		{method: FIFO, cost: 100 + 150, openCost: 150 + 200},
+		{method: LIFO, cost: 200 + 150, openCost: 100 + 150},
+		{method: HIFO, cost: 300 + 100, openCost: 100 + 100},
+		{method: AverageCost, cost: 300, openCost: 300},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(string(tt.method), func(t *testing.T) {
+			t.Parallel()
+			r, err := Calculate(testEvents(), tt.method)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, s := r.DisposalsBetween(testStart, day(365))
+			if !closeTo(s.Proceeds, 600) {
+				t.Errorf("received proceeds %v expected %v", s.Proceeds, 600)
+			}
+			if !closeTo(s.Cost, tt.cost) {
+				t.Errorf("received cost %v expected %v", s.Cost, tt.cost)
+			}
+			if !closeTo(s.Gain, 600-tt.cost) {
+				t.Errorf("received gain %v expected %v", s.Gain, 600-tt.cost)
+			}
+			var amount, openCost float64
+			for i := range r.OpenLots {
+				amount += r.OpenLots[i].Amount
+				openCost += r.OpenLots[i].Cost
+			}
+			if !closeTo(amount, 1.5) {
+				t.Errorf("received open amount %v expected %v", amount, 1.5)
+			}
+			if !closeTo(openCost, tt.openCost) {
+				t.Errorf("received open cost %v expected %v", openCost, tt.openCost)
+			}
+		})
+	}
+
+	_, err := Calculate(testEvents(), "random")
+	if !errors.Is(err, errUnsupportedMethod) {
+		t.Errorf("received %v expected %v", err, errUnsupportedMethod)
+	}
+	_, err = Calculate([]Event{{Currency: currency.BTC, Type: Buy}}, FIFO)
+	if !errors.Is(err, errInvalidAmount) {
+		t.Errorf("received %v expected %v", err, errInvalidAmount)
+	}
+	_, err = Calculate([]Event{{Currency: currency.BTC, Amount: 1}}, FIFO)
+	if !errors.Is(err, errInvalidEventType) {
+		t.Errorf("received %v expected %v", err, errInvalidEventType)
+	}
+	_, err = Calculate([]Event{{Type: Buy, Amount: 1}}, FIFO)
+	if !errors.Is(err, errCurrencyUnset) {
+		t.Errorf("received %v expected %v", err, errCurrencyUnset)
+	}
+}
+
+func TestCalculateFees(t *testing.T) {
+	t.Parallel()
+	r, err := Calculate([]Event{
+		{Currency: currency.BTC, Type: Buy, Amount: 1, Price: 100, Fee: 10, Timestamp: day(0)},
+		{Currency: currency.BTC, Type: Sell, Amount: 1, Price: 200, Fee: 5, Timestamp: day(1)},
+		{Currency: currency.ETH, Type: Sell, Amount: 2, Price: 50, Timestamp: day(2)},
+	}, FIFO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Disposals) != 2 {
+		t.Fatalf("received %v disposals expected %v", len(r.Disposals), 2)
+	}
+	if !closeTo(r.Disposals[0].Cost, 110) || !closeTo(r.Disposals[0].Proceeds, 195) {
+		t.Errorf("unexpected disposal %+v", r.Disposals[0])
+	}
+	if !r.Disposals[1].Acquired.IsZero() || !closeTo(r.Disposals[1].Gain, 100) {
+		t.Errorf("expected disposal at zero cost, received %+v", r.Disposals[1])
+	}
+	if len(r.OpenLots) != 0 {
+		t.Errorf("received %v open lots expected %v", len(r.OpenLots), 0)
+	}
+}
+
+func TestCalculateTransfers(t *testing.T) {
+	t.Parallel()
+	r, err := Calculate([]Event{
+		{Exchange: "a", Currency: currency.BTC, Type: Buy, Amount: 2, Price: 100, Timestamp: day(0)},
+		{Exchange: "a", Currency: currency.BTC, Type: Withdrawal, Amount: 1, TransferID: "tx", Timestamp: day(1)},
+		{Exchange: "b", Currency: currency.BTC, Type: Deposit, Amount: 0.9, TransferID: "tx", Price: 500, Timestamp: day(2)},
+		{Exchange: "b", Currency: currency.BTC, Type: Sell, Amount: 0.9, Price: 200, Timestamp: day(3)},
+		{Exchange: "a", Currency: currency.BTC, Type: Withdrawal, Amount: 0.5, TransferID: "external", Timestamp: day(4)},
+		{Exchange: "c", Currency: currency.ETH, Type: Deposit, Amount: 1, Price: 50, Timestamp: day(5)},
+	}, FIFO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Disposals) != 1 {
+		t.Fatalf("received %v disposals expected %v", len(r.Disposals), 1)
+	}
+	if !closeTo(r.Disposals[0].Cost, 90) || !closeTo(r.Disposals[0].Gain, 90) {
+		t.Errorf("transfer should keep lot cost, received %+v", r.Disposals[0])
+	}
+	if len(r.OpenLots) != 2 {
+		t.Fatalf("received %v open lots expected %v", len(r.OpenLots), 2)
+	}
+	if !r.OpenLots[0].Currency.Match(currency.BTC) || !closeTo(r.OpenLots[0].Amount, 0.5) {
+		t.Errorf("unexpected open lot %+v", r.OpenLots[0])
+	}
+	if !r.OpenLots[1].Currency.Match(currency.ETH) || !closeTo(r.OpenLots[1].Cost, 50) {
+		t.Errorf("unexpected open lot %+v", r.OpenLots[1])
+	}
+}
+
+func TestCalculateExternalDeposit(t *testing.T) {
+	t.Parallel()
+	r, err := Calculate([]Event{
+		{Exchange: "a", Currency: currency.BTC, Type: Deposit, Amount: 1, TransferID: "onchain", Price: 300, Timestamp: day(0)},
+		{Exchange: "a", Currency: currency.BTC, Type: Sell, Amount: 1, Price: 400, Timestamp: day(1)},
+	}, FIFO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Disposals) != 1 {
+		t.Fatalf("received %v disposals expected %v", len(r.Disposals), 1)
+	}
+	if !closeTo(r.Disposals[0].Cost, 300) || !closeTo(r.Disposals[0].Gain, 100) {
+		t.Errorf("unmatched deposit should be acquired as a lot, received %+v", r.Disposals[0])
+	}
+}
+
+func TestDisposalsBetween(t *testing.T) {
+	t.Parallel()
+	r := &Report{Disposals: []Disposal{
+		{Disposed: day(0), Proceeds: 1, Cost: 1},
+		{Disposed: day(10), Proceeds: 5, Cost: 2, Gain: 3},
+		{Disposed: day(20), Proceeds: 1, Cost: 1},
+	}}
+	d, s := r.DisposalsBetween(day(5), day(20))
+	if len(d) != 1 {
+		t.Fatalf("received %v disposals expected %v", len(d), 1)
+	}
+	if s.Proceeds != 5 || s.Cost != 2 || s.Gain != 3 {
+		t.Errorf("unexpected summary %+v", s)
+	}
+}
+
+func TestTaxYear(t *testing.T) {
+	t.Parallel()
+	start, end, err := TaxYear(2020, time.April, 6, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !start.Equal(time.Date(2020, 4, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("received %v", start)
+	}
+	if !end.Equal(time.Date(2021, 4, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("received %v", end)
+	}
+	_, _, err = TaxYear(2020, time.February, 30, nil)
+	if !errors.Is(err, errInvalidTaxYear) {
+		t.Errorf("received %v expected %v", err, errInvalidTaxYear)
+	}
+	_, _, err = TaxYear(2020, 13, 1, nil)
+	if !errors.Is(err, errInvalidTaxYear) {
+		t.Errorf("received %v expected %v", err, errInvalidTaxYear)
+	}
+}
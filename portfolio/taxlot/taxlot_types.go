@@ -0,0 +1,105 @@
+package taxlot
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Method defines how disposals are matched against acquired lots
+type Method string
+
+// Supported lot matching methods
+const (
+	// FIFO disposes of the earliest acquired lots first
+	FIFO Method = "fifo"
+	// LIFO disposes of the most recently acquired lots first
+	LIFO Method = "lifo"
+	// HIFO disposes of the lots with the highest cost per unit first
+	HIFO Method = "hifo"
+	// AverageCost pools every acquisition of a currency at its average cost
+	AverageCost Method = "average"
+)
+
+// EventType defines how an event changes holdings
+type EventType string
+
+// Event types
+const (
+	Buy        EventType = "buy"
+	Sell       EventType = "sell"
+	Deposit    EventType = "deposit"
+	Withdrawal EventType = "withdrawal"
+)
+
+// dust is the amount below which a lot is considered fully disposed of
+const dust = 1e-12
+
+var (
+	errUnsupportedMethod = errors.New("unsupported lot matching method")
+	errInvalidEventType  = errors.New("invalid event type")
+	errInvalidAmount     = errors.New("event amount must be greater than zero")
+	errCurrencyUnset     = errors.New("event currency unset")
+	errInvalidTaxYear    = errors.New("invalid tax year start")
+)
+
+// Event is a change in the holdings of a currency with its value in the
+// reporting fiat currency
+type Event struct {
+	Exchange string
+	ID       string
+	Currency currency.Code
+	Type     EventType
+	Amount   float64
+	// Price is the fiat value of one unit at the time of the event. Deposits
+	// without a price are acquired at zero cost
+	Price float64
+	// Fee is the fiat value of fees paid, added to the cost of acquisitions
+	// and deducted from the proceeds of disposals
+	Fee float64
+	// TransferID links a withdrawal to the deposit crediting it on another
+	// tracked exchange, usually the blockchain transaction ID. Transfers
+	// between tracked exchanges keep their lots
+	TransferID string
+	Timestamp  time.Time
+}
+
+// Lot is an acquired amount of a currency which has not been disposed of
+type Lot struct {
+	Currency currency.Code
+	Exchange string
+	Amount   float64
+	// Cost is the total fiat cost of the remaining amount
+	Cost     float64
+	Acquired time.Time
+}
+
+// Disposal is a realised gain or loss from disposing of part or all of a lot
+type Disposal struct {
+	Currency currency.Code
+	Exchange string
+	Amount   float64
+	// Acquired is zero when the disposed amount has no known acquisition,
+	// in which case it is reported at zero cost
+	Acquired time.Time
+	Disposed time.Time
+	Proceeds float64
+	Cost     float64
+	Gain     float64
+}
+
+// Report holds the realised gains calculated from a series of events and the
+// lots still held
+type Report struct {
+	Method    Method
+	Disposals []Disposal
+	OpenLots  []Lot
+}
+
+// Summary totals the disposals in a period
+type Summary struct {
+	Proceeds float64
+	Cost     float64
+	Gain     float64
+}
@@ -22,6 +22,32 @@ const (
 	Unknown
 )
 
+const (
+	// ApprovalPending status for requests awaiting sign-off
+	ApprovalPending = "pending"
+	// ApprovalApproved status for requests that received the required approvals
+	ApprovalApproved = "approved"
+	// ApprovalRejected status for requests rejected by an approver
+	ApprovalRejected = "rejected"
+)
+
+const (
+	// TrackingPending status for submitted requests awaiting completion on the
+	// exchange
+	TrackingPending = "pending"
+	// TrackingCompleted status for requests the exchange reports as completed
+	TrackingCompleted = "completed"
+	// TrackingFailed status for requests the exchange reports as failed,
+	// cancelled or rejected
+	TrackingFailed = "failed"
+	// TrackingExpired status for requests that did not complete before the
+	// tracker's maximum age
+	TrackingExpired = "expired"
+	// TrackingUnsupported status for requests on exchanges which do not
+	// support withdrawal history
+	TrackingUnsupported = "unsupported"
+)
+
 const (
 	// ErrStrAmountMustBeGreaterThanZero message to return when requested amount is less than 0
 	ErrStrAmountMustBeGreaterThanZero = "amount must be greater than 0"
@@ -96,6 +122,9 @@ type Request struct {
 	OneTimePassword int64
 	PIN             int64
 
+	// RequestedBy is the RPC user that submitted the request
+	RequestedBy string `json:"requested_by"`
+
 	Crypto CryptoRequest `json:",omitempty"`
 	Fiat   FiatRequest   `json:",omitempty"`
 }
@@ -109,6 +138,19 @@ type Response struct {
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	// ApprovalStatus is empty when no approval is required
+	ApprovalStatus string     `json:"approval_status,omitempty"`
+	Approvals      []Approval `json:"approvals,omitempty"`
+	// TrackingStatus is empty until the request is accepted by the exchange
+	TrackingStatus string `json:"tracking_status,omitempty"`
+}
+
+// Approval holds a single user's sign-off decision on a withdrawal request
+type Approval struct {
+	Username  string    `json:"username"`
+	Approved  bool      `json:"approved"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // ExchangeResponse holds information returned from an exchange
@@ -117,4 +159,5 @@ type ExchangeResponse struct {
 	UUID   uuid.UUID
 	ID     string `json:"id"`
 	Status string `json:"status"`
+	TxID   string `json:"tx_id,omitempty"`
 }
@@ -0,0 +1,326 @@
+package portfolio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"golang.org/x/crypto/ripemd160" // nolint:staticcheck // hash function used by bitcoin addresses
+)
+
+const (
+	base58Alphabet     = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	extendedKeyLength  = 78
+	hardenedKeyStart   = 0x80000000
+	externalChainIndex = 0
+	changeChainIndex   = 1
+)
+
+// ExtendedKeyGapLimit is the number of consecutive empty addresses derived
+// from an extended public key's chain before the chain is considered unused
+var ExtendedKeyGapLimit = 20
+
+var (
+	xpubVersion = [4]byte{0x04, 0x88, 0xb2, 0x1e}
+	ltubVersion = [4]byte{0x01, 0x9d, 0xa4, 0x62}
+
+	secp256k1P, _  = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+	secp256k1N, _  = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+	secp256k1Gx, _ = new(big.Int).SetString("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", 16)
+	secp256k1Gy, _ = new(big.Int).SetString("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8", 16)
+
+	errHardenedDerivation = errors.New("cannot derive hardened child from public key")
+	errInvalidChild       = errors.New("derived child key is invalid")
+	errNoAddressVersion   = errors.New("extended public key addresses not supported for coin type")
+)
+
+// IsExtendedPublicKey returns whether the address is a BTC xpub or LTC Ltub
+// extended public key rather than a single address
+func IsExtendedPublicKey(address string) bool {
+	return strings.HasPrefix(address, "xpub") || strings.HasPrefix(address, "Ltub")
+}
+
+// GetExtendedPublicKeyBalance derives the pay to public key hash addresses of
+// the extended public key's external and change chains and returns their
+// combined balance. Derivation of each chain stops once ExtendedKeyGapLimit
+// consecutive addresses hold no balance
+func GetExtendedPublicKeyBalance(p BalanceProvider, xpub string, coinType currency.Code) (float64, error) {
+	key, err := ParseExtendedPublicKey(xpub)
+	if err != nil {
+		return 0, err
+	}
+	var total float64
+	for _, chainIndex := range []uint32{externalChainIndex, changeChainIndex} {
+		var chain *ExtendedPublicKey
+		chain, err = key.Child(chainIndex)
+		if err != nil {
+			return 0, err
+		}
+		gap := 0
+		for i := uint32(0); gap < ExtendedKeyGapLimit && i < hardenedKeyStart; i++ {
+			var child *ExtendedPublicKey
+			child, err = chain.Child(i)
+			if errors.Is(err, errInvalidChild) {
+				continue
+			}
+			if err != nil {
+				return 0, err
+			}
+			var address string
+			address, err = child.Address(coinType)
+			if err != nil {
+				return 0, err
+			}
+			var balance float64
+			balance, err = p.GetBalance(address, coinType)
+			if err != nil {
+				return 0, err
+			}
+			if balance == 0 {
+				gap++
+				continue
+			}
+			gap = 0
+			total += balance
+		}
+	}
+	return total, nil
+}
+
+// ParseExtendedPublicKey decodes a base58 encoded BIP32 extended public key
+func ParseExtendedPublicKey(s string) (*ExtendedPublicKey, error) {
+	payload, err := base58CheckDecode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) != extendedKeyLength {
+		return nil, fmt.Errorf("%w: length %d", errInvalidExtendedKey, len(payload))
+	}
+	k := &ExtendedPublicKey{
+		Depth:       payload[4],
+		ChildNumber: binary.BigEndian.Uint32(payload[9:13]),
+		ChainCode:   payload[13:45],
+		Key:         payload[45:78],
+	}
+	copy(k.Version[:], payload[:4])
+	copy(k.Fingerprint[:], payload[5:9])
+	if k.Version != xpubVersion && k.Version != ltubVersion {
+		return nil, fmt.Errorf("%w: %x", errUnsupportedExtendedKey, k.Version)
+	}
+	if _, _, err = decompressPublicKey(k.Key); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// String returns the base58 encoded extended public key
+func (k *ExtendedPublicKey) String() string {
+	payload := make([]byte, 0, extendedKeyLength)
+	payload = append(payload, k.Version[:]...)
+	payload = append(payload, k.Depth)
+	payload = append(payload, k.Fingerprint[:]...)
+	var child [4]byte
+	binary.BigEndian.PutUint32(child[:], k.ChildNumber)
+	payload = append(payload, child[:]...)
+	payload = append(payload, k.ChainCode...)
+	payload = append(payload, k.Key...)
+	return base58CheckEncode(payload)
+}
+
+// Child derives the non-hardened child extended public key at the index
+func (k *ExtendedPublicKey) Child(index uint32) (*ExtendedPublicKey, error) {
+	if index >= hardenedKeyStart {
+		return nil, errHardenedDerivation
+	}
+	data := make([]byte, len(k.Key)+4)
+	copy(data, k.Key)
+	binary.BigEndian.PutUint32(data[len(k.Key):], index)
+	sum := crypto.GetHMAC(crypto.HashSHA512, data, k.ChainCode)
+
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(secp256k1N) >= 0 {
+		return nil, errInvalidChild
+	}
+	px, py, err := decompressPublicKey(k.Key)
+	if err != nil {
+		return nil, err
+	}
+	ix, iy := scalarBaseMult(il)
+	cx, cy := addPoints(ix, iy, px, py)
+	if cx == nil {
+		return nil, errInvalidChild
+	}
+
+	child := &ExtendedPublicKey{
+		Version:     k.Version,
+		Depth:       k.Depth + 1,
+		ChildNumber: index,
+		ChainCode:   sum[32:],
+		Key:         compressPublicKey(cx, cy),
+	}
+	copy(child.Fingerprint[:], hash160(k.Key)[:4])
+	return child, nil
+}
+
+// Address returns the pay to public key hash address of the key for the coin
+// type
+func (k *ExtendedPublicKey) Address(coinType currency.Code) (string, error) {
+	var version byte
+	switch {
+	case coinType.Match(currency.BTC):
+		version = 0x00
+	case coinType.Match(currency.LTC):
+		version = 0x30
+	default:
+		return "", fmt.Errorf("%s %w", coinType, errNoAddressVersion)
+	}
+	return base58CheckEncode(append([]byte{version}, hash160(k.Key)...)), nil
+}
+
+func hash160(b []byte) []byte {
+	r := ripemd160.New()
+	r.Write(crypto.GetSHA256(b))
+	return r.Sum(nil)
+}
+
+func doubleSHA256(b []byte) []byte {
+	return crypto.GetSHA256(crypto.GetSHA256(b))
+}
+
+func base58CheckEncode(payload []byte) string {
+	data := make([]byte, 0, len(payload)+4)
+	data = append(data, payload...)
+	data = append(data, doubleSHA256(payload)[:4]...)
+
+	x := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	var encoded []byte
+	for x.Sign() > 0 {
+		x.DivMod(x, radix, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+	for i := 0; i < len(data) && data[i] == 0; i++ {
+		encoded = append(encoded, base58Alphabet[0])
+	}
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
+
+func base58CheckDecode(s string) ([]byte, error) {
+	x := new(big.Int)
+	radix := big.NewInt(58)
+	for i := range s {
+		v := strings.IndexByte(base58Alphabet, s[i])
+		if v < 0 {
+			return nil, fmt.Errorf("%w: invalid base58 character %q", errInvalidExtendedKey, s[i])
+		}
+		x.Mul(x, radix)
+		x.Add(x, big.NewInt(int64(v)))
+	}
+	var leading int
+	for leading < len(s) && s[leading] == base58Alphabet[0] {
+		leading++
+	}
+	data := append(make([]byte, leading), x.Bytes()...)
+	if len(data) < 5 {
+		return nil, fmt.Errorf("%w: too short", errInvalidExtendedKey)
+	}
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	if !bytes.Equal(doubleSHA256(payload)[:4], checksum) {
+		return nil, fmt.Errorf("%w: checksum mismatch", errInvalidExtendedKey)
+	}
+	return payload, nil
+}
+
+// decompressPublicKey returns the secp256k1 point of a compressed public key
+func decompressPublicKey(key []byte) (x, y *big.Int, err error) {
+	if len(key) != 33 || (key[0] != 0x02 && key[0] != 0x03) {
+		return nil, nil, fmt.Errorf("%w: public key not compressed", errInvalidExtendedKey)
+	}
+	x = new(big.Int).SetBytes(key[1:])
+	if x.Cmp(secp256k1P) >= 0 {
+		return nil, nil, fmt.Errorf("%w: public key not on curve", errInvalidExtendedKey)
+	}
+	// y^2 = x^3 + 7, p = 3 mod 4 so the root is (x^3 + 7)^((p+1)/4)
+	ySquared := new(big.Int).Exp(x, big.NewInt(3), secp256k1P)
+	ySquared.Add(ySquared, big.NewInt(7))
+	ySquared.Mod(ySquared, secp256k1P)
+	exp := new(big.Int).Add(secp256k1P, big.NewInt(1))
+	exp.Rsh(exp, 2)
+	y = new(big.Int).Exp(ySquared, exp, secp256k1P)
+	if new(big.Int).Exp(y, big.NewInt(2), secp256k1P).Cmp(ySquared) != 0 {
+		return nil, nil, fmt.Errorf("%w: public key not on curve", errInvalidExtendedKey)
+	}
+	if y.Bit(0) != uint(key[0]&1) {
+		y.Sub(secp256k1P, y)
+	}
+	return x, y, nil
+}
+
+func compressPublicKey(x, y *big.Int) []byte {
+	key := make([]byte, 33)
+	key[0] = 0x02 | byte(y.Bit(0))
+	b := x.Bytes()
+	copy(key[33-len(b):], b)
+	return key
+}
+
+// addPoints adds two secp256k1 points in affine coordinates, a nil x denotes
+// the point at infinity
+func addPoints(x1, y1, x2, y2 *big.Int) (x, y *big.Int) {
+	if x1 == nil {
+		return x2, y2
+	}
+	if x2 == nil {
+		return x1, y1
+	}
+	var lambda *big.Int
+	if x1.Cmp(x2) == 0 {
+		if y1.Cmp(y2) != 0 || y1.Sign() == 0 {
+			return nil, nil
+		}
+		// lambda = 3x^2 / 2y
+		num := new(big.Int).Mul(x1, x1)
+		num.Mul(num, big.NewInt(3))
+		den := new(big.Int).Lsh(y1, 1)
+		den.ModInverse(den, secp256k1P)
+		lambda = num.Mul(num, den)
+	} else {
+		// lambda = (y2 - y1) / (x2 - x1)
+		num := new(big.Int).Sub(y2, y1)
+		den := new(big.Int).Sub(x2, x1)
+		den.Mod(den, secp256k1P)
+		den.ModInverse(den, secp256k1P)
+		lambda = num.Mul(num, den)
+	}
+	lambda.Mod(lambda, secp256k1P)
+	x = new(big.Int).Mul(lambda, lambda)
+	x.Sub(x, x1)
+	x.Sub(x, x2)
+	x.Mod(x, secp256k1P)
+	y = new(big.Int).Sub(x1, x)
+	y.Mul(y, lambda)
+	y.Sub(y, y1)
+	y.Mod(y, secp256k1P)
+	return x, y
+}
+
+// scalarBaseMult multiplies the secp256k1 generator by k
+func scalarBaseMult(k *big.Int) (x, y *big.Int) {
+	bx, by := secp256k1Gx, secp256k1Gy
+	for i := 0; i < k.BitLen(); i++ {
+		if k.Bit(i) == 1 {
+			x, y = addPoints(x, y, bx, by)
+		}
+		bx, by = addPoints(bx, by, bx, by)
+	}
+	return x, y
+}
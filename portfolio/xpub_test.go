@@ -0,0 +1,132 @@
+package portfolio
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// BIP32 test vector 1 extended public keys
+const (
+	testMasterXPub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	// m/0H and m/0H/1
+	testXPub      = "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+	testChildXPub = "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ"
+	// m/0H/1/2H/2 and m/0H/1/2H/2/1000000000
+	testHardenedParentXPub = "xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV"
+	testLargeIndexXPub     = "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy"
+)
+
+func TestParseExtendedPublicKey(t *testing.T) {
+	t.Parallel()
+	k, err := ParseExtendedPublicKey(testMasterXPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.String() != testMasterXPub {
+		t.Errorf("received %v expected %v", k.String(), testMasterXPub)
+	}
+	address, err := k.Address(currency.BTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address != "15mKKb2eos1hWa6tisdPwwDC1a5J1y9nma" {
+		t.Errorf("received %v expected %v", address, "15mKKb2eos1hWa6tisdPwwDC1a5J1y9nma")
+	}
+	if _, err = k.Address(currency.ETH); !errors.Is(err, errNoAddressVersion) {
+		t.Errorf("received %v expected %v", err, errNoAddressVersion)
+	}
+
+	_, err = ParseExtendedPublicKey(testMasterXPub[:len(testMasterXPub)-1] + "9")
+	if !errors.Is(err, errInvalidExtendedKey) {
+		t.Errorf("received %v expected %v", err, errInvalidExtendedKey)
+	}
+	_, err = ParseExtendedPublicKey("xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi")
+	if !errors.Is(err, errUnsupportedExtendedKey) {
+		t.Errorf("received %v expected %v", err, errUnsupportedExtendedKey)
+	}
+}
+
+func TestExtendedPublicKeyChild(t *testing.T) {
+	t.Parallel()
+	k, err := ParseExtendedPublicKey(testXPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := k.Child(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.String() != testChildXPub {
+		t.Errorf("received %v expected %v", child.String(), testChildXPub)
+	}
+	k, err = ParseExtendedPublicKey(testHardenedParentXPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err = k.Child(1000000000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.String() != testLargeIndexXPub {
+		t.Errorf("received %v expected %v", child.String(), testLargeIndexXPub)
+	}
+	if _, err = k.Child(hardenedKeyStart); !errors.Is(err, errHardenedDerivation) {
+		t.Errorf("received %v expected %v", err, errHardenedDerivation)
+	}
+}
+
+type testBalanceProvider struct {
+	balances map[string]float64
+	queried  int
+}
+
+func (t *testBalanceProvider) Name() string {
+	return "test"
+}
+
+func (t *testBalanceProvider) GetBalance(address string, _ currency.Code) (float64, error) {
+	t.queried++
+	return t.balances[address], nil
+}
+
+func TestGetExtendedPublicKeyBalance(t *testing.T) {
+	t.Parallel()
+	k, err := ParseExtendedPublicKey(testMasterXPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := func(chain, index uint32) string {
+		c, err := k.Child(chain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err = c.Child(index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, err := c.Address(currency.LTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+	p := &testBalanceProvider{balances: map[string]float64{
+		address(externalChainIndex, 0):  1,
+		address(externalChainIndex, 19): 2,
+		address(externalChainIndex, 45): 4,
+		address(changeChainIndex, 3):    0.5,
+	}}
+	balance, err := GetExtendedPublicKeyBalance(p, testMasterXPub, currency.LTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The external chain address at index 45 lies beyond the gap limit
+	if balance != 3.5 {
+		t.Errorf("received %v expected %v", balance, 3.5)
+	}
+	if p.queried != 40+24 {
+		t.Errorf("received %v queries expected %v", p.queried, 40+24)
+	}
+}